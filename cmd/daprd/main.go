@@ -9,6 +9,7 @@ import (
 	"os"
 	"os/signal"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -118,7 +119,7 @@ func main() {
 		log.Fatal(err)
 	}
 
-	err = rt.Run(
+	opts := []runtime.Option{
 		runtime.WithSecretStores(
 			secretstores_loader.New("kubernetes", func() secretstores.SecretStore {
 				return sercetstores_kubernetes.NewKubernetesSecretStore(logContrib)
@@ -354,16 +355,35 @@ func main() {
 				return handler
 			}),
 		),
-	)
+	}
+
+	// a single runtime is returned unless several apps are served by this process
+	runtimes, err := rt.TenantRuntimes()
 	if err != nil {
-		log.Fatalf("fatal error from runtime: %s", err)
+		log.Fatal(err)
+	}
+	for _, r := range runtimes {
+		err = r.Run(opts...)
+		if err != nil {
+			log.Fatalf("fatal error from runtime: %s", err)
+		}
 	}
 
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, os.Interrupt)
 	<-stop
 	gracefulShutdownDuration := 5 * time.Second
-	log.Info("dapr shutting down. Waiting 5 seconds to finish outstanding operations")
-	rt.Stop()
-	<-time.After(gracefulShutdownDuration)
+	log.Infof("dapr shutting down. Waiting at least %s to finish outstanding operations", gracefulShutdownDuration)
+	start := time.Now()
+	var wg sync.WaitGroup
+	for _, r := range runtimes {
+		wg.Add(1)
+		go func(r *runtime.DaprRuntime) {
+			defer wg.Done()
+			r.Stop()
+		}(r)
+	}
+	wg.Wait()
+	// components already had their share of the grace period to flush and close
+	<-time.After(gracefulShutdownDuration - time.Since(start))
 }
@@ -15,4 +15,60 @@ type actor struct {
 	lastUsedTime time.Time
 	busy         bool
 	busyCh       chan (bool)
+	busyLock     sync.Mutex
+	pendingCalls int
+	routingKey   string
+	// affinity holds the labels of the hosts the actor was activated for
+	affinity map[string]string
+}
+
+// getRoutingKey returns the placement key the actor was activated with
+func (a *actor) getRoutingKey(actorID string) string {
+	if a.routingKey != "" {
+		return a.routingKey
+	}
+	return actorID
+}
+
+// markBusy registers an ongoing call. Read-only calls can overlap, so the actor stays busy until the last one completes.
+func (a *actor) markBusy() {
+	a.busyLock.Lock()
+	defer a.busyLock.Unlock()
+
+	if a.pendingCalls == 0 {
+		a.busy = true
+		a.busyCh = make(chan bool, 1)
+	}
+	a.pendingCalls++
+	a.lastUsedTime = time.Now().UTC()
+}
+
+// isBusy returns true if the actor has ongoing calls, with the channel closed once they complete
+func (a *actor) isBusy() (bool, chan bool) {
+	a.busyLock.Lock()
+	defer a.busyLock.Unlock()
+
+	return a.busy, a.busyCh
+}
+
+// getLastUsedTime returns the time the latest call to the actor started
+func (a *actor) getLastUsedTime() time.Time {
+	a.busyLock.Lock()
+	defer a.busyLock.Unlock()
+
+	return a.lastUsedTime
+}
+
+// markIdle unregisters an ongoing call and signals waiters once no calls are pending.
+func (a *actor) markIdle() {
+	a.busyLock.Lock()
+	defer a.busyLock.Unlock()
+
+	if a.pendingCalls > 0 {
+		a.pendingCalls--
+	}
+	if a.pendingCalls == 0 && a.busy {
+		a.busy = false
+		close(a.busyCh)
+	}
 }
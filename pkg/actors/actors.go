@@ -19,6 +19,7 @@ import (
 	"github.com/dapr/dapr/pkg/channel"
 	"github.com/dapr/dapr/pkg/config"
 	dapr_credentials "github.com/dapr/dapr/pkg/credentials"
+	"github.com/dapr/dapr/pkg/cron"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/health"
 	"github.com/dapr/dapr/pkg/logger"
@@ -28,6 +29,7 @@ import (
 	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
 	placementv1pb "github.com/dapr/dapr/pkg/proto/placement/v1"
 	"github.com/dapr/dapr/pkg/runtime/security"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
 	"github.com/mitchellh/mapstructure"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc"
@@ -52,6 +54,7 @@ type Actors interface {
 	DeleteState(ctx context.Context, req *DeleteStateRequest) error
 	TransactionalStateOperation(ctx context.Context, req *TransactionalRequest) error
 	GetReminder(ctx context.Context, req *GetReminderRequest) (*Reminder, error)
+	ListReminders(ctx context.Context, req *ListRemindersRequest) (*ListRemindersResponse, error)
 	CreateReminder(ctx context.Context, req *CreateReminderRequest) error
 	DeleteReminder(ctx context.Context, req *DeleteReminderRequest) error
 	CreateTimer(ctx context.Context, req *CreateTimerRequest) error
@@ -63,6 +66,7 @@ type Actors interface {
 type actorsRuntime struct {
 	appChannel          channel.AppChannel
 	store               state.Store
+	storeName           string
 	placementTableLock  *sync.RWMutex
 	placementTables     *placement.ConsistentHashTables
 	placementSignal     chan struct{}
@@ -81,6 +85,8 @@ type actorsRuntime struct {
 	appHealthy          bool
 	certChain           *dapr_credentials.CertChain
 	tracingSpec         config.TracingSpec
+	metadataPolicy      *invokev1.HeaderPolicy
+	responseCache       *responseCache
 }
 
 // ActiveActorsCount contain actorType and count of actors each type has
@@ -90,16 +96,16 @@ type ActiveActorsCount struct {
 }
 
 const (
-	idHeader               = "id"
-	lockOperation          = "lock"
-	unlockOperation        = "unlock"
-	updateOperation        = "update"
-	incompatibleStateStore = "state store does not support transactions which actors require to save state - please see https://github.com/dapr/docs"
+	idHeader        = "id"
+	lockOperation   = "lock"
+	unlockOperation = "unlock"
+	updateOperation = "update"
 )
 
 // NewActors create a new actors runtime with given config
 func NewActors(
 	stateStore state.Store,
+	stateStoreName string,
 	appChannel channel.AppChannel,
 	grpcConnectionFn func(address, id string, skipTLS, recreateIfExists bool) (*grpc.ClientConn, error),
 	config Config,
@@ -109,6 +115,7 @@ func NewActors(
 		appChannel:          appChannel,
 		config:              config,
 		store:               stateStore,
+		storeName:           stateStoreName,
 		placementTableLock:  &sync.RWMutex{},
 		placementTables:     &placement.ConsistentHashTables{Entries: make(map[string]*placement.Consistent)},
 		operationUpdateLock: &sync.Mutex{},
@@ -124,6 +131,7 @@ func NewActors(
 		appHealthy:          true,
 		certChain:           certChain,
 		tracingSpec:         tracingSpec,
+		responseCache:       newResponseCache(config.CachedMethodTTL),
 	}
 }
 
@@ -135,9 +143,11 @@ func (a *actorsRuntime) Init() error {
 		log.Warn("actors: state store must be present to initialize the actor runtime")
 	}
 
-	_, ok := a.store.(state.TransactionalStore)
-	if !ok {
-		return errors.New(incompatibleStateStore)
+	if err := a.requireTransactionalStore(); err != nil {
+		return err
+	}
+	if err := a.initMetadataPolicy(); err != nil {
+		return err
 	}
 
 	go a.connectToPlacementService(a.config.PlacementServiceAddress, a.config.HostAddress, a.config.HeartbeatInterval)
@@ -150,6 +160,19 @@ func (a *actorsRuntime) Init() error {
 	return nil
 }
 
+// initMetadataPolicy builds the allow-list of the metadata forwarded to actor methods
+func (a *actorsRuntime) initMetadataPolicy() error {
+	if len(a.config.ForwardedMetadata) == 0 {
+		return nil
+	}
+	policy, err := invokev1.NewHeaderPolicy(invokev1.HeaderRules{Allow: a.config.ForwardedMetadata})
+	if err != nil {
+		return fmt.Errorf("actors: invalid actorMetadata: %s", err)
+	}
+	a.metadataPolicy = policy
+	return nil
+}
+
 func (a *actorsRuntime) startAppHealthCheck(opts ...health.Option) {
 	if len(a.config.HostedActorTypes) == 0 {
 		return
@@ -176,7 +199,7 @@ func (a *actorsRuntime) deactivateActor(actorType, actorID string) error {
 	req.WithRawData(nil, invokev1.JSONContentType)
 
 	// TODO Propagate context
-	ctx := context.Background()
+	ctx := channel.WithPath(context.Background(), channel.ActorsPath)
 	resp, err := a.appChannel.InvokeMethod(ctx, req)
 	if err != nil {
 		diag.DefaultMonitoring.ActorDeactivationFailed(actorType, "invoke")
@@ -191,6 +214,7 @@ func (a *actorsRuntime) deactivateActor(actorType, actorID string) error {
 
 	actorKey := a.constructCompositeKey(actorType, actorID)
 	a.actorsTable.Delete(actorKey)
+	a.responseCache.invalidate(actorKey)
 	diag.DefaultMonitoring.ActorDeactivated(actorType)
 	return nil
 }
@@ -207,11 +231,11 @@ func (a *actorsRuntime) startDeactivationTicker(interval, actorIdleTimeout time.
 			a.actorsTable.Range(func(key, value interface{}) bool {
 				actorInstance := value.(*actor)
 
-				if actorInstance.busy {
+				if busy, _ := actorInstance.isBusy(); busy {
 					return true
 				}
 
-				durationPassed := t.Sub(actorInstance.lastUsedTime)
+				durationPassed := t.Sub(actorInstance.getLastUsedTime())
 				if durationPassed >= actorIdleTimeout {
 					go func(actorKey string) {
 						actorType, actorID := a.getActorTypeAndIDFromKey(actorKey)
@@ -230,15 +254,22 @@ func (a *actorsRuntime) startDeactivationTicker(interval, actorIdleTimeout time.
 
 func (a *actorsRuntime) Call(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
 	actor := req.Actor()
-	targetActorAddress, appID := a.lookupActorAddress(actor.GetActorType(), actor.GetActorId())
-	if targetActorAddress == "" {
-		return nil, fmt.Errorf("error finding address for actor type %s with id %s", actor.GetActorType(), actor.GetActorId())
-	}
+	hints := getRoutingHints(req.Metadata())
 
-	if a.placementBlock {
+	if a.placementBlock && hints.consistency == ConsistencyStrong {
 		<-a.placementSignal
 	}
 
+	targetActorAddress, appID := a.lookupActorAddress(actor.GetActorType(), hints.routingKey(actor.GetActorId()), hints.affinity)
+	if targetActorAddress == "" {
+		if len(hints.affinity) > 0 {
+			return nil, fmt.Errorf("error finding address for actor type %s with id %s on a host with labels %s",
+				actor.GetActorType(), actor.GetActorId(), placement.FormatLabels(hints.affinity))
+		}
+		return nil, fmt.Errorf("error finding address for actor type %s with id %s", actor.GetActorType(), actor.GetActorId())
+	}
+	version := a.getPlacementVersion()
+
 	var resp *invokev1.InvokeMethodResponse
 	var err error
 
@@ -251,6 +282,8 @@ func (a *actorsRuntime) Call(ctx context.Context, req *invokev1.InvokeMethodRequ
 	if err != nil {
 		return nil, err
 	}
+
+	withPlacementHeaders(resp, targetActorAddress, appID, version)
 	return resp, nil
 }
 
@@ -283,17 +316,40 @@ func (a *actorsRuntime) callLocalActor(ctx context.Context, req *invokev1.Invoke
 	actorTypeID := req.Actor()
 	key := a.constructCompositeKey(actorTypeID.GetActorType(), actorTypeID.GetActorId())
 
+	// the responses of cached methods are reused until the actor's state changes
+	cached := a.isCachedMethod(actorTypeID.GetActorType(), req.Message().Method)
+	var callKey string
+	var generation uint64
+	if cached {
+		callKey = responseCacheKey(req)
+		var resp *invokev1.InvokeMethodResponse
+		if resp, generation = a.responseCache.get(key, callKey); resp != nil {
+			return resp, nil
+		}
+	}
+
+	hints := getRoutingHints(req.Metadata())
 	val, exists := a.actorsTable.LoadOrStore(key, &actor{
 		lock:         &sync.RWMutex{},
-		busy:         true,
 		lastUsedTime: time.Now().UTC(),
-		busyCh:       make(chan bool, 1),
+		routingKey:   hints.routingKey(actorTypeID.GetActorId()),
+		affinity:     hints.affinity,
 	})
 
 	act := val.(*actor)
+	act.markBusy()
+	defer act.markIdle()
+
+	// read-only methods don't mutate the actor state, so they can run concurrently with each other
+	// while still waiting for the in-flight turn to commit
 	lock := act.lock
-	lock.Lock()
-	defer lock.Unlock()
+	if exists && (hints.readOnly || a.isReadOnlyMethod(actorTypeID.GetActorType(), req.Message().Method)) {
+		lock.RLock()
+		defer lock.RUnlock()
+	} else {
+		lock.Lock()
+		defer lock.Unlock()
+	}
 
 	if !exists {
 		err := a.tryActivateActor(actorTypeID.GetActorType(), actorTypeID.GetActorId())
@@ -301,10 +357,6 @@ func (a *actorsRuntime) callLocalActor(ctx context.Context, req *invokev1.Invoke
 			a.actorsTable.Delete(key)
 			return nil, err
 		}
-	} else {
-		act.busy = true
-		act.busyCh = make(chan bool, 1)
-		act.lastUsedTime = time.Now().UTC()
 	}
 
 	// Replace method to actors method
@@ -315,13 +367,9 @@ func (a *actorsRuntime) callLocalActor(ctx context.Context, req *invokev1.Invoke
 	} else {
 		req.Message().HttpExtension.Verb = commonv1pb.HTTPExtension_PUT
 	}
-	resp, err := a.appChannel.InvokeMethod(ctx, req)
-
-	if act.busy {
-		act.busy = false
-		close(act.busyCh)
-	}
-
+	// only the allow-listed metadata of the caller reaches the actor method
+	req.WithHeaderPolicy(a.metadataPolicy)
+	resp, err := a.appChannel.InvokeMethod(channel.WithPath(ctx, channel.ActorsPath), req)
 	if err != nil {
 		return nil, err
 	}
@@ -332,6 +380,9 @@ func (a *actorsRuntime) callLocalActor(ctx context.Context, req *invokev1.Invoke
 		return nil, fmt.Errorf("error from actor service: %s", string(respData))
 	}
 
+	if cached {
+		a.responseCache.put(key, callKey, generation, resp)
+	}
 	return resp, nil
 }
 
@@ -369,7 +420,7 @@ func (a *actorsRuntime) tryActivateActor(actorType, actorID string) error {
 	req.WithRawData(nil, invokev1.JSONContentType)
 
 	// TODO Propagate context
-	ctx := context.Background()
+	ctx := channel.WithPath(context.Background(), channel.ActorsPath)
 	resp, err := a.appChannel.InvokeMethod(ctx, req)
 	if err != nil {
 		return err
@@ -387,6 +438,26 @@ func (a *actorsRuntime) tryActivateActor(actorType, actorID string) error {
 	return nil
 }
 
+// isReadOnlyMethod returns true if the app declared the method of the actor type as read-only
+func (a *actorsRuntime) isReadOnlyMethod(actorType, method string) bool {
+	for _, m := range a.config.ReadOnlyMethods[actorType] {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// isCachedMethod returns true if the app declared the responses of the method of the actor type as cacheable
+func (a *actorsRuntime) isCachedMethod(actorType, method string) bool {
+	for _, m := range a.config.CachedMethods[actorType] {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *actorsRuntime) isActorLocal(targetActorAddress, hostAddress string, grpcPort int) bool {
 	return strings.Contains(targetActorAddress, "localhost") || strings.Contains(targetActorAddress, "127.0.0.1") ||
 		targetActorAddress == fmt.Sprintf("%s:%v", hostAddress, grpcPort)
@@ -449,15 +520,24 @@ func (a *actorsRuntime) TransactionalStateOperation(ctx context.Context, req *Tr
 		}
 	}
 
-	transactionalStore, ok := a.store.(state.TransactionalStore)
-	if !ok {
-		return errors.New(incompatibleStateStore)
+	if err := a.requireTransactionalStore(); err != nil {
+		return err
 	}
 
-	err := transactionalStore.Multi(requests)
+	err := a.store.(state.TransactionalStore).Multi(requests)
+	a.responseCache.invalidate(a.constructCompositeKey(req.ActorType, req.ActorID))
 	return err
 }
 
+// requireTransactionalStore returns a runtime_state.FeatureError if the actor state store doesn't support transactions
+func (a *actorsRuntime) requireTransactionalStore() error {
+	err := runtime_state.RequireFeature(a.storeName, a.store, runtime_state.FeatureTransactional)
+	if err != nil {
+		return fmt.Errorf("actors require transactions to save state: %w", err)
+	}
+	return nil
+}
+
 func (a *actorsRuntime) IsActorHosted(ctx context.Context, req *ActorHostedRequest) bool {
 	key := a.constructCompositeKey(req.ActorType, req.ActorID)
 	_, exists := a.actorsTable.Load(key)
@@ -473,6 +553,7 @@ func (a *actorsRuntime) SaveState(ctx context.Context, req *SaveStateRequest) er
 		Value: req.Value,
 		Key:   key,
 	})
+	a.responseCache.invalidate(a.constructCompositeKey(req.ActorType, req.ActorID))
 	return err
 }
 
@@ -484,6 +565,7 @@ func (a *actorsRuntime) DeleteState(ctx context.Context, req *DeleteStateRequest
 	err := a.store.Delete(&state.DeleteRequest{
 		Key: key,
 	})
+	a.responseCache.invalidate(a.constructCompositeKey(req.ActorType, req.ActorID))
 	return err
 }
 
@@ -504,6 +586,8 @@ func (a *actorsRuntime) connectToPlacementService(placementAddress, hostAddress
 				Entities: a.config.HostedActorTypes,
 				Port:     int64(a.config.Port),
 				Id:       a.config.AppID,
+				Weight:   a.config.PlacementWeight,
+				Labels:   a.config.PlacementLabels,
 			}
 
 			if stream != nil {
@@ -632,7 +716,8 @@ func (a *actorsRuntime) updatePlacements(in *placementv1pb.PlacementTables) {
 		for k, v := range in.Entries {
 			loadMap := map[string]*placement.Host{}
 			for lk, lv := range v.LoadMap {
-				loadMap[lk] = placement.NewHost(lv.Name, lv.Id, lv.Load, lv.Port)
+				loadMap[lk] = placement.NewHost(lv.Name, lv.Id, lv.Load, lv.Port, lv.Weight)
+				loadMap[lk].Labels = lv.Labels
 			}
 			c := placement.NewFromExisting(v.Hosts, v.SortedSet, loadMap)
 			a.placementTables.Entries[k] = c
@@ -658,7 +743,8 @@ func (a *actorsRuntime) drainRebalancedActors() {
 			// for each actor, deactivate if no longer hosted locally
 			actorKey := key.(string)
 			actorType, actorID := a.getActorTypeAndIDFromKey(actorKey)
-			address, _ := a.lookupActorAddress(actorType, actorID)
+			act := value.(*actor)
+			address, _ := a.lookupActorAddress(actorType, act.getRoutingKey(actorID), act.affinity)
 			if address != "" && !a.isActorLocal(address, a.config.HostAddress, a.config.Port) {
 				// actor has been moved to a different host, deactivate when calls are done
 				// cancel any reminders
@@ -677,11 +763,11 @@ func (a *actorsRuntime) drainRebalancedActors() {
 				actor := value.(*actor)
 				if a.config.DrainRebalancedActors {
 					// wait until actor isn't busy or timeout hits
-					if actor.busy {
+					if busy, busyCh := actor.isBusy(); busy {
 						select {
 						case <-time.After(a.config.DrainOngoingCallTimeout):
 							break
-						case <-actor.busyCh:
+						case <-busyCh:
 							// if a call comes in from the actor for state changes, that's still allowed
 							break
 						}
@@ -690,12 +776,13 @@ func (a *actorsRuntime) drainRebalancedActors() {
 
 				// don't allow state changes
 				a.actorsTable.Delete(key)
+				a.responseCache.invalidate(actorKey)
 
 				diag.DefaultMonitoring.ActorRebalanced(actorType)
 
 				for {
 					// wait until actor is not busy, then deactivate
-					if !actor.busy {
+					if busy, _ := actor.isBusy(); !busy {
 						err := a.deactivateActor(actorType, actorID)
 						if err != nil {
 							log.Warnf("failed to deactivate actor %s: %s", actorKey, err)
@@ -732,7 +819,7 @@ func (a *actorsRuntime) evaluateReminders() {
 				defer wg.Done()
 
 				for _, r := range reminders {
-					targetActorAddress, _ := a.lookupActorAddress(r.ActorType, r.ActorID)
+					targetActorAddress, _ := a.lookupActorAddress(r.ActorType, routingHints{partitionKey: r.PartitionKey}.routingKey(r.ActorID), r.affinityLabels())
 					if targetActorAddress == "" {
 						continue
 					}
@@ -758,7 +845,9 @@ func (a *actorsRuntime) evaluateReminders() {
 	a.evaluationBusy = false
 }
 
-func (a *actorsRuntime) lookupActorAddress(actorType, actorID string) (string, string) {
+// lookupActorAddress returns the address and app ID of the host of an actor, among the hosts with the labels of the
+// affinity if it has any. The address is empty when no host is found.
+func (a *actorsRuntime) lookupActorAddress(actorType, actorID string, affinity map[string]string) (string, string) {
 	// read lock for table map
 	a.placementTableLock.RLock()
 	defer a.placementTableLock.RUnlock()
@@ -767,13 +856,19 @@ func (a *actorsRuntime) lookupActorAddress(actorType, actorID string) (string, s
 	if t == nil {
 		return "", ""
 	}
-	host, err := t.GetHost(actorID)
+	host, err := t.GetMatchingHost(actorID, affinity)
 	if err != nil || host == nil {
 		return "", ""
 	}
 	return fmt.Sprintf("%s:%v", host.Name, host.Port), host.AppID
 }
 
+func (a *actorsRuntime) getPlacementVersion() string {
+	a.placementTableLock.RLock()
+	defer a.placementTableLock.RUnlock()
+	return a.placementTables.Version
+}
+
 func (a *actorsRuntime) getReminderTrack(actorKey, name string) (*ReminderTrack, error) {
 	resp, err := a.store.Get(&state.GetRequest{
 		Key: a.constructCompositeKey(actorKey, name),
@@ -807,9 +902,12 @@ func (a *actorsRuntime) getUpcomingReminderInvokeTime(reminder *Reminder) (time.
 		return nextInvokeTime, fmt.Errorf("error parsing reminder registered time: %s", err)
 	}
 
-	dueTime, err := time.ParseDuration(reminder.DueTime)
-	if err != nil {
-		return nextInvokeTime, fmt.Errorf("error parsing reminder due time: %s", err)
+	var dueTime time.Duration
+	if reminder.DueTime != "" || reminder.Schedule == "" {
+		dueTime, err = time.ParseDuration(reminder.DueTime)
+		if err != nil {
+			return nextInvokeTime, fmt.Errorf("error parsing reminder due time: %s", err)
+		}
 	}
 
 	key := a.constructCompositeKey(reminder.ActorType, reminder.ActorID)
@@ -826,7 +924,22 @@ func (a *actorsRuntime) getUpcomingReminderInvokeTime(reminder *Reminder) (time.
 		}
 	}
 
-	if reminder.Period != "" {
+	if reminder.Schedule != "" {
+		schedule, err := cron.Parse(reminder.Schedule, reminder.TimeZone)
+		if err != nil {
+			return nextInvokeTime, fmt.Errorf("error parsing reminder schedule: %s", err)
+		}
+
+		// the due time delays the first evaluation of the schedule
+		if !lastFiredTime.IsZero() {
+			nextInvokeTime = schedule.Next(lastFiredTime)
+		} else {
+			nextInvokeTime = schedule.Next(registeredTime.Add(dueTime))
+		}
+		if nextInvokeTime.IsZero() {
+			return nextInvokeTime, fmt.Errorf("reminder schedule %s has no upcoming fire time", reminder.Schedule)
+		}
+	} else if reminder.Period != "" {
 		period, err := time.ParseDuration(reminder.Period)
 		if err != nil {
 			return nextInvokeTime, fmt.Errorf("error parsing reminder period: %s", err)
@@ -856,13 +969,32 @@ func (a *actorsRuntime) startReminder(reminder *Reminder) error {
 		return err
 	}
 
+	missedFires, missedCount, nextInvokeTime, err := getMissedReminderFires(reminder, nextInvokeTime, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if missedCount > 0 {
+		policy := reminder.MissedFirePolicy
+		if policy == "" {
+			policy = MissedFirePolicyFireOnce
+		}
+		log.Debugf("reminder %s missed %v fire time(s), applying policy %s", reminderKey, missedCount, policy)
+		diag.DefaultMonitoring.ActorReminderMissed(reminder.ActorType, policy, missedCount)
+	}
+
+	if reminder.Schedule != "" {
+		a.startScheduledReminder(*reminder, reminderKey, missedFires, nextInvokeTime)
+		return nil
+	}
+
 	go func() {
-		now := time.Now().UTC()
-		initialDuration := nextInvokeTime.Sub(now)
-		time.Sleep(initialDuration)
-		err = a.executeReminder(reminder.ActorType, reminder.ActorID, reminder.DueTime, reminder.Period, reminder.Name, reminder.Data)
-		if err != nil {
-			log.Errorf("error executing reminder: %s", err)
+		for _, fireTime := range missedFires {
+			a.fireReminder(reminder, fireTime)
+		}
+
+		if !nextInvokeTime.IsZero() {
+			time.Sleep(time.Until(nextInvokeTime))
+			a.fireReminder(reminder, nextInvokeTime)
 		}
 
 		if reminder.Period != "" {
@@ -875,19 +1007,17 @@ func (a *actorsRuntime) startReminder(reminder *Reminder) error {
 			a.activeReminders.Store(reminderKey, stop)
 
 			t := a.configureTicker(period)
-			go func(ticker *time.Ticker, stop chan (bool), actorType, actorID, reminder, dueTime, period string, data interface{}) {
+			go func(ticker *time.Ticker, stop chan (bool), reminder Reminder, fireTime time.Time) {
 				for {
 					select {
 					case <-ticker.C:
-						err := a.executeReminder(actorType, actorID, dueTime, period, reminder, data)
-						if err != nil {
-							log.Debugf("error invoking reminder on actor %s: %s", a.constructCompositeKey(actorType, actorID), err)
-						}
+						fireTime = fireTime.Add(period)
+						a.fireReminder(&reminder, fireTime)
 					case <-stop:
 						return
 					}
 				}
-			}(t, stop, reminder.ActorType, reminder.ActorID, reminder.Name, reminder.DueTime, reminder.Period, reminder.Data)
+			}(t, stop, *reminder, nextInvokeTime)
 		} else {
 			err := a.DeleteReminder(context.TODO(), &DeleteReminderRequest{
 				Name:      reminder.Name,
@@ -903,35 +1033,98 @@ func (a *actorsRuntime) startReminder(reminder *Reminder) error {
 	return nil
 }
 
-func (a *actorsRuntime) executeReminder(actorType, actorID, dueTime, period, reminder string, data interface{}) error {
+// startScheduledReminder fires a reminder at the times of its cron schedule until the reminder is deleted
+func (a *actorsRuntime) startScheduledReminder(reminder Reminder, reminderKey string, missedFires []time.Time, nextInvokeTime time.Time) {
+	schedule, err := cron.Parse(reminder.Schedule, reminder.TimeZone)
+	if err != nil {
+		log.Errorf("error parsing reminder schedule: %s", err)
+		return
+	}
+
+	stop := make(chan bool, 1)
+	a.activeReminders.Store(reminderKey, stop)
+
+	go func() {
+		for _, fireTime := range missedFires {
+			a.fireReminder(&reminder, fireTime)
+		}
+
+		for !nextInvokeTime.IsZero() {
+			timer := time.NewTimer(time.Until(nextInvokeTime))
+			select {
+			case <-timer.C:
+			case <-stop:
+				timer.Stop()
+				return
+			}
+
+			a.fireReminder(&reminder, nextInvokeTime)
+
+			// fire times missed while the reminder was running are skipped
+			now := time.Now()
+			if now.After(nextInvokeTime) {
+				nextInvokeTime = schedule.Next(now)
+			} else {
+				nextInvokeTime = schedule.Next(nextInvokeTime)
+			}
+		}
+		log.Debugf("reminder %s has no upcoming fire time", reminderKey)
+	}()
+}
+
+// fireReminder executes a reminder and records how late it fired compared to its scheduled fire time
+func (a *actorsRuntime) fireReminder(reminder *Reminder, fireTime time.Time) {
+	diag.DefaultMonitoring.ActorReminderFired(reminder.ActorType, time.Since(fireTime))
+
+	err := a.executeReminder(reminder)
+	if err != nil {
+		log.Debugf("error invoking reminder on actor %s: %s", a.constructCompositeKey(reminder.ActorType, reminder.ActorID), err)
+	}
+}
+
+func (a *actorsRuntime) executeReminder(reminder *Reminder) error {
 	r := ReminderResponse{
-		DueTime: dueTime,
-		Period:  period,
-		Data:    data,
+		DueTime:  reminder.DueTime,
+		Period:   reminder.Period,
+		Schedule: reminder.Schedule,
+		TimeZone: reminder.TimeZone,
+		Data:     reminder.Data,
 	}
 	b, err := json.Marshal(&r)
 	if err != nil {
 		return err
 	}
 
-	log.Debugf("executing reminder %s for actor type %s with id %s", reminder, actorType, actorID)
-	req := invokev1.NewInvokeMethodRequest(fmt.Sprintf("remind/%s", reminder))
-	req.WithActor(actorType, actorID)
+	log.Debugf("executing reminder %s for actor type %s with id %s", reminder.Name, reminder.ActorType, reminder.ActorID)
+	req := invokev1.NewInvokeMethodRequest(fmt.Sprintf("remind/%s", reminder.Name))
+	req.WithActor(reminder.ActorType, reminder.ActorID)
 	req.WithRawData(b, invokev1.JSONContentType)
+	md := map[string][]string{}
+	if reminder.PartitionKey != "" {
+		md[PartitionKeyHeader] = []string{reminder.PartitionKey}
+	}
+	if reminder.Affinity != "" {
+		md[AffinityHeader] = []string{reminder.Affinity}
+	}
+	if len(md) > 0 {
+		req.WithMetadata(md)
+	}
 
 	_, err = a.callLocalActor(context.Background(), req)
 	if err == nil {
-		key := a.constructCompositeKey(actorType, actorID)
-		a.updateReminderTrack(key, reminder)
+		key := a.constructCompositeKey(reminder.ActorType, reminder.ActorID)
+		a.updateReminderTrack(key, reminder.Name)
 	} else {
-		log.Debugf("error execution of reminder %s for actor type %s with id %s: %s", reminder, actorType, actorID, err)
+		log.Debugf("error execution of reminder %s for actor type %s with id %s: %s", reminder.Name, reminder.ActorType, reminder.ActorID, err)
 	}
 	return err
 }
 
 func (a *actorsRuntime) reminderRequiresUpdate(req *CreateReminderRequest, reminder *Reminder) bool {
 	if reminder.ActorID == req.ActorID && reminder.ActorType == req.ActorType && reminder.Name == req.Name &&
-		(reminder.Data != req.Data || reminder.DueTime != req.DueTime || reminder.Period != req.Period) {
+		(reminder.Data != req.Data || reminder.DueTime != req.DueTime || reminder.Period != req.Period || reminder.PartitionKey != req.PartitionKey ||
+			reminder.Schedule != req.Schedule || reminder.TimeZone != req.TimeZone || reminder.MissedFirePolicy != req.MissedFirePolicy ||
+			reminder.Affinity != req.Affinity) {
 		return true
 	}
 
@@ -953,6 +1146,11 @@ func (a *actorsRuntime) getReminder(req *CreateReminderRequest) (*Reminder, bool
 }
 
 func (a *actorsRuntime) CreateReminder(ctx context.Context, req *CreateReminderRequest) error {
+	err := validateReminderRequest(req)
+	if err != nil {
+		return err
+	}
+
 	r, exists := a.getReminder(req)
 	if exists {
 		if a.reminderRequiresUpdate(req, r) {
@@ -979,13 +1177,18 @@ func (a *actorsRuntime) CreateReminder(ctx context.Context, req *CreateReminderR
 	}
 
 	reminder := Reminder{
-		ActorID:        req.ActorID,
-		ActorType:      req.ActorType,
-		Name:           req.Name,
-		Data:           req.Data,
-		Period:         req.Period,
-		DueTime:        req.DueTime,
-		RegisteredTime: time.Now().UTC().Format(time.RFC3339),
+		ActorID:          req.ActorID,
+		ActorType:        req.ActorType,
+		Name:             req.Name,
+		Data:             req.Data,
+		Period:           req.Period,
+		DueTime:          req.DueTime,
+		RegisteredTime:   time.Now().UTC().Format(time.RFC3339),
+		PartitionKey:     req.PartitionKey,
+		Schedule:         req.Schedule,
+		TimeZone:         req.TimeZone,
+		MissedFirePolicy: req.MissedFirePolicy,
+		Affinity:         req.Affinity,
 	}
 
 	reminders, err := a.getRemindersForActorType(req.ActorType)
@@ -1014,6 +1217,34 @@ func (a *actorsRuntime) CreateReminder(ctx context.Context, req *CreateReminderR
 	return nil
 }
 
+// validateReminderRequest checks that a reminder uses either a period or a valid cron schedule and a known missed fire policy
+func validateReminderRequest(req *CreateReminderRequest) error {
+	if !IsValidMissedFirePolicy(req.MissedFirePolicy) {
+		return fmt.Errorf("error creating reminder: missedFirePolicy must be %s, %s or %s", MissedFirePolicyFireOnce, MissedFirePolicyFireAll, MissedFirePolicySkip)
+	}
+	if err := ValidateAffinity(req.Affinity); err != nil {
+		return fmt.Errorf("error creating reminder: affinity: %s", err)
+	}
+	if req.Schedule == "" {
+		if req.TimeZone != "" {
+			return errors.New("error creating reminder: timezone requires a schedule")
+		}
+		return nil
+	}
+	if req.Period != "" {
+		return errors.New("error creating reminder: period and schedule are mutually exclusive")
+	}
+	if req.DueTime != "" {
+		if _, err := time.ParseDuration(req.DueTime); err != nil {
+			return fmt.Errorf("error creating reminder: %s", err)
+		}
+	}
+	if _, err := cron.Parse(req.Schedule, req.TimeZone); err != nil {
+		return fmt.Errorf("error creating reminder: %s", err)
+	}
+	return nil
+}
+
 func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest) error {
 	actorKey := a.constructCompositeKey(req.ActorType, req.ActorID)
 	timerKey := a.constructCompositeKey(actorKey, req.Name)
@@ -1023,11 +1254,20 @@ func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest
 		return fmt.Errorf("can't create timer for actor %s: actor not activated", actorKey)
 	}
 
+	if err := validateTimerRequest(req); err != nil {
+		return err
+	}
+
 	stopChan, exists := a.activeTimers.Load(timerKey)
 	if exists {
 		close(stopChan.(chan bool))
 	}
 
+	if req.Schedule != "" {
+		a.startScheduledTimer(ctx, req, timerKey)
+		return nil
+	}
+
 	d, err := time.ParseDuration(req.Period)
 	if err != nil {
 		return err
@@ -1071,6 +1311,78 @@ func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest
 	return nil
 }
 
+// validateTimerRequest checks that a timer uses either a period or a valid cron schedule
+func validateTimerRequest(req *CreateTimerRequest) error {
+	if req.Schedule == "" {
+		if req.TimeZone != "" {
+			return errors.New("error creating timer: timezone requires a schedule")
+		}
+		return nil
+	}
+	if req.Period != "" {
+		return errors.New("error creating timer: period and schedule are mutually exclusive")
+	}
+	if req.DueTime != "" {
+		if _, err := time.ParseDuration(req.DueTime); err != nil {
+			return fmt.Errorf("error creating timer: %s", err)
+		}
+	}
+	if _, err := cron.Parse(req.Schedule, req.TimeZone); err != nil {
+		return fmt.Errorf("error creating timer: %s", err)
+	}
+	return nil
+}
+
+// startScheduledTimer fires a timer at the times of its cron schedule until the timer is deleted or the actor is
+// deactivated. The schedule of the request must be valid.
+func (a *actorsRuntime) startScheduledTimer(ctx context.Context, req *CreateTimerRequest, timerKey string) {
+	schedule, _ := cron.Parse(req.Schedule, req.TimeZone)
+	start := time.Now()
+	if req.DueTime != "" {
+		d, _ := time.ParseDuration(req.DueTime)
+		start = start.Add(d)
+	}
+
+	stop := make(chan bool, 1)
+	a.activeTimers.Store(timerKey, stop)
+
+	go func(r CreateTimerRequest) {
+		actorKey := a.constructCompositeKey(r.ActorType, r.ActorID)
+		nextInvokeTime := schedule.Next(start)
+		for !nextInvokeTime.IsZero() {
+			timer := time.NewTimer(time.Until(nextInvokeTime))
+			select {
+			case <-timer.C:
+			case <-stop:
+				timer.Stop()
+				return
+			}
+
+			if _, exists := a.actorsTable.Load(actorKey); !exists {
+				a.DeleteTimer(ctx, &DeleteTimerRequest{
+					Name:      r.Name,
+					ActorID:   r.ActorID,
+					ActorType: r.ActorType,
+				})
+				return
+			}
+			err := a.executeTimer(r.ActorType, r.ActorID, r.Name, r.DueTime, r.Period, r.Callback, r.Data)
+			if err != nil {
+				log.Debugf("error invoking timer on actor %s: %s", actorKey, err)
+			}
+
+			// fire times missed while the timer was running are skipped
+			now := time.Now()
+			if now.After(nextInvokeTime) {
+				nextInvokeTime = schedule.Next(now)
+			} else {
+				nextInvokeTime = schedule.Next(nextInvokeTime)
+			}
+		}
+		log.Debugf("timer %s has no upcoming fire time", timerKey)
+	}(*req)
+}
+
 func (a *actorsRuntime) configureTicker(d time.Duration) *time.Ticker {
 	if d == 0 {
 		// NewTicker cannot take in 0.  The ticker is not exact anyways since it fires
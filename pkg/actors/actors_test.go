@@ -8,6 +8,7 @@ package actors
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"strings"
 	"sync"
@@ -19,6 +20,8 @@ import (
 	"github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/health"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/placement"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -79,12 +82,12 @@ func newTestActorsRuntime() *actorsRuntime {
 	mockAppChannel.On("GetBaseAddress").Return("http://127.0.0.1", nil)
 	mockAppChannel.On(
 		"InvokeMethod",
-		mock.AnythingOfType("*context.emptyCtx"),
+		mock.AnythingOfType("*context.valueCtx"),
 		mock.AnythingOfType("*v1.InvokeMethodRequest")).Return(fakeResp, nil)
 
 	store := fakeStore()
-	config := NewConfig("", TestAppID, "", nil, 0, "", "", "", false)
-	a := NewActors(store, mockAppChannel, nil, config, nil, spec)
+	config := NewConfig("", TestAppID, "", nil, 0, "", "", "", false, nil)
+	a := NewActors(store, "actorStore", mockAppChannel, nil, config, nil, spec)
 
 	return a.(*actorsRuntime)
 }
@@ -192,7 +195,7 @@ func TestReminderExecution(t *testing.T) {
 	actorKey := testActorsRuntime.constructCompositeKey(actorType, actorID)
 	fakeCallAndActivateActor(testActorsRuntime, actorKey)
 
-	err := testActorsRuntime.executeReminder(actorType, actorID, "2s", "2s", "reminder1", "data")
+	err := testActorsRuntime.executeReminder(&Reminder{ActorType: actorType, ActorID: actorID, DueTime: "2s", Period: "2s", Name: "reminder1", Data: "data"})
 	assert.Nil(t, err)
 }
 
@@ -202,7 +205,7 @@ func TestReminderExecutionZeroDuration(t *testing.T) {
 	actorKey := testActorsRuntime.constructCompositeKey(actorType, actorID)
 	fakeCallAndActivateActor(testActorsRuntime, actorKey)
 
-	err := testActorsRuntime.executeReminder(actorType, actorID, "0ms", "0ms", "reminder0", "data")
+	err := testActorsRuntime.executeReminder(&Reminder{ActorType: actorType, ActorID: actorID, DueTime: "0ms", Period: "0ms", Name: "reminder0", Data: "data"})
 	assert.Nil(t, err)
 }
 
@@ -245,6 +248,151 @@ func TestCreateReminder(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCreateScheduledReminder(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("valid schedule", func(t *testing.T) {
+		testActorsRuntime := newTestActorsRuntime()
+		actorType, actorID := getTestActorTypeAndID()
+		err := testActorsRuntime.CreateReminder(ctx, &CreateReminderRequest{
+			ActorID:   actorID,
+			ActorType: actorType,
+			Name:      "reminder1",
+			Schedule:  "0 9 * * MON-FRI",
+			TimeZone:  "Europe/Paris",
+		})
+		assert.Nil(t, err)
+
+		reminders, err := testActorsRuntime.getRemindersForActorType(actorType)
+		assert.Nil(t, err)
+		assert.Equal(t, "0 9 * * MON-FRI", reminders[0].Schedule)
+		assert.Equal(t, "Europe/Paris", reminders[0].TimeZone)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		testActorsRuntime := newTestActorsRuntime()
+		actorType, actorID := getTestActorTypeAndID()
+		requests := []CreateReminderRequest{
+			{Schedule: "0 9 * * MON-FRI", Period: "1s"},
+			{Schedule: "0 25 * * *"},
+			{Schedule: "0 9 * * *", TimeZone: "Mars/Olympus"},
+			{Period: "1s", DueTime: "1s", TimeZone: "Europe/Paris"},
+			{Period: "1s", DueTime: "1s", MissedFirePolicy: "fireTwice"},
+			{Period: "1s", DueTime: "1s", Affinity: "gpu"},
+			{Period: "1s", DueTime: "1s", Affinity: "=gpu"},
+		}
+		for _, req := range requests {
+			req.ActorID, req.ActorType, req.Name = actorID, actorType, "reminder1"
+			assert.NotNil(t, testActorsRuntime.CreateReminder(ctx, &req))
+		}
+	})
+}
+
+func TestReminderAffinity(t *testing.T) {
+	testActorsRuntime := newTestActorsRuntime()
+	actorType, actorID := getTestActorTypeAndID()
+	err := testActorsRuntime.CreateReminder(context.Background(), &CreateReminderRequest{
+		ActorID:   actorID,
+		ActorType: actorType,
+		Name:      "reminder1",
+		Period:    "1s",
+		DueTime:   "1s",
+		Affinity:  "pool=gpu",
+	})
+	assert.Nil(t, err)
+
+	reminders, err := testActorsRuntime.getRemindersForActorType(actorType)
+	assert.Nil(t, err)
+	assert.Equal(t, "pool=gpu", reminders[0].Affinity)
+
+	// the actor is activated for the hosts of the affinity
+	err = testActorsRuntime.executeReminder(&reminders[0])
+	assert.Nil(t, err)
+	act, ok := testActorsRuntime.actorsTable.Load(testActorsRuntime.constructCompositeKey(actorType, actorID))
+	assert.True(t, ok)
+	assert.Equal(t, map[string]string{"pool": "gpu"}, act.(*actor).affinity)
+}
+
+func TestGetUpcomingScheduledReminderInvokeTime(t *testing.T) {
+	testActorsRuntime := newTestActorsRuntime()
+	actorType, actorID := getTestActorTypeAndID()
+
+	nextInvokeTime, err := testActorsRuntime.getUpcomingReminderInvokeTime(&Reminder{
+		ActorID:        actorID,
+		ActorType:      actorType,
+		Name:           "reminder1",
+		RegisteredTime: "2020-03-27T12:00:00Z",
+		Schedule:       "0 9 * * *",
+		TimeZone:       "Europe/Paris",
+	})
+	assert.Nil(t, err)
+	// Paris switches to summer time on March 29, 2020
+	assert.Equal(t, "2020-03-28T08:00:00Z", nextInvokeTime.UTC().Format(time.RFC3339))
+}
+
+func TestListReminders(t *testing.T) {
+	testActorsRuntime := newTestActorsRuntime()
+	ctx := context.Background()
+	registered := time.Date(2020, 3, 27, 12, 0, 0, 0, time.UTC)
+	reminders := []Reminder{
+		{ActorType: "cat", ActorID: "b", Name: "r1", DueTime: "1h", RegisteredTime: registered.Format(time.RFC3339)},
+		{ActorType: "cat", ActorID: "a", Name: "r2", DueTime: "3h", RegisteredTime: registered.Format(time.RFC3339)},
+		{ActorType: "cat", ActorID: "a", Name: "r1", DueTime: "2h", RegisteredTime: registered.Format(time.RFC3339)},
+		{ActorType: "cat", ActorID: "c", Name: "broken", DueTime: "never", RegisteredTime: registered.Format(time.RFC3339)},
+	}
+	testActorsRuntime.store.Set(&state.SetRequest{Key: testActorsRuntime.constructCompositeKey("actors", "cat"), Value: reminders})
+	names := func(resp *ListRemindersResponse) []string {
+		n := []string{}
+		for _, r := range resp.Reminders {
+			n = append(n, r.ActorID+"/"+r.Name)
+		}
+		return n
+	}
+
+	t.Run("pages through the reminders by actor and name", func(t *testing.T) {
+		resp, err := testActorsRuntime.ListReminders(ctx, &ListRemindersRequest{ActorType: "cat", PageSize: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a/r1", "a/r2"}, names(resp))
+		assert.Equal(t, registered.Add(2*time.Hour).Format(time.RFC3339), resp.Reminders[0].NextFireTime)
+		assert.NotEmpty(t, resp.NextPageToken)
+
+		resp, err = testActorsRuntime.ListReminders(ctx, &ListRemindersRequest{ActorType: "cat", PageSize: 2, PageToken: resp.NextPageToken})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"b/r1", "c/broken"}, names(resp))
+		assert.Empty(t, resp.Reminders[1].NextFireTime)
+		assert.Empty(t, resp.NextPageToken)
+	})
+
+	t.Run("due time filters", func(t *testing.T) {
+		resp, err := testActorsRuntime.ListReminders(ctx, &ListRemindersRequest{
+			ActorType: "cat",
+			DueAfter:  registered.Add(2 * time.Hour),
+			DueBefore: registered.Add(3 * time.Hour),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a/r1"}, names(resp))
+	})
+
+	t.Run("no token for a page completed by the last match", func(t *testing.T) {
+		resp, err := testActorsRuntime.ListReminders(ctx, &ListRemindersRequest{ActorType: "cat", ActorID: "a", PageSize: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a/r1", "a/r2"}, names(resp))
+		assert.Empty(t, resp.NextPageToken)
+	})
+
+	t.Run("page token of another actor", func(t *testing.T) {
+		resp, err := testActorsRuntime.ListReminders(ctx, &ListRemindersRequest{ActorType: "cat", PageSize: 1})
+		assert.NoError(t, err)
+		_, err = testActorsRuntime.ListReminders(ctx, &ListRemindersRequest{ActorType: "cat", ActorID: "a", PageToken: resp.NextPageToken})
+		assert.IsType(t, &PageTokenError{}, err)
+	})
+
+	t.Run("actor type is required", func(t *testing.T) {
+		_, err := testActorsRuntime.ListReminders(ctx, &ListRemindersRequest{})
+		assert.Error(t, err)
+	})
+}
+
 func TestOverrideReminder(t *testing.T) {
 	ctx := context.Background()
 	t.Run("override data", func(t *testing.T) {
@@ -351,6 +499,56 @@ func TestDeleteTimer(t *testing.T) {
 	assert.False(t, ok)
 }
 
+func TestCreateScheduledTimer(t *testing.T) {
+	testActorsRuntime := newTestActorsRuntime()
+	actorType, actorID := getTestActorTypeAndID()
+	ctx := context.Background()
+	actorKey := testActorsRuntime.constructCompositeKey(actorType, actorID)
+	fakeCallAndActivateActor(testActorsRuntime, actorKey)
+
+	t.Run("invalid requests", func(t *testing.T) {
+		tests := map[string]CreateTimerRequest{
+			"period and schedule": {Period: "1s", Schedule: "* * * * *"},
+			"timezone only":       {Period: "1s", TimeZone: "Europe/Paris"},
+			"bad schedule":        {Schedule: "not a schedule"},
+			"bad timezone":        {Schedule: "* * * * *", TimeZone: "Mars/Olympus"},
+			"bad due time":        {Schedule: "* * * * *", DueTime: "soon"},
+		}
+		for name, req := range tests {
+			t.Run(name, func(t *testing.T) {
+				req.ActorType, req.ActorID, req.Name = actorType, actorID, "timer1"
+				assert.Error(t, testActorsRuntime.CreateTimer(ctx, &req))
+			})
+		}
+	})
+
+	t.Run("schedule with timezone", func(t *testing.T) {
+		timer := CreateTimerRequest{
+			Name:      "timer1",
+			ActorType: actorType,
+			ActorID:   actorID,
+			Schedule:  "0 9 * * MON-FRI",
+			TimeZone:  "Europe/Paris",
+			Callback:  "callback",
+		}
+		err := testActorsRuntime.CreateTimer(ctx, &timer)
+		assert.NoError(t, err)
+
+		timerKey := testActorsRuntime.constructCompositeKey(actorKey, timer.Name)
+		_, ok := testActorsRuntime.activeTimers.Load(timerKey)
+		assert.True(t, ok)
+
+		err = testActorsRuntime.DeleteTimer(ctx, &DeleteTimerRequest{
+			Name:      timer.Name,
+			ActorID:   actorID,
+			ActorType: actorType,
+		})
+		assert.NoError(t, err)
+		_, ok = testActorsRuntime.activeTimers.Load(timerKey)
+		assert.False(t, ok)
+	})
+}
+
 func TestReminderFires(t *testing.T) {
 	testActorsRuntime := newTestActorsRuntime()
 	actorType, actorID := getTestActorTypeAndID()
@@ -690,3 +888,321 @@ func TestActorsAppHealthCheck(t *testing.T) {
 	time.Sleep(time.Second * 2)
 	assert.False(t, testActorRuntime.appHealthy)
 }
+
+func TestReadOnlyActorCalls(t *testing.T) {
+	testActorRuntime := newTestActorsRuntime()
+	testActorRuntime.config.ReadOnlyMethods = map[string][]string{"cat": {"getName"}}
+
+	mockAppChannel := new(channelt.MockAppChannel)
+	mockAppChannel.On(
+		"InvokeMethod",
+		mock.Anything,
+		mock.AnythingOfType("*v1.InvokeMethodRequest")).After(time.Millisecond*300).Return(invokev1.NewInvokeMethodResponse(200, "OK", nil), nil)
+	testActorRuntime.appChannel = mockAppChannel
+
+	actorType, actorID := getTestActorTypeAndID()
+	actorKey := testActorRuntime.constructCompositeKey(actorType, actorID)
+	fakeCallAndActivateActor(testActorRuntime, actorKey)
+
+	t.Run("is read-only method", func(t *testing.T) {
+		assert.True(t, testActorRuntime.isReadOnlyMethod("cat", "getName"))
+		assert.False(t, testActorRuntime.isReadOnlyMethod("cat", "setName"))
+		assert.False(t, testActorRuntime.isReadOnlyMethod("dog", "getName"))
+	})
+
+	callActor := func(method string, wg *sync.WaitGroup) {
+		defer wg.Done()
+		req := invokev1.NewInvokeMethodRequest(method).WithActor(actorType, actorID)
+		_, err := testActorRuntime.callLocalActor(context.Background(), req)
+		assert.NoError(t, err)
+	}
+
+	t.Run("read-only calls run concurrently", func(t *testing.T) {
+		var wg sync.WaitGroup
+		wg.Add(2)
+		start := time.Now()
+		go callActor("getName", &wg)
+		go callActor("getName", &wg)
+		wg.Wait()
+
+		assert.Less(t, int64(time.Since(start)), int64(time.Millisecond*600))
+		val, _ := testActorRuntime.actorsTable.Load(actorKey)
+		busy, _ := val.(*actor).isBusy()
+		assert.False(t, busy)
+	})
+
+	t.Run("calls marked read-only in their metadata run concurrently", func(t *testing.T) {
+		callReadOnly := func(wg *sync.WaitGroup) {
+			defer wg.Done()
+			req := invokev1.NewInvokeMethodRequest("getAge").WithActor(actorType, actorID)
+			req.WithMetadata(map[string][]string{ReadOnlyHeader: {"true"}})
+			_, err := testActorRuntime.callLocalActor(context.Background(), req)
+			assert.NoError(t, err)
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		start := time.Now()
+		go callReadOnly(&wg)
+		go callReadOnly(&wg)
+		wg.Wait()
+
+		assert.Less(t, int64(time.Since(start)), int64(time.Millisecond*600))
+	})
+
+	t.Run("calls to other methods are serialized", func(t *testing.T) {
+		var wg sync.WaitGroup
+		wg.Add(2)
+		start := time.Now()
+		go callActor("setName", &wg)
+		go callActor("getName", &wg)
+		wg.Wait()
+
+		assert.GreaterOrEqual(t, int64(time.Since(start)), int64(time.Millisecond*600))
+	})
+}
+
+func TestActorRoutingHints(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		hints := getRoutingHints(invokev1.DaprInternalMetadata{})
+		assert.Equal(t, ConsistencyStrong, hints.consistency)
+		assert.Equal(t, "actor1", hints.routingKey("actor1"))
+	})
+
+	t.Run("header names are case insensitive", func(t *testing.T) {
+		hints := getRoutingHints(invokev1.DaprInternalMetadata{
+			"Dapr-Actor-Partition-Key": &internalv1pb.ListStringValue{Values: []string{"tenant1"}},
+			"Dapr-Actor-Consistency":   &internalv1pb.ListStringValue{Values: []string{"Eventual"}},
+			"Dapr-Actor-Read-Only":     &internalv1pb.ListStringValue{Values: []string{"TRUE"}},
+		})
+		assert.Equal(t, ConsistencyEventual, hints.consistency)
+		assert.True(t, hints.readOnly)
+		assert.Equal(t, "tenant1", hints.routingKey("actor1"))
+	})
+
+	t.Run("consistency values", func(t *testing.T) {
+		assert.True(t, IsValidConsistency(""))
+		assert.True(t, IsValidConsistency("strong"))
+		assert.True(t, IsValidConsistency("EVENTUAL"))
+		assert.False(t, IsValidConsistency("bounded"))
+	})
+
+	t.Run("affinity", func(t *testing.T) {
+		hints := getRoutingHints(invokev1.DaprInternalMetadata{
+			"Dapr-Actor-Affinity": &internalv1pb.ListStringValue{Values: []string{"pool=gpu,zone=eu-1"}},
+		})
+		assert.Equal(t, map[string]string{"pool": "gpu", "zone": "eu-1"}, hints.affinity)
+		assert.NoError(t, ValidateAffinity(""))
+		assert.NoError(t, ValidateAffinity("pool=gpu"))
+		assert.Error(t, ValidateAffinity("gpu"))
+	})
+}
+
+func TestLookupActorAddressWithAffinity(t *testing.T) {
+	testActorsRuntime := newTestActorsRuntime()
+	c := placement.NewConsistentHash()
+	c.Add("cpu", "app", 50002, 1)
+	c.Add("gpu", "app", 50002, 1)
+	c.UpdateLabels("gpu", map[string]string{"pool": "gpu"})
+	testActorsRuntime.placementTables.Entries["cat"] = c
+
+	for i := 0; i < 20; i++ {
+		address, appID := testActorsRuntime.lookupActorAddress("cat", fmt.Sprintf("actor%d", i), map[string]string{"pool": "gpu"})
+		assert.Equal(t, "gpu:50002", address)
+		assert.Equal(t, "app", appID)
+	}
+	address, _ := testActorsRuntime.lookupActorAddress("cat", "actor1", map[string]string{"pool": "tpu"})
+	assert.Empty(t, address)
+}
+
+func TestWithPlacementHeaders(t *testing.T) {
+	resp := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+	withPlacementHeaders(resp, "10.0.0.1:50002", "app1", "3")
+
+	headers := resp.Headers()
+	assert.Equal(t, []string{"10.0.0.1:50002"}, headers[PlacementHostHeader].GetValues())
+	assert.Equal(t, []string{"app1"}, headers[PlacementAppIDHeader].GetValues())
+	assert.Equal(t, []string{"3"}, headers[PlacementVersionHeader].GetValues())
+}
+
+func TestGetMissedReminderFires(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 30, 0, time.UTC)
+	due := now.Add(-time.Minute * 3)
+
+	t.Run("not missed", func(t *testing.T) {
+		next := now.Add(time.Minute)
+		fires, count, nextInvokeTime, err := getMissedReminderFires(&Reminder{Period: "1m"}, next, now)
+		assert.Nil(t, err)
+		assert.Empty(t, fires)
+		assert.Equal(t, 0, count)
+		assert.Equal(t, next, nextInvokeTime)
+	})
+
+	t.Run("period fire once", func(t *testing.T) {
+		fires, count, nextInvokeTime, err := getMissedReminderFires(&Reminder{Period: "1m"}, due, now)
+		assert.Nil(t, err)
+		assert.Equal(t, 4, count)
+		assert.Equal(t, []time.Time{due.Add(time.Minute * 3)}, fires)
+		assert.Equal(t, due.Add(time.Minute*4), nextInvokeTime)
+	})
+
+	t.Run("period fire all", func(t *testing.T) {
+		fires, count, _, err := getMissedReminderFires(&Reminder{Period: "1m", MissedFirePolicy: MissedFirePolicyFireAll}, due, now)
+		assert.Nil(t, err)
+		assert.Equal(t, 4, count)
+		assert.Len(t, fires, 4)
+	})
+
+	t.Run("fire all is capped", func(t *testing.T) {
+		fires, count, _, err := getMissedReminderFires(&Reminder{Period: "1s", MissedFirePolicy: MissedFirePolicyFireAll}, due, now)
+		assert.Nil(t, err)
+		assert.Equal(t, 181, count)
+		assert.Len(t, fires, maxMissedReminderFires)
+		assert.Equal(t, now, fires[len(fires)-1])
+	})
+
+	t.Run("schedule skip", func(t *testing.T) {
+		r := &Reminder{Schedule: "* * * * *", MissedFirePolicy: MissedFirePolicySkip}
+		fires, count, nextInvokeTime, err := getMissedReminderFires(r, due.Truncate(time.Minute), now)
+		assert.Nil(t, err)
+		assert.Empty(t, fires)
+		assert.Equal(t, 4, count)
+		assert.Equal(t, now.Truncate(time.Minute).Add(time.Minute), nextInvokeTime)
+	})
+
+	t.Run("one-off reminder", func(t *testing.T) {
+		fires, count, nextInvokeTime, err := getMissedReminderFires(&Reminder{DueTime: "1m"}, due, now)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, count)
+		assert.Equal(t, []time.Time{due}, fires)
+		assert.True(t, nextInvokeTime.IsZero())
+	})
+}
+
+func TestActorMetadataForwarding(t *testing.T) {
+	testActorRuntime := newTestActorsRuntime()
+	testActorRuntime.config.ForwardedMetadata = []string{"x-tenant", "x-trace-*"}
+	assert.NoError(t, testActorRuntime.initMetadataPolicy())
+
+	var forwarded invokev1.DaprInternalMetadata
+	mockAppChannel := new(channelt.MockAppChannel)
+	mockAppChannel.On("InvokeMethod", mock.Anything, mock.AnythingOfType("*v1.InvokeMethodRequest")).
+		Run(func(args mock.Arguments) {
+			forwarded = args.Get(1).(*invokev1.InvokeMethodRequest).Metadata()
+		}).
+		Return(invokev1.NewInvokeMethodResponse(200, "OK", nil).WithHeaders(map[string][]string{"x-result": {"done"}}), nil)
+	testActorRuntime.appChannel = mockAppChannel
+
+	actorType, actorID := getTestActorTypeAndID()
+	fakeCallAndActivateActor(testActorRuntime, testActorRuntime.constructCompositeKey(actorType, actorID))
+
+	req := invokev1.NewInvokeMethodRequest("method").WithActor(actorType, actorID).
+		WithMetadata(map[string][]string{"X-Tenant": {"t1"}, "x-trace-id": {"1"}, "authorization": {"secret"}})
+	resp, err := testActorRuntime.callLocalActor(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Len(t, forwarded, 2)
+	assert.Equal(t, []string{"t1"}, forwarded["X-Tenant"].Values)
+	assert.Equal(t, []string{"done"}, resp.Headers()["x-result"].Values)
+
+	testActorRuntime.config.ForwardedMetadata = []string{"["}
+	assert.Error(t, testActorRuntime.initMetadataPolicy())
+}
+
+func TestCachedActorCalls(t *testing.T) {
+	testActorRuntime := newTestActorsRuntime()
+	testActorRuntime.config.CachedMethods = map[string][]string{"cat": {"getName"}}
+
+	calls := 0
+	mockAppChannel := new(channelt.MockAppChannel)
+	mockAppChannel.On("InvokeMethod", mock.Anything, mock.AnythingOfType("*v1.InvokeMethodRequest")).
+		Run(func(args mock.Arguments) {
+			calls++
+		}).
+		Return(invokev1.NewInvokeMethodResponse(200, "OK", nil).WithRawData([]byte("kitty"), "text/plain"), nil)
+	testActorRuntime.appChannel = mockAppChannel
+
+	actorType, actorID := getTestActorTypeAndID()
+	actorKey := testActorRuntime.constructCompositeKey(actorType, actorID)
+	fakeCallAndActivateActor(testActorRuntime, actorKey)
+
+	callActor := func(method, data string) *invokev1.InvokeMethodResponse {
+		req := invokev1.NewInvokeMethodRequest(method).WithActor(actorType, actorID).WithRawData([]byte(data), "text/plain")
+		resp, err := testActorRuntime.callLocalActor(context.Background(), req)
+		assert.NoError(t, err)
+		return resp
+	}
+
+	t.Run("responses of cached methods are reused", func(t *testing.T) {
+		callActor("getName", "")
+		resp := callActor("getName", "")
+		assert.Equal(t, 1, calls)
+		_, data := resp.RawData()
+		assert.Equal(t, []byte("kitty"), data)
+
+		callActor("getName", "other")
+		assert.Equal(t, 2, calls)
+		callActor("setName", "")
+		callActor("setName", "")
+		assert.Equal(t, 4, calls)
+	})
+
+	t.Run("state changes invalidate the responses", func(t *testing.T) {
+		calls = 0
+		callActor("getName", "")
+		assert.Equal(t, 0, calls)
+
+		err := testActorRuntime.SaveState(context.Background(), &SaveStateRequest{ActorType: actorType, ActorID: actorID, Key: "name", Value: "tom"})
+		assert.NoError(t, err)
+		callActor("getName", "")
+		assert.Equal(t, 1, calls)
+
+		err = testActorRuntime.TransactionalStateOperation(context.Background(), &TransactionalRequest{
+			ActorType:  actorType,
+			ActorID:    actorID,
+			Operations: []TransactionalOperation{{Operation: Delete, Request: TransactionalDelete{Key: "name"}}},
+		})
+		assert.NoError(t, err)
+		callActor("getName", "")
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestResponseCache(t *testing.T) {
+	now := time.Now()
+	cache := newResponseCache(time.Second)
+	cache.now = func() time.Time { return now }
+	resp := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+
+	t.Run("responses expire", func(t *testing.T) {
+		_, generation := cache.get("cat||1", "call")
+		cache.put("cat||1", "call", generation, resp)
+		cached, _ := cache.get("cat||1", "call")
+		assert.NotNil(t, cached)
+
+		now = now.Add(time.Second)
+		cached, _ = cache.get("cat||1", "call")
+		assert.Nil(t, cached)
+		assert.Equal(t, 0, cache.size)
+	})
+
+	t.Run("responses computed before an invalidation aren't cached", func(t *testing.T) {
+		_, generation := cache.get("cat||1", "call")
+		cache.invalidate("cat||1")
+		cache.put("cat||1", "call", generation, resp)
+		cached, _ := cache.get("cat||1", "call")
+		assert.Nil(t, cached)
+	})
+
+	t.Run("responses aren't cached when the cache is full", func(t *testing.T) {
+		_, generation := cache.get("cat||1", "call")
+		for i := 0; i < maxCachedResponses; i++ {
+			cache.put("cat||1", fmt.Sprintf("call%v", i), generation, resp)
+		}
+		cache.put("cat||2", "call", generation, resp)
+		cached, _ := cache.get("cat||2", "call")
+		assert.Nil(t, cached)
+
+		cache.invalidate("cat||1")
+		assert.Equal(t, 0, cache.size)
+	})
+}
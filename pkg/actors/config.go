@@ -19,6 +19,17 @@ type Config struct {
 	ActorIdleTimeout              time.Duration
 	DrainOngoingCallTimeout       time.Duration
 	DrainRebalancedActors         bool
+	ReadOnlyMethods               map[string][]string
+	// ForwardedMetadata lists the patterns of the metadata keys of callers forwarded to actor methods. All metadata is forwarded when empty.
+	ForwardedMetadata []string
+	// PlacementWeight is the capacity of the host reported to the placement service, relative to the other hosts
+	PlacementWeight int64
+	// PlacementLabels describe the host to the placement service, for the actors requiring an affinity
+	PlacementLabels map[string]string
+	// CachedMethods lists the methods whose responses are cached, keyed by actor type
+	CachedMethods map[string][]string
+	// CachedMethodTTL is how long the response of a cached method is reused
+	CachedMethodTTL time.Duration
 }
 
 const (
@@ -30,7 +41,7 @@ const (
 
 // NewConfig returns the actor runtime configuration
 func NewConfig(hostAddress, appID, placementAddress string, hostedActors []string, port int,
-	actorScanInterval, actorIdleTimeout, ongoingCallTimeout string, drainRebalancedActors bool) Config {
+	actorScanInterval, actorIdleTimeout, ongoingCallTimeout string, drainRebalancedActors bool, readOnlyMethods map[string][]string) Config {
 	c := Config{
 		HostAddress:                   hostAddress,
 		AppID:                         appID,
@@ -42,6 +53,7 @@ func NewConfig(hostAddress, appID, placementAddress string, hostedActors []strin
 		ActorIdleTimeout:              defaultActorIdleTimeout,
 		DrainOngoingCallTimeout:       defaultOngoingCallTimeout,
 		DrainRebalancedActors:         drainRebalancedActors,
+		ReadOnlyMethods:               readOnlyMethods,
 	}
 
 	scanDuration, err := time.ParseDuration(actorScanInterval)
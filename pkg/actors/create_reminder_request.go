@@ -13,4 +13,14 @@ type CreateReminderRequest struct {
 	Data      interface{} `json:"data"`
 	DueTime   string      `json:"dueTime"`
 	Period    string      `json:"period"`
+	// PartitionKey places the reminder with the actor when the actor is called with a partition key
+	PartitionKey string `json:"partitionKey,omitempty"`
+	// Schedule is a cron expression that replaces Period, e.g. "0 9 * * MON-FRI". DueTime delays its first evaluation.
+	Schedule string `json:"schedule,omitempty"`
+	// TimeZone is the IANA time zone the schedule is evaluated in. Defaults to UTC.
+	TimeZone string `json:"timezone,omitempty"`
+	// MissedFirePolicy is fireOnce (default), fireAll or skip for fire times missed while no host was running the reminder
+	MissedFirePolicy string `json:"missedFirePolicy,omitempty"`
+	// Affinity holds the labels of the hosts the reminder fires the actor on, in the format of AffinityHeader, e.g. "pool=gpu"
+	Affinity string `json:"affinity,omitempty"`
 }
@@ -14,4 +14,8 @@ type CreateTimerRequest struct {
 	Period    string      `json:"period"`
 	Callback  string      `json:"callback"`
 	Data      interface{} `json:"data"`
+	// Schedule is a cron expression that replaces Period, e.g. "0 9 * * MON-FRI". DueTime delays its first evaluation.
+	Schedule string `json:"schedule,omitempty"`
+	// TimeZone is the IANA time zone the schedule is evaluated in. Defaults to UTC.
+	TimeZone string `json:"timezone,omitempty"`
 }
@@ -0,0 +1,136 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package actors
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	// DefaultListRemindersPageSize is the number of reminders of the pages of a request without a page size
+	DefaultListRemindersPageSize = 100
+	// MaxListRemindersPageSize bounds the number of reminders of a page
+	MaxListRemindersPageSize = 1000
+)
+
+// PageTokenError is returned for a page token the runtime didn't issue for the actor type and actor ID of the request
+type PageTokenError struct {
+	Reason string
+}
+
+func (e *PageTokenError) Error() string {
+	return fmt.Sprintf("invalid page token: %s", e.Reason)
+}
+
+// remindersCursor is the position of a page in the reminders of an actor type, ordered by actor ID and name, encoded
+// in the page tokens. The next page starts after the last reminder returned, so reminders created or deleted between
+// two pages don't shift the pages.
+type remindersCursor struct {
+	ActorType string `json:"t"`
+	ActorID   string `json:"a,omitempty"`
+	LastID    string `json:"i"`
+	LastName  string `json:"n"`
+}
+
+func (c remindersCursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeRemindersCursor(actorType, actorID, token string) (*remindersCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, &PageTokenError{Reason: "malformed token"}
+	}
+	var cursor remindersCursor
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return nil, &PageTokenError{Reason: "malformed token"}
+	}
+	if cursor.ActorType != actorType || cursor.ActorID != actorID {
+		return nil, &PageTokenError{Reason: "the token was issued for another actor type or actor"}
+	}
+	return &cursor, nil
+}
+
+// ListReminders returns a page of the reminders of an actor type, ordered by actor ID and name, with the time they
+// fire next. The due time filters apply to the next fire time, so the reminders whose next fire time can't be
+// computed are only returned without filters. The next page token is rejected with a PageTokenError by the requests
+// for another actor type or actor.
+func (a *actorsRuntime) ListReminders(ctx context.Context, req *ListRemindersRequest) (*ListRemindersResponse, error) {
+	if req.ActorType == "" {
+		return nil, fmt.Errorf("actor type is required")
+	}
+	pageSize := req.PageSize
+	if pageSize < 0 {
+		return nil, fmt.Errorf("page size must not be negative")
+	} else if pageSize == 0 {
+		pageSize = DefaultListRemindersPageSize
+	} else if pageSize > MaxListRemindersPageSize {
+		pageSize = MaxListRemindersPageSize
+	}
+	cursor, err := decodeRemindersCursor(req.ActorType, req.ActorID, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	reminders, err := a.getRemindersForActorType(req.ActorType)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(reminders, func(i, j int) bool {
+		if reminders[i].ActorID != reminders[j].ActorID {
+			return reminders[i].ActorID < reminders[j].ActorID
+		}
+		return reminders[i].Name < reminders[j].Name
+	})
+
+	filtered := !req.DueAfter.IsZero() || !req.DueBefore.IsZero()
+	resp := &ListRemindersResponse{Reminders: []ListedReminder{}}
+	for i := range reminders {
+		r := &reminders[i]
+		if req.ActorID != "" && r.ActorID != req.ActorID {
+			continue
+		}
+		if cursor != nil && (r.ActorID < cursor.LastID || (r.ActorID == cursor.LastID && r.Name <= cursor.LastName)) {
+			continue
+		}
+		listed := ListedReminder{Reminder: *r}
+		next, err := a.getUpcomingReminderInvokeTime(r)
+		if err != nil {
+			log.Debugf("error computing the next fire time of reminder %s of actor type %s with id %s: %s", r.Name, r.ActorType, r.ActorID, err)
+			if filtered {
+				continue
+			}
+		} else {
+			if (!req.DueAfter.IsZero() && next.Before(req.DueAfter)) || (!req.DueBefore.IsZero() && !next.Before(req.DueBefore)) {
+				continue
+			}
+			listed.NextFireTime = next.UTC().Format(time.RFC3339)
+		}
+
+		// another reminder matches, the page is complete
+		if len(resp.Reminders) == pageSize {
+			last := resp.Reminders[pageSize-1]
+			resp.NextPageToken = remindersCursor{
+				ActorType: req.ActorType,
+				ActorID:   req.ActorID,
+				LastID:    last.ActorID,
+				LastName:  last.Name,
+			}.encode()
+			break
+		}
+		resp.Reminders = append(resp.Reminders, listed)
+	}
+	return resp, nil
+}
@@ -0,0 +1,23 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package actors
+
+import "time"
+
+// ListRemindersRequest is the request object to list a page of the reminders of an actor type
+type ListRemindersRequest struct {
+	ActorType string
+	// ActorID selects the reminders of an actor, the reminders of every actor of the type when empty
+	ActorID string
+	// DueAfter selects the reminders firing next at DueAfter or later, unbounded when zero
+	DueAfter time.Time
+	// DueBefore selects the reminders firing next before DueBefore, unbounded when zero
+	DueBefore time.Time
+	// PageToken is the NextPageToken of the previous page, empty for the first page
+	PageToken string
+	// PageSize bounds the number of reminders of the page, DefaultListRemindersPageSize when 0
+	PageSize int
+}
@@ -0,0 +1,20 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package actors
+
+// ListRemindersResponse is a page of the reminders of an actor type
+type ListRemindersResponse struct {
+	Reminders []ListedReminder `json:"reminders"`
+	// NextPageToken requests the next page, empty on the last page
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// ListedReminder is a persisted reminder with the time it fires next
+type ListedReminder struct {
+	Reminder
+	// NextFireTime is the RFC3339 time the reminder fires next, empty when it can't be computed
+	NextFireTime string `json:"nextFireTime,omitempty"`
+}
@@ -0,0 +1,94 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package actors
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dapr/dapr/pkg/cron"
+)
+
+const (
+	// MissedFirePolicyFireOnce fires a reminder once for all the fire times missed while it wasn't running. This is the default.
+	MissedFirePolicyFireOnce = "fireOnce"
+	// MissedFirePolicyFireAll fires a reminder for every fire time missed while it wasn't running, up to maxMissedReminderFires
+	MissedFirePolicyFireAll = "fireAll"
+	// MissedFirePolicySkip drops missed fire times and waits for the next one
+	MissedFirePolicySkip = "skip"
+
+	// maxMissedReminderFires caps the fires replayed by MissedFirePolicyFireAll after a long outage
+	maxMissedReminderFires = 100
+)
+
+// IsValidMissedFirePolicy returns true for the values accepted as a reminder's missed fire policy
+func IsValidMissedFirePolicy(policy string) bool {
+	switch policy {
+	case "", MissedFirePolicyFireOnce, MissedFirePolicyFireAll, MissedFirePolicySkip:
+		return true
+	default:
+		return false
+	}
+}
+
+// getMissedReminderFires applies the missed fire policy of a reminder whose next fire time is already past.
+// It returns the missed fire times to execute right away, the number of missed fire times and the first fire time after now.
+// The returned fire time is zero when the reminder doesn't fire again.
+func getMissedReminderFires(reminder *Reminder, nextInvokeTime, now time.Time) ([]time.Time, int, time.Time, error) {
+	if !nextInvokeTime.Before(now) {
+		return nil, 0, nextInvokeTime, nil
+	}
+
+	// missed holds the most recent missed fire times, up to maxMissedReminderFires
+	var missed []time.Time
+	var next time.Time
+	count := 0
+	switch {
+	case reminder.Schedule != "":
+		schedule, err := cron.Parse(reminder.Schedule, reminder.TimeZone)
+		if err != nil {
+			return nil, 0, nextInvokeTime, fmt.Errorf("error parsing reminder schedule: %s", err)
+		}
+		for next = nextInvokeTime; !next.IsZero() && next.Before(now); next = schedule.Next(next) {
+			count++
+			missed = append(missed, next)
+			if len(missed) > maxMissedReminderFires {
+				missed = missed[1:]
+			}
+		}
+	case reminder.Period != "":
+		period, err := time.ParseDuration(reminder.Period)
+		if err != nil {
+			return nil, 0, nextInvokeTime, fmt.Errorf("error parsing reminder period: %s", err)
+		}
+		if period <= 0 {
+			count, next = 1, now
+			missed = []time.Time{nextInvokeTime}
+			break
+		}
+		count = int(now.Sub(nextInvokeTime)/period) + 1
+		first := 0
+		if count > maxMissedReminderFires {
+			first = count - maxMissedReminderFires
+		}
+		for i := first; i < count; i++ {
+			missed = append(missed, nextInvokeTime.Add(time.Duration(i)*period))
+		}
+		next = nextInvokeTime.Add(time.Duration(count) * period)
+	default:
+		count = 1
+		missed = []time.Time{nextInvokeTime}
+	}
+
+	switch reminder.MissedFirePolicy {
+	case MissedFirePolicySkip:
+		missed = nil
+	case MissedFirePolicyFireAll:
+	default:
+		missed = missed[len(missed)-1:]
+	}
+	return missed, count, next, nil
+}
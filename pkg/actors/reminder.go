@@ -5,13 +5,27 @@
 
 package actors
 
+import "github.com/dapr/dapr/pkg/placement"
+
 // Reminder represents a persisted reminder for a unique actor
 type Reminder struct {
-	ActorID        string      `json:"actorID,omitempty"`
-	ActorType      string      `json:"actorType,omitempty"`
-	Name           string      `json:"name,omitempty"`
-	Data           interface{} `json:"data"`
-	Period         string      `json:"period"`
-	DueTime        string      `json:"dueTime"`
-	RegisteredTime string      `json:"registeredTime,omitempty"`
+	ActorID          string      `json:"actorID,omitempty"`
+	ActorType        string      `json:"actorType,omitempty"`
+	Name             string      `json:"name,omitempty"`
+	Data             interface{} `json:"data"`
+	Period           string      `json:"period"`
+	DueTime          string      `json:"dueTime"`
+	RegisteredTime   string      `json:"registeredTime,omitempty"`
+	PartitionKey     string      `json:"partitionKey,omitempty"`
+	Schedule         string      `json:"schedule,omitempty"`
+	TimeZone         string      `json:"timezone,omitempty"`
+	MissedFirePolicy string      `json:"missedFirePolicy,omitempty"`
+	Affinity         string      `json:"affinity,omitempty"`
+}
+
+// affinityLabels returns the labels of the hosts the reminder fires the actor on
+func (r *Reminder) affinityLabels() map[string]string {
+	// the affinity was validated when the reminder was created
+	labels, _ := placement.ParseLabels(r.Affinity)
+	return labels
 }
@@ -10,4 +10,7 @@ type ReminderResponse struct {
 	Data    interface{} `json:"data"`
 	DueTime string      `json:"dueTime"`
 	Period  string      `json:"period"`
+	// Schedule and TimeZone are set for reminders that fire on a cron schedule
+	Schedule string `json:"schedule,omitempty"`
+	TimeZone string `json:"timezone,omitempty"`
 }
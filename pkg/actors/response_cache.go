@@ -0,0 +1,133 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package actors
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// defaultCachedMethodTTL is how long the response of a cached method is reused when the app doesn't set it
+	defaultCachedMethodTTL = time.Second
+	// maxCachedResponses bounds the responses cached by a host. Responses aren't cached while it's reached.
+	maxCachedResponses = 10000
+)
+
+type cachedResponse struct {
+	resp    *internalv1pb.InternalInvokeResponse
+	expires time.Time
+}
+
+// responseCache holds the responses of the cached methods of the actors hosted locally, keyed by actor and by
+// method and payload. The responses of an actor are dropped when its state changes or it's deactivated.
+type responseCache struct {
+	lock      sync.Mutex
+	ttl       time.Duration
+	responses map[string]map[string]cachedResponse
+	// generation counts the invalidations, so a response computed before the state of an actor changed isn't cached
+	// after the change
+	generation uint64
+	size       int
+	now        func() time.Time
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	if ttl <= 0 {
+		ttl = defaultCachedMethodTTL
+	}
+	return &responseCache{
+		ttl:       ttl,
+		responses: map[string]map[string]cachedResponse{},
+		now:       time.Now,
+	}
+}
+
+// responseCacheKey returns the key of a call to a method with its payload
+func responseCacheKey(req *invokev1.InvokeMethodRequest) string {
+	contentType, data := req.RawData()
+	h := sha256.New()
+	h.Write([]byte(req.Message().Method))
+	h.Write([]byte{0})
+	h.Write([]byte(contentType))
+	h.Write([]byte{0})
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// get returns a copy of the cached response of a call to an actor, and the generation to cache the response of the
+// call with if there's none
+func (c *responseCache) get(actorKey, callKey string) (*invokev1.InvokeMethodResponse, uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if cached, ok := c.responses[actorKey][callKey]; ok {
+		if c.now().Before(cached.expires) {
+			resp, _ := invokev1.InternalInvokeResponse(proto.Clone(cached.resp).(*internalv1pb.InternalInvokeResponse))
+			return resp, c.generation
+		}
+		c.remove(actorKey, callKey)
+	}
+	return nil, c.generation
+}
+
+// put caches the response of a call to an actor unless an actor was invalidated since generation
+func (c *responseCache) put(actorKey, callKey string, generation uint64, resp *invokev1.InvokeMethodResponse) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.generation != generation {
+		return
+	}
+	if _, ok := c.responses[actorKey][callKey]; !ok {
+		if c.size >= maxCachedResponses {
+			c.removeExpired()
+			if c.size >= maxCachedResponses {
+				return
+			}
+		}
+		c.size++
+	}
+	if c.responses[actorKey] == nil {
+		c.responses[actorKey] = map[string]cachedResponse{}
+	}
+	c.responses[actorKey][callKey] = cachedResponse{
+		resp:    proto.Clone(resp.Proto()).(*internalv1pb.InternalInvokeResponse),
+		expires: c.now().Add(c.ttl),
+	}
+}
+
+// invalidate drops the cached responses of an actor
+func (c *responseCache) invalidate(actorKey string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.generation++
+	c.size -= len(c.responses[actorKey])
+	delete(c.responses, actorKey)
+}
+
+func (c *responseCache) remove(actorKey, callKey string) {
+	delete(c.responses[actorKey], callKey)
+	c.size--
+	if len(c.responses[actorKey]) == 0 {
+		delete(c.responses, actorKey)
+	}
+}
+
+func (c *responseCache) removeExpired() {
+	now := c.now()
+	for actorKey, responses := range c.responses {
+		for callKey, cached := range responses {
+			if !now.Before(cached.expires) {
+				c.remove(actorKey, callKey)
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package actors
+
+import (
+	"strings"
+
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/placement"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
+)
+
+const (
+	// PartitionKeyHeader places the actor by the given key instead of its ID.
+	// All calls and reminders of an actor must use the same partition key.
+	PartitionKeyHeader = "dapr-actor-partition-key"
+	// AffinityHeader places the actor on a host with the given labels, written as comma separated name=value pairs.
+	// All calls and reminders of an actor must use the same affinity.
+	AffinityHeader = "dapr-actor-affinity"
+	// ReadOnlyHeader marks a call as read-only with the value true. Read-only calls don't take the turn-based lock, so
+	// they run concurrently with each other against the last committed state of the actor. The method must not change
+	// the state of the actor.
+	ReadOnlyHeader = "dapr-actor-read-only"
+	// ConsistencyHeader selects whether a call waits for an in-flight placement table update
+	ConsistencyHeader = "dapr-actor-consistency"
+	// PlacementHostHeader is returned with the address of the host that served the call
+	PlacementHostHeader = "dapr-actor-host"
+	// PlacementAppIDHeader is returned with the app ID of the host that served the call
+	PlacementAppIDHeader = "dapr-actor-host-app-id"
+	// PlacementVersionHeader is returned with the placement table version used to route the call
+	PlacementVersionHeader = "dapr-actor-placement-version"
+
+	// ConsistencyStrong waits until placement table updates are applied before routing. This is the default.
+	ConsistencyStrong = "strong"
+	// ConsistencyEventual routes with the current placement table, even while an update is in progress
+	ConsistencyEventual = "eventual"
+)
+
+// routingHints are the optional routing instructions a caller can pass with an actor invocation
+type routingHints struct {
+	partitionKey string
+	consistency  string
+	affinity     map[string]string
+	readOnly     bool
+}
+
+// getRoutingHints reads the routing hints from the request metadata. Header names are case insensitive.
+func getRoutingHints(md invokev1.DaprInternalMetadata) routingHints {
+	hints := routingHints{consistency: ConsistencyStrong}
+	for k, v := range md {
+		if len(v.GetValues()) == 0 {
+			continue
+		}
+		switch strings.ToLower(k) {
+		case PartitionKeyHeader:
+			hints.partitionKey = v.GetValues()[0]
+		case AffinityHeader:
+			// malformed affinities are rejected by the APIs, see ValidateAffinity
+			hints.affinity, _ = placement.ParseLabels(v.GetValues()[0])
+		case ReadOnlyHeader:
+			hints.readOnly = strings.EqualFold(v.GetValues()[0], "true")
+		case ConsistencyHeader:
+			if strings.EqualFold(v.GetValues()[0], ConsistencyEventual) {
+				hints.consistency = ConsistencyEventual
+			}
+		}
+	}
+	return hints
+}
+
+// routingKey returns the key used to look up the actor in the placement table
+func (h routingHints) routingKey(actorID string) string {
+	if h.partitionKey != "" {
+		return h.partitionKey
+	}
+	return actorID
+}
+
+// withPlacementHeaders adds the placement diagnostics of the call to the response headers
+func withPlacementHeaders(resp *invokev1.InvokeMethodResponse, address, appID, version string) {
+	pb := resp.Proto()
+	if pb.Headers == nil {
+		pb.Headers = invokev1.DaprInternalMetadata{}
+	}
+	pb.Headers[PlacementHostHeader] = &internalv1pb.ListStringValue{Values: []string{address}}
+	pb.Headers[PlacementAppIDHeader] = &internalv1pb.ListStringValue{Values: []string{appID}}
+	pb.Headers[PlacementVersionHeader] = &internalv1pb.ListStringValue{Values: []string{version}}
+}
+
+// ValidateAffinity returns an error if the value of AffinityHeader isn't a list of name=value pairs
+func ValidateAffinity(affinity string) error {
+	_, err := placement.ParseLabels(affinity)
+	return err
+}
+
+// IsValidConsistency returns true for the consistency values accepted in ConsistencyHeader
+func IsValidConsistency(consistency string) bool {
+	return consistency == "" || strings.EqualFold(consistency, ConsistencyStrong) || strings.EqualFold(consistency, ConsistencyEventual)
+}
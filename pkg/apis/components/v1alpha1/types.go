@@ -30,6 +30,12 @@ type Component struct {
 type ComponentSpec struct {
 	Type     string         `json:"type"`
 	Metadata []MetadataItem `json:"metadata"`
+	// InitTimeout is the maximum duration to wait for the component to initialize. example: "10s"
+	// +optional
+	InitTimeout string `json:"initTimeout,omitempty"`
+	// DependsOn are the names of the components initialized before this one
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
 }
 
 // MetadataItem is a name/value pair for a metadata
@@ -30,6 +30,18 @@ type ConfigurationSpec struct {
 	TracingSpec TracingSpec `json:"tracing,omitempty"`
 	// +optional
 	MTLSSpec MTLSSpec `json:"mtls,omitempty"`
+	// +optional
+	CrossNamespaceSpec CrossNamespaceSpec `json:"crossNamespaceInvocation,omitempty"`
+	// +optional
+	HeaderForwardingSpec HeaderForwardingSpec `json:"headerForwarding,omitempty"`
+	// +optional
+	InvocationCacheSpec InvocationCacheSpec `json:"invocationCache,omitempty"`
+	// +optional
+	InvocationRetrySpec InvocationRetrySpec `json:"invocationRetry,omitempty"`
+	// +optional
+	AppTokenValidationSpec AppTokenValidationSpec `json:"appTokenValidation,omitempty"`
+	// +optional
+	AppChannelSpec AppChannelSpec `json:"appChannel,omitempty"`
 }
 
 // PipelineSpec defines the middleware pipeline
@@ -51,6 +63,97 @@ type MTLSSpec struct {
 	AllowedClockSkew string `json:"allowedClockSkew"`
 }
 
+// CrossNamespaceSpec defines the policy for invoking apps in other namespaces
+type CrossNamespaceSpec struct {
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// +optional
+	DefaultAction string `json:"defaultAction,omitempty"`
+	// +optional
+	Rules []CrossNamespaceRule `json:"rules,omitempty"`
+}
+
+// CrossNamespaceRule allows or denies invocation of the apps matching the namespace and app ID patterns
+type CrossNamespaceRule struct {
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// +optional
+	AppID  string `json:"appId,omitempty"`
+	Action string `json:"action"`
+}
+
+// HeaderForwardingSpec defines the headers forwarded on service invocation
+type HeaderForwardingSpec struct {
+	// +optional
+	Request HeaderRulesSpec `json:"request,omitempty"`
+	// +optional
+	Response HeaderRulesSpec `json:"response,omitempty"`
+}
+
+// HeaderRulesSpec lists the headers forwarded, stripped and renamed
+type HeaderRulesSpec struct {
+	// +optional
+	Allow []string `json:"allow,omitempty"`
+	// +optional
+	Deny []string `json:"deny,omitempty"`
+	// +optional
+	Rename map[string]string `json:"rename,omitempty"`
+}
+
+// InvocationCacheSpec defines the cache of the responses of HTTP GET service invocations
+type InvocationCacheSpec struct {
+	Enabled bool `json:"enabled"`
+	// +optional
+	TTL string `json:"ttl,omitempty"`
+	// +optional
+	MaxEntries int `json:"maxEntries,omitempty"`
+	// +optional
+	VaryHeaders []string `json:"varyHeaders,omitempty"`
+}
+
+// InvocationRetrySpec defines the connection failures of service invocations that are retried
+type InvocationRetrySpec struct {
+	// +optional
+	Conditions []string `json:"conditions,omitempty"`
+}
+
+// AppTokenValidationSpec defines the validation of the JWTs presented by the callers of the app
+type AppTokenValidationSpec struct {
+	// +optional
+	JWKSURL string `json:"jwksUrl,omitempty"`
+	// +optional
+	ClaimHeaders map[string]string `json:"claimHeaders,omitempty"`
+	// +optional
+	Routes []AppTokenRoute `json:"routes,omitempty"`
+}
+
+// AppChannelSpec defines the budgets of the calls of the sidecar to the app
+type AppChannelSpec struct {
+	// +optional
+	Budgets []AppChannelBudget `json:"budgets,omitempty"`
+}
+
+// AppChannelBudget defines the concurrency limit and the circuit breaker of the calls of a path to the app
+type AppChannelBudget struct {
+	Path string `json:"path"`
+	// +optional
+	MaxConcurrency int `json:"maxConcurrency,omitempty"`
+	// +optional
+	FailureThreshold int `json:"failureThreshold,omitempty"`
+	// +optional
+	OpenDuration string `json:"openDuration,omitempty"`
+}
+
+// AppTokenRoute defines the requirements on the tokens of the invocations of the methods matching a pattern
+type AppTokenRoute struct {
+	Method string `json:"method"`
+	Issuer string `json:"issuer"`
+	// +optional
+	Audience string `json:"audience,omitempty"`
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+}
+
 // SelectorSpec selects target services to which the handler is to be applied
 type SelectorSpec struct {
 	Fields []SelectorField `json:"fields"`
@@ -24,6 +24,94 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppChannelBudget) DeepCopyInto(out *AppChannelBudget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppChannelBudget.
+func (in *AppChannelBudget) DeepCopy() *AppChannelBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(AppChannelBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppChannelSpec) DeepCopyInto(out *AppChannelSpec) {
+	*out = *in
+	if in.Budgets != nil {
+		in, out := &in.Budgets, &out.Budgets
+		*out = make([]AppChannelBudget, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppChannelSpec.
+func (in *AppChannelSpec) DeepCopy() *AppChannelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppChannelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppTokenRoute) DeepCopyInto(out *AppTokenRoute) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppTokenRoute.
+func (in *AppTokenRoute) DeepCopy() *AppTokenRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(AppTokenRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppTokenValidationSpec) DeepCopyInto(out *AppTokenValidationSpec) {
+	*out = *in
+	if in.ClaimHeaders != nil {
+		in, out := &in.ClaimHeaders, &out.ClaimHeaders
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]AppTokenRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppTokenValidationSpec.
+func (in *AppTokenValidationSpec) DeepCopy() *AppTokenValidationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppTokenValidationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Configuration) DeepCopyInto(out *Configuration) {
 	*out = *in
@@ -90,6 +178,12 @@ func (in *ConfigurationSpec) DeepCopyInto(out *ConfigurationSpec) {
 	in.HTTPPipelineSpec.DeepCopyInto(&out.HTTPPipelineSpec)
 	out.TracingSpec = in.TracingSpec
 	out.MTLSSpec = in.MTLSSpec
+	in.CrossNamespaceSpec.DeepCopyInto(&out.CrossNamespaceSpec)
+	in.HeaderForwardingSpec.DeepCopyInto(&out.HeaderForwardingSpec)
+	in.InvocationCacheSpec.DeepCopyInto(&out.InvocationCacheSpec)
+	in.InvocationRetrySpec.DeepCopyInto(&out.InvocationRetrySpec)
+	in.AppTokenValidationSpec.DeepCopyInto(&out.AppTokenValidationSpec)
+	in.AppChannelSpec.DeepCopyInto(&out.AppChannelSpec)
 	return
 }
 
@@ -103,6 +197,94 @@ func (in *ConfigurationSpec) DeepCopy() *ConfigurationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrossNamespaceRule) DeepCopyInto(out *CrossNamespaceRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrossNamespaceRule.
+func (in *CrossNamespaceRule) DeepCopy() *CrossNamespaceRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CrossNamespaceRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrossNamespaceSpec) DeepCopyInto(out *CrossNamespaceSpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]CrossNamespaceRule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrossNamespaceSpec.
+func (in *CrossNamespaceSpec) DeepCopy() *CrossNamespaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CrossNamespaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderForwardingSpec) DeepCopyInto(out *HeaderForwardingSpec) {
+	*out = *in
+	in.Request.DeepCopyInto(&out.Request)
+	in.Response.DeepCopyInto(&out.Response)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderForwardingSpec.
+func (in *HeaderForwardingSpec) DeepCopy() *HeaderForwardingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderForwardingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderRulesSpec) DeepCopyInto(out *HeaderRulesSpec) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rename != nil {
+		in, out := &in.Rename, &out.Rename
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderRulesSpec.
+func (in *HeaderRulesSpec) DeepCopy() *HeaderRulesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderRulesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HandlerSpec) DeepCopyInto(out *HandlerSpec) {
 	*out = *in
@@ -120,6 +302,48 @@ func (in *HandlerSpec) DeepCopy() *HandlerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvocationCacheSpec) DeepCopyInto(out *InvocationCacheSpec) {
+	*out = *in
+	if in.VaryHeaders != nil {
+		in, out := &in.VaryHeaders, &out.VaryHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvocationCacheSpec.
+func (in *InvocationCacheSpec) DeepCopy() *InvocationCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InvocationCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvocationRetrySpec) DeepCopyInto(out *InvocationRetrySpec) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvocationRetrySpec.
+func (in *InvocationRetrySpec) DeepCopy() *InvocationRetrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InvocationRetrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MTLSSpec) DeepCopyInto(out *MTLSSpec) {
 	*out = *in
@@ -0,0 +1,88 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package apptoken
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+const (
+	// keysRefreshInterval is how long the keys of a JWKS endpoint are used before they are fetched again
+	keysRefreshInterval = 10 * time.Minute
+	// keysMinRefreshInterval is the minimum time between two fetches, it bounds the fetches caused by unknown key IDs
+	keysMinRefreshInterval = 30 * time.Second
+	keysRequestTimeout     = 10 * time.Second
+)
+
+// KeySet returns the keys verifying the tokens signed with a key ID
+type KeySet interface {
+	Keys(keyID string) ([]jose.JSONWebKey, error)
+}
+
+// remoteKeySet is the key set published at a JWKS endpoint. Keys are cached and fetched again after a refresh interval,
+// or when a token is signed with an unknown key ID.
+type remoteKeySet struct {
+	url    string
+	client *http.Client
+
+	lock      sync.Mutex
+	keys      jose.JSONWebKeySet
+	fetchedAt time.Time
+}
+
+// NewRemoteKeySet returns the key set published at the JWKS URL
+func NewRemoteKeySet(url string) KeySet {
+	return &remoteKeySet{
+		url:    url,
+		client: &http.Client{Timeout: keysRequestTimeout},
+	}
+}
+
+func (r *remoteKeySet) Keys(keyID string) ([]jose.JSONWebKey, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	age := time.Since(r.fetchedAt)
+	keys := r.keys.Key(keyID)
+	if age < keysRefreshInterval && (len(keys) > 0 || age < keysMinRefreshInterval) {
+		return keys, nil
+	}
+
+	fetched, err := r.fetch()
+	if err != nil {
+		if r.fetchedAt.IsZero() {
+			return nil, err
+		}
+		log.Warnf("error refreshing the keys of %s, using the cached keys: %s", r.url, err)
+		return keys, nil
+	}
+	r.keys = fetched
+	r.fetchedAt = time.Now()
+	return r.keys.Key(keyID), nil
+}
+
+func (r *remoteKeySet) fetch() (jose.JSONWebKeySet, error) {
+	keys := jose.JSONWebKeySet{}
+	resp, err := r.client.Get(r.url)
+	if err != nil {
+		return keys, fmt.Errorf("error fetching the keys: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return keys, fmt.Errorf("error fetching the keys: status code %v", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&keys); err != nil {
+		return keys, fmt.Errorf("error parsing the keys: %s", err)
+	}
+	return keys, nil
+}
@@ -0,0 +1,287 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package apptoken
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/logger"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	// DefaultLeeway is the clock skew tolerated when validating the expiry and not before times of a token
+	DefaultLeeway = time.Minute
+
+	authorizationHeader   = "authorization"
+	wwwAuthenticateHeader = "WWW-Authenticate"
+	bearerPrefix          = "bearer "
+
+	scopeClaim = "scope"
+	scpClaim   = "scp"
+)
+
+var log = logger.NewLogger("dapr.runtime.apptoken")
+
+// defaultClaimHeaders are the claims passed to the app when the configuration doesn't list any
+var defaultClaimHeaders = map[string]string{
+	"sub": "x-jwt-sub",
+	"iss": "x-jwt-iss",
+}
+
+// Validator validates the bearer tokens of the invocations of the app before they are forwarded to it.
+// A nil Validator accepts every invocation.
+type Validator struct {
+	keys         KeySet
+	routes       []config.AppTokenRoute
+	claimHeaders map[string]string
+	now          func() time.Time
+}
+
+// tokenError rejects an invocation with the status code and the error of RFC 6750
+type tokenError struct {
+	status int
+	code   string
+	err    error
+}
+
+func (e *tokenError) Error() string {
+	return e.err.Error()
+}
+
+// errorResponse is the body of the responses rejecting an invocation
+type errorResponse struct {
+	ErrorCode string `json:"errorCode"`
+	Message   string `json:"message"`
+}
+
+// NewValidator returns the validator configured by the spec, or nil when no route requires a token
+func NewValidator(spec config.AppTokenValidationSpec) (*Validator, error) {
+	if len(spec.Routes) == 0 {
+		return nil, nil
+	}
+	if spec.JWKSURL == "" {
+		return nil, errors.New("jwksUrl is required")
+	}
+	return newValidator(spec, NewRemoteKeySet(spec.JWKSURL))
+}
+
+func newValidator(spec config.AppTokenValidationSpec, keys KeySet) (*Validator, error) {
+	for _, r := range spec.Routes {
+		if r.Method == "" || r.Issuer == "" {
+			return nil, errors.New("routes require a method and an issuer")
+		}
+		if _, err := path.Match(r.Method, ""); err != nil {
+			return nil, fmt.Errorf("invalid method pattern %s: %s", r.Method, err)
+		}
+	}
+
+	claimHeaders := spec.ClaimHeaders
+	if len(claimHeaders) == 0 {
+		claimHeaders = defaultClaimHeaders
+	}
+	return &Validator{
+		keys:         keys,
+		routes:       spec.Routes,
+		claimHeaders: claimHeaders,
+		now:          time.Now,
+	}, nil
+}
+
+// Validate checks the bearer token of an invocation of the app against the first route matching the invoked method and
+// passes the claims of a valid token to the app as headers. Claim headers set by the caller are always removed.
+// It returns the response rejecting the invocation when the token is missing or invalid, nil otherwise.
+func (v *Validator) Validate(req *invokev1.InvokeMethodRequest) *invokev1.InvokeMethodResponse {
+	if v == nil {
+		return nil
+	}
+
+	md := req.Proto().Metadata
+	for _, h := range v.claimHeaders {
+		deleteHeader(md, h)
+	}
+
+	route := v.route(req.Message().GetMethod())
+	if route == nil {
+		return nil
+	}
+
+	claims, tokenErr := v.validate(route, headerValue(md, authorizationHeader))
+	if tokenErr != nil {
+		log.Debugf("rejecting the invocation of method %s: %s", req.Message().GetMethod(), tokenErr)
+		return rejection(tokenErr)
+	}
+
+	if req.Proto().Metadata == nil {
+		req.Proto().Metadata = invokev1.DaprInternalMetadata{}
+	}
+	for claim, header := range v.claimHeaders {
+		if value, ok := claimValue(claims[claim]); ok {
+			req.Proto().Metadata[header] = &internalv1pb.ListStringValue{Values: []string{value}}
+		}
+	}
+	return nil
+}
+
+// route returns the first route matching the method, nil if none does
+func (v *Validator) route(method string) *config.AppTokenRoute {
+	for i, r := range v.routes {
+		if ok, _ := path.Match(r.Method, method); ok {
+			return &v.routes[i]
+		}
+	}
+	return nil
+}
+
+// validate verifies the signature and the claims of the token and returns its claims
+func (v *Validator) validate(route *config.AppTokenRoute, authorization string) (map[string]interface{}, *tokenError) {
+	token := BearerToken(authorization)
+	if token == "" {
+		return nil, &tokenError{status: http.StatusUnauthorized, code: "invalid_request", err: errors.New("bearer token is missing")}
+	}
+	claims, _, err := VerifyToken(v.keys, token, route.Issuer, route.Audience, v.now())
+	if err != nil {
+		return nil, &tokenError{status: http.StatusUnauthorized, code: "invalid_token", err: err}
+	}
+
+	granted := scopes(claims)
+	for _, s := range route.Scopes {
+		if _, ok := granted[s]; !ok {
+			return nil, &tokenError{status: http.StatusForbidden, code: "insufficient_scope", err: fmt.Errorf("the token lacks the %s scope", s)}
+		}
+	}
+	return claims, nil
+}
+
+// BearerToken returns the token of a bearer authorization header value, or an empty string if it isn't one
+func BearerToken(authorization string) string {
+	if len(authorization) < len(bearerPrefix) || !strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		return ""
+	}
+	return strings.TrimSpace(authorization[len(bearerPrefix):])
+}
+
+// VerifyToken verifies the signature of a JWT with the keys of the key set, its expiry and issuer, and its audience
+// when one is given. It returns the claims and the expiry of a valid token.
+func VerifyToken(keySet KeySet, rawToken, issuer, audience string, now time.Time) (map[string]interface{}, time.Time, error) {
+	token, err := jwt.ParseSigned(rawToken)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("error parsing the token: %s", err)
+	}
+	if len(token.Headers) == 0 {
+		return nil, time.Time{}, errors.New("the token has no header")
+	}
+	keys, err := keySet.Keys(token.Headers[0].KeyID)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("error getting the signing keys: %s", err)
+	}
+
+	var standard jwt.Claims
+	var claims map[string]interface{}
+	verified := false
+	for _, k := range keys {
+		if token.Claims(k.Key, &standard, &claims) == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, time.Time{}, errors.New("the token signature can't be verified")
+	}
+
+	if standard.Expiry == nil {
+		return nil, time.Time{}, errors.New("the token has no expiry")
+	}
+	expected := jwt.Expected{Issuer: issuer, Time: now}
+	if audience != "" {
+		expected.Audience = jwt.Audience{audience}
+	}
+	if err := standard.ValidateWithLeeway(expected, DefaultLeeway); err != nil {
+		return nil, time.Time{}, err
+	}
+	return claims, standard.Expiry.Time(), nil
+}
+
+// scopes returns the scopes granted by the token, from the space-delimited scope claim or the scp claim
+func scopes(claims map[string]interface{}) map[string]struct{} {
+	granted := map[string]struct{}{}
+	for _, name := range []string{scopeClaim, scpClaim} {
+		switch v := claims[name].(type) {
+		case string:
+			for _, s := range strings.Fields(v) {
+				granted[s] = struct{}{}
+			}
+		case []interface{}:
+			for _, s := range v {
+				if str, ok := s.(string); ok {
+					granted[str] = struct{}{}
+				}
+			}
+		}
+	}
+	return granted
+}
+
+// claimValue formats a claim as a header value. Lists are joined with spaces and other values are JSON encoded.
+func claimValue(claim interface{}) (string, bool) {
+	switch v := claim.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			s, _ := claimValue(item)
+			values = append(values, s)
+		}
+		return strings.Join(values, " "), true
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(b), true
+	}
+}
+
+// rejection returns the response rejecting an invocation with a token error
+func rejection(tokenErr *tokenError) *invokev1.InvokeMethodResponse {
+	body, _ := json.Marshal(errorResponse{ErrorCode: "ERR_APP_TOKEN", Message: tokenErr.Error()})
+	resp := invokev1.NewInvokeMethodResponse(int32(tokenErr.status), http.StatusText(tokenErr.status), nil).WithRawData(body, invokev1.JSONContentType)
+	resp.Proto().Headers = invokev1.DaprInternalMetadata{
+		wwwAuthenticateHeader: {Values: []string{fmt.Sprintf(`Bearer error="%s"`, tokenErr.code)}},
+	}
+	return resp
+}
+
+// headerValue returns the first value of a header, matching its name case-insensitively
+func headerValue(md invokev1.DaprInternalMetadata, name string) string {
+	for k, v := range md {
+		if strings.EqualFold(k, name) && len(v.GetValues()) > 0 {
+			return v.GetValues()[0]
+		}
+	}
+	return ""
+}
+
+// deleteHeader removes a header, matching its name case-insensitively
+func deleteHeader(md invokev1.DaprInternalMetadata, name string) {
+	for k := range md {
+		if strings.EqualFold(k, name) {
+			delete(md, k)
+		}
+	}
+}
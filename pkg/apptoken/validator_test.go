@@ -0,0 +1,173 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package apptoken
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/stretchr/testify/assert"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const testIssuer = "https://issuer.example.com"
+
+type staticKeySet struct {
+	keys jose.JSONWebKeySet
+}
+
+func (s *staticKeySet) Keys(keyID string) ([]jose.JSONWebKey, error) {
+	return s.keys.Key(keyID), nil
+}
+
+func newSigningKey(t *testing.T, keyID string) (*rsa.PrivateKey, jose.JSONWebKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	return key, jose.JSONWebKey{Key: &key.PublicKey, KeyID: keyID, Algorithm: string(jose.RS256), Use: "sig"}
+}
+
+func newToken(t *testing.T, key *rsa.PrivateKey, keyID string, claims map[string]interface{}) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", keyID))
+	assert.NoError(t, err)
+	token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+	assert.NoError(t, err)
+	return token
+}
+
+func newInvocation(method string, headers map[string][]string) *invokev1.InvokeMethodRequest {
+	return invokev1.NewInvokeMethodRequest(method).WithMetadata(headers)
+}
+
+func TestNewValidator(t *testing.T) {
+	v, err := NewValidator(config.AppTokenValidationSpec{})
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	assert.Nil(t, v.Validate(newInvocation("orders", nil)))
+
+	_, err = NewValidator(config.AppTokenValidationSpec{Routes: []config.AppTokenRoute{{Method: "*", Issuer: testIssuer}}})
+	assert.Error(t, err)
+	_, err = NewValidator(config.AppTokenValidationSpec{JWKSURL: "http://keys", Routes: []config.AppTokenRoute{{Method: "*"}}})
+	assert.Error(t, err)
+	_, err = NewValidator(config.AppTokenValidationSpec{JWKSURL: "http://keys", Routes: []config.AppTokenRoute{{Method: "[", Issuer: testIssuer}}})
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	key, jwk := newSigningKey(t, "key1")
+	otherKey, _ := newSigningKey(t, "key1")
+	v, err := newValidator(config.AppTokenValidationSpec{
+		Routes: []config.AppTokenRoute{
+			{Method: "health", Issuer: "nobody"},
+			{Method: "orders/*", Issuer: testIssuer, Audience: "orders", Scopes: []string{"orders.read"}},
+		},
+		ClaimHeaders: map[string]string{"sub": "x-user", "roles": "x-roles"},
+	}, &staticKeySet{keys: jose.JSONWebKeySet{Keys: []jose.JSONWebKey{jwk}}})
+	assert.NoError(t, err)
+
+	claims := func(overrides map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"iss":   testIssuer,
+			"aud":   "orders",
+			"sub":   "alice",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"scope": "orders.read orders.write",
+			"roles": []string{"admin", "ops"},
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(c, k)
+			} else {
+				c[k] = v
+			}
+		}
+		return c
+	}
+	bearer := func(token string) map[string][]string {
+		return map[string][]string{"Authorization": {"Bearer " + token}, "x-user": {"mallory"}}
+	}
+
+	t.Run("valid token", func(t *testing.T) {
+		req := newInvocation("orders/1", bearer(newToken(t, key, "key1", claims(nil))))
+		assert.Nil(t, v.Validate(req))
+		assert.Equal(t, []string{"alice"}, req.Metadata()["x-user"].Values)
+		assert.Equal(t, []string{"admin ops"}, req.Metadata()["x-roles"].Values)
+	})
+
+	t.Run("scp claim", func(t *testing.T) {
+		req := newInvocation("orders/1", bearer(newToken(t, key, "key1", claims(map[string]interface{}{"scope": nil, "scp": []string{"orders.read"}}))))
+		assert.Nil(t, v.Validate(req))
+	})
+
+	t.Run("method without route", func(t *testing.T) {
+		req := newInvocation("catalog", map[string][]string{"x-user": {"mallory"}})
+		assert.Nil(t, v.Validate(req))
+		assert.Empty(t, req.Metadata())
+	})
+
+	rejected := []struct {
+		name    string
+		headers map[string][]string
+		status  int32
+		code    string
+	}{
+		{"missing token", map[string][]string{}, http.StatusUnauthorized, "invalid_request"},
+		{"malformed token", bearer("abc"), http.StatusUnauthorized, "invalid_token"},
+		{"wrong signature", bearer(newToken(t, otherKey, "key1", claims(nil))), http.StatusUnauthorized, "invalid_token"},
+		{"unknown key", bearer(newToken(t, key, "key2", claims(nil))), http.StatusUnauthorized, "invalid_token"},
+		{"wrong issuer", bearer(newToken(t, key, "key1", claims(map[string]interface{}{"iss": "https://evil.example.com"}))), http.StatusUnauthorized, "invalid_token"},
+		{"wrong audience", bearer(newToken(t, key, "key1", claims(map[string]interface{}{"aud": "payments"}))), http.StatusUnauthorized, "invalid_token"},
+		{"expired", bearer(newToken(t, key, "key1", claims(map[string]interface{}{"exp": time.Now().Add(-time.Hour).Unix()}))), http.StatusUnauthorized, "invalid_token"},
+		{"no expiry", bearer(newToken(t, key, "key1", claims(map[string]interface{}{"exp": nil}))), http.StatusUnauthorized, "invalid_token"},
+		{"missing scope", bearer(newToken(t, key, "key1", claims(map[string]interface{}{"scope": "orders.write"}))), http.StatusForbidden, "insufficient_scope"},
+	}
+	for _, tt := range rejected {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := v.Validate(newInvocation("orders/1", tt.headers))
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.status, resp.Status().Code)
+			assert.Equal(t, []string{`Bearer error="` + tt.code + `"`}, resp.Headers()[wwwAuthenticateHeader].Values)
+
+			_, body := resp.RawData()
+			var e errorResponse
+			assert.NoError(t, json.Unmarshal(body, &e))
+			assert.Equal(t, "ERR_APP_TOKEN", e.ErrorCode)
+		})
+	}
+}
+
+func TestRemoteKeySet(t *testing.T) {
+	_, jwk := newSigningKey(t, "key1")
+	var fetches int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{jwk}})
+	}))
+	defer server.Close()
+
+	keys := NewRemoteKeySet(server.URL)
+	found, err := keys.Keys("key1")
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+
+	// known and recently refreshed keys are served from the cache
+	keys.Keys("key1")
+	keys.Keys("key2")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+
+	_, err = NewRemoteKeySet("http://127.0.0.1:1").Keys("key1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,248 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package channel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// InvocationPath is the path of the service invocations of the app
+	InvocationPath = "invocation"
+	// PubSubPath is the path of the pubsub messages delivered to the app
+	PubSubPath = "pubsub"
+	// ActorsPath is the path of the actor calls, reminders and timers of the app
+	ActorsPath = "actors"
+	// BindingsPath is the path of the input binding events delivered to the app
+	BindingsPath = "bindings"
+
+	// DefaultOpenDuration is how long the circuit of a budget without an open duration stays open
+	DefaultOpenDuration = time.Second * 30
+)
+
+var budgetPaths = map[string]bool{
+	InvocationPath: true,
+	PubSubPath:     true,
+	ActorsPath:     true,
+	BindingsPath:   true,
+}
+
+type pathContextKey struct{}
+
+// WithPath returns a context tagging the calls to the app made with it with path
+func WithPath(ctx context.Context, path string) context.Context {
+	return context.WithValue(ctx, pathContextKey{}, path)
+}
+
+// PathFromContext returns the path a context is tagged with, or an empty string
+func PathFromContext(ctx context.Context) string {
+	path, _ := ctx.Value(pathContextKey{}).(string)
+	return path
+}
+
+// Budgets bounds the concurrent calls of each path to the app and breaks the circuit of a path whose calls keep failing.
+// The calls of paths without a budget are not bounded.
+type Budgets struct {
+	budgets map[string]*budget
+}
+
+type budget struct {
+	path         string
+	sem          chan struct{}
+	threshold    int
+	openDuration time.Duration
+
+	lock     sync.Mutex
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewBudgets returns the budgets of the paths of the app channel spec
+func NewBudgets(spec config.AppChannelSpec) (*Budgets, error) {
+	b := &Budgets{budgets: map[string]*budget{}}
+	for _, s := range spec.Budgets {
+		if !budgetPaths[s.Path] {
+			return nil, fmt.Errorf("unknown app channel path %q", s.Path)
+		}
+		if _, ok := b.budgets[s.Path]; ok {
+			return nil, fmt.Errorf("duplicate budget of app channel path %s", s.Path)
+		}
+		if s.MaxConcurrency < 0 || s.FailureThreshold < 0 {
+			return nil, fmt.Errorf("the budget of app channel path %s must not be negative", s.Path)
+		}
+
+		openDuration := DefaultOpenDuration
+		if s.OpenDuration != "" {
+			d, err := time.ParseDuration(s.OpenDuration)
+			if err != nil || d <= 0 {
+				return nil, fmt.Errorf("invalid open duration %q of app channel path %s", s.OpenDuration, s.Path)
+			}
+			openDuration = d
+		}
+
+		bu := &budget{
+			path:         s.Path,
+			threshold:    s.FailureThreshold,
+			openDuration: openDuration,
+		}
+		if s.MaxConcurrency > 0 {
+			bu.sem = make(chan struct{}, s.MaxConcurrency)
+		}
+		b.budgets[s.Path] = bu
+	}
+	return b, nil
+}
+
+// Len returns the number of paths with a budget
+func (b *Budgets) Len() int {
+	if b == nil {
+		return 0
+	}
+	return len(b.budgets)
+}
+
+// Acquire waits for a call of path to fit in its budget. The returned function must be called with the outcome of the
+// call once it completes. It fails with codes.Unavailable while the circuit of path is open.
+func (b *Budgets) Acquire(ctx context.Context, path string) (func(failed bool), error) {
+	if b == nil {
+		return func(bool) {}, nil
+	}
+	bu, ok := b.budgets[path]
+	if !ok {
+		return func(bool) {}, nil
+	}
+
+	probe, err := bu.allow()
+	if err != nil {
+		return nil, err
+	}
+	if bu.sem != nil {
+		select {
+		case bu.sem <- struct{}{}:
+		case <-ctx.Done():
+			bu.cancel(probe)
+			return nil, ctx.Err()
+		}
+	}
+
+	var once sync.Once
+	return func(failed bool) {
+		once.Do(func() {
+			if bu.sem != nil {
+				<-bu.sem
+			}
+			bu.record(probe, failed)
+		})
+	}, nil
+}
+
+// allow fails while the circuit is open. It returns true for the call probing the app once the circuit was open for
+// the open duration, which is the only call allowed until it completes.
+func (bu *budget) allow() (bool, error) {
+	if bu.threshold == 0 {
+		return false, nil
+	}
+
+	bu.lock.Lock()
+	defer bu.lock.Unlock()
+
+	if bu.failures < bu.threshold {
+		return false, nil
+	}
+	if bu.probing || time.Since(bu.openedAt) < bu.openDuration {
+		return false, status.Errorf(codes.Unavailable, "the circuit of app channel path %s is open", bu.path)
+	}
+	bu.probing = true
+	return true, nil
+}
+
+func (bu *budget) cancel(probe bool) {
+	if probe {
+		bu.lock.Lock()
+		bu.probing = false
+		bu.lock.Unlock()
+	}
+}
+
+func (bu *budget) record(probe, failed bool) {
+	if bu.threshold == 0 {
+		return
+	}
+
+	bu.lock.Lock()
+	defer bu.lock.Unlock()
+
+	if probe {
+		bu.probing = false
+	}
+	if !failed {
+		bu.failures = 0
+		return
+	}
+	// the failures of the calls started before the circuit opened don't extend it
+	if bu.failures < bu.threshold || probe {
+		bu.failures++
+		if bu.failures >= bu.threshold {
+			bu.openedAt = time.Now()
+		}
+	}
+}
+
+type budgetedChannel struct {
+	AppChannel
+	budgets *Budgets
+}
+
+// NewBudgetedChannel returns an app channel holding the calls made with a context tagged by WithPath to the budget of
+// their path
+func NewBudgetedChannel(ch AppChannel, budgets *Budgets) AppChannel {
+	return &budgetedChannel{AppChannel: ch, budgets: budgets}
+}
+
+func (c *budgetedChannel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	release, err := c.budgets.Acquire(ctx, PathFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.AppChannel.InvokeMethod(ctx, req)
+	release(err != nil || FailedResponse(resp))
+	return resp, err
+}
+
+// FailedResponse returns true if the response of the app tells it failed to handle the call rather than rejected it,
+// an HTTP server error or a gRPC status of the same kind
+func FailedResponse(resp *invokev1.InvokeMethodResponse) bool {
+	if resp == nil || resp.Status() == nil {
+		return false
+	}
+	code := resp.Status().Code
+	if resp.IsHTTPResponse() {
+		return code >= 500
+	}
+	return failedCode(codes.Code(code))
+}
+
+// FailedError returns true if the error of a gRPC call to the app tells it failed to handle the call
+func FailedError(err error) bool {
+	return err != nil && failedCode(status.Code(err))
+}
+
+func failedCode(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package channel
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeChannel struct {
+	calls int
+	resp  *invokev1.InvokeMethodResponse
+	err   error
+}
+
+func (f *fakeChannel) GetBaseAddress() string {
+	return ""
+}
+
+func (f *fakeChannel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	f.calls++
+	return f.resp, f.err
+}
+
+func TestNewBudgets(t *testing.T) {
+	t.Run("valid budgets", func(t *testing.T) {
+		b, err := NewBudgets(config.AppChannelSpec{Budgets: []config.AppChannelBudget{
+			{Path: PubSubPath, MaxConcurrency: 2},
+			{Path: ActorsPath, FailureThreshold: 3, OpenDuration: "5s"},
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, b.Len())
+		assert.Equal(t, 5*time.Second, b.budgets[ActorsPath].openDuration)
+		assert.Equal(t, DefaultOpenDuration, b.budgets[PubSubPath].openDuration)
+	})
+
+	invalid := map[string]config.AppChannelBudget{
+		"unknown path":          {Path: "workflows"},
+		"negative concurrency":  {Path: PubSubPath, MaxConcurrency: -1},
+		"invalid open duration": {Path: PubSubPath, FailureThreshold: 1, OpenDuration: "soon"},
+	}
+	for name, budget := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewBudgets(config.AppChannelSpec{Budgets: []config.AppChannelBudget{budget}})
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("duplicate path", func(t *testing.T) {
+		_, err := NewBudgets(config.AppChannelSpec{Budgets: []config.AppChannelBudget{
+			{Path: PubSubPath}, {Path: PubSubPath},
+		}})
+		assert.Error(t, err)
+	})
+}
+
+func TestBudgetConcurrency(t *testing.T) {
+	b, _ := NewBudgets(config.AppChannelSpec{Budgets: []config.AppChannelBudget{
+		{Path: PubSubPath, MaxConcurrency: 1},
+	}})
+
+	release, err := b.Acquire(context.Background(), PubSubPath)
+	assert.NoError(t, err)
+
+	t.Run("full path waits", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := b.Acquire(ctx, PubSubPath)
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+
+	t.Run("other paths are not bounded", func(t *testing.T) {
+		_, err := b.Acquire(context.Background(), InvocationPath)
+		assert.NoError(t, err)
+		_, err = b.Acquire(context.Background(), "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("released call frees the path", func(t *testing.T) {
+		release(false)
+		release(false)
+		_, err := b.Acquire(context.Background(), PubSubPath)
+		assert.NoError(t, err)
+	})
+}
+
+func TestBudgetCircuit(t *testing.T) {
+	b, _ := NewBudgets(config.AppChannelSpec{Budgets: []config.AppChannelBudget{
+		{Path: ActorsPath, FailureThreshold: 2, OpenDuration: "20ms"},
+	}})
+	call := func(failed bool) error {
+		release, err := b.Acquire(context.Background(), ActorsPath)
+		if err == nil {
+			release(failed)
+		}
+		return err
+	}
+
+	assert.NoError(t, call(true))
+	assert.NoError(t, call(false))
+	assert.NoError(t, call(true))
+	assert.NoError(t, call(true))
+
+	err := call(false)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	time.Sleep(30 * time.Millisecond)
+	t.Run("single probe once open duration elapsed", func(t *testing.T) {
+		release, err := b.Acquire(context.Background(), ActorsPath)
+		assert.NoError(t, err)
+		assert.Equal(t, codes.Unavailable, status.Code(call(false)))
+
+		release(true)
+		assert.Equal(t, codes.Unavailable, status.Code(call(false)))
+	})
+
+	time.Sleep(30 * time.Millisecond)
+	t.Run("successful probe closes the circuit", func(t *testing.T) {
+		assert.NoError(t, call(false))
+		assert.NoError(t, call(true))
+		assert.NoError(t, call(false))
+	})
+}
+
+func TestBudgetedChannel(t *testing.T) {
+	b, _ := NewBudgets(config.AppChannelSpec{Budgets: []config.AppChannelBudget{
+		{Path: PubSubPath, FailureThreshold: 1, OpenDuration: "1m"},
+	}})
+	req := invokev1.NewInvokeMethodRequest("method")
+
+	t.Run("untagged calls skip the budget", func(t *testing.T) {
+		inner := &fakeChannel{err: errors.New("connection refused")}
+		ch := NewBudgetedChannel(inner, b)
+		for i := 0; i < 3; i++ {
+			_, err := ch.InvokeMethod(context.Background(), req)
+			assert.EqualError(t, err, "connection refused")
+		}
+		assert.Equal(t, 3, inner.calls)
+	})
+
+	t.Run("client errors don't open the circuit", func(t *testing.T) {
+		inner := &fakeChannel{resp: invokev1.NewInvokeMethodResponse(http.StatusNotFound, "", nil)}
+		ch := NewBudgetedChannel(inner, b)
+		ctx := WithPath(context.Background(), PubSubPath)
+		for i := 0; i < 2; i++ {
+			_, err := ch.InvokeMethod(ctx, req)
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, 2, inner.calls)
+	})
+
+	t.Run("server errors open the circuit", func(t *testing.T) {
+		inner := &fakeChannel{resp: invokev1.NewInvokeMethodResponse(http.StatusServiceUnavailable, "", nil)}
+		ch := NewBudgetedChannel(inner, b)
+		ctx := WithPath(context.Background(), PubSubPath)
+		_, err := ch.InvokeMethod(ctx, req)
+		assert.NoError(t, err)
+		_, err = ch.InvokeMethod(ctx, req)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, 1, inner.calls)
+	})
+}
+
+func TestFailedResponse(t *testing.T) {
+	assert.False(t, FailedResponse(nil))
+	assert.False(t, FailedResponse(invokev1.NewInvokeMethodResponse(http.StatusOK, "", nil)))
+	assert.False(t, FailedResponse(invokev1.NewInvokeMethodResponse(http.StatusBadRequest, "", nil)))
+	assert.True(t, FailedResponse(invokev1.NewInvokeMethodResponse(http.StatusInternalServerError, "", nil)))
+	assert.False(t, FailedResponse(invokev1.NewInvokeMethodResponse(int32(codes.NotFound), "", nil)))
+	assert.True(t, FailedResponse(invokev1.NewInvokeMethodResponse(int32(codes.Unavailable), "", nil)))
+
+	assert.False(t, FailedError(nil))
+	assert.False(t, FailedError(status.Error(codes.InvalidArgument, "bad")))
+	assert.True(t, FailedError(status.Error(codes.DeadlineExceeded, "slow")))
+	assert.True(t, FailedError(errors.New("connection reset")))
+}
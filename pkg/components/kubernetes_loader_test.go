@@ -42,6 +42,10 @@ func (o *mockOperator) GetComponents(ctx context.Context, in *empty.Empty) (*ope
 	}, nil
 }
 
+func (o *mockOperator) GetFleetMetadata(ctx context.Context, in *operatorv1pb.GetFleetMetadataRequest) (*operatorv1pb.GetFleetMetadataResponse, error) {
+	return nil, nil
+}
+
 func (o *mockOperator) ComponentUpdate(in *empty.Empty, srv operatorv1pb.Operator_ComponentUpdateServer) error {
 	return nil
 }
@@ -0,0 +1,88 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package lifecycle
+
+import (
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+)
+
+// inFlightPollInterval is the interval between checks of the in-flight operations of a component shutting down
+const inFlightPollInterval = 10 * time.Millisecond
+
+// Flusher is implemented by components buffering writes that must be persisted before the runtime exits
+type Flusher interface {
+	Flush() error
+}
+
+// InFlight counts the operations a component is running
+type InFlight struct {
+	count int64
+}
+
+// Start records the start of an operation
+func (i *InFlight) Start() {
+	atomic.AddInt64(&i.count, 1)
+}
+
+// Done records the end of an operation
+func (i *InFlight) Done() {
+	atomic.AddInt64(&i.count, -1)
+}
+
+// Count returns the number of operations running
+func (i *InFlight) Count() int64 {
+	return atomic.LoadInt64(&i.count)
+}
+
+// Wait waits for the running operations to complete. It returns false if some are still running at the deadline.
+func (i *InFlight) Wait(deadline time.Time) bool {
+	for i.Count() > 0 {
+		if !time.Now().Before(deadline) {
+			return false
+		}
+		time.Sleep(inFlightPollInterval)
+	}
+	return true
+}
+
+// Shutdown waits for the in-flight operations of a component, then flushes and closes it if it supports it.
+// Each step only runs until the deadline: a component that doesn't complete in time keeps its buffered writes.
+func Shutdown(component interface{}, inFlight *InFlight, deadline time.Time) error {
+	if inFlight != nil && !inFlight.Wait(deadline) {
+		return fmt.Errorf("%v operations still in flight", inFlight.Count())
+	}
+	if f, ok := component.(Flusher); ok {
+		if err := runUntil(deadline, f.Flush); err != nil {
+			return fmt.Errorf("flush failed: %s", err)
+		}
+	}
+	if c, ok := component.(io.Closer); ok {
+		if err := runUntil(deadline, c.Close); err != nil {
+			return fmt.Errorf("close failed: %s", err)
+		}
+	}
+	return nil
+}
+
+// runUntil runs fn and stops waiting for it at the deadline
+func runUntil(deadline time.Time, fn func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+		return fmt.Errorf("timed out")
+	}
+}
@@ -0,0 +1,95 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package lifecycle
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeComponent struct {
+	flushDelay time.Duration
+	flushErr   error
+	flushed    bool
+	closed     bool
+}
+
+func (f *fakeComponent) Flush() error {
+	time.Sleep(f.flushDelay)
+	f.flushed = true
+	return f.flushErr
+}
+
+func (f *fakeComponent) Close() error {
+	f.closed = true
+	return nil
+}
+
+func TestInFlightWait(t *testing.T) {
+	t.Run("no operations", func(t *testing.T) {
+		i := &InFlight{}
+		assert.True(t, i.Wait(time.Now()))
+	})
+
+	t.Run("operations complete before the deadline", func(t *testing.T) {
+		i := &InFlight{}
+		i.Start()
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			i.Done()
+		}()
+		assert.True(t, i.Wait(time.Now().Add(time.Second)))
+		assert.Equal(t, int64(0), i.Count())
+	})
+
+	t.Run("operations still running at the deadline", func(t *testing.T) {
+		i := &InFlight{}
+		i.Start()
+		assert.False(t, i.Wait(time.Now().Add(20*time.Millisecond)))
+		assert.Equal(t, int64(1), i.Count())
+	})
+}
+
+func TestShutdown(t *testing.T) {
+	t.Run("flushes then closes", func(t *testing.T) {
+		c := &fakeComponent{}
+		assert.NoError(t, Shutdown(c, &InFlight{}, time.Now().Add(time.Second)))
+		assert.True(t, c.flushed)
+		assert.True(t, c.closed)
+	})
+
+	t.Run("component without hooks", func(t *testing.T) {
+		assert.NoError(t, Shutdown(struct{}{}, nil, time.Now().Add(time.Second)))
+	})
+
+	t.Run("in-flight operations past the deadline skip the flush", func(t *testing.T) {
+		c := &fakeComponent{}
+		i := &InFlight{}
+		i.Start()
+		err := Shutdown(c, i, time.Now().Add(20*time.Millisecond))
+		assert.Error(t, err)
+		assert.False(t, c.flushed)
+		assert.False(t, c.closed)
+	})
+
+	t.Run("flush error skips close", func(t *testing.T) {
+		c := &fakeComponent{flushErr: errors.New("broker unavailable")}
+		err := Shutdown(c, nil, time.Now().Add(time.Second))
+		assert.EqualError(t, err, "flush failed: broker unavailable")
+		assert.False(t, c.closed)
+	})
+
+	t.Run("flush times out", func(t *testing.T) {
+		c := &fakeComponent{flushDelay: 200 * time.Millisecond}
+		start := time.Now()
+		err := Shutdown(c, nil, start.Add(20*time.Millisecond))
+		assert.EqualError(t, err, "flush failed: timed out")
+		assert.True(t, time.Since(start) < 200*time.Millisecond)
+	})
+}
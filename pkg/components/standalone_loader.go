@@ -35,29 +35,42 @@ func NewStandaloneComponents(configuration config.StandaloneConfig) *StandaloneC
 
 // LoadComponents loads dapr components from a given directory
 func (s *StandaloneComponents) LoadComponents() ([]components_v1alpha1.Component, error) {
+	list, _, err := s.LoadComponentsWithErrors()
+	return list, err
+}
+
+// LoadComponentsWithErrors loads dapr components from a given directory and also returns
+// the errors of the files or yaml documents that were skipped
+func (s *StandaloneComponents) LoadComponentsWithErrors() ([]components_v1alpha1.Component, []error, error) {
 	dir := s.config.ComponentsPath
 	files, err := ioutil.ReadDir(dir)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	list := []components_v1alpha1.Component{}
+	errs := []error{}
 
 	for _, file := range files {
 		if !file.IsDir() && s.isYaml(file.Name()) {
-			b, err := ioutil.ReadFile(fmt.Sprintf("%s/%s", dir, file.Name()))
+			path := fmt.Sprintf("%s/%s", dir, file.Name())
+			b, err := ioutil.ReadFile(path)
 
 			if err != nil {
-				log.Warnf("error reading file %s/%s : %s", dir, file.Name(), err)
+				log.Warnf("error reading file %s : %s", path, err)
+				errs = append(errs, fmt.Errorf("error reading file %s: %s", path, err))
 				continue
 			}
 
-			components, _ := s.decodeYaml(fmt.Sprintf("%s/%s", dir, file.Name()), b)
+			components, decodeErrs := s.decodeYaml(path, b)
 			list = append(list, components...)
+			for _, decodeErr := range decodeErrs {
+				errs = append(errs, fmt.Errorf("error parsing yaml resource in %s: %s", path, decodeErr))
+			}
 		}
 	}
 
-	return list, nil
+	return list, errs, nil
 }
 
 // isYaml checks whether the file is yaml or not
@@ -15,4 +15,15 @@ type ApplicationConfig struct {
 	// Duration. example: "30s"
 	DrainOngoingCallTimeout string `json:"drainOngoingCallTimeout"`
 	DrainRebalancedActors   bool   `json:"drainRebalancedActors"`
+	// Actor methods that don't change state, keyed by actor type. Calls to these methods
+	// skip the turn-based lock and run concurrently with each other.
+	ReadOnlyMethods map[string][]string `json:"readOnlyMethods,omitempty"`
+	// Metadata keys of actor invocations forwarded to actor methods, e.g. "x-tenant-*".
+	// All metadata is forwarded when empty.
+	ActorMetadata []string `json:"actorMetadata,omitempty"`
+	// Actor methods that only read state, keyed by actor type. Their responses are cached
+	// per actor and payload until the actor's state changes or CachedMethodTTL passes.
+	CachedMethods map[string][]string `json:"cachedMethods,omitempty"`
+	// Duration. example: "1s"
+	CachedMethodTTL string `json:"cachedMethodTTL,omitempty"`
 }
@@ -31,6 +31,18 @@ type ConfigurationSpec struct {
 	HTTPPipelineSpec PipelineSpec `json:"httpPipeline,omitempty" yaml:"httpPipeline,omitempty"`
 	TracingSpec      TracingSpec  `json:"tracing,omitempty" yaml:"tracing,omitempty"`
 	MTLSSpec         MTLSSpec     `json:"mtls,omitempty"`
+	// +optional
+	CrossNamespaceSpec CrossNamespaceSpec `json:"crossNamespaceInvocation,omitempty" yaml:"crossNamespaceInvocation,omitempty"`
+	// +optional
+	HeaderForwardingSpec HeaderForwardingSpec `json:"headerForwarding,omitempty" yaml:"headerForwarding,omitempty"`
+	// +optional
+	InvocationCacheSpec InvocationCacheSpec `json:"invocationCache,omitempty" yaml:"invocationCache,omitempty"`
+	// +optional
+	InvocationRetrySpec InvocationRetrySpec `json:"invocationRetry,omitempty" yaml:"invocationRetry,omitempty"`
+	// +optional
+	AppTokenValidationSpec AppTokenValidationSpec `json:"appTokenValidation,omitempty" yaml:"appTokenValidation,omitempty"`
+	// +optional
+	AppChannelSpec AppChannelSpec `json:"appChannel,omitempty" yaml:"appChannel,omitempty"`
 }
 
 type PipelineSpec struct {
@@ -62,6 +74,104 @@ type MTLSSpec struct {
 	AllowedClockSkew string `json:"allowedClockSkew"`
 }
 
+// CrossNamespaceSpec controls which apps in other namespaces can be invoked.
+// Targets are only parsed as "id.namespace" when Enabled is set, otherwise app IDs containing dots are used as is.
+// Rules are evaluated in order and the first match wins. DefaultAction applies when no rule matches.
+type CrossNamespaceSpec struct {
+	Enabled       bool                 `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	DefaultAction string               `json:"defaultAction,omitempty" yaml:"defaultAction,omitempty"`
+	Rules         []CrossNamespaceRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+}
+
+// CrossNamespaceRule allows or denies invocation of the apps matching the namespace and app ID patterns.
+// Patterns use shell glob syntax, e.g. "prod-*". An empty pattern matches everything.
+type CrossNamespaceRule struct {
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	AppID     string `json:"appId,omitempty" yaml:"appId,omitempty"`
+	Action    string `json:"action" yaml:"action"`
+}
+
+// HeaderForwardingSpec controls which headers and metadata are forwarded on service invocation.
+// Request rules apply to the headers sent by the app to the invoked app, response rules to the headers returned to the app.
+type HeaderForwardingSpec struct {
+	Request  HeaderRulesSpec `json:"request,omitempty" yaml:"request,omitempty"`
+	Response HeaderRulesSpec `json:"response,omitempty" yaml:"response,omitempty"`
+}
+
+// HeaderRulesSpec lists the headers forwarded, stripped and renamed. Deny rules win over allow rules and all headers are
+// allowed when Allow is empty. Patterns use shell glob syntax, e.g. "x-internal-*", and are matched case-insensitively.
+type HeaderRulesSpec struct {
+	Allow  []string          `json:"allow,omitempty" yaml:"allow,omitempty"`
+	Deny   []string          `json:"deny,omitempty" yaml:"deny,omitempty"`
+	Rename map[string]string `json:"rename,omitempty" yaml:"rename,omitempty"`
+}
+
+// InvocationCacheSpec configures the opt-in cache of the responses of HTTP GET service invocations.
+// Responses are cached by target app, method, query string, credentials and the values of the Vary headers.
+// TTL is a duration, e.g. "5s", used for the responses that don't set max-age, and MaxEntries bounds the number of
+// cached responses.
+type InvocationCacheSpec struct {
+	Enabled     bool     `json:"enabled" yaml:"enabled"`
+	TTL         string   `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+	MaxEntries  int      `json:"maxEntries,omitempty" yaml:"maxEntries,omitempty"`
+	VaryHeaders []string `json:"varyHeaders,omitempty" yaml:"varyHeaders,omitempty"`
+}
+
+// InvocationRetrySpec lists the connection failures of service invocations that are retried. "connect" retries the
+// failures before the request is sent, which are safe to retry for any call. "sent" retries the connection losses
+// after the request is sent, which may have reached the invoked app and are only safe to retry for idempotent calls.
+// Both are retried when Conditions is empty.
+type InvocationRetrySpec struct {
+	Conditions []string `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+}
+
+// AppTokenValidationSpec configures the validation of the JWTs presented by the callers of the app.
+// The sidecar validates the bearer token of the invocations of the methods matching a route before forwarding them to
+// the app, and passes the claims listed in ClaimHeaders to the app as headers. Routes are evaluated in order and the
+// first route matching the method applies. Tokens are verified with the keys published at JWKSURL.
+type AppTokenValidationSpec struct {
+	JWKSURL      string            `json:"jwksUrl,omitempty" yaml:"jwksUrl,omitempty"`
+	ClaimHeaders map[string]string `json:"claimHeaders,omitempty" yaml:"claimHeaders,omitempty"`
+	Routes       []AppTokenRoute   `json:"routes,omitempty" yaml:"routes,omitempty"`
+}
+
+// AppTokenRoute lists the requirements on the tokens of the invocations of the methods matching Method,
+// a shell glob pattern, e.g. "orders/*"
+type AppTokenRoute struct {
+	Method   string   `json:"method" yaml:"method"`
+	Issuer   string   `json:"issuer" yaml:"issuer"`
+	Audience string   `json:"audience,omitempty" yaml:"audience,omitempty"`
+	Scopes   []string `json:"scopes,omitempty" yaml:"scopes,omitempty"`
+}
+
+// AppChannelSpec configures the budgets of the calls of the sidecar to the app. Each budget applies to the calls of a
+// path, "invocation", "pubsub", "actors" or "bindings", so an overloaded path can't starve the others.
+type AppChannelSpec struct {
+	Budgets []AppChannelBudget `json:"budgets,omitempty" yaml:"budgets,omitempty"`
+}
+
+// AppChannelBudget bounds the concurrent calls of a path to the app and breaks its circuit after FailureThreshold
+// consecutive failed calls. The calls of an open circuit fail right away for OpenDuration, a duration, e.g. "30s",
+// then a single call probes the app before the circuit closes again.
+type AppChannelBudget struct {
+	Path             string `json:"path" yaml:"path"`
+	MaxConcurrency   int    `json:"maxConcurrency,omitempty" yaml:"maxConcurrency,omitempty"`
+	FailureThreshold int    `json:"failureThreshold,omitempty" yaml:"failureThreshold,omitempty"`
+	OpenDuration     string `json:"openDuration,omitempty" yaml:"openDuration,omitempty"`
+}
+
+const (
+	// AllowAction allows a matching cross-namespace invocation
+	AllowAction = "allow"
+	// DenyAction denies a matching cross-namespace invocation
+	DenyAction = "deny"
+
+	// RetryOnConnect retries the service invocations failing before the request is sent
+	RetryOnConnect = "connect"
+	// RetryOnSent retries the service invocations losing the connection after the request is sent
+	RetryOnSent = "sent"
+)
+
 // LoadDefaultConfiguration returns the default config with tracing disabled
 func LoadDefaultConfiguration() *Configuration {
 	return &Configuration{
@@ -0,0 +1,401 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSearchYears bounds the search for the next fire time of schedules that rarely or never match, e.g. 30 2 * *
+const maxSearchYears = 5
+
+const (
+	fieldMinute = iota
+	fieldHour
+	fieldDayOfMonth
+	fieldMonth
+	fieldDayOfWeek
+)
+
+// timeZonePrefixes may precede an expression to set its time zone, e.g. CRON_TZ=Europe/Paris 0 9 * * MON-FRI
+var timeZonePrefixes = []string{"CRON_TZ=", "TZ="}
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type fieldBounds struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var bounds = []fieldBounds{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}},
+	// 7 is accepted as an alias of Sunday
+	{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}},
+}
+
+// ParseError describes an invalid token of a cron expression
+type ParseError struct {
+	Expression string
+	// Field is the name of the field that holds the token, e.g. "day of month"
+	Field string
+	// Token is the invalid part of the expression
+	Token string
+	// Position is the 1 based index of the field in the expression, 0 if the error isn't tied to a field
+	Position int
+	Reason   string
+}
+
+func (e *ParseError) Error() string {
+	if e.Position == 0 {
+		return fmt.Sprintf("invalid cron expression %q: %s", e.Expression, e.Reason)
+	}
+	return fmt.Sprintf("invalid cron expression %q: %s field (position %v) token %q: %s", e.Expression, e.Field, e.Position, e.Token, e.Reason)
+}
+
+// Schedule is a parsed cron expression bound to a time zone
+type Schedule struct {
+	expression string
+	location   *time.Location
+
+	minutes     uint64
+	hours       uint64
+	daysOfMonth uint64
+	months      uint64
+	daysOfWeek  uint64
+
+	// lastDayOfMonth is set by L, lastWeekday by LW and nearestWeekdays by nW in the day of month field
+	lastDayOfMonth  bool
+	lastWeekday     bool
+	nearestWeekdays []int
+
+	// domRestricted and dowRestricted tell whether the day fields were given as something other than * or ?.
+	// When both are restricted a day matches if either field matches.
+	domRestricted bool
+	dowRestricted bool
+}
+
+// Parse parses a standard five field cron expression (minute hour day-of-month month day-of-week) evaluated in the given
+// IANA time zone. An empty time zone means UTC unless the expression starts with a CRON_TZ= or TZ= prefix.
+// Besides lists, ranges, steps and month and weekday names, the day of month field accepts L (last day of the month),
+// LW (last weekday of the month) and nW (weekday nearest to day n), and the descriptors @yearly, @monthly, @weekly,
+// @daily and @hourly are supported.
+func Parse(expression, timeZone string) (*Schedule, error) {
+	spec := strings.TrimSpace(expression)
+
+	for _, prefix := range timeZonePrefixes {
+		if !strings.HasPrefix(spec, prefix) {
+			continue
+		}
+		parts := strings.SplitN(spec, " ", 2)
+		tz := strings.TrimPrefix(parts[0], prefix)
+		if timeZone != "" && timeZone != tz {
+			return nil, &ParseError{Expression: expression, Reason: fmt.Sprintf("time zone %s conflicts with %s", tz, timeZone)}
+		}
+		timeZone = tz
+		spec = ""
+		if len(parts) == 2 {
+			spec = strings.TrimSpace(parts[1])
+		}
+		break
+	}
+
+	location, err := LoadLocation(timeZone)
+	if err != nil {
+		return nil, &ParseError{Expression: expression, Reason: err.Error()}
+	}
+
+	if strings.HasPrefix(spec, "@") {
+		d, ok := descriptors[strings.ToLower(spec)]
+		if !ok {
+			return nil, &ParseError{Expression: expression, Token: spec, Reason: "unknown descriptor"}
+		}
+		spec = d
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, &ParseError{Expression: expression, Reason: fmt.Sprintf("expected 5 fields, got %v", len(fields))}
+	}
+
+	s := &Schedule{expression: expression, location: location}
+	parsers := []func(string) error{
+		func(f string) (err error) { s.minutes, err = parseField(f, bounds[fieldMinute]); return },
+		func(f string) (err error) { s.hours, err = parseField(f, bounds[fieldHour]); return },
+		s.parseDayOfMonth,
+		func(f string) (err error) { s.months, err = parseField(f, bounds[fieldMonth]); return },
+		s.parseDayOfWeek,
+	}
+	for i, f := range fields {
+		if err := parsers[i](f); err != nil {
+			pe := err.(*ParseError)
+			pe.Expression = expression
+			pe.Field = bounds[i].name
+			pe.Position = i + 1
+			return nil, pe
+		}
+	}
+	return s, nil
+}
+
+// LoadLocation returns the location of an IANA time zone name. An empty name is UTC.
+func LoadLocation(timeZone string) (*time.Location, error) {
+	if timeZone == "" {
+		return time.UTC, nil
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %s", timeZone)
+	}
+	return location, nil
+}
+
+// Location returns the time zone the schedule is evaluated in
+func (s *Schedule) Location() *time.Location {
+	return s.location
+}
+
+// String returns the expression the schedule was parsed from
+func (s *Schedule) String() string {
+	return s.expression
+}
+
+// Next returns the first fire time strictly after t, or the zero time if the schedule doesn't fire within five years.
+// Fire times are computed on the wall clock of the schedule's time zone, so 0 9 * * * fires at 9:00 local time on both
+// sides of a daylight saving change. A fire time that falls into a daylight saving gap fires at the end of the gap.
+func (s *Schedule) Next(t time.Time) time.Time {
+	local := t.In(s.location)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, s.location)
+	end := day.AddDate(maxSearchYears, 0, 0)
+
+	for ; day.Before(end); day = day.AddDate(0, 0, 1) {
+		if !s.matchesDay(day) {
+			continue
+		}
+		for hour := 0; hour < 24; hour++ {
+			if s.hours&(1<<uint(hour)) == 0 {
+				continue
+			}
+			for minute := 0; minute < 60; minute++ {
+				if s.minutes&(1<<uint(minute)) == 0 {
+					continue
+				}
+				fire := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, s.location)
+				if fire.Hour() != hour || fire.Minute() != minute {
+					// the wall clock time doesn't exist on this day, fire when the gap ends
+					fire = time.Date(day.Year(), day.Month(), day.Day(), hour+1, 0, 0, 0, s.location)
+				}
+				if fire.After(t) {
+					return fire
+				}
+			}
+		}
+	}
+	return time.Time{}
+}
+
+func (s *Schedule) matchesDay(day time.Time) bool {
+	if s.months&(1<<uint(day.Month())) == 0 {
+		return false
+	}
+
+	dom := s.matchesDayOfMonth(day)
+	dow := s.daysOfWeek&(1<<uint(day.Weekday())) != 0
+	if s.domRestricted && s.dowRestricted {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+func (s *Schedule) matchesDayOfMonth(day time.Time) bool {
+	if s.daysOfMonth&(1<<uint(day.Day())) != 0 {
+		return true
+	}
+
+	last := daysIn(day.Year(), day.Month(), s.location)
+	if s.lastDayOfMonth && day.Day() == last {
+		return true
+	}
+	if s.lastWeekday && day.Day() == nearestWeekday(day.Year(), day.Month(), last, s.location) {
+		return true
+	}
+	for _, n := range s.nearestWeekdays {
+		if n <= last && day.Day() == nearestWeekday(day.Year(), day.Month(), n, s.location) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Schedule) parseDayOfMonth(field string) error {
+	s.domRestricted = field != "*" && field != "?"
+	if field == "?" {
+		field = "*"
+	}
+
+	remaining := []string{}
+	for _, token := range strings.Split(field, ",") {
+		upper := strings.ToUpper(token)
+		switch {
+		case upper == "L":
+			s.lastDayOfMonth = true
+		case upper == "LW":
+			s.lastWeekday = true
+		case strings.HasSuffix(upper, "W"):
+			n, err := strconv.Atoi(strings.TrimSuffix(upper, "W"))
+			if err != nil || n < 1 || n > 31 {
+				return &ParseError{Token: token, Reason: "W must follow a day between 1 and 31"}
+			}
+			s.nearestWeekdays = append(s.nearestWeekdays, n)
+		default:
+			remaining = append(remaining, token)
+		}
+	}
+
+	if len(remaining) == 0 {
+		return nil
+	}
+	var err error
+	s.daysOfMonth, err = parseField(strings.Join(remaining, ","), bounds[fieldDayOfMonth])
+	return err
+}
+
+func (s *Schedule) parseDayOfWeek(field string) error {
+	s.dowRestricted = field != "*" && field != "?"
+	if field == "?" {
+		field = "*"
+	}
+
+	days, err := parseField(field, bounds[fieldDayOfWeek])
+	if err != nil {
+		return err
+	}
+	if days&(1<<7) != 0 {
+		days |= 1
+		days &^= 1 << 7
+	}
+	s.daysOfWeek = days
+	return nil
+}
+
+// parseField parses a comma separated list of values, ranges and steps into a bit set
+func parseField(field string, b fieldBounds) (uint64, error) {
+	var bits uint64
+	for _, token := range strings.Split(field, ",") {
+		r, err := parseRange(token, b)
+		if err != nil {
+			return 0, err
+		}
+		bits |= r
+	}
+	return bits, nil
+}
+
+func parseRange(token string, b fieldBounds) (uint64, error) {
+	if token == "" {
+		return 0, &ParseError{Token: token, Reason: "empty list item"}
+	}
+
+	rangePart := token
+	step := 1
+	if i := strings.Index(token, "/"); i >= 0 {
+		rangePart = token[:i]
+		s, err := strconv.Atoi(token[i+1:])
+		if err != nil || s < 1 {
+			return 0, &ParseError{Token: token, Reason: "step must be a positive number"}
+		}
+		step = s
+	}
+
+	var start, end int
+	switch {
+	case rangePart == "*":
+		start, end = b.min, b.max
+	case strings.Contains(rangePart, "-"):
+		parts := strings.SplitN(rangePart, "-", 2)
+		var err error
+		if start, err = parseValue(parts[0], b); err != nil {
+			return 0, &ParseError{Token: token, Reason: err.Error()}
+		}
+		if end, err = parseValue(parts[1], b); err != nil {
+			return 0, &ParseError{Token: token, Reason: err.Error()}
+		}
+		if start > end {
+			return 0, &ParseError{Token: token, Reason: "range start is after range end"}
+		}
+	default:
+		v, err := parseValue(rangePart, b)
+		if err != nil {
+			return 0, &ParseError{Token: token, Reason: err.Error()}
+		}
+		start, end = v, v
+		if step > 1 {
+			end = b.max
+		}
+	}
+
+	var bits uint64
+	for v := start; v <= end; v += step {
+		bits |= 1 << uint(v)
+	}
+	return bits, nil
+}
+
+func parseValue(value string, b fieldBounds) (int, error) {
+	if v, ok := b.names[strings.ToUpper(value)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s is not a number or name", value)
+	}
+	if v < b.min || v > b.max {
+		return 0, fmt.Errorf("%v is out of range %v-%v", v, b.min, b.max)
+	}
+	return v, nil
+}
+
+func daysIn(year int, month time.Month, location *time.Location) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, location).Day()
+}
+
+// nearestWeekday returns the Monday to Friday day closest to day n, staying within the month
+func nearestWeekday(year int, month time.Month, n int, location *time.Location) int {
+	switch time.Date(year, month, n, 0, 0, 0, 0, location).Weekday() {
+	case time.Saturday:
+		if n == 1 {
+			return n + 2
+		}
+		return n - 1
+	case time.Sunday:
+		if n == daysIn(year, month, location) {
+			return n - 2
+		}
+		return n + 1
+	default:
+		return n
+	}
+}
@@ -0,0 +1,101 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParse(t *testing.T, expression, timeZone string) *Schedule {
+	s, err := Parse(expression, timeZone)
+	assert.NoError(t, err)
+	return s
+}
+
+func mustTime(t *testing.T, value string) time.Time {
+	v, err := time.Parse(time.RFC3339, value)
+	assert.NoError(t, err)
+	return v
+}
+
+func TestNext(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		timeZone   string
+		after      string
+		expected   string
+	}{
+		{"every minute", "* * * * *", "", "2020-05-01T10:00:30Z", "2020-05-01T10:01:00Z"},
+		{"step", "*/15 * * * *", "", "2020-05-01T10:16:00Z", "2020-05-01T10:30:00Z"},
+		{"range and list", "0 8-10,17 * * *", "", "2020-05-01T10:00:00Z", "2020-05-01T17:00:00Z"},
+		{"weekday names", "0 9 * * MON-FRI", "", "2020-05-01T09:00:00Z", "2020-05-04T09:00:00Z"},
+		{"sunday as 7", "0 0 * * 7", "", "2020-05-01T00:00:00Z", "2020-05-03T00:00:00Z"},
+		{"month names", "0 0 1 jan,jul *", "", "2020-05-01T00:00:00Z", "2020-07-01T00:00:00Z"},
+		{"day of month or day of week", "0 0 13 * FRI", "", "2020-05-01T00:00:00Z", "2020-05-08T00:00:00Z"},
+		{"descriptor", "@monthly", "", "2020-05-01T00:00:00Z", "2020-06-01T00:00:00Z"},
+		{"last day of month", "0 0 L * *", "", "2020-02-01T00:00:00Z", "2020-02-29T00:00:00Z"},
+		{"last weekday of month", "0 0 LW * *", "", "2020-05-01T00:00:00Z", "2020-05-29T00:00:00Z"},
+		{"nearest weekday", "0 0 16W * *", "", "2020-05-01T00:00:00Z", "2020-05-15T00:00:00Z"},
+		{"nearest weekday stays in month", "0 0 1W * *", "", "2020-07-31T00:00:00Z", "2020-08-03T00:00:00Z"},
+		{"time zone", "0 9 * * *", "Europe/Paris", "2020-05-01T08:00:00Z", "2020-05-02T07:00:00Z"},
+		{"time zone prefix", "CRON_TZ=America/New_York 0 9 * * *", "", "2020-05-01T14:00:00Z", "2020-05-02T13:00:00Z"},
+		{"across daylight saving start", "0 9 * * *", "Europe/Paris", "2020-03-28T08:00:00Z", "2020-03-29T07:00:00Z"},
+		{"across daylight saving end", "0 9 * * *", "Europe/Paris", "2020-10-24T07:00:00Z", "2020-10-25T08:00:00Z"},
+		{"daylight saving gap fires at end of gap", "30 2 * * *", "Europe/Paris", "2020-03-28T02:00:00Z", "2020-03-29T01:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := mustParse(t, tt.expression, tt.timeZone)
+			next := s.Next(mustTime(t, tt.after))
+			assert.Equal(t, tt.expected, next.UTC().Format(time.RFC3339))
+		})
+	}
+
+	t.Run("never fires", func(t *testing.T) {
+		s := mustParse(t, "0 0 30 2 *", "")
+		assert.True(t, s.Next(mustTime(t, "2020-01-01T00:00:00Z")).IsZero())
+	})
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		timeZone   string
+		field      string
+		token      string
+		position   int
+	}{
+		{"field count", "* * * *", "", "", "", 0},
+		{"out of range", "0 24 * * *", "", "hour", "24", 2},
+		{"unknown name", "0 0 * * MON-FUN", "", "day of week", "MON-FUN", 5},
+		{"reversed range", "0 0 * 12-1 *", "", "month", "12-1", 4},
+		{"bad step", "*/0 * * * *", "", "minute", "*/0", 1},
+		{"bad weekday", "0 0 32W * *", "", "day of month", "32W", 3},
+		{"empty list item", "0 0,,1 * * *", "", "hour", "", 2},
+		{"unknown time zone", "0 0 * * *", "Mars/Olympus", "", "", 0},
+		{"conflicting time zones", "TZ=Europe/Paris 0 0 * * *", "UTC", "", "", 0},
+		{"unknown descriptor", "@fortnightly", "", "", "@fortnightly", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.expression, tt.timeZone)
+			assert.Error(t, err)
+
+			pe, ok := err.(*ParseError)
+			assert.True(t, ok)
+			assert.Equal(t, tt.field, pe.Field)
+			assert.Equal(t, tt.token, pe.Token)
+			assert.Equal(t, tt.position, pe.Position)
+		})
+	}
+}
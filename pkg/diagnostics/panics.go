@@ -0,0 +1,82 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package diagnostics
+
+import (
+	"fmt"
+	"regexp"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxCrashRecords bounds the crash records kept by the runtime
+const maxCrashRecords = 100
+
+// maxPanicMessageLength bounds the panic message of a crash record
+const maxPanicMessageLength = 512
+
+// stackArgs matches the argument words of the function calls of a stack trace
+var stackArgs = regexp.MustCompile(`(?m)^(\S+)\(.*\)$`)
+
+// CrashRecord describes a panic recovered by the runtime
+type CrashRecord struct {
+	// ID correlates the record with the error returned to the caller and the log of the panic
+	ID string `json:"id"`
+	// Source is where the panic was recovered, an API method or a background loop
+	Source string    `json:"source"`
+	Time   time.Time `json:"time"`
+	Panic  string    `json:"panic"`
+	// Stack is the stack trace of the panic with the argument values of the calls removed
+	Stack string `json:"stack"`
+}
+
+var (
+	crashLock    sync.Mutex
+	crashRecords []CrashRecord
+)
+
+// RecordPanic records a panic recovered from source and returns its crash record. It must be called from the deferred
+// function recovering the panic so the stack trace includes the panicking calls.
+func RecordPanic(source string, recovered interface{}) CrashRecord {
+	msg := fmt.Sprint(recovered)
+	if len(msg) > maxPanicMessageLength {
+		msg = msg[:maxPanicMessageLength] + "..."
+	}
+	record := CrashRecord{
+		ID:     uuid.New().String(),
+		Source: source,
+		Time:   time.Now().UTC(),
+		Panic:  msg,
+		Stack:  redactStack(debug.Stack()),
+	}
+
+	crashLock.Lock()
+	crashRecords = append(crashRecords, record)
+	if len(crashRecords) > maxCrashRecords {
+		crashRecords = crashRecords[len(crashRecords)-maxCrashRecords:]
+	}
+	crashLock.Unlock()
+
+	DefaultMonitoring.PanicRecovered(source)
+	return record
+}
+
+// RecentCrashes returns the most recent crash records of the runtime, oldest first
+func RecentCrashes() []CrashRecord {
+	crashLock.Lock()
+	defer crashLock.Unlock()
+	records := make([]CrashRecord, len(crashRecords))
+	copy(records, crashRecords)
+	return records
+}
+
+// redactStack removes the argument values of the calls of a stack trace, which can hold pointers to request data
+func redactStack(stack []byte) string {
+	return stackArgs.ReplaceAllString(string(stack), "$1(...)")
+}
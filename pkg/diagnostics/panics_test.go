@@ -0,0 +1,50 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package diagnostics
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func recoverPanic(source string, value interface{}) (record CrashRecord) {
+	defer func() {
+		record = RecordPanic(source, recover())
+	}()
+	panic(value)
+}
+
+func TestRecordPanic(t *testing.T) {
+	record := recoverPanic("/dapr.proto.runtime.v1.Dapr/GetState", "boom")
+	assert.NotEmpty(t, record.ID)
+	assert.Equal(t, "/dapr.proto.runtime.v1.Dapr/GetState", record.Source)
+	assert.Equal(t, "boom", record.Panic)
+	assert.Contains(t, record.Stack, "recoverPanic(...)")
+	assert.False(t, strings.Contains(record.Stack, "recoverPanic(0x"))
+
+	crashes := RecentCrashes()
+	assert.Equal(t, record, crashes[len(crashes)-1])
+
+	long := recoverPanic("loop", strings.Repeat("x", 2*maxPanicMessageLength))
+	assert.Len(t, long.Panic, maxPanicMessageLength+len("..."))
+}
+
+func TestRecentCrashesBounded(t *testing.T) {
+	for i := 0; i < maxCrashRecords+10; i++ {
+		recoverPanic("loop", fmt.Sprintf("panic %v", i))
+	}
+	crashes := RecentCrashes()
+	assert.Len(t, crashes, maxCrashRecords)
+	assert.Equal(t, fmt.Sprintf("panic %v", maxCrashRecords+9), crashes[len(crashes)-1].Panic)
+}
+
+func TestRedactStack(t *testing.T) {
+	stack := "goroutine 1 [running]:\nmain.handle(0xc000010000, 0x5, {0x1, 0x2})\n\t/app/main.go:12 +0x1d\nmain.main()\n\t/app/main.go:5 +0x25\n"
+	assert.Equal(t, "goroutine 1 [running]:\nmain.handle(...)\n\t/app/main.go:12 +0x1d\nmain.main(...)\n\t/app/main.go:5 +0x25\n", redactStack([]byte(stack)))
+}
@@ -2,6 +2,8 @@ package diagnostics
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	"go.opencensus.io/stats"
@@ -11,18 +13,34 @@ import (
 
 // Tag keys
 var (
-	componentKey  = tag.MustNewKey("component")
-	failReasonKey = tag.MustNewKey("reason")
-	operationKey  = tag.MustNewKey("operation")
-	actorTypeKey  = tag.MustNewKey("actor_type")
+	componentKey     = tag.MustNewKey("component")
+	componentNameKey = tag.MustNewKey("name")
+	failReasonKey    = tag.MustNewKey("reason")
+	operationKey     = tag.MustNewKey("operation")
+	actorTypeKey     = tag.MustNewKey("actor_type")
+
+	targetAppIDKey     = tag.MustNewKey("target_app_id")
+	targetNamespaceKey = tag.MustNewKey("target_namespace")
+	policyActionKey    = tag.MustNewKey("action")
+	missedPolicyKey    = tag.MustNewKey("policy")
+	failoverTargetKey  = tag.MustNewKey("target")
+	topicKey           = tag.MustNewKey("topic")
+	routeKey           = tag.MustNewKey("route")
+	successKey         = tag.MustNewKey("success")
+	cacheResultKey     = tag.MustNewKey("result")
+	panicSourceKey     = tag.MustNewKey("source")
 )
 
 // serviceMetrics holds dapr runtime metric monitoring methods
 type serviceMetrics struct {
 	// component metrics
-	componentLoaded        *stats.Int64Measure
-	componentInitCompleted *stats.Int64Measure
-	componentInitFailed    *stats.Int64Measure
+	componentLoaded          *stats.Int64Measure
+	componentInitCompleted   *stats.Int64Measure
+	componentInitFailed      *stats.Int64Measure
+	componentInitLatency     *stats.Float64Measure
+	componentFailover        *stats.Int64Measure
+	componentShutdownFailed  *stats.Int64Measure
+	componentShutdownLatency *stats.Float64Measure
 
 	// mTLS metrics
 	mtlsInitCompleted             *stats.Int64Measure
@@ -39,6 +57,24 @@ type serviceMetrics struct {
 	actorActivatedFailedTotal    *stats.Int64Measure
 	actorDeactivationTotal       *stats.Int64Measure
 	actorDeactivationFailedTotal *stats.Int64Measure
+	actorReminderDrift           *stats.Float64Measure
+	actorReminderMissedTotal     *stats.Int64Measure
+
+	// Service invocation metrics
+	crossNamespaceInvocationTotal *stats.Int64Measure
+	invocationCacheTotal          *stats.Int64Measure
+
+	// Pub/sub metrics
+	pubsubMessageFilteredTotal *stats.Int64Measure
+	pubsubAppLatency           *stats.Float64Measure
+	pubsubProcessingLatency    *stats.Float64Measure
+	pubsubSlowHandlerTotal     *stats.Int64Measure
+
+	// Throttling metrics
+	bulkOperationThrottledTotal *stats.Int64Measure
+
+	// Crash metrics
+	panicRecoveredTotal *stats.Int64Measure
 
 	appID   string
 	ctx     context.Context
@@ -61,6 +97,22 @@ func newServiceMetrics() *serviceMetrics {
 			"runtime/component/init_fail_total",
 			"The number of component initialization failures.",
 			stats.UnitDimensionless),
+		componentInitLatency: stats.Float64(
+			"runtime/component/init_latency",
+			"The time it took to initialize a component in milliseconds.",
+			stats.UnitMilliseconds),
+		componentFailover: stats.Int64(
+			"runtime/component/failover_total",
+			"The number of switches between the primary and secondary components of a failover pair.",
+			stats.UnitDimensionless),
+		componentShutdownFailed: stats.Int64(
+			"runtime/component/shutdown_fail_total",
+			"The number of components that didn't flush and close within the shutdown timeout.",
+			stats.UnitDimensionless),
+		componentShutdownLatency: stats.Float64(
+			"runtime/component/shutdown_latency",
+			"The time it took to flush and close a component in milliseconds.",
+			stats.UnitMilliseconds),
 
 		// mTLS
 		mtlsInitCompleted: stats.Int64(
@@ -113,6 +165,54 @@ func newServiceMetrics() *serviceMetrics {
 			"runtime/actor/deactivated_failed_total",
 			"The number of the failed actor deactivation.",
 			stats.UnitDimensionless),
+		actorReminderDrift: stats.Float64(
+			"runtime/actor/reminder_drift_ms",
+			"The delay between the scheduled and the actual fire time of a reminder.",
+			stats.UnitMilliseconds),
+		actorReminderMissedTotal: stats.Int64(
+			"runtime/actor/reminder_missed_total",
+			"The number of reminder fire times missed while the reminder wasn't running.",
+			stats.UnitDimensionless),
+
+		// Service invocation
+		crossNamespaceInvocationTotal: stats.Int64(
+			"runtime/service_invocation/cross_namespace_total",
+			"The number of the service invocations targeting another namespace.",
+			stats.UnitDimensionless),
+		invocationCacheTotal: stats.Int64(
+			"runtime/service_invocation/cache_total",
+			"The number of the HTTP GET service invocations looked up in the response cache.",
+			stats.UnitDimensionless),
+
+		// Pub/sub
+		pubsubMessageFilteredTotal: stats.Int64(
+			"runtime/pubsub/filtered_total",
+			"The number of messages acknowledged without delivery because the filter of their subscription didn't match.",
+			stats.UnitDimensionless),
+		pubsubAppLatency: stats.Float64(
+			"runtime/pubsub/app_latency",
+			"The time the app took to process a message, from its delivery to the app response, in milliseconds.",
+			stats.UnitMilliseconds),
+		pubsubProcessingLatency: stats.Float64(
+			"runtime/pubsub/processing_latency",
+			"The time from the publication of a message, as set in its cloud event time attribute, to the app response in milliseconds.",
+			stats.UnitMilliseconds),
+		pubsubSlowHandlerTotal: stats.Int64(
+			"runtime/pubsub/slow_handler_total",
+			"The number of messages the app took longer than the slow handler threshold to process.",
+			stats.UnitDimensionless),
+
+		// Throttling
+		bulkOperationThrottledTotal: stats.Int64(
+			"runtime/throttle/bulk_throttled_total",
+			"The number of bulk operations whose parallelism was reduced because the sidecar approached its memory limit.",
+			stats.UnitDimensionless),
+
+		// Crashes
+		panicRecoveredTotal: stats.Int64(
+			"runtime/panics_recovered_total",
+			"The number of panics recovered in API handlers and background loops instead of ending the call or the loop silently.",
+			stats.UnitDimensionless),
 
 		// TODO: use the correct context for each request
 		ctx:     context.Background(),
@@ -128,6 +228,10 @@ func (s *serviceMetrics) Init(appID string) error {
 		diag_utils.NewMeasureView(s.componentLoaded, []tag.Key{appIDKey}, view.Count()),
 		diag_utils.NewMeasureView(s.componentInitCompleted, []tag.Key{appIDKey, componentKey}, view.Count()),
 		diag_utils.NewMeasureView(s.componentInitFailed, []tag.Key{appIDKey, componentKey, failReasonKey}, view.Count()),
+		diag_utils.NewMeasureView(s.componentInitLatency, []tag.Key{appIDKey, componentKey, componentNameKey}, defaultLatencyDistribution),
+		diag_utils.NewMeasureView(s.componentFailover, []tag.Key{appIDKey, componentKey, componentNameKey, failoverTargetKey}, view.Count()),
+		diag_utils.NewMeasureView(s.componentShutdownFailed, []tag.Key{appIDKey, componentKey, componentNameKey, failReasonKey}, view.Count()),
+		diag_utils.NewMeasureView(s.componentShutdownLatency, []tag.Key{appIDKey, componentKey, componentNameKey}, defaultLatencyDistribution),
 
 		diag_utils.NewMeasureView(s.mtlsInitCompleted, []tag.Key{appIDKey}, view.Count()),
 		diag_utils.NewMeasureView(s.mtlsInitFailed, []tag.Key{appIDKey, failReasonKey}, view.Count()),
@@ -142,6 +246,20 @@ func (s *serviceMetrics) Init(appID string) error {
 		diag_utils.NewMeasureView(s.actorActivatedFailedTotal, []tag.Key{appIDKey, actorTypeKey}, view.Count()),
 		diag_utils.NewMeasureView(s.actorDeactivationTotal, []tag.Key{appIDKey, actorTypeKey}, view.Count()),
 		diag_utils.NewMeasureView(s.actorDeactivationFailedTotal, []tag.Key{appIDKey, actorTypeKey}, view.Count()),
+		diag_utils.NewMeasureView(s.actorReminderDrift, []tag.Key{appIDKey, actorTypeKey}, defaultLatencyDistribution),
+		diag_utils.NewMeasureView(s.actorReminderMissedTotal, []tag.Key{appIDKey, actorTypeKey, missedPolicyKey}, view.Sum()),
+
+		diag_utils.NewMeasureView(s.crossNamespaceInvocationTotal, []tag.Key{appIDKey, targetAppIDKey, targetNamespaceKey, policyActionKey}, view.Count()),
+		diag_utils.NewMeasureView(s.invocationCacheTotal, []tag.Key{appIDKey, targetAppIDKey, cacheResultKey}, view.Count()),
+
+		diag_utils.NewMeasureView(s.pubsubMessageFilteredTotal, []tag.Key{appIDKey, componentNameKey, topicKey}, view.Count()),
+		diag_utils.NewMeasureView(s.pubsubAppLatency, []tag.Key{appIDKey, componentNameKey, topicKey, routeKey, successKey}, defaultLatencyDistribution),
+		diag_utils.NewMeasureView(s.pubsubProcessingLatency, []tag.Key{appIDKey, componentNameKey, topicKey, routeKey, successKey}, defaultLatencyDistribution),
+		diag_utils.NewMeasureView(s.pubsubSlowHandlerTotal, []tag.Key{appIDKey, componentNameKey, topicKey, routeKey}, view.Count()),
+
+		diag_utils.NewMeasureView(s.bulkOperationThrottledTotal, []tag.Key{appIDKey, operationKey}, view.Count()),
+
+		diag_utils.NewMeasureView(s.panicRecoveredTotal, []tag.Key{appIDKey, panicSourceKey}, view.Count()),
 	)
 }
 
@@ -172,6 +290,46 @@ func (s *serviceMetrics) ComponentInitFailed(component string, reason string) {
 	}
 }
 
+// ComponentInitDuration records the time it took to initialize the named component
+func (s *serviceMetrics) ComponentInitDuration(component, name string, elapsed time.Duration) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, componentKey, component, componentNameKey, name),
+			s.componentInitLatency.M(float64(elapsed)/float64(time.Millisecond)))
+	}
+}
+
+// ComponentFailover records metric when a failover pair switches the component in use, with the target it switched to
+func (s *serviceMetrics) ComponentFailover(component, name, target string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, componentKey, component, componentNameKey, name, failoverTargetKey, target),
+			s.componentFailover.M(1))
+	}
+}
+
+// ComponentShutdownDuration records the time it took to flush and close the named component
+func (s *serviceMetrics) ComponentShutdownDuration(component, name string, elapsed time.Duration) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, componentKey, component, componentNameKey, name),
+			s.componentShutdownLatency.M(float64(elapsed)/float64(time.Millisecond)))
+	}
+}
+
+// ComponentShutdownFailed records metric when the named component doesn't flush and close within the shutdown timeout
+func (s *serviceMetrics) ComponentShutdownFailed(component, name, reason string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, componentKey, component, componentNameKey, name, failReasonKey, reason),
+			s.componentShutdownFailed.M(1))
+	}
+}
+
 // MTLSInitCompleted records metric when component is initialized
 func (s *serviceMetrics) MTLSInitCompleted() {
 	if s.enabled {
@@ -280,3 +438,104 @@ func (s *serviceMetrics) ActorDeactivationFailed(actorType, reason string) {
 			s.actorDeactivationFailedTotal.M(1))
 	}
 }
+
+// ActorReminderFired records the delay between the scheduled and the actual fire time of a reminder.
+func (s *serviceMetrics) ActorReminderFired(actorType string, drift time.Duration) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, actorTypeKey, actorType),
+			s.actorReminderDrift.M(float64(drift)/float64(time.Millisecond)))
+	}
+}
+
+// ActorReminderMissed records the fire times a reminder missed and the policy applied to them.
+func (s *serviceMetrics) ActorReminderMissed(actorType, policy string, count int) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, actorTypeKey, actorType, missedPolicyKey, policy),
+			s.actorReminderMissedTotal.M(int64(count)))
+	}
+}
+
+// CrossNamespaceInvocation records metric when an app in another namespace is invoked, with the policy action applied to the call
+func (s *serviceMetrics) CrossNamespaceInvocation(targetAppID, targetNamespace, action string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, targetAppIDKey, targetAppID, targetNamespaceKey, targetNamespace, policyActionKey, action),
+			s.crossNamespaceInvocationTotal.M(1))
+	}
+}
+
+// InvocationCacheLookup records metric when the response of a service invocation is looked up in the response cache,
+// with the result of the lookup: hit, miss or bypass
+func (s *serviceMetrics) InvocationCacheLookup(targetAppID, result string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, targetAppIDKey, targetAppID, cacheResultKey, result),
+			s.invocationCacheTotal.M(1))
+	}
+}
+
+// PubsubMessageFiltered records metric when a message is acknowledged without delivery because the filter of its subscription didn't match
+func (s *serviceMetrics) PubsubMessageFiltered(pubsubName, topic string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, componentNameKey, pubsubName, topicKey, topic),
+			s.pubsubMessageFilteredTotal.M(1))
+	}
+}
+
+// PubsubAppResponded records the time the app took to process a message delivered on the route of a topic
+func (s *serviceMetrics) PubsubAppResponded(pubsubName, topic, route string, success bool, elapsed time.Duration) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, componentNameKey, pubsubName, topicKey, topic, routeKey, route, successKey, strconv.FormatBool(success)),
+			s.pubsubAppLatency.M(float64(elapsed)/float64(time.Millisecond)))
+	}
+}
+
+// PubsubMessageProcessed records the time from the publication of a message to the app response
+func (s *serviceMetrics) PubsubMessageProcessed(pubsubName, topic, route string, success bool, elapsed time.Duration) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, componentNameKey, pubsubName, topicKey, topic, routeKey, route, successKey, strconv.FormatBool(success)),
+			s.pubsubProcessingLatency.M(float64(elapsed)/float64(time.Millisecond)))
+	}
+}
+
+// PubsubSlowHandler records metric when the app takes longer than the slow handler threshold to process a message
+func (s *serviceMetrics) PubsubSlowHandler(pubsubName, topic, route string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, componentNameKey, pubsubName, topicKey, topic, routeKey, route),
+			s.pubsubSlowHandlerTotal.M(1))
+	}
+}
+
+// BulkOperationThrottled records metric when the parallelism of a bulk operation is reduced under memory pressure
+func (s *serviceMetrics) BulkOperationThrottled(operation string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, operationKey, operation),
+			s.bulkOperationThrottledTotal.M(1))
+	}
+}
+
+// PanicRecovered records metric when a panic is recovered in an API handler or a background loop
+func (s *serviceMetrics) PanicRecovered(source string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(appIDKey, s.appID, panicSourceKey, source),
+			s.panicRecoveredTotal.M(1))
+	}
+}
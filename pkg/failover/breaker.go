@@ -0,0 +1,167 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package failover
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Target is one of the components of an active-passive pair
+type Target string
+
+const (
+	// Primary is the component used while it is healthy
+	Primary Target = "primary"
+	// Secondary is the component used while the primary is failing
+	Secondary Target = "secondary"
+
+	// ComponentMetadataKey is the metadata item of a primary component naming its secondary component
+	ComponentMetadataKey = "failoverComponent"
+	// ThresholdMetadataKey is the metadata item with the number of consecutive failures that trips the failover
+	ThresholdMetadataKey = "failoverThreshold"
+	// CooldownMetadataKey is the metadata item with the time to wait before trying the primary component again
+	CooldownMetadataKey = "failoverCooldown"
+
+	defaultThreshold = 5
+	defaultCooldown  = 30 * time.Second
+)
+
+// Config configures the failover of a primary component to a secondary component
+type Config struct {
+	Secondary string
+	Threshold int
+	Cooldown  time.Duration
+}
+
+// ConfigFromMetadata reads the failover configuration from the metadata of a primary component.
+// It returns false if the component doesn't declare a secondary component.
+func ConfigFromMetadata(properties map[string]string) (Config, bool, error) {
+	c := Config{
+		Secondary: properties[ComponentMetadataKey],
+		Threshold: defaultThreshold,
+		Cooldown:  defaultCooldown,
+	}
+	if c.Secondary == "" {
+		return c, false, nil
+	}
+
+	if v := properties[ThresholdMetadataKey]; v != "" {
+		threshold, err := strconv.Atoi(v)
+		if err != nil || threshold <= 0 {
+			return c, true, fmt.Errorf("%s must be a positive integer", ThresholdMetadataKey)
+		}
+		c.Threshold = threshold
+	}
+	if v := properties[CooldownMetadataKey]; v != "" {
+		cooldown, err := time.ParseDuration(v)
+		if err != nil || cooldown <= 0 {
+			return c, true, fmt.Errorf("%s must be a positive duration", CooldownMetadataKey)
+		}
+		c.Cooldown = cooldown
+	}
+	return c, true, nil
+}
+
+// Breaker selects the active component of an active-passive pair.
+// It fails over to the secondary component after Threshold consecutive failures of the primary component,
+// and sends one call to the primary component every Cooldown to fail back once it succeeds.
+type Breaker struct {
+	config   Config
+	onSwitch func(from, to Target)
+	lock     sync.Mutex
+	active   Target
+	failures int
+	probeAt  time.Time
+	now      func() time.Time
+}
+
+// NewBreaker returns a breaker calling onSwitch every time the active component changes
+func NewBreaker(config Config, onSwitch func(from, to Target)) *Breaker {
+	return &Breaker{
+		config:   config,
+		onSwitch: onSwitch,
+		active:   Primary,
+		now:      time.Now,
+	}
+}
+
+// Active returns the component currently in use
+func (b *Breaker) Active() Target {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.active
+}
+
+// Next returns the component to send a call to
+func (b *Breaker) Next() Target {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.active == Secondary && !b.now().Before(b.probeAt) {
+		// Let a single call probe the primary component until the next cooldown
+		b.probeAt = b.now().Add(b.config.Cooldown)
+		return Primary
+	}
+	return b.active
+}
+
+// Success reports a successful call to a component
+func (b *Breaker) Success(target Target) {
+	if target != Primary {
+		return
+	}
+
+	b.lock.Lock()
+	b.failures = 0
+	switched := b.active == Secondary
+	b.active = Primary
+	b.lock.Unlock()
+
+	if switched && b.onSwitch != nil {
+		b.onSwitch(Secondary, Primary)
+	}
+}
+
+// Trip fails over to the secondary component without waiting for the failure threshold,
+// e.g. when the primary component can't be used at all. It returns true if the breaker switched.
+func (b *Breaker) Trip() bool {
+	b.lock.Lock()
+	switched := b.active == Primary
+	if switched {
+		b.active = Secondary
+		b.probeAt = b.now().Add(b.config.Cooldown)
+	}
+	b.lock.Unlock()
+
+	if switched && b.onSwitch != nil {
+		b.onSwitch(Primary, Secondary)
+	}
+	return switched
+}
+
+// Failure reports a failed call to a component. It returns true if the breaker failed over to the secondary component.
+func (b *Breaker) Failure(target Target) bool {
+	if target != Primary {
+		return false
+	}
+
+	b.lock.Lock()
+	b.failures++
+	switched := b.active == Primary && b.failures >= b.config.Threshold
+	if switched {
+		b.active = Secondary
+		b.probeAt = b.now().Add(b.config.Cooldown)
+	}
+	b.lock.Unlock()
+
+	if switched && b.onSwitch != nil {
+		b.onSwitch(Primary, Secondary)
+	}
+	return switched
+}
@@ -0,0 +1,87 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package failover
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigFromMetadata(t *testing.T) {
+	t.Run("no secondary", func(t *testing.T) {
+		_, ok, err := ConfigFromMetadata(map[string]string{})
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		c, ok, err := ConfigFromMetadata(map[string]string{ComponentMetadataKey: "dr"})
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, Config{Secondary: "dr", Threshold: defaultThreshold, Cooldown: defaultCooldown}, c)
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		c, _, err := ConfigFromMetadata(map[string]string{ComponentMetadataKey: "dr", ThresholdMetadataKey: "2", CooldownMetadataKey: "1m"})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, c.Threshold)
+		assert.Equal(t, time.Minute, c.Cooldown)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, _, err := ConfigFromMetadata(map[string]string{ComponentMetadataKey: "dr", ThresholdMetadataKey: "0"})
+		assert.Error(t, err)
+		_, _, err = ConfigFromMetadata(map[string]string{ComponentMetadataKey: "dr", CooldownMetadataKey: "soon"})
+		assert.Error(t, err)
+	})
+}
+
+func TestBreaker(t *testing.T) {
+	var switches []Target
+	now := time.Now()
+	b := NewBreaker(Config{Secondary: "dr", Threshold: 2, Cooldown: time.Minute}, func(from, to Target) {
+		switches = append(switches, to)
+	})
+	b.now = func() time.Time { return now }
+
+	assert.Equal(t, Primary, b.Next())
+	assert.False(t, b.Failure(Primary))
+	b.Success(Primary)
+	assert.False(t, b.Failure(Primary))
+	assert.Equal(t, Primary, b.Active(), "successes reset the consecutive failures")
+
+	assert.True(t, b.Failure(Primary))
+	assert.Equal(t, Secondary, b.Active())
+	assert.Equal(t, Secondary, b.Next())
+	assert.False(t, b.Failure(Secondary))
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, Primary, b.Next(), "the primary is probed after the cooldown")
+	assert.Equal(t, Secondary, b.Next(), "a single call probes the primary")
+	assert.False(t, b.Failure(Primary))
+	assert.Equal(t, Secondary, b.Active())
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, Primary, b.Next())
+	b.Success(Primary)
+	assert.Equal(t, Primary, b.Active())
+	assert.Equal(t, []Target{Secondary, Primary}, switches)
+}
+
+func TestBreakerTrip(t *testing.T) {
+	var switches []Target
+	b := NewBreaker(Config{Secondary: "dr", Threshold: 5, Cooldown: time.Minute}, func(from, to Target) {
+		switches = append(switches, to)
+	})
+
+	assert.True(t, b.Trip())
+	assert.Equal(t, Secondary, b.Active())
+	assert.Equal(t, Secondary, b.Next(), "the primary isn't probed before the cooldown")
+	assert.False(t, b.Trip())
+	assert.Equal(t, []Target{Secondary}, switches)
+}
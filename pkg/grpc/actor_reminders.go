@@ -0,0 +1,107 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dapr/dapr/pkg/actors"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListActorRemindersAlpha1 returns a page of the reminders of an actor type, with the time they fire next, so the
+// backlog of reminders can be audited without reading the actor state store
+func (a *api) ListActorRemindersAlpha1(ctx context.Context, in *daprv1pb.ListActorRemindersRequest) (*daprv1pb.ListActorRemindersResponse, error) {
+	if a.actor == nil {
+		return nil, status.Error(codes.FailedPrecondition, "ERR_ACTOR_RUNTIME_NOT_FOUND")
+	}
+	if in.ActorType == "" {
+		return nil, status.Error(codes.InvalidArgument, "ERR_MALFORMED_REQUEST: actor type is required")
+	}
+	if in.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "ERR_MALFORMED_REQUEST: page size must not be negative")
+	}
+	req := &actors.ListRemindersRequest{
+		ActorType: in.ActorType,
+		ActorID:   in.ActorId,
+		PageToken: in.PageToken,
+		PageSize:  int(in.PageSize),
+	}
+	var err error
+	if req.DueAfter, err = optionalTimestamp(in.DueAfter); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ERR_MALFORMED_REQUEST: due after: %s", err)
+	}
+	if req.DueBefore, err = optionalTimestamp(in.DueBefore); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ERR_MALFORMED_REQUEST: due before: %s", err)
+	}
+
+	spanName := fmt.Sprintf("ListActorReminders: %s", in.ActorType)
+	ctx, span := diag.StartTracingClientSpanFromGRPCContext(ctx, spanName, a.tracingSpec)
+	defer span.End()
+
+	resp, err := a.actor.ListReminders(ctx, req)
+	diag.UpdateSpanPairStatusesFromError(span, err, spanName)
+	if _, ok := err.(*actors.PageTokenError); ok {
+		return nil, status.Errorf(codes.InvalidArgument, "ERR_MALFORMED_REQUEST: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "ERR_ACTOR_REMINDER_LIST: %s", err)
+	}
+
+	out := &daprv1pb.ListActorRemindersResponse{
+		Reminders:     make([]*daprv1pb.ActorReminder, 0, len(resp.Reminders)),
+		NextPageToken: resp.NextPageToken,
+	}
+	for _, r := range resp.Reminders {
+		reminder := &daprv1pb.ActorReminder{
+			ActorType:        r.ActorType,
+			ActorId:          r.ActorID,
+			Name:             r.Name,
+			DueTime:          r.DueTime,
+			Period:           r.Period,
+			Schedule:         r.Schedule,
+			Timezone:         r.TimeZone,
+			MissedFirePolicy: r.MissedFirePolicy,
+			RegisteredTime:   rfc3339Timestamp(r.RegisteredTime),
+			NextFireTime:     rfc3339Timestamp(r.NextFireTime),
+		}
+		if r.Data != nil {
+			if reminder.Data, err = json.Marshal(r.Data); err != nil {
+				return nil, status.Errorf(codes.Internal, "ERR_ACTOR_REMINDER_LIST: %s", err)
+			}
+		}
+		out.Reminders = append(out.Reminders, reminder)
+	}
+	return out, nil
+}
+
+// optionalTimestamp returns the time of ts, the zero time when unset
+func optionalTimestamp(ts *timestamp.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+	return ptypes.Timestamp(ts)
+}
+
+// rfc3339Timestamp returns the timestamp of an RFC3339 time, nil when empty or malformed
+func rfc3339Timestamp(s string) *timestamp.Timestamp {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil
+	}
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return nil
+	}
+	return ts
+}
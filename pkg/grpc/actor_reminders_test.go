@@ -0,0 +1,98 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dapr/dapr/pkg/actors"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	daprt "github.com/dapr/dapr/pkg/testing"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListActorRemindersAlpha1(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	dueAfter := time.Date(2020, 3, 27, 0, 0, 0, 0, time.UTC)
+	mockActors := new(daprt.MockActors)
+	mockActors.On("ListReminders", &actors.ListRemindersRequest{
+		ActorType: "cat",
+		ActorID:   "1",
+		DueAfter:  dueAfter,
+		PageSize:  10,
+	}).Return(&actors.ListRemindersResponse{
+		Reminders: []actors.ListedReminder{
+			{
+				Reminder: actors.Reminder{
+					ActorType:      "cat",
+					ActorID:        "1",
+					Name:           "feed",
+					Data:           map[string]string{"food": "fish"},
+					DueTime:        "1h",
+					RegisteredTime: "2020-03-27T11:00:00Z",
+				},
+				NextFireTime: "2020-03-27T12:00:00Z",
+			},
+			{Reminder: actors.Reminder{ActorType: "cat", ActorID: "1", Name: "broken", DueTime: "never"}},
+		},
+		NextPageToken: "next",
+	}, nil)
+	mockActors.On("ListReminders", mock.MatchedBy(func(req *actors.ListRemindersRequest) bool { return req.PageToken == "other" })).
+		Return(nil, &actors.PageTokenError{Reason: "the token was issued for another actor type or actor"})
+	fakeAPI := &api{
+		id:    "fakeAPI",
+		actor: mockActors,
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	t.Run("returns the reminders with their next fire time", func(t *testing.T) {
+		ts, _ := ptypes.TimestampProto(dueAfter)
+		resp, err := client.ListActorRemindersAlpha1(context.Background(), &daprv1pb.ListActorRemindersRequest{
+			ActorType: "cat",
+			ActorId:   "1",
+			DueAfter:  ts,
+			PageSize:  10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "next", resp.NextPageToken)
+		assert.Len(t, resp.Reminders, 2)
+		assert.Equal(t, "feed", resp.Reminders[0].Name)
+		assert.Equal(t, []byte(`{"food":"fish"}`), resp.Reminders[0].Data)
+		next, _ := ptypes.Timestamp(resp.Reminders[0].NextFireTime)
+		assert.Equal(t, time.Date(2020, 3, 27, 12, 0, 0, 0, time.UTC), next)
+		assert.NotNil(t, resp.Reminders[0].RegisteredTime)
+		assert.Nil(t, resp.Reminders[1].NextFireTime)
+	})
+
+	t.Run("page token of another actor", func(t *testing.T) {
+		_, err := client.ListActorRemindersAlpha1(context.Background(), &daprv1pb.ListActorRemindersRequest{ActorType: "cat", PageToken: "other"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("actor type is required", func(t *testing.T) {
+		_, err := client.ListActorRemindersAlpha1(context.Background(), &daprv1pb.ListActorRemindersRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("actor runtime not initialized", func(t *testing.T) {
+		fakeAPI.actor = nil
+		defer func() { fakeAPI.actor = mockActors }()
+		_, err := client.ListActorRemindersAlpha1(context.Background(), &daprv1pb.ListActorRemindersRequest{ActorType: "cat"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
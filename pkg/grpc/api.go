@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/dapr/components-contrib/bindings"
@@ -16,6 +17,7 @@ import (
 	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/actors"
+	"github.com/dapr/dapr/pkg/apptoken"
 	"github.com/dapr/dapr/pkg/channel"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
@@ -24,6 +26,11 @@ import (
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
+	"github.com/dapr/dapr/pkg/ratelimit"
+	runtime_bindings "github.com/dapr/dapr/pkg/runtime/bindings"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/dapr/dapr/pkg/throttle"
 	"github.com/golang/protobuf/ptypes/any"
 	durpb "github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -49,15 +56,28 @@ type API interface {
 	// DaprInternal Service methods
 	CallActor(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error)
 	CallLocal(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error)
+	CallLocalStream(stream internalv1pb.DaprInternal_CallLocalStreamServer) error
 
 	// Dapr Service methods
 	PublishEvent(ctx context.Context, in *daprv1pb.PublishEventEnvelope) (*empty.Empty, error)
+	PublishEventStreamAlpha1(stream daprv1pb.Dapr_PublishEventStreamAlpha1Server) error
+	BulkPublishEventAlpha1(ctx context.Context, in *daprv1pb.BulkPublishRequest) (*daprv1pb.BulkPublishResponse, error)
 	InvokeService(ctx context.Context, in *daprv1pb.InvokeServiceRequest) (*commonv1pb.InvokeResponse, error)
 	InvokeBinding(ctx context.Context, in *daprv1pb.InvokeBindingEnvelope) (*empty.Empty, error)
+	InvokeBindingBulkAlpha1(ctx context.Context, in *daprv1pb.InvokeBindingBulkRequest) (*daprv1pb.InvokeBindingBulkResponse, error)
+	InvokeActor(ctx context.Context, in *daprv1pb.InvokeActorEnvelope) (*daprv1pb.InvokeActorResponseEnvelope, error)
 	GetState(ctx context.Context, in *daprv1pb.GetStateEnvelope) (*daprv1pb.GetStateResponseEnvelope, error)
+	GetBulkStateStreamAlpha1(in *daprv1pb.GetBulkStateRequest, stream daprv1pb.Dapr_GetBulkStateStreamAlpha1Server) error
+	SubscribeStateAlpha1(in *daprv1pb.SubscribeStateRequest, stream daprv1pb.Dapr_SubscribeStateAlpha1Server) error
 	GetSecret(ctx context.Context, in *daprv1pb.GetSecretEnvelope) (*daprv1pb.GetSecretResponseEnvelope, error)
 	SaveState(ctx context.Context, in *daprv1pb.SaveStateEnvelope) (*empty.Empty, error)
+	SaveBulkStateAlpha1(ctx context.Context, in *daprv1pb.SaveStateEnvelope) (*daprv1pb.SaveBulkStateResponse, error)
 	DeleteState(ctx context.Context, in *daprv1pb.DeleteStateEnvelope) (*empty.Empty, error)
+	ExecuteCrossStoreTransactionAlpha1(ctx context.Context, in *daprv1pb.CrossStoreTransactionRequest) (*daprv1pb.CrossStoreTransactionResponse, error)
+	QueryStateKeysAlpha1(ctx context.Context, in *daprv1pb.QueryStateKeysRequest) (*daprv1pb.QueryStateKeysResponse, error)
+	MigrateStateAlpha1(in *daprv1pb.MigrateStateRequest, stream daprv1pb.Dapr_MigrateStateAlpha1Server) error
+	ListActorRemindersAlpha1(ctx context.Context, in *daprv1pb.ListActorRemindersRequest) (*daprv1pb.ListActorRemindersResponse, error)
+	GetBulkStateTransactionalAlpha1(ctx context.Context, in *daprv1pb.GetBulkStateTransactionalRequest) (*daprv1pb.GetBulkStateTransactionalResponse, error)
 }
 
 type api struct {
@@ -65,33 +85,55 @@ type api struct {
 	directMessaging       messaging.DirectMessaging
 	appChannel            channel.AppChannel
 	stateStores           map[string]state.Store
+	getStateDefaultsFn    func(storeName string) runtime_state.Defaults
+	getStateKeyPrefixFn   func(storeName string) (string, bool)
 	secretStores          map[string]secretstores.SecretStore
-	publishFn             func(req *pubsub.PublishRequest) error
+	publishFn             func(req *pubsub.PublishRequest, metadata map[string]string) (string, error)
+	bulkPublishFn         func(req *runtime_pubsub.BulkPublishRequest) (runtime_pubsub.BulkPublishResponse, error)
 	id                    string
 	sendToOutputBindingFn func(name string, req *bindings.WriteRequest) error
+	bulkBindingFn         func(req *runtime_bindings.BulkWriteRequest) (runtime_bindings.BulkWriteResponse, error)
 	tracingSpec           config.TracingSpec
+	transfers             transfers
+	memoryThrottle        *throttle.MemoryThrottle
+	appTokenValidator     *apptoken.Validator
+	migrateStateFn        runtime_state.MigrateFunc
 }
 
 // NewAPI returns a new gRPC API
 func NewAPI(
 	appID string, appChannel channel.AppChannel,
 	stateStores map[string]state.Store,
+	getStateDefaultsFn func(storeName string) runtime_state.Defaults,
+	getStateKeyPrefixFn func(storeName string) (string, bool),
 	secretStores map[string]secretstores.SecretStore,
-	publishFn func(req *pubsub.PublishRequest) error,
+	publishFn func(req *pubsub.PublishRequest, metadata map[string]string) (string, error),
+	bulkPublishFn func(req *runtime_pubsub.BulkPublishRequest) (runtime_pubsub.BulkPublishResponse, error),
 	directMessaging messaging.DirectMessaging,
 	actor actors.Actors,
 	sendToOutputBindingFn func(name string, req *bindings.WriteRequest) error,
-	tracingSpec config.TracingSpec) API {
+	bulkBindingFn func(req *runtime_bindings.BulkWriteRequest) (runtime_bindings.BulkWriteResponse, error),
+	tracingSpec config.TracingSpec,
+	memoryThrottle *throttle.MemoryThrottle,
+	appTokenValidator *apptoken.Validator,
+	migrateStateFn runtime_state.MigrateFunc) API {
 	return &api{
 		directMessaging:       directMessaging,
 		actor:                 actor,
 		id:                    appID,
 		appChannel:            appChannel,
 		publishFn:             publishFn,
+		bulkPublishFn:         bulkPublishFn,
 		stateStores:           stateStores,
+		getStateDefaultsFn:    getStateDefaultsFn,
+		getStateKeyPrefixFn:   getStateKeyPrefixFn,
 		secretStores:          secretStores,
 		sendToOutputBindingFn: sendToOutputBindingFn,
+		bulkBindingFn:         bulkBindingFn,
 		tracingSpec:           tracingSpec,
+		memoryThrottle:        memoryThrottle,
+		appTokenValidator:     appTokenValidator,
+		migrateStateFn:        migrateStateFn,
 	}
 }
 
@@ -110,7 +152,11 @@ func (a *api) CallLocal(ctx context.Context, in *internalv1pb.InternalInvokeRequ
 	defer span.End()
 	ctx = diag.NewContext(ctx, span.SpanContext())
 
-	resp, err := a.appChannel.InvokeMethod(ctx, req)
+	if rejection := a.appTokenValidator.Validate(req); rejection != nil {
+		return rejection.Proto(), nil
+	}
+
+	resp, err := a.appChannel.InvokeMethod(channel.WithPath(ctx, channel.InvocationPath), req)
 	diag.UpdateSpanPairStatusesFromError(span, err, req.Message().Method)
 	if err != nil {
 		return nil, err
@@ -136,9 +182,27 @@ func (a *api) CallActor(ctx context.Context, in *internalv1pb.InternalInvokeRequ
 	return resp.Proto(), nil
 }
 
+// MessageIDHeader is the response header holding the ID the broker assigned to a published event
+const MessageIDHeader = "dapr-message-id"
+
+// PublishEvent publishes an event and returns the ID the broker assigned to it in the MessageIDHeader response header,
+// when the pubsub component reports it
 func (a *api) PublishEvent(ctx context.Context, in *daprv1pb.PublishEventEnvelope) (*empty.Empty, error) {
+	id, err := a.publish(ctx, in)
+	if err != nil {
+		return &empty.Empty{}, err
+	}
+	if id != "" {
+		grpc.SetHeader(ctx, metadata.Pairs(MessageIDHeader, id))
+	}
+	return &empty.Empty{}, nil
+}
+
+// publish wraps the data of an event in a cloud event, unless the app relays a complete one, and publishes it.
+// It returns the ID the broker assigned to the event, if reported by the pubsub component.
+func (a *api) publish(ctx context.Context, in *daprv1pb.PublishEventEnvelope) (string, error) {
 	if a.publishFn == nil {
-		return &empty.Empty{}, errors.New("ERR_PUBSUB_NOT_FOUND")
+		return "", errors.New("ERR_PUBSUB_NOT_FOUND")
 	}
 
 	topic := in.Topic
@@ -148,16 +212,19 @@ func (a *api) PublishEvent(ctx context.Context, in *daprv1pb.PublishEventEnvelop
 		body = in.Data.Value
 	}
 
+	md := withCallerToken(callerToken(ctx), in.Metadata)
+	if _, _, err := runtime_pubsub.GetPriority(md); err != nil {
+		return "", fmt.Errorf("ERR_PUBSUB_INVALID_METADATA: %s", err)
+	}
+
 	var span *trace.Span
 	spanName := fmt.Sprintf("PublishEvent: %s", topic)
 	_, span = diag.StartTracingClientSpanFromGRPCContext(ctx, spanName, a.tracingSpec)
 	defer span.End()
 
-	corID := diag.SpanContextToString(span.SpanContext())
-	envelope := pubsub.NewCloudEventsEnvelope(uuid.New().String(), a.id, pubsub.DefaultCloudEventType, corID, body)
-	b, err := jsoniter.ConfigFastest.Marshal(envelope)
+	b, err := a.cloudEventData(span, body, md)
 	if err != nil {
-		return &empty.Empty{}, fmt.Errorf("ERR_PUBSUB_CLOUD_EVENTS_SER: %s", err)
+		return "", err
 	}
 
 	req := pubsub.PublishRequest{
@@ -165,11 +232,60 @@ func (a *api) PublishEvent(ctx context.Context, in *daprv1pb.PublishEventEnvelop
 		Data:  b,
 	}
 
-	err = a.publishFn(&req)
+	id, err := a.publishFn(&req, md)
+	if _, ok := err.(*runtime_pubsub.SchemaError); ok {
+		return "", fmt.Errorf("ERR_PUBSUB_CLOUD_EVENTS_SCHEMA: %s", err)
+	} else if _, ok := err.(*runtime_pubsub.AuthorizationError); ok {
+		return "", fmt.Errorf("ERR_PUBSUB_FORBIDDEN: %s", err)
+	} else if _, ok := err.(*ratelimit.Error); ok {
+		return "", fmt.Errorf("ERR_PUBSUB_RATE_LIMITED: %s", err)
+	} else if err != nil {
+		return "", fmt.Errorf("ERR_PUBSUB_PUBLISH_MESSAGE: %s", err)
+	}
+	return id, nil
+}
+
+// callerToken returns the bearer token in the authorization metadata of a call, if any
+func callerToken(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("authorization"); len(values) > 0 {
+		return apptoken.BearerToken(values[0])
+	}
+	return ""
+}
+
+// withCallerToken returns a copy of the publish metadata with the caller token the topic access rules match the
+// claims of. A token the app sets in the publish metadata itself is never trusted.
+func withCallerToken(token string, in map[string]string) map[string]string {
+	out := make(map[string]string, len(in)+1)
+	for k, v := range in {
+		out[k] = v
+	}
+	delete(out, runtime_pubsub.CallerTokenMetadataKey)
+	if token != "" {
+		out[runtime_pubsub.CallerTokenMetadataKey] = token
+	}
+	return out
+}
+
+// cloudEventData returns the data published for an event: the data of the app when it relays a complete cloud event,
+// otherwise the data wrapped in a new cloud event correlated with the span
+func (a *api) cloudEventData(span *trace.Span, body []byte, metadata map[string]string) ([]byte, error) {
+	if runtime_pubsub.IsPreservedCloudEvent(metadata) {
+		// the app relays a complete cloud event, keep its id, time and traceparent
+		if err := runtime_pubsub.ValidateCloudEvent(body); err != nil {
+			return nil, fmt.Errorf("ERR_PUBSUB_CLOUD_EVENTS_INVALID: %s", err)
+		}
+		return body, nil
+	}
+
+	corID := diag.SpanContextToString(span.SpanContext())
+	envelope := pubsub.NewCloudEventsEnvelope(uuid.New().String(), a.id, pubsub.DefaultCloudEventType, corID, body)
+	b, err := jsoniter.ConfigFastest.Marshal(envelope)
 	if err != nil {
-		return &empty.Empty{}, fmt.Errorf("ERR_PUBSUB_PUBLISH_MESSAGE: %s", err)
+		return nil, fmt.Errorf("ERR_PUBSUB_CLOUD_EVENTS_SER: %s", err)
 	}
-	return &empty.Empty{}, nil
+	return b, nil
 }
 
 func (a *api) InvokeService(ctx context.Context, in *daprv1pb.InvokeServiceRequest) (*commonv1pb.InvokeResponse, error) {
@@ -222,6 +338,55 @@ func (a *api) InvokeBinding(ctx context.Context, in *daprv1pb.InvokeBindingEnvel
 	return &empty.Empty{}, nil
 }
 
+// InvokeActor invokes an actor method. The metadata of the request is forwarded to the method and the headers set by
+// the method are returned in the metadata of the response.
+func (a *api) InvokeActor(ctx context.Context, in *daprv1pb.InvokeActorEnvelope) (*daprv1pb.InvokeActorResponseEnvelope, error) {
+	if a.actor == nil {
+		return nil, errors.New("ERR_ACTOR_RUNTIME_NOT_FOUND")
+	}
+	if !actors.IsValidConsistency(in.Metadata[actors.ConsistencyHeader]) {
+		return nil, status.Errorf(codes.InvalidArgument, "ERR_ACTOR_INVALID_ROUTING_HINT: %s must be %s or %s",
+			actors.ConsistencyHeader, actors.ConsistencyStrong, actors.ConsistencyEventual)
+	}
+	if err := actors.ValidateAffinity(in.Metadata[actors.AffinityHeader]); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ERR_ACTOR_INVALID_ROUTING_HINT: %s: %s", actors.AffinityHeader, err)
+	}
+
+	var data []byte
+	if in.Data != nil {
+		data = in.Data.Value
+	}
+	md := map[string][]string{}
+	for k, v := range in.Metadata {
+		md[k] = []string{v}
+	}
+	req := invokev1.NewInvokeMethodRequest(in.Method)
+	req.WithActor(in.ActorType, in.ActorId)
+	req.WithRawData(data, "")
+	req.WithMetadata(md)
+
+	spanName := fmt.Sprintf("InvokeActor: %s.%s", in.ActorType, in.Method)
+	ctx, span := diag.StartTracingClientSpanFromGRPCContext(ctx, spanName, a.tracingSpec)
+	defer span.End()
+
+	resp, err := a.actor.Call(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("ERR_ACTOR_INVOKE_METHOD: %s", err)
+	}
+
+	_, body := resp.RawData()
+	out := &daprv1pb.InvokeActorResponseEnvelope{
+		Data:     &any.Any{Value: body},
+		Metadata: map[string]string{},
+	}
+	for k, v := range resp.Headers() {
+		if len(v.GetValues()) > 0 {
+			out.Metadata[strings.ToLower(k)] = v.GetValues()[0]
+		}
+	}
+	return out, nil
+}
+
 func (a *api) GetState(ctx context.Context, in *daprv1pb.GetStateEnvelope) (*daprv1pb.GetStateResponseEnvelope, error) {
 	if a.stateStores == nil || len(a.stateStores) == 0 {
 		return nil, errors.New("ERR_STATE_STORE_NOT_CONFIGURED")
@@ -233,12 +398,24 @@ func (a *api) GetState(ctx context.Context, in *daprv1pb.GetStateEnvelope) (*dap
 		return nil, errors.New("ERR_STATE_STORE_NOT_FOUND")
 	}
 
+	projection, metadata, err := runtime_state.ProjectionFromMetadata(in.Metadata)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ERR_STATE_PROJECTION: %s", err)
+	}
 	req := state.GetRequest{
-		Key: a.getModifiedStateKey(in.Key),
+		Key:      a.getModifiedStateKey(storeName, in.Key),
+		Metadata: metadata,
 		Options: state.GetStateOption{
 			Consistency: in.Consistency,
 		},
 	}
+	a.getStateDefaults(storeName).ApplyToGet(&req)
+	if token := sessionTokenFromContext(ctx); token != "" {
+		err = runtime_state.ApplySessionToken(storeName, a.stateStores[storeName], &req, token)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "ERR_STATE_STORE_NOT_SUPPORTED: %s", err)
+		}
+	}
 
 	var span *trace.Span
 	spanName := fmt.Sprintf("GetState: %s", storeName)
@@ -249,11 +426,19 @@ func (a *api) GetState(ctx context.Context, in *daprv1pb.GetStateEnvelope) (*dap
 	if err != nil {
 		return nil, fmt.Errorf("ERR_STATE_GET: %s", err)
 	}
+	setStateOptionHeaders(ctx, req.Options.Consistency, "")
+	setSessionTokenHeader(ctx, a.stateStores[storeName], req.Key)
 
 	response := &daprv1pb.GetStateResponseEnvelope{}
 	if getResponse != nil {
+		data := getResponse.Data
+		if projection != nil && data != nil {
+			if data, err = projection.Apply(data); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "ERR_STATE_PROJECTION: %s", err)
+			}
+		}
 		response.Etag = getResponse.ETag
-		response.Data = &any.Any{Value: getResponse.Data}
+		response.Data = &any.Any{Value: data}
 	}
 	return response, nil
 }
@@ -269,14 +454,42 @@ func (a *api) SaveState(ctx context.Context, in *daprv1pb.SaveStateEnvelope) (*e
 		return &empty.Empty{}, errors.New("ERR_STATE_STORE_NOT_FOUND")
 	}
 
+	reqs, etags, err := a.stateSetRequests(storeName, in.Requests)
+	if err != nil {
+		return &empty.Empty{}, err
+	}
+
+	var span *trace.Span
+	spanName := fmt.Sprintf("SaveState: %s", storeName)
+	_, span = diag.StartTracingClientSpanFromGRPCContext(ctx, spanName, a.tracingSpec)
+	defer span.End()
+
+	err = runtime_state.BulkSetWithETags(a.stateStores[storeName], reqs, etags)
+	if err != nil {
+		return &empty.Empty{}, fmt.Errorf("ERR_STATE_SAVE: %s", err)
+	}
+	consistency, concurrency := runtime_state.EffectiveSetOptions(reqs)
+	setStateOptionHeaders(ctx, consistency, concurrency)
+	keys := make([]string, 0, len(reqs))
+	for _, r := range reqs {
+		keys = append(keys, r.Key)
+	}
+	setSessionTokenHeader(ctx, a.stateStores[storeName], keys...)
+	return &empty.Empty{}, nil
+}
+
+// stateSetRequests returns the set requests of the state requests of a store, with the accepted ETags of every request
+func (a *api) stateSetRequests(storeName string, requests []*daprv1pb.StateRequest) ([]state.SetRequest, [][]string, error) {
 	reqs := []state.SetRequest{}
-	for _, s := range in.Requests {
+	etags := [][]string{}
+	for _, s := range requests {
 		req := state.SetRequest{
-			Key:      a.getModifiedStateKey(s.Key),
+			Key:      a.getModifiedStateKey(storeName, s.Key),
 			Metadata: s.Metadata,
 			Value:    s.Value.Value,
 			ETag:     s.Etag,
 		}
+		etags = append(etags, runtime_state.AcceptedETags(s.Etag, s.Etags))
 		if s.Options != nil {
 			req.Options = state.SetStateOption{
 				Consistency: s.Options.Consistency,
@@ -295,19 +508,22 @@ func (a *api) SaveState(ctx context.Context, in *daprv1pb.SaveStateEnvelope) (*e
 				}
 			}
 		}
+		if s.Ttl != nil {
+			ttl, err := duration(s.Ttl)
+			if err != nil {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "ERR_STATE_SAVE: %s", err)
+			}
+			if err := runtime_state.ApplyTTL(storeName, a.stateStores[storeName], &req, ttl); err != nil {
+				if _, ok := err.(*runtime_state.FeatureError); ok {
+					return nil, nil, status.Errorf(codes.FailedPrecondition, "ERR_STATE_STORE_NOT_SUPPORTED: %s", err)
+				}
+				return nil, nil, status.Errorf(codes.InvalidArgument, "ERR_STATE_SAVE: %s", err)
+			}
+		}
+		a.getStateDefaults(storeName).ApplyToSet(&req)
 		reqs = append(reqs, req)
 	}
-
-	var span *trace.Span
-	spanName := fmt.Sprintf("SaveState: %s", storeName)
-	_, span = diag.StartTracingClientSpanFromGRPCContext(ctx, spanName, a.tracingSpec)
-	defer span.End()
-
-	err := a.stateStores[storeName].BulkSet(reqs)
-	if err != nil {
-		return &empty.Empty{}, fmt.Errorf("ERR_STATE_SAVE: %s", err)
-	}
-	return &empty.Empty{}, nil
+	return reqs, etags, nil
 }
 
 func (a *api) DeleteState(ctx context.Context, in *daprv1pb.DeleteStateEnvelope) (*empty.Empty, error) {
@@ -322,7 +538,7 @@ func (a *api) DeleteState(ctx context.Context, in *daprv1pb.DeleteStateEnvelope)
 	}
 
 	req := state.DeleteRequest{
-		Key:  a.getModifiedStateKey(in.Key),
+		Key:  a.getModifiedStateKey(storeName, in.Key),
 		ETag: in.Etag,
 	}
 	if in.Options != nil {
@@ -351,14 +567,66 @@ func (a *api) DeleteState(ctx context.Context, in *daprv1pb.DeleteStateEnvelope)
 	_, span = diag.StartTracingClientSpanFromGRPCContext(ctx, spanName, a.tracingSpec)
 	defer span.End()
 
-	err := a.stateStores[storeName].Delete(&req)
+	a.getStateDefaults(storeName).ApplyToDelete(&req)
+	err := runtime_state.DeleteWithETags(a.stateStores[storeName], req, runtime_state.AcceptedETags(in.Etag, in.Etags))
 	if err != nil {
 		return &empty.Empty{}, fmt.Errorf("ERR_STATE_DELETE: failed deleting state with key %s: %s", in.Key, err)
 	}
+	setStateOptionHeaders(ctx, req.Options.Consistency, req.Options.Concurrency)
+	setSessionTokenHeader(ctx, a.stateStores[storeName], req.Key)
 	return &empty.Empty{}, nil
 }
 
-func (a *api) getModifiedStateKey(key string) string {
+// setStateOptionHeaders returns the consistency and concurrency a state request was executed with in the response header
+func setStateOptionHeaders(ctx context.Context, consistency, concurrency string) {
+	md := metadata.MD{}
+	if consistency != "" {
+		md.Set(runtime_state.ConsistencyHeader, consistency)
+	}
+	if concurrency != "" {
+		md.Set(runtime_state.ConcurrencyHeader, concurrency)
+	}
+	if len(md) > 0 {
+		grpc.SetHeader(ctx, md)
+	}
+}
+
+// sessionTokenFromContext returns the session token a get request reads at, from the request header
+func sessionTokenFromContext(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if tokens := md.Get(runtime_state.SessionTokenHeader); len(tokens) > 0 {
+		return tokens[0]
+	}
+	return ""
+}
+
+// setSessionTokenHeader returns the session token of the keys of a state request in the response header, if the state
+// store has session consistency
+func setSessionTokenHeader(ctx context.Context, store state.Store, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	if token := runtime_state.SessionToken(store, keys...); token != "" {
+		grpc.SetHeader(ctx, metadata.Pairs(runtime_state.SessionTokenHeader, token))
+	}
+}
+
+// getStateDefaults returns the defaults of a state store declared by its component
+func (a *api) getStateDefaults(storeName string) runtime_state.Defaults {
+	if a.getStateDefaultsFn == nil {
+		return runtime_state.Defaults{}
+	}
+	return a.getStateDefaultsFn(storeName)
+}
+
+// getModifiedStateKey returns the key of the app in a state store, prefixed with the key prefix of the store or else
+// with the app ID
+func (a *api) getModifiedStateKey(storeName, key string) string {
+	if a.getStateKeyPrefixFn != nil {
+		if prefix, ok := a.getStateKeyPrefixFn(storeName); ok {
+			return prefix + key
+		}
+	}
 	if a.id != "" {
 		return fmt.Sprintf("%s%s%s", a.id, daprSeparator, key)
 	}
@@ -7,13 +7,19 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/dapr/components-contrib/exporters"
 	"github.com/dapr/components-contrib/exporters/stringexporter"
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/actors"
+	"github.com/dapr/dapr/pkg/apptoken"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
@@ -22,10 +28,13 @@ import (
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
+	durpb "github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/empty"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/phayes/freeport"
@@ -35,6 +44,7 @@ import (
 	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
 	grpc_go "google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -49,6 +59,10 @@ func (m *mockGRPCAPI) CallLocal(ctx context.Context, in *internalv1pb.InternalIn
 	return resp.Proto(), nil
 }
 
+func (m *mockGRPCAPI) CallLocalStream(stream internalv1pb.DaprInternal_CallLocalStreamServer) error {
+	return status.Error(codes.Unimplemented, "not implemented")
+}
+
 func (m *mockGRPCAPI) CallActor(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
 	var resp = invokev1.NewInvokeMethodResponse(0, "", nil)
 	resp.WithRawData(ExtractSpanContext(ctx), "text/plains")
@@ -59,6 +73,54 @@ func (m *mockGRPCAPI) PublishEvent(ctx context.Context, in *daprv1pb.PublishEven
 	return &empty.Empty{}, nil
 }
 
+func (m *mockGRPCAPI) PublishEventStreamAlpha1(stream daprv1pb.Dapr_PublishEventStreamAlpha1Server) error {
+	return status.Error(codes.Unimplemented, "not implemented")
+}
+
+func (m *mockGRPCAPI) InvokeActor(ctx context.Context, in *daprv1pb.InvokeActorEnvelope) (*daprv1pb.InvokeActorResponseEnvelope, error) {
+	return &daprv1pb.InvokeActorResponseEnvelope{}, nil
+}
+
+func (m *mockGRPCAPI) BulkPublishEventAlpha1(ctx context.Context, in *daprv1pb.BulkPublishRequest) (*daprv1pb.BulkPublishResponse, error) {
+	return &daprv1pb.BulkPublishResponse{}, nil
+}
+
+func (m *mockGRPCAPI) InvokeBindingBulkAlpha1(ctx context.Context, in *daprv1pb.InvokeBindingBulkRequest) (*daprv1pb.InvokeBindingBulkResponse, error) {
+	return &daprv1pb.InvokeBindingBulkResponse{}, nil
+}
+
+func (m *mockGRPCAPI) GetBulkStateStreamAlpha1(in *daprv1pb.GetBulkStateRequest, stream daprv1pb.Dapr_GetBulkStateStreamAlpha1Server) error {
+	return nil
+}
+
+func (m *mockGRPCAPI) SubscribeStateAlpha1(in *daprv1pb.SubscribeStateRequest, stream daprv1pb.Dapr_SubscribeStateAlpha1Server) error {
+	return nil
+}
+
+func (m *mockGRPCAPI) ExecuteCrossStoreTransactionAlpha1(ctx context.Context, in *daprv1pb.CrossStoreTransactionRequest) (*daprv1pb.CrossStoreTransactionResponse, error) {
+	return &daprv1pb.CrossStoreTransactionResponse{}, nil
+}
+
+func (m *mockGRPCAPI) QueryStateKeysAlpha1(ctx context.Context, in *daprv1pb.QueryStateKeysRequest) (*daprv1pb.QueryStateKeysResponse, error) {
+	return &daprv1pb.QueryStateKeysResponse{}, nil
+}
+
+func (m *mockGRPCAPI) MigrateStateAlpha1(in *daprv1pb.MigrateStateRequest, stream daprv1pb.Dapr_MigrateStateAlpha1Server) error {
+	return nil
+}
+
+func (m *mockGRPCAPI) SaveBulkStateAlpha1(ctx context.Context, in *daprv1pb.SaveStateEnvelope) (*daprv1pb.SaveBulkStateResponse, error) {
+	return &daprv1pb.SaveBulkStateResponse{}, nil
+}
+
+func (m *mockGRPCAPI) ListActorRemindersAlpha1(ctx context.Context, in *daprv1pb.ListActorRemindersRequest) (*daprv1pb.ListActorRemindersResponse, error) {
+	return &daprv1pb.ListActorRemindersResponse{}, nil
+}
+
+func (m *mockGRPCAPI) GetBulkStateTransactionalAlpha1(ctx context.Context, in *daprv1pb.GetBulkStateTransactionalRequest) (*daprv1pb.GetBulkStateTransactionalResponse, error) {
+	return &daprv1pb.GetBulkStateTransactionalResponse{}, nil
+}
+
 func (m *mockGRPCAPI) InvokeService(ctx context.Context, in *daprv1pb.InvokeServiceRequest) (*commonv1pb.InvokeResponse, error) {
 	return &commonv1pb.InvokeResponse{}, nil
 }
@@ -287,6 +349,34 @@ func TestCallLocal(t *testing.T) {
 		_, err := client.CallLocal(context.Background(), request)
 		assert.Equal(t, codes.Unknown, status.Code(err))
 	})
+
+	t.Run("app token is missing", func(t *testing.T) {
+		port, _ := freeport.GetFreePort()
+
+		validator, err := apptoken.NewValidator(config.AppTokenValidationSpec{
+			JWKSURL: "http://localhost/keys",
+			Routes:  []config.AppTokenRoute{{Method: "orders/*", Issuer: "https://issuer.example.com"}},
+		})
+		assert.NoError(t, err)
+		mockAppChannel := new(channelt.MockAppChannel)
+		fakeAPI := &api{
+			id:                "fakeAPI",
+			appChannel:        mockAppChannel,
+			appTokenValidator: validator,
+		}
+		server := startInternalServer(port, fakeAPI)
+		defer server.Stop()
+		clientConn := createTestClient(port)
+		defer clientConn.Close()
+
+		client := internalv1pb.NewDaprInternalClient(clientConn)
+		request := invokev1.NewInvokeMethodRequest("orders/1").Proto()
+
+		resp, err := client.CallLocal(context.Background(), request)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(401), resp.GetStatus().GetCode())
+		mockAppChannel.AssertNotCalled(t, "InvokeMethod", mock.Anything, mock.Anything)
+	})
 }
 
 func mustMarshalAny(msg proto.Message) *any.Any {
@@ -442,6 +532,232 @@ func TestGetState(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestGetStateProjection(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	fakeAPI := &api{
+		id: "fakeAPI",
+		stateStores: map[string]state.Store{"store": &keyValueStore{values: map[string][]byte{
+			"fakeAPI||doc": []byte(`{"customer":{"id":"c1","name":"Ann"},"total":10}`),
+		}}},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	resp, err := client.GetState(context.Background(), &daprv1pb.GetStateEnvelope{
+		StoreName: "store",
+		Key:       "doc",
+		Metadata:  map[string]string{runtime_state.ProjectionMetadataKey: "$.customer.id"},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"customer":{"id":"c1"}}`, string(resp.Data.Value))
+	assert.Equal(t, "1", resp.Etag)
+
+	_, err = client.GetState(context.Background(), &daprv1pb.GetStateEnvelope{
+		StoreName: "store",
+		Key:       "doc",
+		Metadata:  map[string]string{runtime_state.ProjectionMetadataKey: "$.items[0]"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// sessionStore is a state store with session consistency recording the session tokens of its get requests, the
+// session token of keys lists them
+type sessionStore struct {
+	keyValueStore
+	readAt []string
+}
+
+func (s *sessionStore) SessionToken(keys ...string) string {
+	return "session:" + strings.Join(keys, ",")
+}
+
+func (s *sessionStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	s.readAt = append(s.readAt, req.Metadata[runtime_state.SessionTokenMetadataKey])
+	return s.keyValueStore.Get(req)
+}
+
+func (s *sessionStore) BulkSet(reqs []state.SetRequest) error {
+	return nil
+}
+
+func (s *sessionStore) Delete(req *state.DeleteRequest) error {
+	return nil
+}
+
+func TestStateSessionToken(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	store := &sessionStore{keyValueStore: keyValueStore{values: map[string][]byte{"fakeAPI||k1": []byte("v1")}}}
+	fakeAPI := &api{
+		id: "fakeAPI",
+		stateStores: map[string]state.Store{
+			"session": store,
+			"plain":   &keyValueStore{values: map[string][]byte{}},
+		},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	t.Run("save returns the session token", func(t *testing.T) {
+		var header metadata.MD
+		_, err := client.SaveState(context.Background(), &daprv1pb.SaveStateEnvelope{
+			StoreName: "session",
+			Requests: []*daprv1pb.StateRequest{
+				{Key: "k1", Value: &any.Any{Value: []byte("v1")}},
+				{Key: "k2", Value: &any.Any{Value: []byte("v2")}},
+			},
+		}, grpc_go.Header(&header))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"session:fakeAPI||k1,fakeAPI||k2"}, header.Get(runtime_state.SessionTokenHeader))
+	})
+
+	t.Run("get reads at the session token", func(t *testing.T) {
+		var header metadata.MD
+		ctx := metadata.AppendToOutgoingContext(context.Background(), runtime_state.SessionTokenHeader, "session:fakeAPI||k1")
+		resp, err := client.GetState(ctx, &daprv1pb.GetStateEnvelope{StoreName: "session", Key: "k1"}, grpc_go.Header(&header))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("v1"), resp.Data.Value)
+		assert.Equal(t, []string{"session:fakeAPI||k1"}, store.readAt)
+		assert.Equal(t, []string{"session:fakeAPI||k1"}, header.Get(runtime_state.SessionTokenHeader))
+	})
+
+	t.Run("delete returns the session token", func(t *testing.T) {
+		var header metadata.MD
+		_, err := client.DeleteState(context.Background(), &daprv1pb.DeleteStateEnvelope{StoreName: "session", Key: "k1"}, grpc_go.Header(&header))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"session:fakeAPI||k1"}, header.Get(runtime_state.SessionTokenHeader))
+	})
+
+	t.Run("session token on a store without session consistency", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), runtime_state.SessionTokenHeader, "session:fakeAPI||k1")
+		_, err := client.GetState(ctx, &daprv1pb.GetStateEnvelope{StoreName: "plain", Key: "k1"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), string(runtime_state.FeatureSessionConsistency))
+	})
+}
+
+// recordingStore is a state store recording its bulk set requests
+type recordingStore struct {
+	state.Store
+	ttl  bool
+	sets []state.SetRequest
+}
+
+func (s *recordingStore) BulkSet(reqs []state.SetRequest) error {
+	s.sets = append(s.sets, reqs...)
+	return nil
+}
+
+func (s *recordingStore) SupportsTTL() bool {
+	return s.ttl
+}
+
+func TestSaveStateTTL(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	ttlStore := &recordingStore{ttl: true}
+	fakeAPI := &api{
+		id:          "fakeAPI",
+		stateStores: map[string]state.Store{"ttl": ttlStore, "plain": &recordingStore{}},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	save := func(storeName string, ttl *durpb.Duration, metadata map[string]string) error {
+		_, err := client.SaveState(context.Background(), &daprv1pb.SaveStateEnvelope{
+			StoreName: storeName,
+			Requests:  []*daprv1pb.StateRequest{{Key: "k1", Value: &any.Any{Value: []byte("v1")}, Ttl: ttl, Metadata: metadata}},
+		})
+		return err
+	}
+
+	assert.NoError(t, save("ttl", &durpb.Duration{Seconds: 30}, nil))
+	assert.Equal(t, "30", ttlStore.sets[0].Metadata[runtime_state.TTLMetadataKey])
+
+	err := save("plain", &durpb.Duration{Seconds: 30}, nil)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "ERR_STATE_STORE_NOT_SUPPORTED")
+
+	err = save("ttl", &durpb.Duration{Nanos: 5e8}, nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = save("ttl", &durpb.Duration{Seconds: 30}, map[string]string{runtime_state.TTLMetadataKey: "60"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Len(t, ttlStore.sets, 1)
+}
+
+// versionedStore is a state store accepting the writes with the ETag 2, and counting them
+type versionedStore struct {
+	state.Store
+	writes int
+}
+
+func (s *versionedStore) check(etag string) error {
+	s.writes++
+	if etag != "2" {
+		return errors.New("etag mismatch")
+	}
+	return nil
+}
+
+func (s *versionedStore) Set(req *state.SetRequest) error {
+	return s.check(req.ETag)
+}
+
+func (s *versionedStore) BulkSet(reqs []state.SetRequest) error {
+	for _, req := range reqs {
+		if err := s.check(req.ETag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *versionedStore) Delete(req *state.DeleteRequest) error {
+	return s.check(req.ETag)
+}
+
+func TestStateETags(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	store := &versionedStore{}
+	fakeAPI := &api{
+		id:          "fakeAPI",
+		stateStores: map[string]state.Store{"store": store},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	save := func(etag string, etags ...string) error {
+		_, err := client.SaveState(context.Background(), &daprv1pb.SaveStateEnvelope{
+			StoreName: "store",
+			Requests:  []*daprv1pb.StateRequest{{Key: "k1", Value: &any.Any{Value: []byte("v1")}, Etag: etag, Etags: etags}},
+		})
+		return err
+	}
+	assert.NoError(t, save("1", "2", "3"))
+	assert.Equal(t, 2, store.writes)
+	assert.Error(t, save("1", "3"))
+	assert.Error(t, save("1"))
+
+	_, err := client.DeleteState(context.Background(), &daprv1pb.DeleteStateEnvelope{StoreName: "store", Key: "k1", Etags: []string{"1", "2"}})
+	assert.NoError(t, err)
+	_, err = client.DeleteState(context.Background(), &daprv1pb.DeleteStateEnvelope{StoreName: "store", Key: "k1", Etag: "1", Etags: []string{"3"}})
+	assert.Error(t, err)
+}
+
 func TestDeleteState(t *testing.T) {
 	port, _ := freeport.GetFreePort()
 
@@ -470,6 +786,64 @@ func TestPublishTopic(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestPublishEventMessageID(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	fakeAPI := &api{
+		id: "fakeAPI",
+		publishFn: func(req *pubsub.PublishRequest, metadata map[string]string) (string, error) {
+			return "broker-1", nil
+		},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+
+	client := daprv1pb.NewDaprClient(clientConn)
+	var header metadata.MD
+	_, err := client.PublishEvent(context.Background(), &daprv1pb.PublishEventEnvelope{Topic: "topic"}, grpc_go.Header(&header))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"broker-1"}, header.Get(MessageIDHeader))
+}
+
+func TestPublishEventCallerToken(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	var token string
+	fakeAPI := &api{
+		id: "fakeAPI",
+		publishFn: func(req *pubsub.PublishRequest, metadata map[string]string) (string, error) {
+			token = metadata[runtime_pubsub.CallerTokenMetadataKey]
+			if token != "valid" {
+				return "", &runtime_pubsub.AuthorizationError{Operation: runtime_pubsub.OperationPublish, Topic: req.Topic, Reason: "invalid token"}
+			}
+			return "", nil
+		},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+
+	client := daprv1pb.NewDaprClient(clientConn)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer valid")
+	_, err := client.PublishEvent(ctx, &daprv1pb.PublishEventEnvelope{Topic: "topic"})
+	assert.NoError(t, err)
+	assert.Equal(t, "valid", token)
+
+	// a token set by the app in the publish metadata is ignored
+	_, err = client.PublishEvent(context.Background(), &daprv1pb.PublishEventEnvelope{
+		Topic:    "topic",
+		Metadata: map[string]string{runtime_pubsub.CallerTokenMetadataKey: "valid"},
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "ERR_PUBSUB_FORBIDDEN")
+	assert.Empty(t, token)
+}
+
 func TestInvokeBinding(t *testing.T) {
 	port, _ := freeport.GetFreePort()
 
@@ -483,3 +857,52 @@ func TestInvokeBinding(t *testing.T) {
 	_, err := client.InvokeBinding(context.Background(), &daprv1pb.InvokeBindingEnvelope{})
 	assert.Nil(t, err)
 }
+
+func TestInvokeActor(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	var received *invokev1.InvokeMethodRequest
+	mockActors := new(daprt.MockActors)
+	mockActors.On("Call", mock.AnythingOfType("*v1.InvokeMethodRequest")).
+		Run(func(args mock.Arguments) {
+			received = args.Get(0).(*invokev1.InvokeMethodRequest)
+		}).
+		Return(invokev1.NewInvokeMethodResponse(200, "OK", nil).
+			WithRawData([]byte("result"), "text/plain").
+			WithHeaders(map[string][]string{"X-Result": {"done"}}), nil)
+	fakeAPI := &api{
+		id:    "fakeAPI",
+		actor: mockActors,
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	t.Run("forwards metadata both ways", func(t *testing.T) {
+		resp, err := client.InvokeActor(context.Background(), &daprv1pb.InvokeActorEnvelope{
+			ActorType: "cat",
+			ActorId:   "1",
+			Method:    "getName",
+			Data:      &any.Any{Value: []byte("input")},
+			Metadata:  map[string]string{"x-tenant": "t1"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("result"), resp.Data.Value)
+		assert.Equal(t, "done", resp.Metadata["x-result"])
+		assert.Equal(t, "cat", received.Actor().ActorType)
+		assert.Equal(t, []string{"t1"}, received.Metadata()["x-tenant"].Values)
+	})
+
+	t.Run("rejects an invalid routing hint", func(t *testing.T) {
+		_, err := client.InvokeActor(context.Background(), &daprv1pb.InvokeActorEnvelope{
+			ActorType: "cat",
+			ActorId:   "1",
+			Method:    "getName",
+			Metadata:  map[string]string{actors.ConsistencyHeader: "weak"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
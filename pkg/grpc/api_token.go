@@ -0,0 +1,66 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+
+	grpc_go "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// APITokenMetadataKey is the metadata item carrying the API token of an app calling the Dapr API
+	APITokenMetadataKey = "dapr-api-token"
+	// AdminAPITokenMetadataKey is the metadata item carrying the token of a caller of the admin API
+	AdminAPITokenMetadataKey = "dapr-admin-api-token"
+)
+
+// adminMethods are the methods of the admin API
+var adminMethods = map[string]bool{
+	"/dapr.proto.dapr.v1.Dapr/MigrateStateAlpha1": true,
+}
+
+// apiTokenUnaryInterceptor rejects the calls to the Dapr API without the given API token
+func apiTokenUnaryInterceptor(token string) grpc_go.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc_go.UnaryServerInfo, handler grpc_go.UnaryHandler) (interface{}, error) {
+		if !validToken(ctx, APITokenMetadataKey, token) {
+			return nil, status.Error(codes.Unauthenticated, "invalid api token")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// apiTokenStreamInterceptor rejects the streams of the Dapr API without the given API token
+func apiTokenStreamInterceptor(token string) grpc_go.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc_go.ServerStream, info *grpc_go.StreamServerInfo, handler grpc_go.StreamHandler) error {
+		if !validToken(stream.Context(), APITokenMetadataKey, token) {
+			return status.Error(codes.Unauthenticated, "invalid api token")
+		}
+		return handler(srv, stream)
+	}
+}
+
+// adminAPITokenStreamInterceptor rejects the streams of the admin API without the given admin API token.
+// The admin API methods are all streams, and the other methods aren't checked.
+func adminAPITokenStreamInterceptor(token string) grpc_go.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc_go.ServerStream, info *grpc_go.StreamServerInfo, handler grpc_go.StreamHandler) error {
+		if adminMethods[info.FullMethod] && !validToken(stream.Context(), AdminAPITokenMetadataKey, token) {
+			return status.Error(codes.Unauthenticated, "invalid admin api token")
+		}
+		return handler(srv, stream)
+	}
+}
+
+// validToken reports whether the metadata item of a call carries the given token
+func validToken(ctx context.Context, key, token string) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(key)
+	return len(values) > 0 && subtle.ConstantTimeCompare([]byte(values[0]), []byte(token)) == 1
+}
@@ -0,0 +1,96 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	grpc_go "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAPITokenUnaryInterceptor(t *testing.T) {
+	interceptor := apiTokenUnaryInterceptor("token")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	t.Run("valid token", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(APITokenMetadataKey, "token"))
+		resp, err := interceptor(ctx, nil, &grpc_go.UnaryServerInfo{}, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(APITokenMetadataKey, "other"))
+		_, err := interceptor(ctx, nil, &grpc_go.UnaryServerInfo{}, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		_, err := interceptor(context.Background(), nil, &grpc_go.UnaryServerInfo{}, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+// contextServerStream is a server stream of the given context
+type contextServerStream struct {
+	grpc_go.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAPITokenStreamInterceptor(t *testing.T) {
+	interceptor := apiTokenStreamInterceptor("token")
+	handler := func(srv interface{}, stream grpc_go.ServerStream) error {
+		return nil
+	}
+
+	t.Run("valid token", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(APITokenMetadataKey, "token"))
+		err := interceptor(nil, &contextServerStream{ctx: ctx}, &grpc_go.StreamServerInfo{}, handler)
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		err := interceptor(nil, &contextServerStream{ctx: context.Background()}, &grpc_go.StreamServerInfo{}, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestAdminAPITokenStreamInterceptor(t *testing.T) {
+	interceptor := adminAPITokenStreamInterceptor("token")
+	handler := func(srv interface{}, stream grpc_go.ServerStream) error {
+		return nil
+	}
+	admin := &grpc_go.StreamServerInfo{FullMethod: "/dapr.proto.dapr.v1.Dapr/MigrateStateAlpha1"}
+
+	t.Run("valid token", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(AdminAPITokenMetadataKey, "token"))
+		err := interceptor(nil, &contextServerStream{ctx: ctx}, admin, handler)
+		assert.NoError(t, err)
+	})
+
+	t.Run("API token instead of the admin token", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(APITokenMetadataKey, "token"))
+		err := interceptor(nil, &contextServerStream{ctx: ctx}, admin, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("other methods without token", func(t *testing.T) {
+		info := &grpc_go.StreamServerInfo{FullMethod: "/dapr.proto.dapr.v1.Dapr/SubscribeStateAlpha1"}
+		err := interceptor(nil, &contextServerStream{ctx: context.Background()}, info, handler)
+		assert.NoError(t, err)
+	})
+}
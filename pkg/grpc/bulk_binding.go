@@ -0,0 +1,66 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dapr/components-contrib/bindings"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_bindings "github.com/dapr/dapr/pkg/runtime/bindings"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InvokeBindingBulkAlpha1 sends several requests to an output binding and returns the result of every request
+func (a *api) InvokeBindingBulkAlpha1(ctx context.Context, in *daprv1pb.InvokeBindingBulkRequest) (*daprv1pb.InvokeBindingBulkResponse, error) {
+	if a.bulkBindingFn == nil {
+		return nil, status.Error(codes.FailedPrecondition, "ERR_INVOKE_OUTPUT_BINDING: bulk invocation is not supported")
+	}
+
+	spanName := fmt.Sprintf("BulkBinding: %s", in.Name)
+	_, span := diag.StartTracingClientSpanFromGRPCContext(ctx, spanName, a.tracingSpec)
+	defer span.End()
+
+	req := &runtime_bindings.BulkWriteRequest{
+		Name:    in.Name,
+		Entries: make([]runtime_bindings.BulkWriteEntry, 0, len(in.Entries)),
+	}
+	for _, e := range in.Entries {
+		metadata := make(map[string]string, len(in.Metadata)+len(e.Metadata))
+		for k, v := range in.Metadata {
+			metadata[k] = v
+		}
+		for k, v := range e.Metadata {
+			metadata[k] = v
+		}
+		writeReq := &bindings.WriteRequest{Metadata: metadata}
+		if e.Data != nil {
+			writeReq.Data = e.Data.Value
+		}
+		req.Entries = append(req.Entries, runtime_bindings.BulkWriteEntry{EntryID: e.EntryId, Request: writeReq})
+	}
+	if err := runtime_bindings.ValidateBulkWriteRequest(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ERR_INVOKE_OUTPUT_BINDING_BULK_INVALID: %s", err)
+	}
+
+	resp, err := a.bulkBindingFn(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "ERR_INVOKE_OUTPUT_BINDING: %s", err)
+	}
+
+	out := &daprv1pb.InvokeBindingBulkResponse{Results: make([]*daprv1pb.InvokeBindingBulkResponseEntry, 0, len(resp.Results))}
+	for _, r := range resp.Results {
+		result := &daprv1pb.InvokeBindingBulkResponseEntry{EntryId: r.EntryID}
+		if r.Error != nil {
+			result.Error = fmt.Sprintf("ERR_INVOKE_OUTPUT_BINDING: %s", r.Error)
+		}
+		out.Results = append(out.Results, result)
+	}
+	return out, nil
+}
@@ -0,0 +1,87 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_bindings "github.com/dapr/dapr/pkg/runtime/bindings"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInvokeBindingBulkAlpha1(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	var received *runtime_bindings.BulkWriteRequest
+	fakeAPI := &api{
+		id: "fakeAPI",
+		bulkBindingFn: func(req *runtime_bindings.BulkWriteRequest) (runtime_bindings.BulkWriteResponse, error) {
+			received = req
+			if req.Name != "rows" {
+				return runtime_bindings.BulkWriteResponse{}, errors.New("couldn't find output binding")
+			}
+			resp := runtime_bindings.BulkWriteResponse{}
+			for _, e := range req.Entries {
+				var err error
+				if e.EntryID == "2" {
+					err = errors.New("row rejected")
+				}
+				resp.Results = append(resp.Results, runtime_bindings.BulkWriteResult{EntryID: e.EntryID, Error: err})
+			}
+			return resp, nil
+		},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	entry := func(id string, metadata map[string]string) *daprv1pb.InvokeBindingBulkRequestEntry {
+		return &daprv1pb.InvokeBindingBulkRequestEntry{EntryId: id, Data: &any.Any{Value: []byte("row " + id)}, Metadata: metadata}
+	}
+
+	t.Run("reports the result of every entry", func(t *testing.T) {
+		resp, err := client.InvokeBindingBulkAlpha1(context.Background(), &daprv1pb.InvokeBindingBulkRequest{
+			Name:     "rows",
+			Entries:  []*daprv1pb.InvokeBindingBulkRequestEntry{entry("1", nil), entry("2", map[string]string{"table": "archive"})},
+			Metadata: map[string]string{"table": "orders"},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Results, 2)
+		assert.Equal(t, "1", resp.Results[0].EntryId)
+		assert.Empty(t, resp.Results[0].Error)
+		assert.Equal(t, "2", resp.Results[1].EntryId)
+		assert.Equal(t, "ERR_INVOKE_OUTPUT_BINDING: row rejected", resp.Results[1].Error)
+
+		assert.Equal(t, []byte("row 1"), received.Entries[0].Request.Data)
+		assert.Equal(t, "orders", received.Entries[0].Request.Metadata["table"])
+		assert.Equal(t, "archive", received.Entries[1].Request.Metadata["table"])
+	})
+
+	t.Run("duplicate entry IDs", func(t *testing.T) {
+		_, err := client.InvokeBindingBulkAlpha1(context.Background(), &daprv1pb.InvokeBindingBulkRequest{
+			Name:    "rows",
+			Entries: []*daprv1pb.InvokeBindingBulkRequestEntry{entry("1", nil), entry("1", nil)},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown binding", func(t *testing.T) {
+		_, err := client.InvokeBindingBulkAlpha1(context.Background(), &daprv1pb.InvokeBindingBulkRequest{
+			Name:    "unknown",
+			Entries: []*daprv1pb.InvokeBindingBulkRequestEntry{entry("1", nil)},
+		})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
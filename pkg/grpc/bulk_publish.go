@@ -0,0 +1,112 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dapr/components-contrib/pubsub"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BulkPublishEventAlpha1 publishes several events to a topic. Entries that fail to be published are listed in the
+// response, unless the request is transactional: then either all the entries are published or the request fails.
+func (a *api) BulkPublishEventAlpha1(ctx context.Context, in *daprv1pb.BulkPublishRequest) (*daprv1pb.BulkPublishResponse, error) {
+	if a.bulkPublishFn == nil {
+		return nil, status.Error(codes.FailedPrecondition, "ERR_PUBSUB_NOT_FOUND")
+	}
+
+	spanName := fmt.Sprintf("BulkPublishEvent: %s", in.Topic)
+	_, span := diag.StartTracingClientSpanFromGRPCContext(ctx, spanName, a.tracingSpec)
+	defer span.End()
+
+	req := &runtime_pubsub.BulkPublishRequest{
+		Topic:           in.Topic,
+		Entries:         make([]runtime_pubsub.BulkPublishEntry, 0, len(in.Entries)),
+		Transactional:   in.Transactional,
+		ReturnSucceeded: in.ReturnSucceeded,
+	}
+	token := callerToken(ctx)
+	for _, e := range in.Entries {
+		metadata := make(map[string]string, len(in.Metadata)+len(e.Metadata))
+		for k, v := range in.Metadata {
+			metadata[k] = v
+		}
+		for k, v := range e.Metadata {
+			metadata[k] = v
+		}
+		metadata = withCallerToken(token, metadata)
+		if _, _, err := runtime_pubsub.GetPriority(metadata); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "ERR_PUBSUB_INVALID_METADATA: entry %s: %s", e.EntryId, err)
+		}
+
+		body := []byte{}
+		if e.Data != nil {
+			body = e.Data.Value
+		}
+		data, err := a.cloudEventData(span, body, metadata)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s (entry %s)", err, e.EntryId)
+		}
+		req.Entries = append(req.Entries, runtime_pubsub.BulkPublishEntry{
+			EntryID:  e.EntryId,
+			Request:  &pubsub.PublishRequest{Topic: in.Topic, Data: data},
+			Metadata: metadata,
+		})
+	}
+	if err := runtime_pubsub.ValidateBulkPublishRequest(req); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ERR_PUBSUB_BULK_PUBLISH_INVALID: %s", err)
+	}
+
+	resp, err := a.bulkPublishFn(req)
+	if err != nil {
+		if _, ok := err.(*runtime_pubsub.FeatureError); ok {
+			return nil, status.Errorf(codes.FailedPrecondition, "ERR_PUBSUB_PUBLISH_MESSAGE: %s", err)
+		}
+		if _, ok := err.(*runtime_pubsub.SchemaError); ok {
+			return nil, status.Errorf(codes.InvalidArgument, "ERR_PUBSUB_CLOUD_EVENTS_SCHEMA: %s", err)
+		}
+		if _, ok := err.(*runtime_pubsub.AuthorizationError); ok {
+			return nil, status.Errorf(codes.PermissionDenied, "ERR_PUBSUB_FORBIDDEN: %s", err)
+		}
+		if req.Transactional {
+			return nil, status.Errorf(codes.Aborted, "ERR_PUBSUB_PUBLISH_MESSAGE: no entry was published: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "ERR_PUBSUB_PUBLISH_MESSAGE: %s", err)
+	}
+
+	out := &daprv1pb.BulkPublishResponse{}
+	for _, f := range resp.FailedEntries {
+		errorCode := "ERR_PUBSUB_PUBLISH_MESSAGE"
+		if _, ok := f.Error.(*runtime_pubsub.SchemaError); ok {
+			errorCode = "ERR_PUBSUB_CLOUD_EVENTS_SCHEMA"
+		} else if _, ok := f.Error.(*runtime_pubsub.AuthorizationError); ok {
+			errorCode = "ERR_PUBSUB_FORBIDDEN"
+		}
+		out.FailedEntries = append(out.FailedEntries, &daprv1pb.BulkPublishResponseFailedEntry{
+			EntryId: f.EntryID,
+			Error:   fmt.Sprintf("%s: %s", errorCode, f.Error),
+		})
+	}
+	for _, e := range resp.SucceededEntries {
+		publishedAt, err := ptypes.TimestampProto(e.PublishedAt)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "ERR_PUBSUB_PUBLISH_MESSAGE: entry %s: %s", e.EntryID, err)
+		}
+		out.SucceededEntries = append(out.SucceededEntries, &daprv1pb.BulkPublishResponseSucceededEntry{
+			EntryId:     e.EntryID,
+			MessageId:   e.MessageID,
+			PublishedAt: publishedAt,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,118 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBulkPublishEventAlpha1(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	var received *runtime_pubsub.BulkPublishRequest
+	fakeAPI := &api{
+		id: "fakeAPI",
+		bulkPublishFn: func(req *runtime_pubsub.BulkPublishRequest) (runtime_pubsub.BulkPublishResponse, error) {
+			received = req
+			if req.Transactional {
+				return runtime_pubsub.BulkPublishResponse{}, &runtime_pubsub.FeatureError{PubSubName: "messagebus", Feature: runtime_pubsub.FeatureBulkPublishTransactional}
+			}
+			if req.Entries[0].EntryID == "invalid" {
+				return runtime_pubsub.BulkPublishResponse{
+					FailedEntries: []runtime_pubsub.BulkPublishFailedEntry{{EntryID: "invalid", Error: &runtime_pubsub.SchemaError{Violations: []string{"orderId is required"}}}},
+				}, nil
+			}
+			resp := runtime_pubsub.BulkPublishResponse{
+				FailedEntries: []runtime_pubsub.BulkPublishFailedEntry{{EntryID: "2", Error: errors.New("broker error")}},
+			}
+			if req.ReturnSucceeded {
+				resp.SucceededEntries = []runtime_pubsub.BulkPublishSucceededEntry{{EntryID: "1", MessageID: "msg-1", PublishedAt: time.Unix(1600000000, 0)}}
+			}
+			return resp, nil
+		},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	entry := func(id string, metadata map[string]string) *daprv1pb.BulkPublishRequestEntry {
+		return &daprv1pb.BulkPublishRequestEntry{EntryId: id, Data: &any.Any{Value: []byte("data")}, Metadata: metadata}
+	}
+
+	t.Run("reports failed entries", func(t *testing.T) {
+		resp, err := client.BulkPublishEventAlpha1(context.Background(), &daprv1pb.BulkPublishRequest{
+			Topic:    "orders",
+			Entries:  []*daprv1pb.BulkPublishRequestEntry{entry("1", nil), entry("2", map[string]string{"priority": "7"})},
+			Metadata: map[string]string{"priority": "1"},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.FailedEntries, 1)
+		assert.Equal(t, "2", resp.FailedEntries[0].EntryId)
+		assert.Contains(t, resp.FailedEntries[0].Error, "broker error")
+
+		assert.Len(t, received.Entries, 2)
+		assert.Equal(t, "orders", received.Entries[0].Request.Topic)
+		assert.Equal(t, "1", received.Entries[0].Metadata["priority"])
+		assert.Equal(t, "7", received.Entries[1].Metadata["priority"])
+		assert.Empty(t, resp.SucceededEntries)
+	})
+
+	t.Run("reports succeeded entries", func(t *testing.T) {
+		resp, err := client.BulkPublishEventAlpha1(context.Background(), &daprv1pb.BulkPublishRequest{
+			Topic:           "orders",
+			Entries:         []*daprv1pb.BulkPublishRequestEntry{entry("1", nil), entry("2", nil)},
+			ReturnSucceeded: true,
+		})
+		assert.NoError(t, err)
+		assert.True(t, received.ReturnSucceeded)
+		assert.Len(t, resp.FailedEntries, 1)
+		assert.Len(t, resp.SucceededEntries, 1)
+		assert.Equal(t, "1", resp.SucceededEntries[0].EntryId)
+		assert.Equal(t, "msg-1", resp.SucceededEntries[0].MessageId)
+		assert.Equal(t, int64(1600000000), resp.SucceededEntries[0].PublishedAt.Seconds)
+	})
+
+	t.Run("reports entries not matching the schema", func(t *testing.T) {
+		resp, err := client.BulkPublishEventAlpha1(context.Background(), &daprv1pb.BulkPublishRequest{
+			Topic:   "orders",
+			Entries: []*daprv1pb.BulkPublishRequestEntry{entry("invalid", nil)},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.FailedEntries, 1)
+		assert.True(t, strings.HasPrefix(resp.FailedEntries[0].Error, "ERR_PUBSUB_CLOUD_EVENTS_SCHEMA: "))
+	})
+
+	t.Run("transactional request on a component without support", func(t *testing.T) {
+		_, err := client.BulkPublishEventAlpha1(context.Background(), &daprv1pb.BulkPublishRequest{
+			Topic:         "orders",
+			Entries:       []*daprv1pb.BulkPublishRequestEntry{entry("1", nil)},
+			Transactional: true,
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("duplicate entry IDs", func(t *testing.T) {
+		_, err := client.BulkPublishEventAlpha1(context.Background(), &daprv1pb.BulkPublishRequest{
+			Topic:   "orders",
+			Entries: []*daprv1pb.BulkPublishRequestEntry{entry("1", nil), entry("1", nil)},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
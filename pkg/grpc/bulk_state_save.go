@@ -0,0 +1,53 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SaveBulkStateAlpha1 saves every key of the request on its own and returns the result of every key, so the keys
+// saved don't depend on the keys failing. Invalid requests still fail the whole request, before any key is saved.
+func (a *api) SaveBulkStateAlpha1(ctx context.Context, in *daprv1pb.SaveStateEnvelope) (*daprv1pb.SaveBulkStateResponse, error) {
+	if a.stateStores == nil || len(a.stateStores) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "ERR_STATE_STORE_NOT_CONFIGURED")
+	}
+	store, ok := a.stateStores[in.StoreName]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "ERR_STATE_STORE_NOT_FOUND")
+	}
+	reqs, etags, err := a.stateSetRequests(in.StoreName, in.Requests)
+	if err != nil {
+		return nil, err
+	}
+
+	spanName := fmt.Sprintf("SaveBulkState: %s", in.StoreName)
+	_, span := diag.StartTracingClientSpanFromGRPCContext(ctx, spanName, a.tracingSpec)
+	defer span.End()
+
+	errs := runtime_state.SetEach(store, reqs, etags)
+	resp := &daprv1pb.SaveBulkStateResponse{
+		Results: make([]*daprv1pb.SaveStateResult, len(in.Requests)),
+	}
+	saved := make([]string, 0, len(reqs))
+	for i, r := range in.Requests {
+		resp.Results[i] = &daprv1pb.SaveStateResult{Key: r.Key}
+		if errs[i] != nil {
+			resp.Results[i].Error = fmt.Sprintf("ERR_STATE_SAVE: %s", errs[i])
+		} else {
+			saved = append(saved, reqs[i].Key)
+		}
+	}
+	setSessionTokenHeader(ctx, store, saved...)
+	return resp, nil
+}
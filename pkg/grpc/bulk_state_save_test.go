@@ -0,0 +1,89 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	"github.com/golang/protobuf/ptypes/any"
+	durationpb "github.com/golang/protobuf/ptypes/duration"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// failingKeyStore is a state store failing the writes of a key
+type failingKeyStore struct {
+	state.Store
+	failKey string
+	lock    sync.Mutex
+	keys    []string
+}
+
+func (s *failingKeyStore) Set(req *state.SetRequest) error {
+	if req.Key == s.failKey {
+		return errors.New("write failed")
+	}
+	s.lock.Lock()
+	s.keys = append(s.keys, req.Key)
+	s.lock.Unlock()
+	return nil
+}
+
+func TestSaveBulkStateAlpha1(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	store := &failingKeyStore{failKey: "fakeAPI||b"}
+	fakeAPI := &api{
+		id:          "fakeAPI",
+		stateStores: map[string]state.Store{"store": store},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	t.Run("results of every key", func(t *testing.T) {
+		resp, err := client.SaveBulkStateAlpha1(context.Background(), &daprv1pb.SaveStateEnvelope{
+			StoreName: "store",
+			Requests: []*daprv1pb.StateRequest{
+				{Key: "a", Value: &any.Any{Value: []byte("1")}},
+				{Key: "b", Value: &any.Any{Value: []byte("2")}},
+				{Key: "c", Value: &any.Any{Value: []byte("3")}},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Results, 3)
+		assert.Equal(t, "a", resp.Results[0].Key)
+		assert.Empty(t, resp.Results[0].Error)
+		assert.Equal(t, "b", resp.Results[1].Key)
+		assert.Contains(t, resp.Results[1].Error, "ERR_STATE_SAVE")
+		assert.Empty(t, resp.Results[2].Error)
+		assert.ElementsMatch(t, []string{"fakeAPI||a", "fakeAPI||c"}, store.keys)
+	})
+
+	t.Run("invalid request fails as a whole", func(t *testing.T) {
+		_, err := client.SaveBulkStateAlpha1(context.Background(), &daprv1pb.SaveStateEnvelope{
+			StoreName: "store",
+			Requests: []*daprv1pb.StateRequest{
+				{Key: "d", Value: &any.Any{Value: []byte("1")}, Ttl: &durationpb.Duration{Seconds: 10}},
+			},
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("unknown store", func(t *testing.T) {
+		_, err := client.SaveBulkStateAlpha1(context.Background(), &daprv1pb.SaveStateEnvelope{StoreName: "missing"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,132 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dapr/components-contrib/state"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultBulkStateParallelism = 10
+	maxBulkStateParallelism     = 100
+
+	// bulkStateStreamOperation names the stream for throttling
+	bulkStateStreamOperation = "GetBulkStateStreamAlpha1"
+)
+
+// GetBulkStateStreamAlpha1 fetches the state of several keys with the requested parallelism, reduced when the sidecar
+// nears its memory limit, and streams every item as soon as it is fetched. At most parallelism items wait to be sent,
+// so the response is never buffered whole. Keys that fail to be fetched are streamed with their error.
+func (a *api) GetBulkStateStreamAlpha1(in *daprv1pb.GetBulkStateRequest, stream daprv1pb.Dapr_GetBulkStateStreamAlpha1Server) error {
+	if a.stateStores == nil || len(a.stateStores) == 0 {
+		return status.Error(codes.FailedPrecondition, "ERR_STATE_STORE_NOT_CONFIGURED")
+	}
+	store, ok := a.stateStores[in.StoreName]
+	if !ok {
+		return status.Error(codes.InvalidArgument, "ERR_STATE_STORE_NOT_FOUND")
+	}
+	parallelism := defaultBulkStateParallelism
+	if in.Parallelism != 0 {
+		if in.Parallelism < 0 || in.Parallelism > maxBulkStateParallelism {
+			return status.Errorf(codes.InvalidArgument, "ERR_STATE_BULK_GET: parallelism must be a number between 1 and %v", maxBulkStateParallelism)
+		}
+		parallelism = int(in.Parallelism)
+	}
+	parallelism = a.memoryThrottle.Parallelism(bulkStateStreamOperation, parallelism)
+	projection, metadata, err := runtime_state.ProjectionFromMetadata(in.Metadata)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "ERR_STATE_PROJECTION: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	spanName := fmt.Sprintf("GetBulkStateStream: %s", in.StoreName)
+	_, span := diag.StartTracingClientSpanFromGRPCContext(ctx, spanName, a.tracingSpec)
+	defer span.End()
+
+	keys := make(chan string)
+	items := make(chan *daprv1pb.BulkStateItem, parallelism)
+	var wg sync.WaitGroup
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range keys {
+				items <- a.getBulkStateItem(store, in.StoreName, key, in.Consistency, metadata, projection)
+			}
+		}()
+	}
+	go func() {
+		defer close(keys)
+		for _, key := range in.Keys {
+			select {
+			case keys <- key:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(items)
+	}()
+
+	var sendErr error
+	for item := range items {
+		if sendErr != nil {
+			// drain the fetched items so the workers exit
+			continue
+		}
+		if sendErr = stream.Send(item); sendErr != nil {
+			cancel()
+		}
+	}
+	if sendErr != nil {
+		return sendErr
+	}
+	return stream.Context().Err()
+}
+
+// getBulkStateItem fetches the state of a key of a bulk request
+func (a *api) getBulkStateItem(store state.Store, storeName, key, consistency string, metadata map[string]string, projection *runtime_state.Projection) *daprv1pb.BulkStateItem {
+	req := state.GetRequest{
+		Key:      a.getModifiedStateKey(storeName, key),
+		Metadata: metadata,
+		Options: state.GetStateOption{
+			Consistency: consistency,
+		},
+	}
+	a.getStateDefaults(storeName).ApplyToGet(&req)
+
+	item := &daprv1pb.BulkStateItem{Key: key}
+	resp, err := store.Get(&req)
+	if err != nil {
+		item.Error = fmt.Sprintf("ERR_STATE_GET: %s", err)
+		return item
+	}
+	if resp != nil {
+		data := resp.Data
+		if projection != nil && data != nil {
+			if data, err = projection.Apply(data); err != nil {
+				item.Error = fmt.Sprintf("ERR_STATE_PROJECTION: %s", err)
+				return item
+			}
+		}
+		item.Etag = resp.ETag
+		item.Data = &any.Any{Value: data}
+	}
+	return item
+}
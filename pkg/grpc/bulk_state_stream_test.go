@@ -0,0 +1,118 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// keyValueStore is a state store holding its values in a map, failing to get the key broken
+type keyValueStore struct {
+	state.Store
+	values map[string][]byte
+}
+
+func (s *keyValueStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	if req.Key == "fakeAPI||broken" {
+		return nil, errors.New("connection reset")
+	}
+	if v, ok := s.values[req.Key]; ok {
+		return &state.GetResponse{Data: v, ETag: "1"}, nil
+	}
+	return nil, nil
+}
+
+func TestGetBulkStateStreamAlpha1(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	values := map[string][]byte{}
+	keys := []string{"broken", "missing"}
+	for i := 0; i < 50; i++ {
+		key := fmt.Sprintf("key%v", i)
+		values["fakeAPI||"+key] = []byte(key)
+		keys = append(keys, key)
+	}
+	fakeAPI := &api{
+		id:          "fakeAPI",
+		stateStores: map[string]state.Store{"store": &keyValueStore{values: values}},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	receive := func(stream daprv1pb.Dapr_GetBulkStateStreamAlpha1Client) (map[string]*daprv1pb.BulkStateItem, error) {
+		items := map[string]*daprv1pb.BulkStateItem{}
+		for {
+			item, err := stream.Recv()
+			if err == io.EOF {
+				return items, nil
+			}
+			if err != nil {
+				return items, err
+			}
+			items[item.Key] = item
+		}
+	}
+
+	t.Run("streams every key", func(t *testing.T) {
+		stream, err := client.GetBulkStateStreamAlpha1(context.Background(), &daprv1pb.GetBulkStateRequest{
+			StoreName:   "store",
+			Keys:        keys,
+			Parallelism: 4,
+		})
+		assert.NoError(t, err)
+		items, err := receive(stream)
+		assert.NoError(t, err)
+		assert.Len(t, items, len(keys))
+		assert.Equal(t, []byte("key7"), items["key7"].Data.Value)
+		assert.Equal(t, "1", items["key7"].Etag)
+		assert.Nil(t, items["missing"].Data)
+		assert.Equal(t, "ERR_STATE_GET: connection reset", items["broken"].Error)
+	})
+
+	t.Run("projects every key", func(t *testing.T) {
+		values["fakeAPI||doc"] = []byte(`{"id":"d1","body":"long"}`)
+		defer delete(values, "fakeAPI||doc")
+		stream, err := client.GetBulkStateStreamAlpha1(context.Background(), &daprv1pb.GetBulkStateRequest{
+			StoreName: "store",
+			Keys:      []string{"doc", "key1"},
+			Metadata:  map[string]string{runtime_state.ProjectionMetadataKey: "$.id"},
+		})
+		assert.NoError(t, err)
+		items, err := receive(stream)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id":"d1"}`, string(items["doc"].Data.Value))
+		assert.Contains(t, items["key1"].Error, "ERR_STATE_PROJECTION")
+	})
+
+	t.Run("unknown store", func(t *testing.T) {
+		stream, err := client.GetBulkStateStreamAlpha1(context.Background(), &daprv1pb.GetBulkStateRequest{StoreName: "unknown", Keys: keys})
+		assert.NoError(t, err)
+		_, err = receive(stream)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid parallelism", func(t *testing.T) {
+		stream, err := client.GetBulkStateStreamAlpha1(context.Background(), &daprv1pb.GetBulkStateRequest{StoreName: "store", Keys: keys, Parallelism: 1000})
+		assert.NoError(t, err)
+		_, err = receive(stream)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -10,6 +10,15 @@ type ServerConfig struct {
 	AppID       string
 	HostAddress string
 	Port        int
+	// APIToken is required from the callers of the API server when set
+	APIToken string
+	// AdminAPIToken is required from the callers of the admin API methods of the API server when set
+	AdminAPIToken string
+	// MetadataSizeLimit is the size of the header metadata of the API server responses above which they are rejected.
+	// Zero disables the limit.
+	MetadataSizeLimit int
+	// MetadataToTrailers sends the header metadata of the API server responses above the limit as trailers instead of rejecting them
+	MetadataToTrailers bool
 }
 
 // NewServerConfig returns a new grpc server config
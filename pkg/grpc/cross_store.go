@@ -0,0 +1,101 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/dapr/components-contrib/state"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExecuteCrossStoreTransactionAlpha1 applies operations spanning several state stores as a saga and reports the
+// outcome on every store. The request fails only when it's invalid, before any store is changed.
+func (a *api) ExecuteCrossStoreTransactionAlpha1(ctx context.Context, in *daprv1pb.CrossStoreTransactionRequest) (*daprv1pb.CrossStoreTransactionResponse, error) {
+	if a.stateStores == nil || len(a.stateStores) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "ERR_STATE_STORE_NOT_CONFIGURED")
+	}
+
+	operations := make([]runtime_state.CrossStoreOperation, 0, len(in.Operations))
+	for _, o := range in.Operations {
+		if _, ok := a.stateStores[o.StoreName]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "ERR_STATE_STORE_NOT_FOUND: %s", o.StoreName)
+		}
+		if o.Request == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "ERR_STATE_TRANSACTION: operation on state store %s has no request", o.StoreName)
+		}
+		op := runtime_state.CrossStoreOperation{StoreName: o.StoreName}
+		op.Operation = state.OperationType(o.OperationType)
+		switch op.Operation {
+		case state.Upsert:
+			req := state.SetRequest{
+				Key:      a.getModifiedStateKey(o.StoreName, o.Request.Key),
+				ETag:     o.Request.Etag,
+				Metadata: o.Request.Metadata,
+			}
+			if o.Request.Value != nil {
+				req.Value = o.Request.Value.Value
+			}
+			if o.Request.Options != nil {
+				req.Options = state.SetStateOption{
+					Concurrency: o.Request.Options.Concurrency,
+					Consistency: o.Request.Options.Consistency,
+				}
+			}
+			a.getStateDefaults(o.StoreName).ApplyToSet(&req)
+			op.Request = req
+		case state.Delete:
+			req := state.DeleteRequest{
+				Key:      a.getModifiedStateKey(o.StoreName, o.Request.Key),
+				ETag:     o.Request.Etag,
+				Metadata: o.Request.Metadata,
+			}
+			if o.Request.Options != nil {
+				req.Options = state.DeleteStateOption{
+					Concurrency: o.Request.Options.Concurrency,
+					Consistency: o.Request.Options.Consistency,
+				}
+			}
+			a.getStateDefaults(o.StoreName).ApplyToDelete(&req)
+			op.Request = req
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "ERR_STATE_TRANSACTION: operation type %s not supported", o.OperationType)
+		}
+		operations = append(operations, op)
+	}
+
+	_, span := diag.StartTracingClientSpanFromGRPCContext(ctx, "ExecuteCrossStoreTransaction", a.tracingSpec)
+	defer span.End()
+
+	result, err := runtime_state.ExecuteCrossStore(a.stateStores, operations)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ERR_STATE_TRANSACTION: %s", err)
+	}
+	resp := &daprv1pb.CrossStoreTransactionResponse{Committed: result.Committed}
+	for _, s := range result.Stores {
+		r := &daprv1pb.CrossStoreResult{
+			StoreName: s.StoreName,
+			Status:    crossStoreStatuses[s.Status],
+		}
+		if s.Err != nil {
+			r.Error = s.Err.Error()
+		}
+		resp.Stores = append(resp.Stores, r)
+	}
+	return resp, nil
+}
+
+var crossStoreStatuses = map[runtime_state.CrossStoreStatus]daprv1pb.CrossStoreResult_Status{
+	runtime_state.CrossStoreNotAttempted:       daprv1pb.CrossStoreResult_NOT_ATTEMPTED,
+	runtime_state.CrossStoreCommitted:          daprv1pb.CrossStoreResult_COMMITTED,
+	runtime_state.CrossStoreFailed:             daprv1pb.CrossStoreResult_FAILED,
+	runtime_state.CrossStoreCompensated:        daprv1pb.CrossStoreResult_COMPENSATED,
+	runtime_state.CrossStoreCompensationFailed: daprv1pb.CrossStoreResult_COMPENSATION_FAILED,
+}
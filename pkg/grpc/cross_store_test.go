@@ -0,0 +1,104 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// writableStore is a key value store failing to write the key readonly
+type writableStore struct {
+	keyValueStore
+}
+
+func (s *writableStore) Set(req *state.SetRequest) error {
+	if req.Key == "fakeAPI||readonly" {
+		return errors.New("connection reset")
+	}
+	s.values[req.Key] = req.Value.([]byte)
+	return nil
+}
+
+func (s *writableStore) Delete(req *state.DeleteRequest) error {
+	delete(s.values, req.Key)
+	return nil
+}
+
+func TestExecuteCrossStoreTransactionAlpha1(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	hot := &writableStore{keyValueStore{values: map[string][]byte{"fakeAPI||order": []byte("open")}}}
+	cold := &writableStore{keyValueStore{values: map[string][]byte{}}}
+	fakeAPI := &api{
+		id:          "fakeAPI",
+		stateStores: map[string]state.Store{"hot": hot, "cold": cold},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	upsert := func(storeName, key, value string) *daprv1pb.CrossStoreOperation {
+		return &daprv1pb.CrossStoreOperation{
+			StoreName:     storeName,
+			OperationType: "upsert",
+			Request:       &daprv1pb.StateRequest{Key: key, Value: &any.Any{Value: []byte(value)}},
+		}
+	}
+
+	t.Run("commits every store", func(t *testing.T) {
+		resp, err := client.ExecuteCrossStoreTransactionAlpha1(context.Background(), &daprv1pb.CrossStoreTransactionRequest{
+			Operations: []*daprv1pb.CrossStoreOperation{
+				upsert("hot", "order", "closed"),
+				upsert("cold", "archive", "order"),
+			},
+		})
+		assert.NoError(t, err)
+		assert.True(t, resp.Committed)
+		assert.Equal(t, []byte("closed"), hot.values["fakeAPI||order"])
+		assert.Equal(t, []byte("order"), cold.values["fakeAPI||archive"])
+	})
+
+	t.Run("reports the compensated stores", func(t *testing.T) {
+		resp, err := client.ExecuteCrossStoreTransactionAlpha1(context.Background(), &daprv1pb.CrossStoreTransactionRequest{
+			Operations: []*daprv1pb.CrossStoreOperation{
+				upsert("hot", "order", "reopened"),
+				upsert("cold", "readonly", "order"),
+			},
+		})
+		assert.NoError(t, err)
+		assert.False(t, resp.Committed)
+		assert.Equal(t, daprv1pb.CrossStoreResult_COMPENSATED, resp.Stores[0].Status)
+		assert.Equal(t, daprv1pb.CrossStoreResult_FAILED, resp.Stores[1].Status)
+		assert.Equal(t, "connection reset", resp.Stores[1].Error)
+		assert.Equal(t, []byte("closed"), hot.values["fakeAPI||order"])
+	})
+
+	t.Run("invalid operations", func(t *testing.T) {
+		_, err := client.ExecuteCrossStoreTransactionAlpha1(context.Background(), &daprv1pb.CrossStoreTransactionRequest{
+			Operations: []*daprv1pb.CrossStoreOperation{upsert("unknown", "order", "closed")},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		op := upsert("hot", "order", "closed")
+		op.OperationType = "merge"
+		_, err = client.ExecuteCrossStoreTransactionAlpha1(context.Background(), &daprv1pb.CrossStoreTransactionRequest{
+			Operations: []*daprv1pb.CrossStoreOperation{op},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
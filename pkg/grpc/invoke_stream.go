@@ -0,0 +1,176 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"sync"
+	"time"
+
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// transferTTL is the time the data of an interrupted CallLocalStream transfer is kept for the caller to resume it
+	transferTTL = time.Minute
+	// maxTransferSize is the maximum size of the data of a request sent with CallLocalStream
+	maxTransferSize = 1 << 30
+	// maxTransfersSize is the maximum total size of the data of the CallLocalStream transfers held at the same time
+	maxTransfersSize = 2 << 30
+)
+
+// transfer is a request received in chunks
+type transfer struct {
+	header    *internalv1pb.InternalInvokeRequest
+	totalSize uint64
+	data      []byte
+	updated   time.Time
+	// active is true while a stream is receiving the data of the transfer
+	active bool
+}
+
+// transfers holds the requests being received with CallLocalStream, keyed by transfer ID
+type transfers struct {
+	lock  sync.Mutex
+	items map[string]*transfer
+	// reserved is the sum of the sizes of the transfers held, bounded by the limit
+	reserved uint64
+	limit    uint64
+}
+
+// open returns the transfer with the given ID, creating it if it doesn't exist, and marks it active.
+// Transfers left inactive for longer than the transfer TTL are dropped. A new transfer is rejected if the
+// transfers held would exceed the maximum total size.
+func (t *transfers) open(id string, header *internalv1pb.InternalInvokeRequest, totalSize uint64) (*transfer, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.items == nil {
+		t.items = map[string]*transfer{}
+	}
+	if t.limit == 0 {
+		t.limit = maxTransfersSize
+	}
+	now := time.Now()
+	for k, tr := range t.items {
+		if !tr.active && now.Sub(tr.updated) > transferTTL {
+			t.delete(k)
+		}
+	}
+
+	tr, ok := t.items[id]
+	if !ok {
+		if t.reserved+totalSize > t.limit {
+			return nil, status.Errorf(codes.ResourceExhausted, "request data of %v bytes exceeds the %v bytes left for streamed requests", totalSize, t.limit-t.reserved)
+		}
+		tr = &transfer{header: header, totalSize: totalSize}
+		t.items[id] = tr
+		t.reserved += totalSize
+	}
+	if tr.totalSize != totalSize {
+		return nil, status.Errorf(codes.InvalidArgument, "transfer %s has a size of %v bytes", id, tr.totalSize)
+	}
+	if tr.active {
+		return nil, status.Errorf(codes.Aborted, "transfer %s is already in progress", id)
+	}
+	tr.active = true
+	tr.updated = now
+	return tr, nil
+}
+
+// release marks a transfer inactive so it can be resumed
+func (t *transfers) release(tr *transfer) {
+	t.lock.Lock()
+	tr.active = false
+	tr.updated = time.Now()
+	t.lock.Unlock()
+}
+
+func (t *transfers) remove(id string) {
+	t.lock.Lock()
+	t.delete(id)
+	t.lock.Unlock()
+}
+
+// delete drops a transfer and its reservation. The lock must be held.
+func (t *transfers) delete(id string) {
+	if tr, ok := t.items[id]; ok {
+		t.reserved -= tr.totalSize
+		delete(t.items, id)
+	}
+}
+
+// CallLocalStream receives a request to the local app in chunks from another Dapr instance.
+// The data of an interrupted transfer is kept so the caller can resume it on a new stream.
+func (a *api) CallLocalStream(stream internalv1pb.DaprInternal_CallLocalStreamServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	if v := md.Get(invokev1.StreamVersionMetadataKey); len(v) == 0 || v[0] != invokev1.StreamVersion {
+		return status.Errorf(codes.FailedPrecondition, "unsupported stream version, expected %s", invokev1.StreamVersion)
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if first.TransferId == "" || first.Request == nil {
+		return status.Error(codes.InvalidArgument, "the first message of a stream must have a transfer id and a request")
+	}
+	if first.TotalSize > maxTransferSize {
+		return status.Errorf(codes.ResourceExhausted, "request data of %v bytes exceeds the maximum of %v bytes", first.TotalSize, maxTransferSize)
+	}
+
+	tr, err := a.transfers.open(first.TransferId, first.Request, first.TotalSize)
+	if err != nil {
+		return err
+	}
+	err = a.receiveTransfer(stream, tr)
+	if err != nil {
+		a.transfers.release(tr)
+		return err
+	}
+	a.transfers.remove(first.TransferId)
+
+	// the caller must not resume the transfer once the app may have been invoked
+	err = stream.Send(&internalv1pb.InternalInvokeResponseChunk{Received: true})
+	if err != nil {
+		return err
+	}
+	resp, err := a.CallLocal(stream.Context(), invokev1.JoinRequestData(tr.header, tr.data))
+	if err != nil {
+		return err
+	}
+	return stream.Send(&internalv1pb.InternalInvokeResponseChunk{Response: resp})
+}
+
+// receiveTransfer tells the caller the size of the data already received and appends the chunks it sends
+// until the whole data is received. Chunks are only kept once their checksum is verified.
+func (a *api) receiveTransfer(stream internalv1pb.DaprInternal_CallLocalStreamServer, tr *transfer) error {
+	err := stream.Send(&internalv1pb.InternalInvokeResponseChunk{Offset: uint64(len(tr.data))})
+	if err != nil {
+		return err
+	}
+
+	for uint64(len(tr.data)) < tr.totalSize {
+		chunk, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if chunk.Offset != uint64(len(tr.data)) {
+			return status.Errorf(codes.InvalidArgument, "chunk at offset %v doesn't follow the %v bytes received", chunk.Offset, len(tr.data))
+		}
+		if uint64(len(tr.data)+len(chunk.Data)) > tr.totalSize {
+			return status.Errorf(codes.InvalidArgument, "chunk at offset %v exceeds the request size", chunk.Offset)
+		}
+		if invokev1.ChunkChecksum(chunk.Data) != chunk.Checksum {
+			return status.Errorf(codes.DataLoss, "checksum mismatch for chunk at offset %v", chunk.Offset)
+		}
+		tr.data = append(tr.data, chunk.Data...)
+	}
+	return nil
+}
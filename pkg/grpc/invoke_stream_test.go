@@ -0,0 +1,146 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func openTestStream(t *testing.T, client internalv1pb.DaprInternalClient, ctx context.Context, transferID string, header *internalv1pb.InternalInvokeRequest, size int) (internalv1pb.DaprInternal_CallLocalStreamClient, uint64) {
+	ctx = metadata.AppendToOutgoingContext(ctx, invokev1.StreamVersionMetadataKey, invokev1.StreamVersion)
+	stream, err := client.CallLocalStream(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(&internalv1pb.InternalInvokeRequestChunk{TransferId: transferID, Request: header, TotalSize: uint64(size)}))
+	ack, err := stream.Recv()
+	assert.NoError(t, err)
+	return stream, ack.Offset
+}
+
+func sendTestChunk(stream internalv1pb.DaprInternal_CallLocalStreamClient, offset int, data []byte) error {
+	return stream.Send(&internalv1pb.InternalInvokeRequestChunk{Offset: uint64(offset), Data: data, Checksum: invokev1.ChunkChecksum(data)})
+}
+
+// recvTestResponse receives the acknowledgement of the whole request data, then the response of the app
+func recvTestResponse(t *testing.T, stream internalv1pb.DaprInternal_CallLocalStreamClient) (*internalv1pb.InternalInvokeResponseChunk, error) {
+	done, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	assert.True(t, done.Received)
+	return stream.Recv()
+}
+
+func TestCallLocalStream(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	var received []byte
+	mockAppChannel := new(channelt.MockAppChannel)
+	mockAppChannel.On("InvokeMethod", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*v1.InvokeMethodRequest")).
+		Run(func(args mock.Arguments) {
+			_, received = args.Get(1).(*invokev1.InvokeMethodRequest).RawData()
+		}).
+		Return(invokev1.NewInvokeMethodResponse(200, "OK", nil), nil)
+	fakeAPI := &api{
+		id:         "fakeAPI",
+		appChannel: mockAppChannel,
+	}
+	server := startInternalServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := internalv1pb.NewDaprInternalClient(clientConn)
+
+	header, data := invokev1.SplitRequestData(invokev1.NewInvokeMethodRequest("method").WithRawData([]byte("0123456789"), "text/plain").Proto())
+
+	t.Run("receives the request in chunks", func(t *testing.T) {
+		stream, offset := openTestStream(t, client, context.Background(), "transfer1", header, len(data))
+		assert.Equal(t, uint64(0), offset)
+		assert.NoError(t, sendTestChunk(stream, 0, data[:4]))
+		assert.NoError(t, sendTestChunk(stream, 4, data[4:]))
+
+		resp, err := recvTestResponse(t, stream)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(200), resp.Response.Status.Code)
+		assert.Equal(t, data, received)
+	})
+
+	t.Run("resumes an interrupted transfer", func(t *testing.T) {
+		received = nil
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, _ := openTestStream(t, client, ctx, "transfer2", header, len(data))
+		assert.NoError(t, sendTestChunk(stream, 0, data[:4]))
+		cancel()
+
+		var offset uint64
+		assert.Eventually(t, func() bool {
+			fakeAPI.transfers.lock.Lock()
+			defer fakeAPI.transfers.lock.Unlock()
+			tr := fakeAPI.transfers.items["transfer2"]
+			return tr != nil && !tr.active
+		}, time.Second, 10*time.Millisecond)
+
+		// the chunk sent before the interruption may not have been received
+		stream, offset = openTestStream(t, client, context.Background(), "transfer2", header, len(data))
+		assert.True(t, offset == 0 || offset == 4)
+		assert.NoError(t, sendTestChunk(stream, int(offset), data[offset:]))
+
+		resp, err := recvTestResponse(t, stream)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(200), resp.Response.Status.Code)
+		assert.Equal(t, data, received)
+	})
+
+	t.Run("rejects a corrupted chunk", func(t *testing.T) {
+		stream, _ := openTestStream(t, client, context.Background(), "transfer3", header, len(data))
+		assert.NoError(t, sendTestChunk(stream, 0, data[:4]))
+		assert.NoError(t, stream.Send(&internalv1pb.InternalInvokeRequestChunk{Offset: 4, Data: data[4:], Checksum: 1}))
+
+		_, err := stream.Recv()
+		assert.Equal(t, codes.DataLoss, status.Code(err))
+
+		// the chunks received before the corrupted one are kept
+		_, offset := openTestStream(t, client, context.Background(), "transfer3", header, len(data))
+		assert.Equal(t, uint64(4), offset)
+	})
+
+	t.Run("bounds the data held for transfers", func(t *testing.T) {
+		fakeAPI.transfers.lock.Lock()
+		fakeAPI.transfers.limit = uint64(len(data)) + 5
+		fakeAPI.transfers.lock.Unlock()
+		defer func() {
+			fakeAPI.transfers.lock.Lock()
+			fakeAPI.transfers.limit = 0
+			fakeAPI.transfers.lock.Unlock()
+		}()
+
+		// transfer3 still holds its data
+		ctx := metadata.AppendToOutgoingContext(context.Background(), invokev1.StreamVersionMetadataKey, invokev1.StreamVersion)
+		stream, err := client.CallLocalStream(ctx)
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Send(&internalv1pb.InternalInvokeRequestChunk{TransferId: "transfer4", Request: header, TotalSize: uint64(len(data))}))
+		_, err = stream.Recv()
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("requires the stream version", func(t *testing.T) {
+		stream, err := client.CallLocalStream(context.Background())
+		assert.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
@@ -0,0 +1,97 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	grpc_go "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// MetadataSizeHeader is the response header with the serialized size of the header metadata of a response
+	MetadataSizeHeader = "dapr-metadata-size"
+	// DefaultMetadataSizeLimit is the default size of the header metadata of a response above which the response is rejected.
+	// Zero disables the limit: grpc-go clients accept a header list of 16MB by default, so the limit is only set for apps
+	// whose clients accept less.
+	DefaultMetadataSizeLimit = 0
+
+	// metadataSizeWarningRatio is the ratio of the limit above which a warning is logged
+	metadataSizeWarningRatio = 0.8
+	// hpackEntryOverhead is added to the size of every header field, see RFC 7541 section 4.1
+	hpackEntryOverhead = 32
+)
+
+// metadataSize returns the size of metadata as accounted against the header list size limit of HTTP/2
+func metadataSize(md metadata.MD) int {
+	size := 0
+	for k, values := range md {
+		for _, v := range values {
+			size += len(k) + len(v) + hpackEntryOverhead
+		}
+	}
+	return size
+}
+
+// headerBufferingStream holds back the header metadata set by a handler so its size can be checked before it is sent
+type headerBufferingStream struct {
+	grpc_go.ServerTransportStream
+	lock   sync.Mutex
+	header metadata.MD
+}
+
+func (s *headerBufferingStream) SetHeader(md metadata.MD) error {
+	s.lock.Lock()
+	s.header = metadata.Join(s.header, md)
+	s.lock.Unlock()
+	return nil
+}
+
+func (s *headerBufferingStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+// metadataSizeUnaryInterceptor reports the size of the header metadata of the responses of the Dapr API in the
+// dapr-metadata-size header and warns when it nears the limit. Responses with header metadata above the limit are
+// rejected, or have their header metadata sent as trailers when moveToTrailers is true.
+// A limit of zero disables the checks.
+func (s *server) metadataSizeUnaryInterceptor(limit int, moveToTrailers bool) grpc_go.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc_go.UnaryServerInfo, handler grpc_go.UnaryHandler) (interface{}, error) {
+		stream := grpc_go.ServerTransportStreamFromContext(ctx)
+		if stream == nil {
+			return handler(ctx, req)
+		}
+
+		buffered := &headerBufferingStream{ServerTransportStream: stream}
+		resp, err := handler(grpc_go.NewContextWithServerTransportStream(ctx, buffered), req)
+		if len(buffered.header) == 0 {
+			return resp, err
+		}
+
+		header := buffered.header
+		size := metadataSize(header)
+		header.Set(MetadataSizeHeader, strconv.Itoa(size))
+		switch {
+		case limit <= 0 || size <= limit:
+			if limit > 0 && float64(size) > float64(limit)*metadataSizeWarningRatio {
+				s.logger.Warnf("response metadata of %s is %v bytes, nearing the limit of %v bytes", info.FullMethod, size, limit)
+			}
+			stream.SetHeader(header)
+		case moveToTrailers:
+			s.logger.Warnf("response metadata of %s is %v bytes, above the limit of %v bytes: sending it as trailers", info.FullMethod, size, limit)
+			stream.SetTrailer(header)
+		default:
+			s.logger.Warnf("response metadata of %s is %v bytes, above the limit of %v bytes: rejecting the response", info.FullMethod, size, limit)
+			return nil, status.Errorf(codes.ResourceExhausted, "response metadata of %v bytes exceeds the limit of %v bytes", size, limit)
+		}
+		return resp, err
+	}
+}
@@ -0,0 +1,90 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	grpc_go "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type fakeTransportStream struct {
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+func (s *fakeTransportStream) Method() string {
+	return "/dapr.proto.dapr.v1.Dapr/InvokeService"
+}
+
+func (s *fakeTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *fakeTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *fakeTransportStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func TestMetadataSizeUnaryInterceptor(t *testing.T) {
+	s := &server{logger: apiServerLogger}
+	value := strings.Repeat("v", 100)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		grpc_go.SendHeader(ctx, metadata.Pairs("metadata.a", value, "metadata.b", value))
+		grpc_go.SetTrailer(ctx, metadata.Pairs("trailer", "t"))
+		return "ok", nil
+	}
+	expectedSize := 2 * (len("metadata.a") + len(value) + hpackEntryOverhead)
+
+	t.Run("reports the size of the header metadata", func(t *testing.T) {
+		stream := &fakeTransportStream{}
+		ctx := grpc_go.NewContextWithServerTransportStream(context.Background(), stream)
+		resp, err := s.metadataSizeUnaryInterceptor(DefaultMetadataSizeLimit, false)(ctx, nil, &grpc_go.UnaryServerInfo{}, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+		assert.Equal(t, []string{strconv.Itoa(expectedSize)}, stream.header.Get(MetadataSizeHeader))
+		assert.Equal(t, []string{value}, stream.header.Get("metadata.a"))
+		assert.Equal(t, []string{"t"}, stream.trailer.Get("trailer"))
+	})
+
+	t.Run("rejects header metadata above the limit", func(t *testing.T) {
+		stream := &fakeTransportStream{}
+		ctx := grpc_go.NewContextWithServerTransportStream(context.Background(), stream)
+		_, err := s.metadataSizeUnaryInterceptor(expectedSize-1, false)(ctx, nil, &grpc_go.UnaryServerInfo{}, handler)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Empty(t, stream.header)
+	})
+
+	t.Run("moves header metadata above the limit to trailers", func(t *testing.T) {
+		stream := &fakeTransportStream{}
+		ctx := grpc_go.NewContextWithServerTransportStream(context.Background(), stream)
+		_, err := s.metadataSizeUnaryInterceptor(expectedSize-1, true)(ctx, nil, &grpc_go.UnaryServerInfo{}, handler)
+		assert.NoError(t, err)
+		assert.Empty(t, stream.header)
+		assert.Equal(t, []string{value}, stream.trailer.Get("metadata.b"))
+		assert.Equal(t, []string{strconv.Itoa(expectedSize)}, stream.trailer.Get(MetadataSizeHeader))
+	})
+
+	t.Run("no limit", func(t *testing.T) {
+		stream := &fakeTransportStream{}
+		ctx := grpc_go.NewContextWithServerTransportStream(context.Background(), stream)
+		_, err := s.metadataSizeUnaryInterceptor(0, false)(ctx, nil, &grpc_go.UnaryServerInfo{}, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{value}, stream.header.Get("metadata.b"))
+	})
+}
@@ -0,0 +1,162 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// flushSizeMetadataKey is the gRPC metadata item with the number of events a PublishEventStreamAlpha1 batch is flushed at
+	flushSizeMetadataKey = "dapr-flush-size"
+	// flushIntervalMetadataKey is the gRPC metadata item with the maximum time an event of a PublishEventStreamAlpha1 batch waits to be flushed
+	flushIntervalMetadataKey = "dapr-flush-interval"
+	// flushParallelismMetadataKey is the gRPC metadata item with the number of events of a batch published at once
+	flushParallelismMetadataKey = "dapr-flush-parallelism"
+
+	defaultFlushSize        = 100
+	maxFlushSize            = 1000
+	defaultFlushInterval    = 50 * time.Millisecond
+	defaultFlushParallelism = 1
+	maxFlushParallelism     = 100
+
+	// publishStreamOperation names the stream for throttling
+	publishStreamOperation = "PublishEventStreamAlpha1"
+)
+
+// publishStreamOptions returns the flush size, interval and parallelism requested by the app in the metadata of the stream
+func publishStreamOptions(md metadata.MD) (int, time.Duration, int, error) {
+	size := defaultFlushSize
+	if v := md.Get(flushSizeMetadataKey); len(v) > 0 {
+		n, err := strconv.Atoi(v[0])
+		if err != nil || n <= 0 || n > maxFlushSize {
+			return 0, 0, 0, status.Errorf(codes.InvalidArgument, "%s must be a number between 1 and %v", flushSizeMetadataKey, maxFlushSize)
+		}
+		size = n
+	}
+
+	interval := defaultFlushInterval
+	if v := md.Get(flushIntervalMetadataKey); len(v) > 0 {
+		d, err := time.ParseDuration(v[0])
+		if err != nil || d <= 0 {
+			return 0, 0, 0, status.Errorf(codes.InvalidArgument, "%s must be a positive duration", flushIntervalMetadataKey)
+		}
+		interval = d
+	}
+
+	parallelism := defaultFlushParallelism
+	if v := md.Get(flushParallelismMetadataKey); len(v) > 0 {
+		n, err := strconv.Atoi(v[0])
+		if err != nil || n <= 0 || n > maxFlushParallelism {
+			return 0, 0, 0, status.Errorf(codes.InvalidArgument, "%s must be a number between 1 and %v", flushParallelismMetadataKey, maxFlushParallelism)
+		}
+		parallelism = n
+	}
+	return size, interval, parallelism, nil
+}
+
+// PublishEventStreamAlpha1 publishes the events the app sends on the stream. Events are batched and the batch is
+// flushed when it reaches the flush size or when its oldest event waited for the flush interval.
+// The events of a batch are published with the requested parallelism, reduced when the sidecar nears its memory limit.
+// Every event is acknowledged on the stream once published, in order, with the error of its publication if it failed.
+func (a *api) PublishEventStreamAlpha1(stream daprv1pb.Dapr_PublishEventStreamAlpha1Server) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	flushSize, flushInterval, flushParallelism, err := publishStreamOptions(md)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	requests := make(chan *daprv1pb.PublishEventStreamRequest)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	batch := make([]*daprv1pb.PublishEventStreamRequest, 0, flushSize)
+	flush := func() error {
+		acks := make([]*daprv1pb.PublishEventStreamResponse, len(batch))
+		sem := make(chan struct{}, a.memoryThrottle.Parallelism(publishStreamOperation, flushParallelism))
+		var wg sync.WaitGroup
+		for i, req := range batch {
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(i int, req *daprv1pb.PublishEventStreamRequest) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				ack := &daprv1pb.PublishEventStreamResponse{Id: req.Id}
+				if req.Event == nil {
+					ack.Error = "ERR_PUBSUB_EVENT_MISSING"
+				} else if id, err := a.publish(ctx, req.Event); err != nil {
+					ack.Error = err.Error()
+				} else {
+					ack.MessageId = id
+				}
+				acks[i] = ack
+			}(i, req)
+		}
+		wg.Wait()
+
+		for _, ack := range acks {
+			if err := stream.Send(ack); err != nil {
+				return err
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	timer := time.NewTimer(flushInterval)
+	timer.Stop()
+	defer timer.Stop()
+	for {
+		select {
+		case req := <-requests:
+			if len(batch) == 0 {
+				timer.Reset(flushInterval)
+			}
+			batch = append(batch, req)
+			if len(batch) < flushSize {
+				continue
+			}
+			if !timer.Stop() {
+				<-timer.C
+			}
+		case <-timer.C:
+		case err := <-recvErr:
+			if err != io.EOF {
+				return err
+			}
+			return flush()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+}
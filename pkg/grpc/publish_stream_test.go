@@ -0,0 +1,144 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/pubsub"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestPublishEventStreamAlpha1(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	var lock sync.Mutex
+	var published []string
+	fakeAPI := &api{
+		id: "fakeAPI",
+		publishFn: func(req *pubsub.PublishRequest, metadata map[string]string) (string, error) {
+			if req.Topic == "fail" {
+				return "", errors.New("broker error")
+			}
+			lock.Lock()
+			published = append(published, req.Topic)
+			lock.Unlock()
+			return "msg-" + req.Topic, nil
+		},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	event := func(id, topic string) *daprv1pb.PublishEventStreamRequest {
+		return &daprv1pb.PublishEventStreamRequest{
+			Id:    id,
+			Event: &daprv1pb.PublishEventEnvelope{Topic: topic, Data: &any.Any{Value: []byte("data")}},
+		}
+	}
+
+	t.Run("flushes a full batch", func(t *testing.T) {
+		published = nil
+		ctx := metadata.AppendToOutgoingContext(context.Background(), flushSizeMetadataKey, "2", flushIntervalMetadataKey, "1h")
+		stream, err := client.PublishEventStreamAlpha1(ctx)
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Send(event("1", "a")))
+		assert.NoError(t, stream.Send(event("2", "fail")))
+
+		ack, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, "1", ack.Id)
+		assert.Empty(t, ack.Error)
+		assert.Equal(t, "msg-a", ack.MessageId)
+		ack, err = stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, "2", ack.Id)
+		assert.Contains(t, ack.Error, "ERR_PUBSUB_PUBLISH_MESSAGE")
+
+		assert.NoError(t, stream.CloseSend())
+		_, err = stream.Recv()
+		assert.Equal(t, io.EOF, err)
+		assert.Equal(t, []string{"a"}, published)
+	})
+
+	t.Run("flushes a partial batch after the interval", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), flushSizeMetadataKey, "10", flushIntervalMetadataKey, "10ms")
+		stream, err := client.PublishEventStreamAlpha1(ctx)
+		assert.NoError(t, err)
+		start := time.Now()
+		assert.NoError(t, stream.Send(event("1", "a")))
+
+		ack, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, "1", ack.Id)
+		assert.Less(t, int64(time.Since(start)), int64(time.Second))
+		assert.NoError(t, stream.CloseSend())
+	})
+
+	t.Run("flushes the batch when the app closes the stream", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), flushIntervalMetadataKey, "1h")
+		stream, err := client.PublishEventStreamAlpha1(ctx)
+		assert.NoError(t, err)
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, stream.Send(event(fmt.Sprint(i), "a")))
+		}
+		assert.NoError(t, stream.CloseSend())
+
+		for i := 0; i < 3; i++ {
+			ack, err := stream.Recv()
+			assert.NoError(t, err)
+			assert.Equal(t, fmt.Sprint(i), ack.Id)
+		}
+		_, err = stream.Recv()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("publishes a batch in parallel and acknowledges it in order", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), flushSizeMetadataKey, "10", flushIntervalMetadataKey, "1h", flushParallelismMetadataKey, "4")
+		stream, err := client.PublishEventStreamAlpha1(ctx)
+		assert.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			assert.NoError(t, stream.Send(event(fmt.Sprint(i), "a")))
+		}
+
+		for i := 0; i < 10; i++ {
+			ack, err := stream.Recv()
+			assert.NoError(t, err)
+			assert.Equal(t, fmt.Sprint(i), ack.Id)
+		}
+		assert.NoError(t, stream.CloseSend())
+	})
+
+	t.Run("rejects an invalid flush parallelism", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), flushParallelismMetadataKey, "1000")
+		stream, err := client.PublishEventStreamAlpha1(ctx)
+		assert.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("rejects an invalid flush size", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), flushSizeMetadataKey, "0")
+		stream, err := client.PublishEventStreamAlpha1(ctx)
+		assert.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,47 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	grpc_go "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recoveryUnaryInterceptor turns the panics of the handlers into Internal errors carrying the ID of their crash record
+func (s *server) recoveryUnaryInterceptor() grpc_go.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc_go.UnaryServerInfo, handler grpc_go.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = s.recovered(info.FullMethod, r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// recoveryStreamInterceptor turns the panics of the stream handlers into Internal errors carrying the ID of their crash
+// record, so the stream ends with an error rather than taking the sidecar down
+func (s *server) recoveryStreamInterceptor() grpc_go.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc_go.ServerStream, info *grpc_go.StreamServerInfo, handler grpc_go.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = s.recovered(info.FullMethod, r)
+			}
+		}()
+		return handler(srv, stream)
+	}
+}
+
+// recovered records a panic of a method and returns the error reported to the caller
+func (s *server) recovered(method string, r interface{}) error {
+	record := diag.RecordPanic(method, r)
+	s.logger.Errorf("recovered from panic in %s, correlation id %s: %s\n%s", method, record.ID, record.Panic, record.Stack)
+	return status.Errorf(codes.Internal, "internal error, correlation id %s", record.ID)
+}
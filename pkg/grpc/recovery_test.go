@@ -0,0 +1,54 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/stretchr/testify/assert"
+	grpc_go "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryUnaryInterceptor(t *testing.T) {
+	s := &server{logger: apiServerLogger}
+	info := &grpc_go.UnaryServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/GetState"}
+
+	t.Run("panic", func(t *testing.T) {
+		_, err := s.recoveryUnaryInterceptor()(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			panic("boom")
+		})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		crashes := diag.RecentCrashes()
+		record := crashes[len(crashes)-1]
+		assert.Equal(t, info.FullMethod, record.Source)
+		assert.Contains(t, status.Convert(err).Message(), record.ID)
+	})
+
+	t.Run("no panic", func(t *testing.T) {
+		resp, err := s.recoveryUnaryInterceptor()(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+}
+
+func TestRecoveryStreamInterceptor(t *testing.T) {
+	s := &server{logger: apiServerLogger}
+	info := &grpc_go.StreamServerInfo{FullMethod: "/dapr.proto.runtime.v1.Dapr/SubscribeStateAlpha1"}
+	err := s.recoveryStreamInterceptor()(nil, nil, info, func(srv interface{}, stream grpc_go.ServerStream) error {
+		var m map[string]string
+		m["key"] = "value"
+		return nil
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	crashes := diag.RecentCrashes()
+	assert.Equal(t, info.FullMethod, crashes[len(crashes)-1].Source)
+}
@@ -146,9 +146,46 @@ func (s *server) getMiddlewareOptions() []grpc_go.ServerOption {
 		)
 	}
 
+	if s.kind == apiServer {
+		unaryServerInterceptor = grpc_middleware.ChainUnaryServer(
+			unaryServerInterceptor,
+			s.metadataSizeUnaryInterceptor(s.config.MetadataSizeLimit, s.config.MetadataToTrailers),
+		)
+	}
+
+	if s.kind == apiServer && s.config.APIToken != "" {
+		s.logger.Infof("enabled api token middleware.")
+		unaryServerInterceptor = grpc_middleware.ChainUnaryServer(
+			apiTokenUnaryInterceptor(s.config.APIToken),
+			unaryServerInterceptor,
+		)
+	}
+
+	// the recovery interceptors run innermost, so the other interceptors see the errors of recovered panics
+	unaryServerInterceptor = grpc_middleware.ChainUnaryServer(
+		unaryServerInterceptor,
+		s.recoveryUnaryInterceptor(),
+	)
+	streamServerInterceptor := grpc_middleware.ChainStreamServer(
+		diag.SetTracingSpanContextGRPCMiddlewareStream(s.tracingSpec),
+		s.recoveryStreamInterceptor(),
+	)
+	if s.kind == apiServer && s.config.APIToken != "" {
+		streamServerInterceptor = grpc_middleware.ChainStreamServer(
+			apiTokenStreamInterceptor(s.config.APIToken),
+			streamServerInterceptor,
+		)
+	}
+	if s.kind == apiServer && s.config.AdminAPIToken != "" {
+		streamServerInterceptor = grpc_middleware.ChainStreamServer(
+			adminAPITokenStreamInterceptor(s.config.AdminAPIToken),
+			streamServerInterceptor,
+		)
+	}
+
 	opts = append(
 		opts,
-		grpc_go.StreamInterceptor(diag.SetTracingSpanContextGRPCMiddlewareStream(s.tracingSpec)),
+		grpc_go.StreamInterceptor(streamServerInterceptor),
 		grpc_go.UnaryInterceptor(unaryServerInterceptor))
 
 	return opts
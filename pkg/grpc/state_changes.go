@@ -0,0 +1,81 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"fmt"
+	"strings"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SubscribeStateAlpha1 streams the changes of the keys of the app starting with the key prefix until the app closes
+// the stream. The state store must support change feeds.
+func (a *api) SubscribeStateAlpha1(in *daprv1pb.SubscribeStateRequest, stream daprv1pb.Dapr_SubscribeStateAlpha1Server) error {
+	if a.stateStores == nil || len(a.stateStores) == 0 {
+		return status.Error(codes.FailedPrecondition, "ERR_STATE_STORE_NOT_CONFIGURED")
+	}
+	store, ok := a.stateStores[in.StoreName]
+	if !ok {
+		return status.Error(codes.InvalidArgument, "ERR_STATE_STORE_NOT_FOUND")
+	}
+	if err := runtime_state.RequireFeature(in.StoreName, store, runtime_state.FeatureChangeFeed); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "ERR_STATE_STORE_NOT_SUPPORTED: %s", err)
+	}
+
+	ctx := stream.Context()
+	spanName := fmt.Sprintf("SubscribeState: %s", in.StoreName)
+	_, span := diag.StartTracingClientSpanFromGRPCContext(ctx, spanName, a.tracingSpec)
+	defer span.End()
+
+	changeFeed, _ := runtime_state.AsChangeFeedStore(store)
+	appPrefix := a.getModifiedStateKey(in.StoreName, "")
+	err := changeFeed.SubscribeChanges(ctx, a.getModifiedStateKey(in.StoreName, in.KeyPrefix), func(change runtime_state.Change) error {
+		event, err := stateChangeEvent(change, appPrefix)
+		if err != nil {
+			return err
+		}
+		return stream.Send(event)
+	})
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "ERR_STATE_SUBSCRIBE: %s", err)
+	}
+	return nil
+}
+
+// stateChangeEvent returns the event of a change with the key of the app
+func stateChangeEvent(change runtime_state.Change, appPrefix string) (*daprv1pb.StateChangeEvent, error) {
+	event := &daprv1pb.StateChangeEvent{
+		Key:  strings.TrimPrefix(change.Key, appPrefix),
+		Etag: change.ETag,
+	}
+	switch change.Operation {
+	case runtime_state.ChangeUpsert:
+		event.Operation = daprv1pb.StateChangeEvent_UPSERT
+		event.Data = &any.Any{Value: change.Value}
+	case runtime_state.ChangeDelete:
+		event.Operation = daprv1pb.StateChangeEvent_DELETE
+	default:
+		return nil, fmt.Errorf("unknown operation %s on key %s", change.Operation, event.Key)
+	}
+	if !change.Time.IsZero() {
+		t, err := ptypes.TimestampProto(change.Time)
+		if err != nil {
+			return nil, err
+		}
+		event.Time = t
+	}
+	return event, nil
+}
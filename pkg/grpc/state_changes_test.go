@@ -0,0 +1,99 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// changeFeedStore is a state store reporting its changes, then waiting for the subscription to end
+type changeFeedStore struct {
+	state.Store
+	changes []runtime_state.Change
+}
+
+func (s *changeFeedStore) SubscribeChanges(ctx context.Context, keyPrefix string, handler func(change runtime_state.Change) error) error {
+	for _, c := range s.changes {
+		if !strings.HasPrefix(c.Key, keyPrefix) {
+			continue
+		}
+		if err := handler(c); err != nil {
+			return err
+		}
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func TestSubscribeStateAlpha1(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	changed := time.Unix(1600000000, 0)
+	fakeAPI := &api{
+		id: "fakeAPI",
+		stateStores: map[string]state.Store{
+			"feed": &changeFeedStore{changes: []runtime_state.Change{
+				{Key: "fakeAPI||orders-1", Operation: runtime_state.ChangeUpsert, Value: []byte("v1"), ETag: "1", Time: changed},
+				{Key: "fakeAPI||carts-1", Operation: runtime_state.ChangeUpsert, Value: []byte("v2"), ETag: "1"},
+				{Key: "fakeAPI||orders-2", Operation: runtime_state.ChangeDelete},
+			}},
+			"plain": &recordingStore{},
+		},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	t.Run("streams the changes of the prefix", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stream, err := client.SubscribeStateAlpha1(ctx, &daprv1pb.SubscribeStateRequest{StoreName: "feed", KeyPrefix: "orders-"})
+		assert.NoError(t, err)
+
+		event, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, "orders-1", event.Key)
+		assert.Equal(t, daprv1pb.StateChangeEvent_UPSERT, event.Operation)
+		assert.Equal(t, []byte("v1"), event.Data.Value)
+		assert.Equal(t, "1", event.Etag)
+		assert.Equal(t, int64(1600000000), event.Time.Seconds)
+
+		event, err = stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, "orders-2", event.Key)
+		assert.Equal(t, daprv1pb.StateChangeEvent_DELETE, event.Operation)
+		assert.Nil(t, event.Data)
+		assert.Nil(t, event.Time)
+	})
+
+	t.Run("store without change feed", func(t *testing.T) {
+		stream, err := client.SubscribeStateAlpha1(context.Background(), &daprv1pb.SubscribeStateRequest{StoreName: "plain"})
+		assert.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "CHANGE_FEED")
+	})
+
+	t.Run("unknown store", func(t *testing.T) {
+		stream, err := client.SubscribeStateAlpha1(context.Background(), &daprv1pb.SubscribeStateRequest{StoreName: "unknown"})
+		assert.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,60 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// QueryStateKeysAlpha1 returns a page of the keys of the app starting with the prefix, without their values.
+// The state store must support listing keys. Pages and page tokens are normalized by the runtime, so they don't depend
+// on the pagination of the state store.
+func (a *api) QueryStateKeysAlpha1(ctx context.Context, in *daprv1pb.QueryStateKeysRequest) (*daprv1pb.QueryStateKeysResponse, error) {
+	if a.stateStores == nil || len(a.stateStores) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "ERR_STATE_STORE_NOT_CONFIGURED")
+	}
+	store, ok := a.stateStores[in.StoreName]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "ERR_STATE_STORE_NOT_FOUND")
+	}
+	if err := runtime_state.RequireFeature(in.StoreName, store, runtime_state.FeatureListKeys); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "ERR_STATE_STORE_NOT_SUPPORTED: %s", err)
+	}
+	if in.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "ERR_MALFORMED_REQUEST: page size must not be negative")
+	}
+
+	spanName := fmt.Sprintf("QueryStateKeys: %s", in.StoreName)
+	_, span := diag.StartTracingClientSpanFromGRPCContext(ctx, spanName, a.tracingSpec)
+	defer span.End()
+
+	lister, _ := runtime_state.AsKeyListerStore(store)
+	resp, err := runtime_state.ListKeys(in.StoreName, lister, a.getModifiedStateKey(in.StoreName, in.Prefix), in.PageToken, int(in.PageSize), in.Metadata)
+	diag.UpdateSpanPairStatusesFromError(span, err, spanName)
+	if _, ok := err.(*runtime_state.PageTokenError); ok {
+		return nil, status.Errorf(codes.InvalidArgument, "ERR_MALFORMED_REQUEST: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "ERR_STATE_QUERY_KEYS: %s", err)
+	}
+
+	appPrefix := a.getModifiedStateKey(in.StoreName, "")
+	keys := make([]string, 0, len(resp.Keys))
+	for _, k := range resp.Keys {
+		keys = append(keys, strings.TrimPrefix(k, appPrefix))
+	}
+	return &daprv1pb.QueryStateKeysResponse{
+		Keys:          keys,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
@@ -0,0 +1,137 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// keyListerStore is a state store listing its keys in order, paginated by the index of the next key
+type keyListerStore struct {
+	state.Store
+	keys []string
+	// unfiltered lists the keys without filtering them by prefix
+	unfiltered bool
+}
+
+func (s *keyListerStore) ListKeys(req *runtime_state.ListKeysRequest) (*runtime_state.ListKeysResponse, error) {
+	keys := []string{}
+	for _, k := range s.keys {
+		if s.unfiltered || strings.HasPrefix(k, req.Prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	start, _ := strconv.Atoi(req.PageToken)
+	end := len(keys)
+	if req.PageSize > 0 && start+req.PageSize < end {
+		end = start + req.PageSize
+	}
+	resp := &runtime_state.ListKeysResponse{Keys: keys[start:end]}
+	if end < len(keys) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+func TestQueryStateKeysAlpha1(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	keys := []string{"fakeAPI||orders-1", "fakeAPI||orders-2", "fakeAPI||orders-3", "fakeAPI||carts-1", "otherAPI||orders-4"}
+	fakeAPI := &api{
+		id: "fakeAPI",
+		stateStores: map[string]state.Store{
+			"lister":     &keyListerStore{keys: keys},
+			"unfiltered": &keyListerStore{keys: keys, unfiltered: true},
+			"plain":      &recordingStore{},
+			"prefixed":   &keyListerStore{keys: []string{"prod-fakeAPI||orders-1", "fakeAPI||orders-2"}},
+		},
+		getStateKeyPrefixFn: func(storeName string) (string, bool) {
+			if storeName == "prefixed" {
+				return "prod-fakeAPI||", true
+			}
+			return "", false
+		},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	t.Run("pages through the keys of the prefix", func(t *testing.T) {
+		resp, err := client.QueryStateKeysAlpha1(context.Background(), &daprv1pb.QueryStateKeysRequest{
+			StoreName: "lister",
+			Prefix:    "orders-",
+			PageSize:  2,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"orders-1", "orders-2"}, resp.Keys)
+		assert.NotEmpty(t, resp.NextPageToken)
+
+		resp, err = client.QueryStateKeysAlpha1(context.Background(), &daprv1pb.QueryStateKeysRequest{
+			StoreName: "lister",
+			Prefix:    "orders-",
+			PageSize:  2,
+			PageToken: resp.NextPageToken,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"orders-3"}, resp.Keys)
+		assert.Empty(t, resp.NextPageToken)
+	})
+
+	t.Run("lists only the keys of the app", func(t *testing.T) {
+		resp, err := client.QueryStateKeysAlpha1(context.Background(), &daprv1pb.QueryStateKeysRequest{StoreName: "unfiltered"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"carts-1", "orders-1", "orders-2", "orders-3"}, resp.Keys)
+	})
+
+	t.Run("lists the keys of the key prefix of the store", func(t *testing.T) {
+		resp, err := client.QueryStateKeysAlpha1(context.Background(), &daprv1pb.QueryStateKeysRequest{StoreName: "prefixed"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"orders-1"}, resp.Keys)
+	})
+
+	t.Run("page token of another prefix", func(t *testing.T) {
+		resp, err := client.QueryStateKeysAlpha1(context.Background(), &daprv1pb.QueryStateKeysRequest{StoreName: "lister", PageSize: 1})
+		assert.NoError(t, err)
+		_, err = client.QueryStateKeysAlpha1(context.Background(), &daprv1pb.QueryStateKeysRequest{
+			StoreName: "lister",
+			Prefix:    "orders-",
+			PageToken: resp.NextPageToken,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("store without listing", func(t *testing.T) {
+		_, err := client.QueryStateKeysAlpha1(context.Background(), &daprv1pb.QueryStateKeysRequest{StoreName: "plain"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), string(runtime_state.FeatureListKeys))
+	})
+
+	t.Run("unknown store", func(t *testing.T) {
+		_, err := client.QueryStateKeysAlpha1(context.Background(), &daprv1pb.QueryStateKeysRequest{StoreName: "unknown"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("negative page size", func(t *testing.T) {
+		_, err := client.QueryStateKeysAlpha1(context.Background(), &daprv1pb.QueryStateKeysRequest{StoreName: "lister", PageSize: -1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,66 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"fmt"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MigrateStateAlpha1 copies the keys of the app from a state store to another and streams the progress after every
+// page of keys. It is an admin API, only served when an admin API token is set.
+func (a *api) MigrateStateAlpha1(in *daprv1pb.MigrateStateRequest, stream daprv1pb.Dapr_MigrateStateAlpha1Server) error {
+	if a.migrateStateFn == nil {
+		return status.Error(codes.PermissionDenied, "ERR_ADMIN_API_DISABLED: the admin API requires an admin API token to be configured")
+	}
+	if a.stateStores == nil || len(a.stateStores) == 0 {
+		return status.Error(codes.FailedPrecondition, "ERR_STATE_STORE_NOT_CONFIGURED")
+	}
+	source, ok := a.stateStores[in.SourceStoreName]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "ERR_STATE_STORE_NOT_FOUND: %s", in.SourceStoreName)
+	}
+	if _, ok := a.stateStores[in.DestinationStoreName]; !ok {
+		return status.Errorf(codes.InvalidArgument, "ERR_STATE_STORE_NOT_FOUND: %s", in.DestinationStoreName)
+	}
+	if in.SourceStoreName == in.DestinationStoreName {
+		return status.Error(codes.InvalidArgument, "ERR_MALFORMED_REQUEST: the source and destination state stores must differ")
+	}
+	if in.PageSize < 0 {
+		return status.Error(codes.InvalidArgument, "ERR_MALFORMED_REQUEST: page size must not be negative")
+	}
+	if err := runtime_state.RequireFeature(in.SourceStoreName, source, runtime_state.FeatureListKeys); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "ERR_STATE_STORE_NOT_SUPPORTED: %s", err)
+	}
+
+	spanName := fmt.Sprintf("MigrateState: %s", in.SourceStoreName)
+	ctx, span := diag.StartTracingClientSpanFromGRPCContext(stream.Context(), spanName, a.tracingSpec)
+	defer span.End()
+
+	progress := func(p runtime_state.MigrateProgress) error {
+		return stream.Send(migrateStateProgress(p, false))
+	}
+	p, err := a.migrateStateFn(ctx, in.SourceStoreName, in.DestinationStoreName, in.Rekey, int(in.PageSize), progress)
+	diag.UpdateSpanPairStatusesFromError(span, err, spanName)
+	if err != nil {
+		return status.Errorf(codes.Internal, "ERR_STATE_MIGRATE: %s", err)
+	}
+	return stream.Send(migrateStateProgress(p, true))
+}
+
+func migrateStateProgress(p runtime_state.MigrateProgress, done bool) *daprv1pb.MigrateStateProgress {
+	return &daprv1pb.MigrateStateProgress{
+		Copied:  int64(p.Copied),
+		Skipped: int64(p.Skipped),
+		LastKey: p.LastKey,
+		Done:    done,
+	}
+}
@@ -0,0 +1,102 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func receiveMigrateStateProgress(stream daprv1pb.Dapr_MigrateStateAlpha1Client) ([]*daprv1pb.MigrateStateProgress, error) {
+	messages := []*daprv1pb.MigrateStateProgress{}
+	for {
+		p, err := stream.Recv()
+		if err == io.EOF {
+			return messages, nil
+		} else if err != nil {
+			return messages, err
+		}
+		messages = append(messages, p)
+	}
+}
+
+func TestMigrateStateAlpha1(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	var migrateErr error
+	fakeAPI := &api{
+		id: "fakeAPI",
+		stateStores: map[string]state.Store{
+			"lister": &keyListerStore{},
+			"plain":  &recordingStore{},
+		},
+		migrateStateFn: func(ctx context.Context, source, destination string, rekey bool, pageSize int, progress func(runtime_state.MigrateProgress) error) (runtime_state.MigrateProgress, error) {
+			p := runtime_state.MigrateProgress{Copied: pageSize, LastKey: "a"}
+			progress(p)
+			p.Copied, p.LastKey = 2*pageSize, "b"
+			if migrateErr != nil {
+				return p, migrateErr
+			}
+			progress(p)
+			return p, nil
+		},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	migrate := func(in *daprv1pb.MigrateStateRequest) ([]*daprv1pb.MigrateStateProgress, error) {
+		stream, err := client.MigrateStateAlpha1(context.Background(), in)
+		assert.NoError(t, err)
+		return receiveMigrateStateProgress(stream)
+	}
+
+	t.Run("streams the progress", func(t *testing.T) {
+		messages, err := migrate(&daprv1pb.MigrateStateRequest{SourceStoreName: "lister", DestinationStoreName: "plain", PageSize: 10})
+		assert.NoError(t, err)
+		assert.Len(t, messages, 3)
+		assert.Equal(t, int64(10), messages[0].Copied)
+		assert.Equal(t, int64(20), messages[2].Copied)
+		assert.Equal(t, "b", messages[2].LastKey)
+		assert.True(t, messages[2].Done)
+	})
+
+	t.Run("failure ends the stream with an error", func(t *testing.T) {
+		migrateErr = errors.New("write failed")
+		defer func() { migrateErr = nil }()
+
+		messages, err := migrate(&daprv1pb.MigrateStateRequest{SourceStoreName: "lister", DestinationStoreName: "plain", PageSize: 10})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Len(t, messages, 1)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := migrate(&daprv1pb.MigrateStateRequest{SourceStoreName: "missing", DestinationStoreName: "plain"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = migrate(&daprv1pb.MigrateStateRequest{SourceStoreName: "lister", DestinationStoreName: "lister"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = migrate(&daprv1pb.MigrateStateRequest{SourceStoreName: "plain", DestinationStoreName: "lister"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("admin API disabled", func(t *testing.T) {
+		fakeAPI.migrateStateFn = nil
+		_, err := migrate(&daprv1pb.MigrateStateRequest{SourceStoreName: "lister", DestinationStoreName: "plain"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
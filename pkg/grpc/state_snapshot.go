@@ -0,0 +1,64 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dapr/components-contrib/state"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBulkStateTransactionalAlpha1 reads the keys of the request at a single point in time. The state store must
+// support snapshot reads, unlike GetBulkStateStreamAlpha1 whose keys are read independently.
+func (a *api) GetBulkStateTransactionalAlpha1(ctx context.Context, in *daprv1pb.GetBulkStateTransactionalRequest) (*daprv1pb.GetBulkStateTransactionalResponse, error) {
+	if a.stateStores == nil || len(a.stateStores) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "ERR_STATE_STORE_NOT_CONFIGURED")
+	}
+	store, ok := a.stateStores[in.StoreName]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "ERR_STATE_STORE_NOT_FOUND")
+	}
+	if err := runtime_state.RequireFeature(in.StoreName, store, runtime_state.FeatureSnapshotRead); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "ERR_STATE_STORE_NOT_SUPPORTED: %s", err)
+	}
+
+	reqs := make([]state.GetRequest, 0, len(in.Keys))
+	for _, k := range in.Keys {
+		req := state.GetRequest{
+			Key:      a.getModifiedStateKey(in.StoreName, k),
+			Metadata: in.Metadata,
+		}
+		a.getStateDefaults(in.StoreName).ApplyToGet(&req)
+		reqs = append(reqs, req)
+	}
+
+	spanName := fmt.Sprintf("GetBulkStateTransactional: %s", in.StoreName)
+	_, span := diag.StartTracingClientSpanFromGRPCContext(ctx, spanName, a.tracingSpec)
+	defer span.End()
+
+	resps, err := runtime_state.GetSnapshot(in.StoreName, store, reqs)
+	diag.UpdateSpanPairStatusesFromError(span, err, spanName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "ERR_STATE_GET: %s", err)
+	}
+
+	items := make([]*daprv1pb.TransactionalStateItem, 0, len(resps))
+	for i, resp := range resps {
+		item := &daprv1pb.TransactionalStateItem{Key: in.Keys[i], Etag: resp.ETag}
+		if resp.Data != nil {
+			item.Data = &any.Any{Value: resp.Data}
+		}
+		items = append(items, item)
+	}
+	return &daprv1pb.GetBulkStateTransactionalResponse{Items: items}, nil
+}
@@ -0,0 +1,91 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	daprv1pb "github.com/dapr/dapr/pkg/proto/dapr/v1"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// snapshotStore is a state store reading its keys from a map at once
+type snapshotStore struct {
+	state.Store
+	values map[string]string
+	reqs   []state.GetRequest
+}
+
+func (s *snapshotStore) GetSnapshot(reqs []state.GetRequest) ([]state.GetResponse, error) {
+	s.reqs = reqs
+	resps := make([]state.GetResponse, 0, len(reqs))
+	for _, req := range reqs {
+		resp := state.GetResponse{}
+		if v, ok := s.values[req.Key]; ok {
+			resp.Data, resp.ETag = []byte(v), "1"
+		}
+		resps = append(resps, resp)
+	}
+	return resps, nil
+}
+
+func TestGetBulkStateTransactionalAlpha1(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	store := &snapshotStore{values: map[string]string{"fakeAPI||balance-a": "10", "fakeAPI||balance-b": "20"}}
+	fakeAPI := &api{
+		id: "fakeAPI",
+		stateStores: map[string]state.Store{
+			"snapshot": store,
+			"plain":    &recordingStore{},
+		},
+	}
+	server := startDaprAPIServer(port, fakeAPI)
+	defer server.Stop()
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+	client := daprv1pb.NewDaprClient(clientConn)
+
+	t.Run("reads the keys in one snapshot", func(t *testing.T) {
+		resp, err := client.GetBulkStateTransactionalAlpha1(context.Background(), &daprv1pb.GetBulkStateTransactionalRequest{
+			StoreName: "snapshot",
+			Keys:      []string{"balance-b", "balance-c", "balance-a"},
+			Metadata:  map[string]string{"partitionKey": "p1"},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Items, 3)
+		assert.Equal(t, "balance-b", resp.Items[0].Key)
+		assert.Equal(t, []byte("20"), resp.Items[0].Data.Value)
+		assert.Equal(t, "1", resp.Items[0].Etag)
+		assert.Equal(t, "balance-c", resp.Items[1].Key)
+		assert.Nil(t, resp.Items[1].Data)
+		assert.Equal(t, "balance-a", resp.Items[2].Key)
+		assert.Equal(t, []byte("10"), resp.Items[2].Data.Value)
+
+		assert.Len(t, store.reqs, 3)
+		assert.Equal(t, "fakeAPI||balance-b", store.reqs[0].Key)
+		assert.Equal(t, "p1", store.reqs[0].Metadata["partitionKey"])
+	})
+
+	t.Run("store without snapshot reads", func(t *testing.T) {
+		_, err := client.GetBulkStateTransactionalAlpha1(context.Background(), &daprv1pb.GetBulkStateTransactionalRequest{
+			StoreName: "plain",
+			Keys:      []string{"balance-a"},
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "SNAPSHOT_READ")
+	})
+
+	t.Run("unknown store", func(t *testing.T) {
+		_, err := client.GetBulkStateTransactionalAlpha1(context.Background(), &daprv1pb.GetBulkStateTransactionalRequest{StoreName: "unknown"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
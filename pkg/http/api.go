@@ -7,6 +7,7 @@ package http
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -18,12 +19,17 @@ import (
 	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/actors"
+	"github.com/dapr/dapr/pkg/apptoken"
 	"github.com/dapr/dapr/pkg/channel"
 	"github.com/dapr/dapr/pkg/channel/http"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/messaging"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/ratelimit"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/dapr/dapr/pkg/version"
 	"github.com/google/uuid"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/valyala/fasthttp"
@@ -43,23 +49,97 @@ type api struct {
 	directMessaging       messaging.DirectMessaging
 	appChannel            channel.AppChannel
 	stateStores           map[string]state.Store
+	getStateDefaultsFn    func(storeName string) runtime_state.Defaults
+	getStateKeyPrefixFn   func(storeName string) (string, bool)
 	secretStores          map[string]secretstores.SecretStore
 	json                  jsoniter.API
 	actor                 actors.Actors
-	publishFn             func(req *pubsub.PublishRequest) error
+	publishFn             func(req *pubsub.PublishRequest, metadata map[string]string) (string, error)
 	sendToOutputBindingFn func(name string, req *bindings.WriteRequest) error
 	id                    string
 	extendedMetadata      sync.Map
 	readyStatus           bool
 	tracingSpec           config.TracingSpec
+	getSubscriptionsFn    func() []SubscriptionMetadata
+	getInputBindingsFn    func() []InputBindingMetadata
+	getComponentsFn       func() []ComponentMetadata
+	getSnapshotFn         func() Snapshot
+	migrateStateFn        runtime_state.MigrateFunc
 }
 
 type metadata struct {
 	ID                string                      `json:"id"`
+	RuntimeVersion    string                      `json:"runtimeVersion"`
 	ActiveActorsCount []actors.ActiveActorsCount  `json:"actors"`
 	Extended          map[interface{}]interface{} `json:"extended"`
+	Subscriptions     []SubscriptionMetadata      `json:"subscriptions"`
+	InputBindings     []InputBindingMetadata      `json:"inputBindings"`
+	Components        []ComponentMetadata         `json:"components"`
 }
 
+// ComponentMetadata describes a loaded component, without its metadata items
+type ComponentMetadata struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Initialized bool   `json:"initialized"`
+}
+
+// PublishResponse is returned by the publish endpoint when the pubsub component reports the ID the broker assigned to the event
+type PublishResponse struct {
+	MessageID string `json:"messageId"`
+}
+
+// SaveStateResult is the result of saving a key, returned for every key by the requests with partial results
+type SaveStateResult struct {
+	Key   string         `json:"key"`
+	Error *ErrorResponse `json:"error,omitempty"`
+}
+
+// SubscriptionMetadata describes a topic subscription of the runtime and its current state
+type SubscriptionMetadata struct {
+	PubsubName string `json:"pubsubName"`
+	Topic      string `json:"topic"`
+	Route      string `json:"route,omitempty"`
+	Type       string `json:"type"`
+	Status     string `json:"status"`
+	// InFlight is the number of messages of the subscription being delivered to the app
+	InFlight int64 `json:"inFlight"`
+	// LastDeliveryTime is when a message of the subscription was last delivered to the app
+	LastDeliveryTime string `json:"lastDeliveryTime,omitempty"`
+	// Retries is the number of redeliveries of messages of the subscription the app failed to process
+	Retries int64 `json:"retries"`
+}
+
+// InputBindingMetadata describes an input binding the runtime delivers events from
+type InputBindingMetadata struct {
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Route         string `json:"route"`
+	LastEventTime string `json:"lastEventTime,omitempty"`
+	Status        string `json:"status"`
+	// NextStatusChange is when the schedule of the binding next pauses or resumes it
+	NextStatusChange string `json:"nextStatusChange,omitempty"`
+}
+
+const (
+	// SubscriptionTypeProgrammatic is a subscription returned by the app from its subscribe endpoint
+	SubscriptionTypeProgrammatic = "programmatic"
+
+	// SubscriptionStatusActive is a subscription the pub/sub component delivers messages for
+	SubscriptionStatusActive = "active"
+	// SubscriptionStatusDenied is a subscription the app isn't allowed to make by the pub/sub component scopes
+	SubscriptionStatusDenied = "denied"
+	// SubscriptionStatusFailed is a subscription the pub/sub component failed to create
+	SubscriptionStatusFailed = "failed"
+	// SubscriptionStatusPending is a subscription waiting for its dependencies before consuming messages
+	SubscriptionStatusPending = "pending"
+
+	// InputBindingStatusActive is an input binding delivering its events to the app
+	InputBindingStatusActive = "active"
+	// InputBindingStatusPaused is an input binding whose schedule holds its events until its next active window
+	InputBindingStatusPaused = "paused"
+)
+
 const (
 	apiVersionV1         = "v1.0"
 	idParam              = "id"
@@ -77,15 +157,22 @@ const (
 	retryPatternParam    = "retryPattern"
 	retryThresholdParam  = "retryThreshold"
 	concurrencyParam     = "concurrency"
+	partialResultsParam  = "partialResults"
+	dueAfterParam        = "dueAfter"
+	dueBeforeParam       = "dueBefore"
+	pageSizeParam        = "pageSize"
+	pageTokenParam       = "pageToken"
 	daprSeparator        = "||"
 )
 
 // NewAPI returns a new API
-func NewAPI(appID string, appChannel channel.AppChannel, directMessaging messaging.DirectMessaging, stateStores map[string]state.Store, secretStores map[string]secretstores.SecretStore, publishFn func(*pubsub.PublishRequest) error, actor actors.Actors, sendToOutputBindingFn func(name string, req *bindings.WriteRequest) error, tracingSpec config.TracingSpec) API {
+func NewAPI(appID string, appChannel channel.AppChannel, directMessaging messaging.DirectMessaging, stateStores map[string]state.Store, getStateDefaultsFn func(storeName string) runtime_state.Defaults, getStateKeyPrefixFn func(storeName string) (string, bool), secretStores map[string]secretstores.SecretStore, publishFn func(*pubsub.PublishRequest, map[string]string) (string, error), actor actors.Actors, sendToOutputBindingFn func(name string, req *bindings.WriteRequest) error, tracingSpec config.TracingSpec, getSubscriptionsFn func() []SubscriptionMetadata, getInputBindingsFn func() []InputBindingMetadata, getComponentsFn func() []ComponentMetadata, getSnapshotFn func() Snapshot, migrateStateFn runtime_state.MigrateFunc) API {
 	api := &api{
 		appChannel:            appChannel,
 		directMessaging:       directMessaging,
 		stateStores:           stateStores,
+		getStateDefaultsFn:    getStateDefaultsFn,
+		getStateKeyPrefixFn:   getStateKeyPrefixFn,
 		secretStores:          secretStores,
 		json:                  jsoniter.ConfigFastest,
 		actor:                 actor,
@@ -93,6 +180,11 @@ func NewAPI(appID string, appChannel channel.AppChannel, directMessaging messagi
 		sendToOutputBindingFn: sendToOutputBindingFn,
 		id:                    appID,
 		tracingSpec:           tracingSpec,
+		getSubscriptionsFn:    getSubscriptionsFn,
+		getInputBindingsFn:    getInputBindingsFn,
+		getComponentsFn:       getComponentsFn,
+		getSnapshotFn:         getSnapshotFn,
+		migrateStateFn:        migrateStateFn,
 	}
 	api.endpoints = append(api.endpoints, api.constructStateEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructSecretEndpoints()...)
@@ -102,6 +194,7 @@ func NewAPI(appID string, appChannel channel.AppChannel, directMessaging messagi
 	api.endpoints = append(api.endpoints, api.constructMetadataEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructBindingsEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructHealthzEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructAdminEndpoints()...)
 
 	return api
 }
@@ -245,6 +338,12 @@ func (a *api) constructActorEndpoints() []Endpoint {
 			Version: apiVersionV1,
 			Handler: a.onGetActorReminder,
 		},
+		{
+			Methods: []string{fhttp.MethodGet},
+			Route:   "actors/{actorType}/reminders",
+			Version: apiVersionV1,
+			Handler: a.onListActorReminders,
+		},
 	}
 }
 
@@ -341,12 +440,29 @@ func (a *api) onGetState(reqCtx *fasthttp.RequestCtx) {
 
 	key := reqCtx.UserValue(stateKeyParam).(string)
 	consistency := string(reqCtx.QueryArgs().Peek(consistencyParam))
+	projection, metadata, err := runtime_state.ProjectionFromMetadata(getMetadataFromRequest(reqCtx))
+	if err != nil {
+		msg := NewErrorResponse("ERR_STATE_PROJECTION", err.Error())
+		respondWithError(reqCtx, 400, msg)
+		return
+	}
 	req := state.GetRequest{
-		Key: a.getModifiedStateKey(key),
+		Key:      a.getModifiedStateKey(storeName, key),
+		Metadata: metadata,
 		Options: state.GetStateOption{
 			Consistency: consistency,
 		},
 	}
+	a.getStateDefaults(storeName).ApplyToGet(&req)
+	if token := string(reqCtx.Request.Header.Peek(runtime_state.SessionTokenHeader)); token != "" {
+		err = runtime_state.ApplySessionToken(storeName, a.stateStores[storeName], &req, token)
+		if featureErr, ok := err.(*runtime_state.FeatureError); ok {
+			msg := NewErrorResponse("ERR_STATE_STORE_NOT_SUPPORTED", err.Error())
+			msg.Details = featureErr
+			respondWithError(reqCtx, 501, msg)
+			return
+		}
+	}
 
 	resp, err := a.stateStores[storeName].Get(&req)
 	if err != nil {
@@ -354,11 +470,19 @@ func (a *api) onGetState(reqCtx *fasthttp.RequestCtx) {
 		respondWithError(reqCtx, 500, msg)
 		return
 	}
+	setStateOptionHeaders(reqCtx, req.Options.Consistency, "")
+	setSessionTokenHeader(reqCtx, a.stateStores[storeName], req.Key)
 	if resp == nil || resp.Data == nil {
 		respondEmpty(reqCtx, 204)
 		return
 	}
-	respondWithETaggedJSON(reqCtx, 200, resp.Data, resp.ETag)
+	data, err := projection.Apply(resp.Data)
+	if err != nil {
+		msg := NewErrorResponse("ERR_STATE_PROJECTION", err.Error())
+		respondWithError(reqCtx, 400, msg)
+		return
+	}
+	respondWithETaggedJSON(reqCtx, 200, data, resp.ETag)
 }
 
 func (a *api) onDeleteState(reqCtx *fasthttp.RequestCtx) {
@@ -378,6 +502,7 @@ func (a *api) onDeleteState(reqCtx *fasthttp.RequestCtx) {
 
 	key := reqCtx.UserValue(stateKeyParam).(string)
 	etag := string(reqCtx.Request.Header.Peek("If-Match"))
+	etags := ifMatchETags(etag)
 
 	concurrency := string(reqCtx.QueryArgs().Peek(concurrencyParam))
 	consistency := string(reqCtx.QueryArgs().Peek(consistencyParam))
@@ -395,7 +520,7 @@ func (a *api) onDeleteState(reqCtx *fasthttp.RequestCtx) {
 	}
 
 	req := state.DeleteRequest{
-		Key:  a.getModifiedStateKey(key),
+		Key:  a.getModifiedStateKey(storeName, key),
 		ETag: etag,
 		Options: state.DeleteStateOption{
 			Concurrency: concurrency,
@@ -416,12 +541,15 @@ func (a *api) onDeleteState(reqCtx *fasthttp.RequestCtx) {
 	diag.SpanContextToRequest(span.SpanContext(), &reqCtx.Request)
 	defer span.End()
 
-	err := a.stateStores[storeName].Delete(&req)
+	a.getStateDefaults(storeName).ApplyToDelete(&req)
+	err := runtime_state.DeleteWithETags(a.stateStores[storeName], req, etags)
 	if err != nil {
 		msg := NewErrorResponse("ERR_STATE_DELETE", fmt.Sprintf("failed deleting state with key %s: %s", key, err))
 		respondWithError(reqCtx, 500, msg)
 		return
 	}
+	setSessionTokenHeader(reqCtx, a.stateStores[storeName], req.Key)
+	setStateOptionHeaders(reqCtx, req.Options.Consistency, req.Options.Concurrency)
 	respondEmpty(reqCtx, 200)
 }
 
@@ -440,15 +568,7 @@ func (a *api) onGetSecret(reqCtx *fasthttp.RequestCtx) {
 		return
 	}
 
-	metadata := map[string]string{}
-	const metadataPrefix string = "metadata."
-	reqCtx.QueryArgs().VisitAll(func(key []byte, value []byte) {
-		queryKey := string(key)
-		if strings.HasPrefix(queryKey, metadataPrefix) {
-			k := strings.TrimPrefix(queryKey, metadataPrefix)
-			metadata[k] = string(value)
-		}
-	})
+	metadata := getMetadataFromRequest(reqCtx)
 
 	key := reqCtx.UserValue(secretNameParam).(string)
 	req := secretstores.GetSecretRequest{
@@ -495,16 +615,40 @@ func (a *api) onPostState(reqCtx *fasthttp.RequestCtx) {
 		return
 	}
 
-	reqs := []state.SetRequest{}
-	err := a.json.Unmarshal(reqCtx.PostBody(), &reqs)
+	saveReqs := []SaveStateRequest{}
+	err := a.json.Unmarshal(reqCtx.PostBody(), &saveReqs)
 	if err != nil {
 		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
 		respondWithError(reqCtx, 402, msg)
 		return
 	}
 
-	for i, r := range reqs {
-		reqs[i].Key = a.getModifiedStateKey(r.Key)
+	defaults := a.getStateDefaults(storeName)
+	reqs := make([]state.SetRequest, 0, len(saveReqs))
+	etags := make([][]string, 0, len(saveReqs))
+	for _, r := range saveReqs {
+		req := r.SetRequest
+		etags = append(etags, runtime_state.AcceptedETags(req.ETag, r.ETags))
+		if r.TTL != "" {
+			ttl, err := time.ParseDuration(r.TTL)
+			if err == nil {
+				err = runtime_state.ApplyTTL(storeName, a.stateStores[storeName], &req, ttl)
+			}
+			var featureErr *runtime_state.FeatureError
+			if errors.As(err, &featureErr) {
+				msg := NewErrorResponse("ERR_STATE_STORE_NOT_SUPPORTED", err.Error())
+				msg.Details = featureErr
+				respondWithError(reqCtx, 501, msg)
+				return
+			} else if err != nil {
+				msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
+				respondWithError(reqCtx, 400, msg)
+				return
+			}
+		}
+		req.Key = a.getModifiedStateKey(storeName, req.Key)
+		defaults.ApplyToSet(&req)
+		reqs = append(reqs, req)
 	}
 
 	var span *trace.Span
@@ -515,17 +659,94 @@ func (a *api) onPostState(reqCtx *fasthttp.RequestCtx) {
 	diag.SpanContextToRequest(span.SpanContext(), &reqCtx.Request)
 	defer span.End()
 
-	err = a.stateStores[storeName].BulkSet(reqs)
+	// with partial results, every key is saved on its own and the failed keys don't fail the request
+	if string(reqCtx.QueryArgs().Peek(partialResultsParam)) == "true" {
+		errs := runtime_state.SetEach(a.stateStores[storeName], reqs, etags)
+		results := make([]SaveStateResult, len(saveReqs))
+		saved := make([]string, 0, len(reqs))
+		for i, r := range saveReqs {
+			results[i].Key = r.Key
+			if errs[i] != nil {
+				msg := NewErrorResponse("ERR_STATE_SAVE", errs[i].Error())
+				results[i].Error = &msg
+			} else {
+				saved = append(saved, reqs[i].Key)
+			}
+		}
+		setSessionTokenHeader(reqCtx, a.stateStores[storeName], saved...)
+		b, _ := a.json.Marshal(results)
+		respondWithJSON(reqCtx, 200, b)
+		return
+	}
+
+	err = runtime_state.BulkSetWithETags(a.stateStores[storeName], reqs, etags)
 	if err != nil {
 		msg := NewErrorResponse("ERR_STATE_SAVE", err.Error())
 		respondWithError(reqCtx, 500, msg)
 		return
 	}
 
+	consistency, concurrency := runtime_state.EffectiveSetOptions(reqs)
+	setStateOptionHeaders(reqCtx, consistency, concurrency)
+	keys := make([]string, 0, len(reqs))
+	for _, r := range reqs {
+		keys = append(keys, r.Key)
+	}
+	setSessionTokenHeader(reqCtx, a.stateStores[storeName], keys...)
 	respondEmpty(reqCtx, 201)
 }
 
-func (a *api) getModifiedStateKey(key string) string {
+// ifMatchETags returns the ETags of an If-Match header listing several quoted entity tags, e.g. "1", "2".
+// It returns nil for other values, which are passed to the state store as they are.
+func ifMatchETags(header string) []string {
+	var etags []string
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if len(tag) < 3 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			return nil
+		}
+		etags = append(etags, tag[1:len(tag)-1])
+	}
+	return runtime_state.AcceptedETags("", etags)
+}
+
+// setStateOptionHeaders returns the consistency and concurrency a state request was executed with
+func setStateOptionHeaders(reqCtx *fasthttp.RequestCtx, consistency, concurrency string) {
+	if consistency != "" {
+		reqCtx.Response.Header.Set(runtime_state.ConsistencyHeader, consistency)
+	}
+	if concurrency != "" {
+		reqCtx.Response.Header.Set(runtime_state.ConcurrencyHeader, concurrency)
+	}
+}
+
+// setSessionTokenHeader returns the session token of the keys of a state request, if the state store has session
+// consistency
+func setSessionTokenHeader(reqCtx *fasthttp.RequestCtx, store state.Store, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	if token := runtime_state.SessionToken(store, keys...); token != "" {
+		reqCtx.Response.Header.Set(runtime_state.SessionTokenHeader, token)
+	}
+}
+
+// getStateDefaults returns the defaults of a state store declared by its component
+func (a *api) getStateDefaults(storeName string) runtime_state.Defaults {
+	if a.getStateDefaultsFn == nil {
+		return runtime_state.Defaults{}
+	}
+	return a.getStateDefaultsFn(storeName)
+}
+
+// getModifiedStateKey returns the key of the app in a state store, prefixed with the key prefix of the store or else
+// with the app ID
+func (a *api) getModifiedStateKey(storeName, key string) string {
+	if a.getStateKeyPrefixFn != nil {
+		if prefix, ok := a.getStateKeyPrefixFn(storeName); ok {
+			return prefix + key
+		}
+	}
 	if a.id != "" {
 		return fmt.Sprintf("%s%s%s", a.id, daprSeparator, key)
 	}
@@ -600,6 +821,9 @@ func (a *api) onCreateActorReminder(reqCtx *fasthttp.RequestCtx) {
 	req.Name = name
 	req.ActorType = actorType
 	req.ActorID = actorID
+	if partitionKey := reqCtx.Request.Header.Peek(actors.PartitionKeyHeader); len(partitionKey) > 0 {
+		req.PartitionKey = string(partitionKey)
+	}
 
 	sc := diag.GetSpanContextFromRequestContext(reqCtx, a.tracingSpec)
 	ctx := diag.NewContext((context.Context)(reqCtx), sc)
@@ -757,6 +981,57 @@ func (a *api) onGetActorReminder(reqCtx *fasthttp.RequestCtx) {
 	}
 }
 
+// onListActorReminders returns a page of the reminders of an actor type, optionally of an actor and firing next within
+// the RFC3339 times of the due time query parameters
+func (a *api) onListActorReminders(reqCtx *fasthttp.RequestCtx) {
+	if a.actor == nil {
+		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", "")
+		respondWithError(reqCtx, 400, msg)
+		return
+	}
+
+	args := reqCtx.QueryArgs()
+	req := actors.ListRemindersRequest{
+		ActorType: reqCtx.UserValue(actorTypeParam).(string),
+		ActorID:   string(args.Peek(actorIDParam)),
+		PageToken: string(args.Peek(pageTokenParam)),
+	}
+	var err error
+	if pageSize := string(args.Peek(pageSizeParam)); pageSize != "" {
+		req.PageSize, err = strconv.Atoi(pageSize)
+		if err == nil && req.PageSize < 0 {
+			err = fmt.Errorf("page size must not be negative")
+		}
+	}
+	if dueAfter := string(args.Peek(dueAfterParam)); err == nil && dueAfter != "" {
+		req.DueAfter, err = time.Parse(time.RFC3339, dueAfter)
+	}
+	if dueBefore := string(args.Peek(dueBeforeParam)); err == nil && dueBefore != "" {
+		req.DueBefore, err = time.Parse(time.RFC3339, dueBefore)
+	}
+	if err != nil {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
+		respondWithError(reqCtx, 400, msg)
+		return
+	}
+
+	sc := diag.GetSpanContextFromRequestContext(reqCtx, a.tracingSpec)
+	ctx := diag.NewContext((context.Context)(reqCtx), sc)
+
+	resp, err := a.actor.ListReminders(ctx, &req)
+	if _, ok := err.(*actors.PageTokenError); ok {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
+		respondWithError(reqCtx, 400, msg)
+		return
+	} else if err != nil {
+		msg := NewErrorResponse("ERR_ACTOR_REMINDER_LIST", err.Error())
+		respondWithError(reqCtx, 500, msg)
+		return
+	}
+	b, _ := a.json.Marshal(resp)
+	respondWithJSON(reqCtx, 200, b)
+}
+
 func (a *api) onDeleteActorTimer(reqCtx *fasthttp.RequestCtx) {
 	if a.actor == nil {
 		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", "")
@@ -799,6 +1074,20 @@ func (a *api) onDirectActorMessage(reqCtx *fasthttp.RequestCtx) {
 	method := reqCtx.UserValue(methodParam).(string)
 	body := reqCtx.PostBody()
 
+	// routing hints are passed to the actor runtime with the rest of the headers
+	consistency := string(reqCtx.Request.Header.Peek(actors.ConsistencyHeader))
+	if !actors.IsValidConsistency(consistency) {
+		msg := NewErrorResponse("ERR_ACTOR_INVALID_ROUTING_HINT",
+			fmt.Sprintf("%s must be %s or %s", actors.ConsistencyHeader, actors.ConsistencyStrong, actors.ConsistencyEventual))
+		respondWithError(reqCtx, fhttp.StatusBadRequest, msg)
+		return
+	}
+	if err := actors.ValidateAffinity(string(reqCtx.Request.Header.Peek(actors.AffinityHeader))); err != nil {
+		msg := NewErrorResponse("ERR_ACTOR_INVALID_ROUTING_HINT", fmt.Sprintf("%s: %s", actors.AffinityHeader, err))
+		respondWithError(reqCtx, fhttp.StatusBadRequest, msg)
+		return
+	}
+
 	req := invokev1.NewInvokeMethodRequest(method)
 	req.WithActor(actorType, actorID)
 	req.WithHTTPExtension(verb, reqCtx.QueryArgs().String())
@@ -969,8 +1258,21 @@ func (a *api) onGetMetadata(reqCtx *fasthttp.RequestCtx) {
 
 	mtd := metadata{
 		ID:                a.id,
+		RuntimeVersion:    version.Version(),
 		ActiveActorsCount: a.actor.GetActiveActorsCount(ctx),
 		Extended:          temp,
+		Subscriptions:     []SubscriptionMetadata{},
+		InputBindings:     []InputBindingMetadata{},
+		Components:        []ComponentMetadata{},
+	}
+	if a.getSubscriptionsFn != nil {
+		mtd.Subscriptions = a.getSubscriptionsFn()
+	}
+	if a.getInputBindingsFn != nil {
+		mtd.InputBindings = a.getInputBindingsFn()
+	}
+	if a.getComponentsFn != nil {
+		mtd.Components = a.getComponentsFn()
 	}
 
 	mtdBytes, err := a.json.Marshal(mtd)
@@ -998,6 +1300,17 @@ func (a *api) onPublish(reqCtx *fasthttp.RequestCtx) {
 
 	topic := reqCtx.UserValue(topicParam).(string)
 	body := reqCtx.PostBody()
+	metadata := getMetadataFromRequest(reqCtx)
+	// the topic access rules may match the claims of the token of the caller, never one set in the query
+	delete(metadata, runtime_pubsub.CallerTokenMetadataKey)
+	if token := apptoken.BearerToken(string(reqCtx.Request.Header.Peek("Authorization"))); token != "" {
+		metadata[runtime_pubsub.CallerTokenMetadataKey] = token
+	}
+	if _, _, err := runtime_pubsub.GetPriority(metadata); err != nil {
+		msg := NewErrorResponse("ERR_PUBSUB_INVALID_METADATA", err.Error())
+		respondWithError(reqCtx, 400, msg)
+		return
+	}
 
 	sc := diag.GetSpanContextFromRequestContext(reqCtx, a.tracingSpec)
 	var span *trace.Span
@@ -1007,14 +1320,26 @@ func (a *api) onPublish(reqCtx *fasthttp.RequestCtx) {
 	diag.SpanContextToRequest(span.SpanContext(), &reqCtx.Request)
 	defer span.End()
 
-	corID := diag.SpanContextToString(span.SpanContext())
-	envelope := pubsub.NewCloudEventsEnvelope(uuid.New().String(), a.id, pubsub.DefaultCloudEventType, corID, body)
-
-	b, err := a.json.Marshal(envelope)
-	if err != nil {
-		msg := NewErrorResponse("ERR_PUBSUB_CLOUD_EVENTS_SER", err.Error())
-		respondWithError(reqCtx, 500, msg)
-		return
+	var b []byte
+	if runtime_pubsub.IsPreservedCloudEvent(metadata) {
+		// the app relays a complete cloud event, keep its id, time and traceparent
+		if err := runtime_pubsub.ValidateCloudEvent(body); err != nil {
+			msg := NewErrorResponse("ERR_PUBSUB_CLOUD_EVENTS_INVALID", err.Error())
+			respondWithError(reqCtx, 400, msg)
+			return
+		}
+		b = body
+	} else {
+		corID := diag.SpanContextToString(span.SpanContext())
+		envelope := pubsub.NewCloudEventsEnvelope(uuid.New().String(), a.id, pubsub.DefaultCloudEventType, corID, body)
+
+		var err error
+		b, err = a.json.Marshal(envelope)
+		if err != nil {
+			msg := NewErrorResponse("ERR_PUBSUB_CLOUD_EVENTS_SER", err.Error())
+			respondWithError(reqCtx, 500, msg)
+			return
+		}
 	}
 
 	req := pubsub.PublishRequest{
@@ -1022,15 +1347,41 @@ func (a *api) onPublish(reqCtx *fasthttp.RequestCtx) {
 		Data:  b,
 	}
 
-	err = a.publishFn(&req)
-	if err != nil {
+	id, err := a.publishFn(&req, metadata)
+	if _, ok := err.(*runtime_pubsub.SchemaError); ok {
+		msg := NewErrorResponse("ERR_PUBSUB_CLOUD_EVENTS_SCHEMA", err.Error())
+		respondWithError(reqCtx, 400, msg)
+	} else if _, ok := err.(*runtime_pubsub.AuthorizationError); ok {
+		msg := NewErrorResponse("ERR_PUBSUB_FORBIDDEN", err.Error())
+		respondWithError(reqCtx, 403, msg)
+	} else if _, ok := err.(*ratelimit.Error); ok {
+		msg := NewErrorResponse("ERR_PUBSUB_RATE_LIMITED", err.Error())
+		respondWithError(reqCtx, 429, msg)
+	} else if err != nil {
 		msg := NewErrorResponse("ERR_PUBSUB_PUBLISH_MESSAGE", err.Error())
 		respondWithError(reqCtx, 500, msg)
+	} else if id != "" {
+		b, _ := a.json.Marshal(PublishResponse{MessageID: id})
+		respondWithJSON(reqCtx, 200, b)
 	} else {
 		respondEmpty(reqCtx, 200)
 	}
 }
 
+// getMetadataFromRequest returns the query parameters prefixed with "metadata." as a metadata map
+func getMetadataFromRequest(reqCtx *fasthttp.RequestCtx) map[string]string {
+	metadata := map[string]string{}
+	const metadataPrefix string = "metadata."
+	reqCtx.QueryArgs().VisitAll(func(key []byte, value []byte) {
+		queryKey := string(key)
+		if strings.HasPrefix(queryKey, metadataPrefix) {
+			k := strings.TrimPrefix(queryKey, metadataPrefix)
+			metadata[k] = string(value)
+		}
+	})
+	return metadata
+}
+
 // GetStatusCodeFromMetadata extracts the http status code from the metadata if it exists
 func GetStatusCodeFromMetadata(metadata map[string]string) int {
 	code := metadata[http.HTTPStatusCode]
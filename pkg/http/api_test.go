@@ -18,11 +18,13 @@ import (
 	gohttp "net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/components-contrib/exporters"
 	"github.com/dapr/components-contrib/exporters/stringexporter"
 	"github.com/dapr/components-contrib/middleware"
+	"github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/actors"
@@ -33,6 +35,8 @@ import (
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	v1 "github.com/dapr/dapr/pkg/messaging/v1"
 	http_middleware "github.com/dapr/dapr/pkg/middleware/http"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
 	daprt "github.com/dapr/dapr/pkg/testing"
 	routing "github.com/fasthttp/router"
 	jsoniter "github.com/json-iterator/go"
@@ -156,6 +160,68 @@ func TestV1OutputBindingsEndpointsWithTracer(t *testing.T) {
 	fakeServer.Shutdown()
 }
 
+func TestV1PublishEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	var published []byte
+	var messageID string
+	testAPI := &api{
+		publishFn: func(req *pubsub.PublishRequest, metadata map[string]string) (string, error) {
+			published = req.Data
+			return messageID, nil
+		},
+		json: jsoniter.ConfigFastest,
+	}
+	fakeServer.StartServer(testAPI.constructPubSubEndpoints())
+	apiPath := fmt.Sprintf("%s/publish/topic", apiVersionV1)
+
+	t.Run("Publish wraps the payload in a new cloud event - 200 OK", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", apiPath, []byte("order created"), nil)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		var envelope pubsub.CloudEventsEnvelope
+		assert.NoError(t, json.Unmarshal(published, &envelope))
+		assert.NotEmpty(t, envelope.ID)
+	})
+
+	t.Run("Publish preserves a pre-built cloud event - 200 OK", func(t *testing.T) {
+		event := []byte(`{"id":"relayed-1","source":"relay","type":"order.created","specversion":"1.0","time":"2020-05-01T10:00:00Z"}`)
+		params := map[string]string{"metadata.preserveCloudEvent": "true"}
+		resp := fakeServer.DoRequest("POST", apiPath, event, params)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, event, published)
+	})
+
+	t.Run("Publish returns the message ID reported by the broker - 200 OK", func(t *testing.T) {
+		messageID = "broker-1"
+		defer func() { messageID = "" }()
+		resp := fakeServer.DoRequest("POST", apiPath, []byte("order created"), nil)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.JSONEq(t, `{"messageId":"broker-1"}`, string(resp.RawBody))
+	})
+
+	t.Run("Publish rejects an invalid pre-built cloud event - 400 BadRequest", func(t *testing.T) {
+		params := map[string]string{"metadata.preserveCloudEvent": "true"}
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"orderId":1}`), params)
+
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_CLOUD_EVENTS_INVALID", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Publish rejects an event not matching the schema - 400 BadRequest", func(t *testing.T) {
+		testAPI.publishFn = func(req *pubsub.PublishRequest, metadata map[string]string) (string, error) {
+			return "", &runtime_pubsub.SchemaError{Violations: []string{"data: orderId is required"}}
+		}
+		resp := fakeServer.DoRequest("POST", apiPath, []byte("order created"), nil)
+
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_CLOUD_EVENTS_SCHEMA", resp.ErrorBody["errorCode"])
+	})
+
+	fakeServer.Shutdown()
+}
+
 func TestV1DirectMessagingEndpoints(t *testing.T) {
 	headerMetadata := map[string][]string{
 		"Accept-Encoding": {"gzip"},
@@ -596,6 +662,60 @@ func TestV1ActorEndpoints(t *testing.T) {
 		mockActors.AssertNumberOfCalls(t, "TransactionalStateOperation", 1)
 	})
 
+	t.Run("List actor reminders - 200 OK", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/reminders?actorId=fakeActorID&dueBefore=2020-03-28T00:00:00Z&pageSize=10"
+		mockActors := new(daprt.MockActors)
+		mockActors.On("ListReminders", &actors.ListRemindersRequest{
+			ActorType: "fakeActorType",
+			ActorID:   "fakeActorID",
+			DueBefore: time.Date(2020, 3, 28, 0, 0, 0, 0, time.UTC),
+			PageSize:  10,
+		}).Return(&actors.ListRemindersResponse{
+			Reminders: []actors.ListedReminder{
+				{Reminder: actors.Reminder{ActorType: "fakeActorType", ActorID: "fakeActorID", Name: "reminder1"}, NextFireTime: "2020-03-27T12:00:00Z"},
+			},
+			NextPageToken: "next",
+		}, nil)
+
+		testAPI.actor = mockActors
+
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+
+		// assert
+		assert.Equal(t, 200, resp.StatusCode)
+		var body actors.ListRemindersResponse
+		assert.NoError(t, json.Unmarshal(resp.RawBody, &body))
+		assert.Equal(t, "reminder1", body.Reminders[0].Name)
+		assert.Equal(t, "2020-03-27T12:00:00Z", body.Reminders[0].NextFireTime)
+		assert.Equal(t, "next", body.NextPageToken)
+	})
+
+	t.Run("List actor reminders - 400 malformed due time", func(t *testing.T) {
+		testAPI.actor = new(daprt.MockActors)
+
+		// act
+		resp := fakeServer.DoRequest("GET", "v1.0/actors/fakeActorType/reminders?dueAfter=tomorrow", nil, nil)
+
+		// assert
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("List actor reminders - 400 page token of another actor", func(t *testing.T) {
+		mockActors := new(daprt.MockActors)
+		mockActors.On("ListReminders", mock.AnythingOfType("*actors.ListRemindersRequest")).
+			Return(nil, &actors.PageTokenError{Reason: "the token was issued for another actor type or actor"})
+		testAPI.actor = mockActors
+
+		// act
+		resp := fakeServer.DoRequest("GET", "v1.0/actors/fakeActorType/reminders?pageToken=token", nil, nil)
+
+		// assert
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
 	fakeServer.Shutdown()
 }
 
@@ -610,9 +730,13 @@ func TestV1MetadataEndpoint(t *testing.T) {
 	fakeServer.StartServer(testAPI.constructMetadataEndpoints())
 
 	expectedBody := map[string]interface{}{
-		"id":       "xyz",
-		"actors":   []map[string]interface{}{{"type": "abcd", "count": 10}, {"type": "xyz", "count": 5}},
-		"extended": make(map[string]string),
+		"id":             "xyz",
+		"runtimeVersion": "edge",
+		"actors":         []map[string]interface{}{{"type": "abcd", "count": 10}, {"type": "xyz", "count": 5}},
+		"extended":       make(map[string]string),
+		"subscriptions":  []interface{}{},
+		"inputBindings":  []interface{}{},
+		"components":     []interface{}{},
 	}
 	expectedBodyBytes, _ := json.Marshal(expectedBody)
 
@@ -635,6 +759,122 @@ func TestV1MetadataEndpoint(t *testing.T) {
 	fakeServer.Shutdown()
 }
 
+func TestV1AdminSnapshotEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+
+	testAPI := &api{
+		json: jsoniter.ConfigFastest,
+	}
+
+	fakeServer.StartServer(testAPI.constructAdminEndpoints())
+
+	t.Run("Snapshot - 403 without api token", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0/admin/snapshot", nil, nil)
+
+		assert.Equal(t, 403, resp.StatusCode)
+		assert.Equal(t, "ERR_ADMIN_API_DISABLED", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Snapshot - 200 OK", func(t *testing.T) {
+		mockActors := new(daprt.MockActors)
+		mockActors.On("GetActiveActorsCount")
+		testAPI.actor = mockActors
+		testAPI.getSnapshotFn = func() Snapshot {
+			return Snapshot{
+				ID:         "xyz",
+				Components: []ComponentSnapshot{},
+				ActorTypes: []string{"abcd"},
+			}
+		}
+
+		resp := fakeServer.DoRequest("GET", "v1.0/admin/snapshot", nil, nil)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		var snapshot Snapshot
+		assert.NoError(t, json.Unmarshal(resp.RawBody, &snapshot))
+		assert.Equal(t, "xyz", snapshot.ID)
+		assert.Equal(t, []string{"abcd"}, snapshot.ActorTypes)
+		assert.Len(t, snapshot.ActiveActors, 2)
+	})
+
+	fakeServer.Shutdown()
+}
+
+func TestV1AdminMigrateStateEndpoint(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+
+	testAPI := &api{
+		json: jsoniter.ConfigFastest,
+		stateStores: map[string]state.Store{
+			"lister": fakeKeyListerStateStore{},
+			"plain":  fakeStateStore{},
+		},
+	}
+
+	fakeServer.StartServer(testAPI.constructAdminEndpoints())
+
+	migrate := func(source, destination string) fakeHTTPResponse {
+		body, _ := json.Marshal(MigrateStateRequest{SourceStoreName: source, DestinationStoreName: destination, PageSize: 10})
+		return fakeServer.DoRequest("POST", "v1.0/admin/state/migrate", body, nil)
+	}
+
+	t.Run("Migrate - 403 without api token", func(t *testing.T) {
+		resp := migrate("lister", "plain")
+
+		assert.Equal(t, 403, resp.StatusCode)
+		assert.Equal(t, "ERR_ADMIN_API_DISABLED", resp.ErrorBody["errorCode"])
+	})
+
+	var migrateErr error
+	testAPI.migrateStateFn = func(ctx context.Context, source, destination string, rekey bool, pageSize int, progress func(runtime_state.MigrateProgress) error) (runtime_state.MigrateProgress, error) {
+		p := runtime_state.MigrateProgress{Copied: pageSize, LastKey: "a"}
+		progress(p)
+		return p, migrateErr
+	}
+
+	t.Run("Migrate - 200 OK streams the progress", func(t *testing.T) {
+		resp := migrate("lister", "plain")
+
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "application/x-ndjson", resp.ContentType)
+		lines := strings.Split(strings.TrimSpace(string(resp.RawBody)), "\n")
+		assert.Len(t, lines, 2)
+		var last MigrateStateProgress
+		assert.NoError(t, json.Unmarshal([]byte(lines[1]), &last))
+		assert.Equal(t, MigrateStateProgress{Copied: 10, LastKey: "a", Done: true}, last)
+	})
+
+	t.Run("Migrate - failure reported by the last line", func(t *testing.T) {
+		migrateErr = errors.New("write failed")
+		defer func() { migrateErr = nil }()
+		resp := migrate("lister", "plain")
+
+		assert.Equal(t, 200, resp.StatusCode)
+		lines := strings.Split(strings.TrimSpace(string(resp.RawBody)), "\n")
+		var last MigrateStateProgress
+		assert.NoError(t, json.Unmarshal([]byte(lines[len(lines)-1]), &last))
+		assert.False(t, last.Done)
+		assert.Equal(t, "ERR_STATE_MIGRATE", last.Error.ErrorCode)
+	})
+
+	t.Run("Migrate - 400 for unknown or identical stores", func(t *testing.T) {
+		resp := migrate("missing", "plain")
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_STORE_NOT_FOUND", resp.ErrorBody["errorCode"])
+
+		resp = migrate("lister", "lister")
+		assert.Equal(t, 400, resp.StatusCode)
+	})
+
+	t.Run("Migrate - 501 when the source doesn't list keys", func(t *testing.T) {
+		resp := migrate("plain", "lister")
+		assert.Equal(t, 501, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_STORE_NOT_SUPPORTED", resp.ErrorBody["errorCode"])
+	})
+
+	fakeServer.Shutdown()
+}
+
 func createExporters(meta exporters.Metadata) {
 	exporter := stringexporter.NewStringExporter(logger.NewLogger("fakeLogger"))
 	exporter.Init("fakeID", "fakeAddress", meta)
@@ -1269,6 +1509,11 @@ func (f *fakeHTTPServer) DoRequest(method, path string, body []byte, params map[
 	r.Header.Set("Content-Type", "application/json")
 	if len(headers) == 1 {
 		r.Header.Set("If-Match", headers[0])
+	} else {
+		// more headers are given as pairs of name and value
+		for i := 0; i+1 < len(headers); i += 2 {
+			r.Header.Set(headers[i], headers[i+1])
+		}
 	}
 	res, err := f.client.Do(r)
 	if err != nil {
@@ -1422,6 +1667,288 @@ func TestV1StateEndpoints(t *testing.T) {
 	})
 }
 
+func TestV1StateEndpointsWithDefaults(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		stateStores: map[string]state.Store{
+			"store1": fakeStateStore{},
+		},
+		getStateDefaultsFn: func(storeName string) runtime_state.Defaults {
+			return runtime_state.Defaults{Consistency: state.Strong, Concurrency: state.FirstWrite}
+		},
+		json: jsoniter.ConfigFastest,
+	}
+	fakeServer.StartServer(testAPI.constructStateEndpoints())
+
+	t.Run("Get state - default consistency", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0/state/store1/good-key", nil, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, state.Strong, resp.RawHeader.Get(runtime_state.ConsistencyHeader))
+	})
+	t.Run("Get state - request consistency overrides default", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0/state/store1/good-key", nil, map[string]string{"consistency": state.Eventual})
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, state.Eventual, resp.RawHeader.Get(runtime_state.ConsistencyHeader))
+	})
+	t.Run("Save state - default options", func(t *testing.T) {
+		b, _ := json.Marshal([]state.SetRequest{{Key: "good-key"}})
+		resp := fakeServer.DoRequest("POST", "v1.0/state/store1", b, nil)
+		assert.Equal(t, 201, resp.StatusCode)
+		assert.Equal(t, state.Strong, resp.RawHeader.Get(runtime_state.ConsistencyHeader))
+		assert.Equal(t, state.FirstWrite, resp.RawHeader.Get(runtime_state.ConcurrencyHeader))
+	})
+	t.Run("Delete state - default options", func(t *testing.T) {
+		resp := fakeServer.DoRequest("DELETE", "v1.0/state/store1/good-key", nil, map[string]string{"concurrency": state.LastWrite})
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, state.Strong, resp.RawHeader.Get(runtime_state.ConsistencyHeader))
+		assert.Equal(t, state.LastWrite, resp.RawHeader.Get(runtime_state.ConcurrencyHeader))
+	})
+}
+
+func TestV1StateEndpointsWithTTL(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		stateStores: map[string]state.Store{
+			"store1":   fakeStateStore{},
+			"ttlstore": fakeTTLStateStore{},
+		},
+		json: jsoniter.ConfigFastest,
+	}
+	fakeServer.StartServer(testAPI.constructStateEndpoints())
+
+	t.Run("Save state - ttl", func(t *testing.T) {
+		b := []byte(`[{"key": "good-key", "ttl": "30s"}]`)
+		resp := fakeServer.DoRequest("POST", "v1.0/state/ttlstore", b, nil)
+		assert.Equal(t, 201, resp.StatusCode)
+	})
+	t.Run("Save state - invalid ttl", func(t *testing.T) {
+		for _, ttl := range []string{"soon", "1500ms", "-1s"} {
+			b := []byte(`[{"key": "good-key", "ttl": "` + ttl + `"}]`)
+			resp := fakeServer.DoRequest("POST", "v1.0/state/ttlstore", b, nil)
+			assert.Equal(t, 400, resp.StatusCode, ttl)
+		}
+	})
+	t.Run("Save state - ttl on a store without ttl", func(t *testing.T) {
+		b := []byte(`[{"key": "good-key", "ttl": "30s"}]`)
+		resp := fakeServer.DoRequest("POST", "v1.0/state/store1", b, nil)
+		assert.Equal(t, 501, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_STORE_NOT_SUPPORTED", resp.ErrorBody["errorCode"])
+	})
+}
+
+func TestV1StateEndpointsWithPartialResults(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		stateStores: map[string]state.Store{"store1": fakeStateStore{}},
+		json:        jsoniter.ConfigFastest,
+	}
+	fakeServer.StartServer(testAPI.constructStateEndpoints())
+
+	t.Run("Save state - results of every key", func(t *testing.T) {
+		b := []byte(`[{"key": "good-key"}, {"key": "bad-key"}]`)
+		resp := fakeServer.DoRequest("POST", "v1.0/state/store1", b, map[string]string{"partialResults": "true"})
+
+		assert.Equal(t, 200, resp.StatusCode)
+		var results []SaveStateResult
+		assert.NoError(t, json.Unmarshal(resp.RawBody, &results))
+		assert.Len(t, results, 2)
+		assert.Equal(t, SaveStateResult{Key: "good-key"}, results[0])
+		assert.Equal(t, "bad-key", results[1].Key)
+		assert.Equal(t, "ERR_STATE_SAVE", results[1].Error.ErrorCode)
+	})
+
+	t.Run("Save state - failed key fails the request without partial results", func(t *testing.T) {
+		b := []byte(`[{"key": "good-key"}, {"key": "bad-key"}]`)
+		resp := fakeServer.DoRequest("POST", "v1.0/state/store1", b, nil)
+
+		assert.Equal(t, 500, resp.StatusCode)
+	})
+}
+
+func TestV1StateEndpointsWithETags(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		stateStores: map[string]state.Store{"store1": fakeVersionedStateStore{}},
+		json:        jsoniter.ConfigFastest,
+	}
+	fakeServer.StartServer(testAPI.constructStateEndpoints())
+
+	t.Run("Save state - one of several etags", func(t *testing.T) {
+		b := []byte(`[{"key": "good-key", "etag": "1", "etags": ["2", "3"]}, {"key": "other-key"}]`)
+		resp := fakeServer.DoRequest("POST", "v1.0/state/store1", b, nil)
+		assert.Equal(t, 201, resp.StatusCode)
+	})
+	t.Run("Save state - none of several etags", func(t *testing.T) {
+		b := []byte(`[{"key": "good-key", "etag": "1", "etags": ["3"]}]`)
+		resp := fakeServer.DoRequest("POST", "v1.0/state/store1", b, nil)
+		assert.Equal(t, 500, resp.StatusCode)
+	})
+	t.Run("Delete state - one of several etags", func(t *testing.T) {
+		resp := fakeServer.DoRequest("DELETE", "v1.0/state/store1/good-key", nil, nil, `"1", "2"`)
+		assert.Equal(t, 200, resp.StatusCode)
+	})
+	t.Run("Delete state - none of several etags", func(t *testing.T) {
+		resp := fakeServer.DoRequest("DELETE", "v1.0/state/store1/good-key", nil, nil, `"1", "3"`)
+		assert.Equal(t, 500, resp.StatusCode)
+	})
+}
+
+func TestIfMatchETags(t *testing.T) {
+	assert.Equal(t, []string{"1", "2"}, ifMatchETags(`"1", "2"`))
+	assert.Equal(t, []string{"1", "2"}, ifMatchETags(`"1","2","1"`))
+	// single and unquoted etags are passed to the state store as they are
+	assert.Nil(t, ifMatchETags(`"1"`))
+	assert.Nil(t, ifMatchETags(`1, 2`))
+	assert.Nil(t, ifMatchETags("`~!@#$%^&*()_+-={}[]|\\:\";'<>?,./'"))
+	assert.Nil(t, ifMatchETags(""))
+}
+
+// fakeVersionedStateStore accepts the writes without an ETag or with the ETag 2
+type fakeVersionedStateStore struct {
+	fakeStateStore
+}
+
+func (c fakeVersionedStateStore) Set(req *state.SetRequest) error {
+	if req.ETag != "" && req.ETag != "2" {
+		return errors.New("ETag mismatch")
+	}
+	return nil
+}
+
+func (c fakeVersionedStateStore) BulkSet(req []state.SetRequest) error {
+	for _, r := range req {
+		if err := c.Set(&r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c fakeVersionedStateStore) Delete(req *state.DeleteRequest) error {
+	if req.ETag != "" && req.ETag != "2" {
+		return errors.New("ETag mismatch")
+	}
+	return nil
+}
+
+func TestV1StateEndpointsWithProjection(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		stateStores: map[string]state.Store{"store1": fakeJSONStateStore{}},
+		json:        jsoniter.ConfigFastest,
+	}
+	fakeServer.StartServer(testAPI.constructStateEndpoints())
+
+	t.Run("Get state - projection", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0/state/store1/doc?metadata.projection=$.customer.id,$.total", nil, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.JSONEq(t, `{"customer":{"id":"c1"},"total":10}`, string(resp.RawBody))
+		assert.Equal(t, "1", resp.RawHeader.Get("ETag"))
+	})
+	t.Run("Get state - invalid projection", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0/state/store1/doc?metadata.projection=customer", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_PROJECTION", resp.ErrorBody["errorCode"])
+	})
+	t.Run("Get state - projection of a value that isn't JSON", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0/state/store1/text?metadata.projection=$.id", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_PROJECTION", resp.ErrorBody["errorCode"])
+	})
+}
+
+// fakeJSONStateStore holds a JSON document and a text value, and fails requests with metadata meant for the runtime
+type fakeJSONStateStore struct {
+	fakeStateStore
+}
+
+func (c fakeJSONStateStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	if _, ok := req.Metadata[runtime_state.ProjectionMetadataKey]; ok {
+		return nil, errors.New("unexpected projection metadata")
+	}
+	if req.Key == "text" {
+		return &state.GetResponse{Data: []byte("plain text"), ETag: "1"}, nil
+	}
+	return &state.GetResponse{Data: []byte(`{"customer":{"id":"c1","name":"Ann"},"total":10}`), ETag: "1"}, nil
+}
+
+func TestV1StateEndpointsWithSessionConsistency(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	testAPI := &api{
+		stateStores: map[string]state.Store{
+			"store1":       fakeStateStore{},
+			"sessionstore": fakeSessionStateStore{},
+		},
+		json: jsoniter.ConfigFastest,
+	}
+	fakeServer.StartServer(testAPI.constructStateEndpoints())
+
+	t.Run("Save state - session token", func(t *testing.T) {
+		b := []byte(`[{"key": "good-key"}]`)
+		resp := fakeServer.DoRequest("POST", "v1.0/state/sessionstore", b, nil)
+		assert.Equal(t, 201, resp.StatusCode)
+		assert.Equal(t, "session:good-key", resp.RawHeader.Get(runtime_state.SessionTokenHeader))
+	})
+	t.Run("Get state - read at the session token", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0/state/sessionstore/good-key", nil, nil, runtime_state.SessionTokenHeader, "session:good-key")
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "session:good-key", resp.RawHeader.Get(runtime_state.SessionTokenHeader))
+	})
+	t.Run("Delete state - session token", func(t *testing.T) {
+		resp := fakeServer.DoRequest("DELETE", "v1.0/state/sessionstore/good-key", nil, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "session:good-key", resp.RawHeader.Get(runtime_state.SessionTokenHeader))
+	})
+	t.Run("Get state - session token on a store without session consistency", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0/state/store1/good-key", nil, nil, runtime_state.SessionTokenHeader, "session:good-key")
+		assert.Equal(t, 501, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_STORE_NOT_SUPPORTED", resp.ErrorBody["errorCode"])
+		assert.Empty(t, resp.RawHeader.Get(runtime_state.SessionTokenHeader))
+	})
+}
+
+// fakeSessionStateStore has session consistency, the session token of keys lists them
+type fakeSessionStateStore struct {
+	fakeStateStore
+}
+
+func (c fakeSessionStateStore) SessionToken(keys ...string) string {
+	return "session:" + strings.Join(keys, ",")
+}
+
+func (c fakeSessionStateStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	if token, ok := req.Metadata[runtime_state.SessionTokenMetadataKey]; ok && token != c.SessionToken(req.Key) {
+		return nil, errors.New("unknown session token")
+	}
+	return c.fakeStateStore.Get(req)
+}
+
+type fakeTTLStateStore struct {
+	fakeStateStore
+}
+
+func (c fakeTTLStateStore) SupportsTTL() bool {
+	return true
+}
+
+func (c fakeTTLStateStore) BulkSet(req []state.SetRequest) error {
+	for _, r := range req {
+		if r.Metadata[runtime_state.TTLMetadataKey] == "" {
+			return errors.New("ttl is missing")
+		}
+	}
+	return nil
+}
+
+// fakeKeyListerStateStore lists no keys
+type fakeKeyListerStateStore struct {
+	fakeStateStore
+}
+
+func (c fakeKeyListerStateStore) ListKeys(req *runtime_state.ListKeysRequest) (*runtime_state.ListKeysResponse, error) {
+	return &runtime_state.ListKeysResponse{}, nil
+}
+
 type fakeStateStore struct {
 	counter int
 }
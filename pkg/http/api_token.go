@@ -0,0 +1,60 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package http
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	// APITokenHeader is the header carrying the API token of an app calling the Dapr API
+	APITokenHeader = "dapr-api-token"
+	// AdminAPITokenHeader is the header carrying the token of a caller of the admin API
+	AdminAPITokenHeader = "dapr-admin-api-token"
+)
+
+// useAPIToken rejects the calls to the Dapr API without the configured API token.
+// The health endpoint stays open so probes don't need the token.
+func (s *server) useAPIToken(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	if s.config.APIToken == "" {
+		return next
+	}
+
+	log.Infof("enabled api token http middleware")
+	token := []byte(s.config.APIToken)
+	healthz := fmt.Sprintf("/%s/healthz", apiVersionV1)
+	return func(ctx *fasthttp.RequestCtx) {
+		if string(ctx.Path()) != healthz && subtle.ConstantTimeCompare(ctx.Request.Header.Peek(APITokenHeader), token) != 1 {
+			msg := NewErrorResponse("ERR_API_UNAUTHORIZED", "invalid api token")
+			respondWithError(ctx, fasthttp.StatusUnauthorized, msg)
+			return
+		}
+		next(ctx)
+	}
+}
+
+// useAdminAPIToken rejects the calls to the admin API without the configured admin API token.
+// The other routes of the Dapr API aren't checked.
+func (s *server) useAdminAPIToken(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	if s.config.AdminAPIToken == "" {
+		return next
+	}
+
+	token := []byte(s.config.AdminAPIToken)
+	admin := fmt.Sprintf("/%s/admin/", apiVersionV1)
+	return func(ctx *fasthttp.RequestCtx) {
+		if strings.HasPrefix(string(ctx.Path()), admin) && subtle.ConstantTimeCompare(ctx.Request.Header.Peek(AdminAPITokenHeader), token) != 1 {
+			msg := NewErrorResponse("ERR_API_UNAUTHORIZED", "invalid admin api token")
+			respondWithError(ctx, fasthttp.StatusUnauthorized, msg)
+			return
+		}
+		next(ctx)
+	}
+}
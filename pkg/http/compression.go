@@ -0,0 +1,169 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	encodingGzip     = "gzip"
+	encodingZstd     = "zstd"
+	encodingIdentity = "identity"
+
+	// maxDecompressedBodySize caps the size of a decompressed request body. It matches the fasthttp request body limit.
+	maxDecompressedBodySize = fasthttp.DefaultMaxRequestBodySize
+	// maxDecompressionRatio caps how much larger than the compressed body a decompressed body can be
+	maxDecompressionRatio = 100
+	// minCompressedResponseSize is the response body size under which compression isn't worth its cost
+	minCompressedResponseSize = 1024
+)
+
+// zstdEncoder is safe for concurrent use with EncodeAll
+var zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+
+// decompressionError is returned when a request body can't be decompressed, with the status code to respond with
+type decompressionError struct {
+	statusCode int
+	errorCode  string
+	message    string
+}
+
+func (e *decompressionError) Error() string {
+	return e.message
+}
+
+// useCompression decompresses gzip and zstd encoded request bodies and compresses
+// response bodies with the encoding negotiated through the Accept-Encoding header.
+func (s *server) useCompression(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	log.Infof("enabled compression http middleware")
+	return func(ctx *fasthttp.RequestCtx) {
+		if err := decompressRequest(ctx); err != nil {
+			de := err.(*decompressionError)
+			msg := NewErrorResponse(de.errorCode, de.message)
+			respondWithError(ctx, de.statusCode, msg)
+			return
+		}
+
+		next(ctx)
+
+		compressResponse(ctx)
+	}
+}
+
+// decompressRequest replaces an encoded request body with its decoded content
+func decompressRequest(ctx *fasthttp.RequestCtx) error {
+	encoding := strings.ToLower(strings.TrimSpace(string(ctx.Request.Header.Peek(fasthttp.HeaderContentEncoding))))
+	if encoding == "" || encoding == encodingIdentity {
+		return nil
+	}
+
+	body := ctx.Request.Body()
+	var reader io.Reader
+	switch encoding {
+	case encodingGzip, "x-gzip":
+		gr, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return &decompressionError{fasthttp.StatusBadRequest, "ERR_MALFORMED_REQUEST", fmt.Sprintf("invalid gzip body: %s", err)}
+		}
+		defer gr.Close()
+		reader = gr
+	case encodingZstd:
+		zr, err := zstd.NewReader(bytes.NewReader(body), zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(maxDecompressedBodySize))
+		if err != nil {
+			return &decompressionError{fasthttp.StatusBadRequest, "ERR_MALFORMED_REQUEST", fmt.Sprintf("invalid zstd body: %s", err)}
+		}
+		defer zr.Close()
+		reader = zr
+	default:
+		return &decompressionError{fasthttp.StatusUnsupportedMediaType, "ERR_UNSUPPORTED_CONTENT_ENCODING",
+			fmt.Sprintf("content encoding %s is not supported, use %s or %s", encoding, encodingGzip, encodingZstd)}
+	}
+
+	limit := maxDecompressedBodySize
+	if ratioLimit := len(body) * maxDecompressionRatio; ratioLimit < limit {
+		limit = ratioLimit
+	}
+	decoded, err := ioutil.ReadAll(io.LimitReader(reader, int64(limit)+1))
+	if err != nil {
+		return &decompressionError{fasthttp.StatusBadRequest, "ERR_MALFORMED_REQUEST", fmt.Sprintf("failed to decompress %s body: %s", encoding, err)}
+	}
+	if len(decoded) > limit {
+		return &decompressionError{fasthttp.StatusRequestEntityTooLarge, "ERR_REQUEST_TOO_LARGE",
+			fmt.Sprintf("decompressed body exceeds %v bytes or %v times its compressed size", maxDecompressedBodySize, maxDecompressionRatio)}
+	}
+
+	ctx.Request.SetBody(decoded)
+	ctx.Request.Header.Del(fasthttp.HeaderContentEncoding)
+	ctx.Request.Header.SetContentLength(len(decoded))
+	return nil
+}
+
+// compressResponse encodes the response body with the best encoding the client accepts
+func compressResponse(ctx *fasthttp.RequestCtx) {
+	body := ctx.Response.Body()
+	if len(body) < minCompressedResponseSize || len(ctx.Response.Header.Peek(fasthttp.HeaderContentEncoding)) > 0 {
+		return
+	}
+
+	encoding := negotiateEncoding(string(ctx.Request.Header.Peek(fasthttp.HeaderAcceptEncoding)))
+	var compressed []byte
+	switch encoding {
+	case encodingZstd:
+		compressed = zstdEncoder.EncodeAll(body, nil)
+	case encodingGzip:
+		compressed = fasthttp.AppendGzipBytes(nil, body)
+	default:
+		return
+	}
+	if len(compressed) >= len(body) {
+		return
+	}
+
+	ctx.Response.SetBodyRaw(compressed)
+	ctx.Response.Header.Set(fasthttp.HeaderContentEncoding, encoding)
+	ctx.Response.Header.Add(fasthttp.HeaderVary, fasthttp.HeaderAcceptEncoding)
+}
+
+// negotiateEncoding returns the supported encoding with the highest quality in an Accept-Encoding header.
+// zstd is preferred over gzip when both have the same quality.
+func negotiateEncoding(acceptEncoding string) string {
+	best := ""
+	bestQuality := 0.0
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		fields := strings.Split(part, ";")
+		encoding := strings.ToLower(strings.TrimSpace(fields[0]))
+		if encoding != encodingZstd && encoding != encodingGzip {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+				if err == nil {
+					quality = q
+				}
+			}
+		}
+
+		if quality > bestQuality || (quality == bestQuality && quality > 0 && encoding == encodingZstd) {
+			best = encoding
+			bestQuality = quality
+		}
+	}
+	return best
+}
@@ -0,0 +1,124 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasthttp"
+)
+
+func gzipBytes(t *testing.T, data []byte) []byte {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	_, err := w.Write(data)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+	return b.Bytes()
+}
+
+func TestUseCompression(t *testing.T) {
+	payload := bytes.Repeat([]byte(`{"key":"value"}`), 200)
+	s := NewTestServer()
+	echo := s.useCompression(func(ctx *fasthttp.RequestCtx) {
+		ctx.Response.SetBody(ctx.Request.Body())
+	})
+
+	t.Run("gzip request", func(t *testing.T) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.Set(fasthttp.HeaderContentEncoding, "gzip")
+		ctx.Request.SetBody(gzipBytes(t, payload))
+
+		echo(ctx)
+		assert.Equal(t, payload, ctx.Response.Body())
+		assert.Empty(t, ctx.Request.Header.Peek(fasthttp.HeaderContentEncoding))
+	})
+
+	t.Run("zstd request", func(t *testing.T) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.Set(fasthttp.HeaderContentEncoding, "zstd")
+		ctx.Request.SetBody(zstdEncoder.EncodeAll(payload, nil))
+
+		echo(ctx)
+		assert.Equal(t, payload, ctx.Response.Body())
+	})
+
+	t.Run("unsupported encoding", func(t *testing.T) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.Set(fasthttp.HeaderContentEncoding, "br")
+		ctx.Request.SetBody(payload)
+
+		echo(ctx)
+		assert.Equal(t, fasthttp.StatusUnsupportedMediaType, ctx.Response.StatusCode())
+	})
+
+	t.Run("decompression ratio guardrail", func(t *testing.T) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.Set(fasthttp.HeaderContentEncoding, "gzip")
+		ctx.Request.SetBody(gzipBytes(t, make([]byte, 1024*1024)))
+
+		echo(ctx)
+		assert.Equal(t, fasthttp.StatusRequestEntityTooLarge, ctx.Response.StatusCode())
+	})
+
+	t.Run("malformed body", func(t *testing.T) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.Set(fasthttp.HeaderContentEncoding, "gzip")
+		ctx.Request.SetBody(payload)
+
+		echo(ctx)
+		assert.Equal(t, fasthttp.StatusBadRequest, ctx.Response.StatusCode())
+	})
+
+	t.Run("zstd response", func(t *testing.T) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.Set(fasthttp.HeaderAcceptEncoding, "gzip, zstd")
+		ctx.Request.SetBody(payload)
+
+		echo(ctx)
+		assert.Equal(t, "zstd", string(ctx.Response.Header.Peek(fasthttp.HeaderContentEncoding)))
+		decoder, err := zstd.NewReader(nil)
+		assert.NoError(t, err)
+		decoded, err := decoder.DecodeAll(ctx.Response.Body(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, payload, decoded)
+	})
+
+	t.Run("gzip response", func(t *testing.T) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.Set(fasthttp.HeaderAcceptEncoding, "gzip")
+		ctx.Request.SetBody(payload)
+
+		echo(ctx)
+		assert.Equal(t, "gzip", string(ctx.Response.Header.Peek(fasthttp.HeaderContentEncoding)))
+		decoded, err := fasthttp.AppendGunzipBytes(nil, ctx.Response.Body())
+		assert.NoError(t, err)
+		assert.Equal(t, payload, decoded)
+	})
+
+	t.Run("small response isn't compressed", func(t *testing.T) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.Set(fasthttp.HeaderAcceptEncoding, "gzip")
+		ctx.Request.SetBody([]byte("OK"))
+
+		echo(ctx)
+		assert.Empty(t, ctx.Response.Header.Peek(fasthttp.HeaderContentEncoding))
+		assert.Equal(t, "OK", string(ctx.Response.Body()))
+	})
+}
+
+func TestNegotiateEncoding(t *testing.T) {
+	assert.Equal(t, "", negotiateEncoding(""))
+	assert.Equal(t, "", negotiateEncoding("br, deflate"))
+	assert.Equal(t, "gzip", negotiateEncoding("gzip"))
+	assert.Equal(t, "zstd", negotiateEncoding("gzip, zstd"))
+	assert.Equal(t, "gzip", negotiateEncoding("zstd;q=0.5, gzip;q=0.8"))
+	assert.Equal(t, "", negotiateEncoding("gzip;q=0"))
+}
@@ -13,6 +13,10 @@ type ServerConfig struct {
 	Port            int
 	ProfilePort     int
 	EnableProfiling bool
+	// APIToken is required from the callers of the Dapr API when set
+	APIToken string
+	// AdminAPIToken is required from the callers of the admin API when set
+	AdminAPIToken string
 }
 
 // NewServerConfig returns a new HTTP server config
@@ -0,0 +1,55 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package http
+
+import (
+	"time"
+
+	"github.com/dapr/dapr/pkg/recorder"
+	"github.com/valyala/fasthttp"
+)
+
+// unrecordedHeaders are recomputed by the client when a call is replayed
+var unrecordedHeaders = map[string]bool{
+	fasthttp.HeaderHost:          true,
+	fasthttp.HeaderContentLength: true,
+	fasthttp.HeaderConnection:    true,
+}
+
+// useRecorder records the calls to the Dapr API when a recorder is configured
+func (s *server) useRecorder(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	if s.recorder == nil {
+		return next
+	}
+
+	log.Infof("enabled recorder http middleware")
+	return func(ctx *fasthttp.RequestCtx) {
+		entry := &recorder.Entry{
+			Time:    time.Now().UTC(),
+			Method:  string(ctx.Method()),
+			Path:    string(ctx.Path()),
+			Query:   string(ctx.QueryArgs().QueryString()),
+			Headers: map[string]string{},
+			Body:    append([]byte(nil), ctx.Request.Body()...),
+		}
+		ctx.Request.Header.VisitAll(func(k, v []byte) {
+			if !unrecordedHeaders[string(k)] {
+				entry.Headers[string(k)] = string(v)
+			}
+		})
+
+		next(ctx)
+
+		entry.Duration = time.Since(entry.Time)
+		entry.StatusCode = ctx.Response.StatusCode()
+		entry.ResponseHeaders = map[string]string{}
+		ctx.Response.Header.VisitAll(func(k, v []byte) {
+			entry.ResponseHeaders[string(k)] = string(v)
+		})
+		entry.ResponseBody = append([]byte(nil), ctx.Response.Body()...)
+		s.recorder.Record(entry)
+	}
+}
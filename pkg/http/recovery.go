@@ -0,0 +1,28 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package http
+
+import (
+	"fmt"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/valyala/fasthttp"
+)
+
+// recoverHandler turns the panics of the handler of a route into 500 responses carrying the ID of their crash record
+func recoverHandler(route string, next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		defer func() {
+			if r := recover(); r != nil {
+				record := diag.RecordPanic(route, r)
+				log.Errorf("recovered from panic in %s, correlation id %s: %s\n%s", route, record.ID, record.Panic, record.Stack)
+				msg := NewErrorResponse("ERR_INTERNAL", fmt.Sprintf("internal error, correlation id %s", record.ID))
+				respondWithError(ctx, fasthttp.StatusInternalServerError, msg)
+			}
+		}()
+		next(ctx)
+	}
+}
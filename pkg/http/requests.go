@@ -5,8 +5,18 @@
 
 package http
 
+import "github.com/dapr/components-contrib/state"
+
 // OutputBindingRequest is the request object to invoke an output binding
 type OutputBindingRequest struct {
 	Metadata map[string]string `json:"metadata"`
 	Data     interface{}       `json:"data"`
 }
+
+// SaveStateRequest is a key to save with the state API. TTL is the time the key expires after, e.g. 30s.
+// ETags are more ETags the key is saved with, tried in order after the ETag of the request until one of them is current.
+type SaveStateRequest struct {
+	state.SetRequest
+	TTL   string   `json:"ttl,omitempty"`
+	ETags []string `json:"etags,omitempty"`
+}
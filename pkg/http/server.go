@@ -15,6 +15,7 @@ import (
 
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	http_middleware "github.com/dapr/dapr/pkg/middleware/http"
+	"github.com/dapr/dapr/pkg/recorder"
 	routing "github.com/fasthttp/router"
 	"github.com/valyala/fasthttp"
 	"github.com/valyala/fasthttp/pprofhandler"
@@ -32,15 +33,17 @@ type server struct {
 	tracingSpec config.TracingSpec
 	pipeline    http_middleware.Pipeline
 	api         API
+	recorder    *recorder.Recorder
 }
 
-// NewServer returns a new HTTP server
-func NewServer(api API, config ServerConfig, tracingSpec config.TracingSpec, pipeline http_middleware.Pipeline) Server {
+// NewServer returns a new HTTP server. Calls to the API are recorded when rec is not nil.
+func NewServer(api API, config ServerConfig, tracingSpec config.TracingSpec, pipeline http_middleware.Pipeline, rec *recorder.Recorder) Server {
 	return &server{
 		api:         api,
 		config:      config,
 		tracingSpec: tracingSpec,
 		pipeline:    pipeline,
+		recorder:    rec,
 	}
 }
 
@@ -49,8 +52,12 @@ func (s *server) StartNonBlocking() {
 	handler :=
 		s.useProxy(
 			s.useCors(
-				s.useComponents(
-					s.useRouter())))
+				s.useAPIToken(
+					s.useAdminAPIToken(
+						s.useCompression(
+							s.useRecorder(
+								s.useComponents(
+									s.useRouter())))))))
 
 	handler = s.useMetrics(handler)
 	handler = s.useTracing(handler)
@@ -135,7 +142,7 @@ func (s *server) getRouter(endpoints []Endpoint) *routing.Router {
 	for _, e := range endpoints {
 		path := fmt.Sprintf("/%s/%s", e.Version, e.Route)
 		for _, m := range e.Methods {
-			router.Handle(m, path, e.Handler)
+			router.Handle(m, path, recoverHandler(fmt.Sprintf("%s %s", m, path), e.Handler))
 		}
 	}
 	return router
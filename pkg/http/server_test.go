@@ -1,9 +1,12 @@
 package http
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/recorder"
 	"github.com/stretchr/testify/assert"
 	"github.com/valyala/fasthttp"
 )
@@ -35,6 +38,174 @@ func TestUseProxy(t *testing.T) {
 	h(&fasthttp.RequestCtx{})
 }
 
+type fakeRecordSink struct {
+	entries []*recorder.Entry
+}
+
+func (f *fakeRecordSink) Write(entry *recorder.Entry) error {
+	f.entries = append(f.entries, entry)
+	return nil
+}
+
+func (f *fakeRecordSink) Close() error {
+	return nil
+}
+
+func TestUseRecorder(t *testing.T) {
+	handler := func(ctx *fasthttp.RequestCtx) {
+		ctx.Response.Header.Set("ETag", "1")
+		ctx.Response.SetBodyString("life is good")
+		ctx.SetStatusCode(200)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		s := NewTestServer()
+		h := s.useRecorder(handler)
+		ctx := &fasthttp.RequestCtx{}
+		h(ctx)
+		assert.Equal(t, 200, ctx.Response.StatusCode())
+	})
+
+	t.Run("records sanitized call", func(t *testing.T) {
+		sink := &fakeRecordSink{}
+		s := NewTestServer()
+		s.recorder = recorder.NewRecorder(sink, true)
+		h := s.useRecorder(handler)
+
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.SetMethod("POST")
+		ctx.Request.SetRequestURI("/v1.0/state/store1?consistency=strong")
+		ctx.Request.Header.Set("dapr-api-token", "token")
+		ctx.Request.SetBodyString(`[{"key":"key1"}]`)
+		h(ctx)
+
+		assert.Len(t, sink.entries, 1)
+		entry := sink.entries[0]
+		assert.Equal(t, "POST", entry.Method)
+		assert.Equal(t, "/v1.0/state/store1", entry.Path)
+		assert.Equal(t, "consistency=strong", entry.Query)
+		assert.Equal(t, `[{"key":"key1"}]`, string(entry.Body))
+		assert.NotContains(t, entry.Headers, "Dapr-Api-Token")
+		assert.Equal(t, 200, entry.StatusCode)
+		assert.Equal(t, "1", entry.ResponseHeaders["Etag"])
+		assert.Equal(t, "life is good", string(entry.ResponseBody))
+	})
+
+	t.Run("drops bodies by default", func(t *testing.T) {
+		sink := &fakeRecordSink{}
+		s := NewTestServer()
+		s.recorder = recorder.NewRecorder(sink, false)
+		h := s.useRecorder(handler)
+
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.SetMethod("POST")
+		ctx.Request.SetRequestURI("/v1.0/state/store1")
+		ctx.Request.SetBodyString(`[{"key":"key1","value":"secret"}]`)
+		h(ctx)
+
+		assert.Len(t, sink.entries, 1)
+		entry := sink.entries[0]
+		assert.Empty(t, entry.Body)
+		assert.True(t, entry.BodyRedacted)
+		assert.Empty(t, entry.ResponseBody)
+		assert.Equal(t, 200, entry.StatusCode)
+	})
+}
+
+func TestUseAPIToken(t *testing.T) {
+	handler := func(ctx *fasthttp.RequestCtx) {
+		ctx.SetStatusCode(200)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		s := NewTestServer()
+		h := s.useAPIToken(handler)
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.SetRequestURI("/v1.0/state/store1")
+		h(ctx)
+		assert.Equal(t, 200, ctx.Response.StatusCode())
+	})
+
+	s := NewTestServer()
+	s.config.APIToken = "token"
+	h := s.useAPIToken(handler)
+
+	t.Run("valid token", func(t *testing.T) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.SetRequestURI("/v1.0/state/store1")
+		ctx.Request.Header.Set(APITokenHeader, "token")
+		h(ctx)
+		assert.Equal(t, 200, ctx.Response.StatusCode())
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.SetRequestURI("/v1.0/state/store1")
+		ctx.Request.Header.Set(APITokenHeader, "other")
+		h(ctx)
+		assert.Equal(t, 401, ctx.Response.StatusCode())
+		assert.Contains(t, string(ctx.Response.Body()), "ERR_API_UNAUTHORIZED")
+	})
+
+	t.Run("health endpoint without token", func(t *testing.T) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.SetRequestURI("/v1.0/healthz")
+		h(ctx)
+		assert.Equal(t, 200, ctx.Response.StatusCode())
+	})
+}
+
+func TestUseAdminAPIToken(t *testing.T) {
+	handler := func(ctx *fasthttp.RequestCtx) {
+		ctx.SetStatusCode(200)
+	}
+
+	s := NewTestServer()
+	s.config.AdminAPIToken = "token"
+	h := s.useAdminAPIToken(handler)
+
+	t.Run("valid token", func(t *testing.T) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.SetRequestURI("/v1.0/admin/snapshot")
+		ctx.Request.Header.Set(AdminAPITokenHeader, "token")
+		h(ctx)
+		assert.Equal(t, 200, ctx.Response.StatusCode())
+	})
+
+	t.Run("API token instead of the admin token", func(t *testing.T) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.SetRequestURI("/v1.0/admin/snapshot")
+		ctx.Request.Header.Set(APITokenHeader, "token")
+		h(ctx)
+		assert.Equal(t, 401, ctx.Response.StatusCode())
+		assert.Contains(t, string(ctx.Response.Body()), "ERR_API_UNAUTHORIZED")
+	})
+
+	t.Run("other routes without token", func(t *testing.T) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.SetRequestURI("/v1.0/state/store1")
+		h(ctx)
+		assert.Equal(t, 200, ctx.Response.StatusCode())
+	})
+}
+
 func NewTestServer() *server { //nolint:golint
 	return &server{}
 }
+
+func TestRecoverHandler(t *testing.T) {
+	h := recoverHandler("GET /v1.0/state/{storeName}/{key}", func(ctx *fasthttp.RequestCtx) {
+		panic("boom")
+	})
+	ctx := &fasthttp.RequestCtx{}
+	h(ctx)
+
+	assert.Equal(t, fasthttp.StatusInternalServerError, ctx.Response.StatusCode())
+	crashes := diag.RecentCrashes()
+	record := crashes[len(crashes)-1]
+	assert.Equal(t, "GET /v1.0/state/{storeName}/{key}", record.Source)
+	var resp ErrorResponse
+	assert.NoError(t, json.Unmarshal(ctx.Response.Body(), &resp))
+	assert.Equal(t, "ERR_INTERNAL", resp.ErrorCode)
+	assert.Contains(t, resp.Message, record.ID)
+}
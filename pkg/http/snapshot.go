@@ -0,0 +1,87 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package http
+
+import (
+	"context"
+
+	"github.com/dapr/dapr/pkg/actors"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/valyala/fasthttp"
+)
+
+// Snapshot is the state of the runtime exported by the admin API for support bundles.
+// Secrets must be scrubbed by the provider of the snapshot.
+type Snapshot struct {
+	ID            string                     `json:"id"`
+	Time          string                     `json:"time"`
+	Endpoints     EndpointsSnapshot          `json:"endpoints"`
+	Components    []ComponentSnapshot        `json:"components"`
+	Subscriptions []SubscriptionMetadata     `json:"subscriptions"`
+	InputBindings []InputBindingMetadata     `json:"inputBindings"`
+	ActorTypes    []string                   `json:"actorTypes"`
+	ActiveActors  []actors.ActiveActorsCount `json:"activeActors"`
+	// Crashes are the most recent panics recovered by the runtime
+	Crashes []diag.CrashRecord `json:"crashes"`
+}
+
+// EndpointsSnapshot holds the addresses the runtime serves and calls the app on
+type EndpointsSnapshot struct {
+	HTTPPort         int    `json:"httpPort"`
+	GRPCPort         int    `json:"grpcPort"`
+	InternalGRPCPort int    `json:"internalGrpcPort"`
+	AppPort          int    `json:"appPort"`
+	AppProtocol      string `json:"appProtocol"`
+}
+
+// ComponentSnapshot describes a loaded component. Metadata values are redacted.
+type ComponentSnapshot struct {
+	Name        string            `json:"name"`
+	Type        string            `json:"type"`
+	Initialized bool              `json:"initialized"`
+	Metadata    map[string]string `json:"metadata"`
+}
+
+func (a *api) constructAdminEndpoints() []Endpoint {
+	return []Endpoint{
+		{
+			Methods: []string{fasthttp.MethodGet},
+			Route:   "admin/snapshot",
+			Version: apiVersionV1,
+			Handler: a.onGetSnapshot,
+		},
+		{
+			Methods: []string{fasthttp.MethodPost},
+			Route:   "admin/state/migrate",
+			Version: apiVersionV1,
+			Handler: a.onMigrateState,
+		},
+	}
+}
+
+// onGetSnapshot exports the state of the runtime. The admin API is only served when an admin API token is set.
+func (a *api) onGetSnapshot(reqCtx *fasthttp.RequestCtx) {
+	if a.getSnapshotFn == nil {
+		msg := NewErrorResponse("ERR_ADMIN_API_DISABLED", "the admin API requires an admin API token to be configured")
+		respondWithError(reqCtx, fasthttp.StatusForbidden, msg)
+		return
+	}
+
+	snapshot := a.getSnapshotFn()
+	if a.actor != nil {
+		sc := diag.GetSpanContextFromRequestContext(reqCtx, a.tracingSpec)
+		ctx := diag.NewContext((context.Context)(reqCtx), sc)
+		snapshot.ActiveActors = a.actor.GetActiveActorsCount(ctx)
+	}
+
+	b, err := a.json.Marshal(snapshot)
+	if err != nil {
+		msg := NewErrorResponse("ERR_ADMIN_SNAPSHOT", err.Error())
+		respondWithError(reqCtx, fasthttp.StatusInternalServerError, msg)
+		return
+	}
+	respondWithJSON(reqCtx, fasthttp.StatusOK, b)
+}
@@ -0,0 +1,107 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package http
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/valyala/fasthttp"
+)
+
+const ndjsonContentType = "application/x-ndjson"
+
+// MigrateStateRequest selects the state stores of a state migration
+type MigrateStateRequest struct {
+	SourceStoreName      string `json:"sourceStoreName"`
+	DestinationStoreName string `json:"destinationStoreName"`
+	// Rekey saves the keys with the key prefix of the destination store instead of the prefix of the source store
+	Rekey bool `json:"rekey,omitempty"`
+	// PageSize is the number of keys copied between two progress lines
+	PageSize int `json:"pageSize,omitempty"`
+}
+
+// MigrateStateProgress is a line of the response of a state migration. The last line has done set, or the error of a
+// failed migration.
+type MigrateStateProgress struct {
+	Copied  int            `json:"copied"`
+	Skipped int            `json:"skipped"`
+	LastKey string         `json:"lastKey,omitempty"`
+	Done    bool           `json:"done,omitempty"`
+	Error   *ErrorResponse `json:"error,omitempty"`
+}
+
+// onMigrateState copies the keys of the app from a state store to another and streams the progress as JSON lines.
+// As the status code is sent before the copy starts, a failure of the copy is reported by the last line.
+func (a *api) onMigrateState(reqCtx *fasthttp.RequestCtx) {
+	if a.migrateStateFn == nil {
+		msg := NewErrorResponse("ERR_ADMIN_API_DISABLED", "the admin API requires an admin API token to be configured")
+		respondWithError(reqCtx, fasthttp.StatusForbidden, msg)
+		return
+	}
+	if a.stateStores == nil || len(a.stateStores) == 0 {
+		msg := NewErrorResponse("ERR_STATE_STORES_NOT_CONFIGURED", "")
+		respondWithError(reqCtx, fasthttp.StatusBadRequest, msg)
+		return
+	}
+
+	req := MigrateStateRequest{}
+	if err := a.json.Unmarshal(reqCtx.PostBody(), &req); err != nil {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
+		respondWithError(reqCtx, fasthttp.StatusBadRequest, msg)
+		return
+	}
+	for _, name := range []string{req.SourceStoreName, req.DestinationStoreName} {
+		if a.stateStores[name] == nil {
+			msg := NewErrorResponse("ERR_STATE_STORE_NOT_FOUND", fmt.Sprintf("state store name: %s", name))
+			respondWithError(reqCtx, fasthttp.StatusBadRequest, msg)
+			return
+		}
+	}
+	if req.SourceStoreName == req.DestinationStoreName {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", "the source and destination state stores must differ")
+		respondWithError(reqCtx, fasthttp.StatusBadRequest, msg)
+		return
+	}
+	if req.PageSize < 0 {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", "page size must not be negative")
+		respondWithError(reqCtx, fasthttp.StatusBadRequest, msg)
+		return
+	}
+	if err := runtime_state.RequireFeature(req.SourceStoreName, a.stateStores[req.SourceStoreName], runtime_state.FeatureListKeys); err != nil {
+		msg := NewErrorResponse("ERR_STATE_STORE_NOT_SUPPORTED", err.Error())
+		msg.Details = err
+		respondWithError(reqCtx, fasthttp.StatusNotImplemented, msg)
+		return
+	}
+
+	reqCtx.Response.SetStatusCode(fasthttp.StatusOK)
+	reqCtx.Response.Header.SetContentType(ndjsonContentType)
+	reqCtx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		write := func(p MigrateStateProgress) error {
+			b, err := a.json.Marshal(p)
+			if err != nil {
+				return err
+			}
+			w.Write(append(b, '\n'))
+			return w.Flush()
+		}
+		progress := func(p runtime_state.MigrateProgress) error {
+			return write(MigrateStateProgress{Copied: p.Copied, Skipped: p.Skipped, LastKey: p.LastKey})
+		}
+
+		// the request context is released once the handler returns, the copy stops when the client goes away instead
+		p, err := a.migrateStateFn(context.Background(), req.SourceStoreName, req.DestinationStoreName, req.Rekey, req.PageSize, progress)
+		last := MigrateStateProgress{Copied: p.Copied, Skipped: p.Skipped, LastKey: p.LastKey, Done: err == nil}
+		if err != nil {
+			msg := NewErrorResponse("ERR_STATE_MIGRATE", err.Error())
+			last.Error = &msg
+		}
+		write(last)
+	})
+}
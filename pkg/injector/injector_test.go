@@ -155,6 +155,46 @@ func TestMaxConcurrency(t *testing.T) {
 	})
 }
 
+func TestPlacementWeight(t *testing.T) {
+	t.Run("empty placement weight - should be -1", func(t *testing.T) {
+		weight, err := getPlacementWeight(map[string]string{})
+		assert.Nil(t, err)
+		assert.Equal(t, int32(-1), weight)
+	})
+
+	t.Run("invalid placement weight - should error", func(t *testing.T) {
+		_, err := getPlacementWeight(map[string]string{daprPlacementWeightKey: "invalid"})
+		assert.NotNil(t, err)
+		_, err = getPlacementWeight(map[string]string{daprPlacementWeightKey: "0"})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("valid placement weight - should be 4", func(t *testing.T) {
+		weight, err := getPlacementWeight(map[string]string{daprPlacementWeightKey: "4"})
+		assert.Nil(t, err)
+		assert.Equal(t, int32(4), weight)
+	})
+}
+
+func TestPlacementLabels(t *testing.T) {
+	t.Run("empty placement labels", func(t *testing.T) {
+		labels, err := getPlacementLabels(map[string]string{})
+		assert.Nil(t, err)
+		assert.Empty(t, labels)
+	})
+
+	t.Run("invalid placement labels - should error", func(t *testing.T) {
+		_, err := getPlacementLabels(map[string]string{daprPlacementLabelsKey: "gpu"})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("valid placement labels", func(t *testing.T) {
+		labels, err := getPlacementLabels(map[string]string{daprPlacementLabelsKey: "pool=gpu,zone=eu-1"})
+		assert.Nil(t, err)
+		assert.Equal(t, "pool=gpu,zone=eu-1", labels)
+	})
+}
+
 func TestKubernetesDNS(t *testing.T) {
 	dns := getKubernetesDNS("a", "b")
 	assert.Equal(t, "a.b.svc.cluster.local", dns)
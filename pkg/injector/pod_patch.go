@@ -13,6 +13,7 @@ import (
 
 	scheme "github.com/dapr/dapr/pkg/client/clientset/versioned"
 	"github.com/dapr/dapr/pkg/credentials"
+	"github.com/dapr/dapr/pkg/placement"
 	"github.com/dapr/dapr/pkg/runtime"
 	"github.com/dapr/dapr/pkg/sentry/certs"
 	"k8s.io/api/admission/v1beta1"
@@ -35,6 +36,8 @@ const (
 	daprLogLevel                      = "dapr.io/log-level"
 	daprLogAsJSON                     = "dapr.io/log-as-json"
 	daprMaxConcurrencyKey             = "dapr.io/max-concurrency"
+	daprPlacementWeightKey            = "dapr.io/placement-weight"
+	daprPlacementLabelsKey            = "dapr.io/placement-labels"
 	daprMetricsPortKey                = "dapr.io/metrics-port"
 	daprCPULimitKey                   = "dapr.io/sidecar-cpu-limit"
 	daprMemoryLimitKey                = "dapr.io/sidecar-memory-limit"
@@ -189,6 +192,25 @@ func getMaxConcurrency(annotations map[string]string) (int32, error) {
 	return getInt32Annotation(annotations, daprMaxConcurrencyKey)
 }
 
+func getPlacementWeight(annotations map[string]string) (int32, error) {
+	weight, err := getInt32Annotation(annotations, daprPlacementWeightKey)
+	if err != nil || weight == -1 {
+		return -1, err
+	}
+	if weight < 1 {
+		return -1, fmt.Errorf("%s must be a positive integer: %v", daprPlacementWeightKey, weight)
+	}
+	return weight, nil
+}
+
+func getPlacementLabels(annotations map[string]string) (string, error) {
+	labels := getStringAnnotation(annotations, daprPlacementLabelsKey)
+	if _, err := placement.ParseLabels(labels); err != nil {
+		return "", fmt.Errorf("%s must be comma separated name=value pairs: %s", daprPlacementLabelsKey, err)
+	}
+	return labels, nil
+}
+
 func getAppPort(annotations map[string]string) (int32, error) {
 	return getInt32Annotation(annotations, daprPortKey)
 }
@@ -342,6 +364,14 @@ func getSidecarContainer(annotations map[string]string, id, daprSidecarImage, na
 	if err != nil {
 		log.Warn(err)
 	}
+	placementWeight, err := getPlacementWeight(annotations)
+	if err != nil {
+		log.Warn(err)
+	}
+	placementLabels, err := getPlacementLabels(annotations)
+	if err != nil {
+		log.Warn(err)
+	}
 
 	c := &corev1.Container{
 		Name:            sidecarContainerName,
@@ -436,6 +466,14 @@ func getSidecarContainer(annotations map[string]string, id, daprSidecarImage, na
 		c.Args = append(c.Args, "--enable-profiling")
 	}
 
+	if placementWeight > 0 {
+		c.Args = append(c.Args, "--placement-weight", fmt.Sprintf("%v", placementWeight))
+	}
+
+	if placementLabels != "" {
+		c.Args = append(c.Args, "--placement-labels", placementLabels)
+	}
+
 	if mtlsEnabled && trustAnchors != "" {
 		c.Args = append(c.Args, "--enable-mtls")
 		c.Env = append(c.Env, corev1.EnvVar{
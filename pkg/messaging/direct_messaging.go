@@ -9,6 +9,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
+	"strings"
 
 	"github.com/dapr/components-contrib/servicediscovery"
 	"github.com/dapr/dapr/pkg/channel"
@@ -18,10 +20,10 @@ import (
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
-	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
 )
 
 const (
@@ -46,6 +48,11 @@ type directMessaging struct {
 	namespace           string
 	resolver            servicediscovery.Resolver
 	tracingSpec         config.TracingSpec
+	crossNamespaceSpec  config.CrossNamespaceSpec
+	requestHeaders      *invokev1.HeaderPolicy
+	responseHeaders     *invokev1.HeaderPolicy
+	cache               *invocationCache
+	retryConditions     retryConditions
 }
 
 // NewDirectMessaging returns a new direct messaging api
@@ -55,7 +62,28 @@ func NewDirectMessaging(
 	appChannel channel.AppChannel,
 	clientConnFn messageClientConnection,
 	resolver servicediscovery.Resolver,
-	tracingSpec config.TracingSpec) DirectMessaging {
+	tracingSpec config.TracingSpec,
+	crossNamespaceSpec config.CrossNamespaceSpec,
+	headerForwardingSpec config.HeaderForwardingSpec,
+	invocationCacheSpec config.InvocationCacheSpec,
+	invocationRetrySpec config.InvocationRetrySpec) (DirectMessaging, error) {
+	requestHeaders, err := NewHeaderPolicy(headerForwardingSpec.Request)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request header forwarding rules: %s", err)
+	}
+	responseHeaders, err := NewHeaderPolicy(headerForwardingSpec.Response)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response header forwarding rules: %s", err)
+	}
+	cache, err := newInvocationCache(invocationCacheSpec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid invocation cache configuration: %s", err)
+	}
+	conditions, err := newRetryConditions(invocationRetrySpec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid invocation retry configuration: %s", err)
+	}
+
 	return &directMessaging{
 		appChannel:          appChannel,
 		connectionCreatorFn: clientConnFn,
@@ -65,18 +93,55 @@ func NewDirectMessaging(
 		namespace:           namespace,
 		resolver:            resolver,
 		tracingSpec:         tracingSpec,
-	}
+		crossNamespaceSpec:  crossNamespaceSpec,
+		requestHeaders:      requestHeaders,
+		responseHeaders:     responseHeaders,
+		cache:               cache,
+		retryConditions:     conditions,
+	}, nil
 }
 
-// Invoke takes a message requests and invokes an app, either local or remote
+// NewHeaderPolicy returns the policy applying header forwarding rules
+func NewHeaderPolicy(spec config.HeaderRulesSpec) (*invokev1.HeaderPolicy, error) {
+	return invokev1.NewHeaderPolicy(invokev1.HeaderRules{
+		Allow:  spec.Allow,
+		Deny:   spec.Deny,
+		Rename: spec.Rename,
+	})
+}
+
+// Invoke takes a message requests and invokes an app, either local or remote.
+// The target app ID can be suffixed with a namespace, e.g. "myapp.production", to invoke an app in another namespace.
 func (d *directMessaging) Invoke(ctx context.Context, targetAppID string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
-	if targetAppID == d.appID {
-		return d.invokeLocal(ctx, req)
+	id, namespace, err := d.requestAppIDAndNamespace(targetAppID)
+	if err != nil {
+		return nil, err
+	}
+
+	if namespace != d.namespace {
+		// enforce the policy before resolving the target so denied apps are never looked up
+		action := d.crossNamespaceAction(id, namespace)
+		diag.DefaultMonitoring.CrossNamespaceInvocation(id, namespace, action)
+		if action != config.AllowAction {
+			return nil, fmt.Errorf("invocation of app %s in namespace %s is denied by the cross-namespace invocation policy", id, namespace)
+		}
+	}
+
+	req.WithHeaderPolicy(d.requestHeaders)
+	resp, err := d.cache.invoke(targetAppID, req, func() (*invokev1.InvokeMethodResponse, error) {
+		if namespace == d.namespace && id == d.appID {
+			return d.invokeLocal(ctx, req)
+		}
+		return d.invokeWithRetry(ctx, invokeRemoteRetryCount, targetAppID, d.invokeRemote, req)
+	})
+	if resp != nil {
+		resp.WithHeaderPolicy(d.responseHeaders)
 	}
-	return d.invokeWithRetry(ctx, invokeRemoteRetryCount, targetAppID, d.invokeRemote, req)
+	return resp, err
 }
 
 // invokeWithRetry will call a remote endpoint for the specified number of retries and will only retry in the case of transient failures
+// matching the retry conditions
 // TODO: check why https://github.com/grpc-ecosystem/go-grpc-middleware/blob/master/retry/examples_test.go doesn't recover the connection when target
 // Server shuts down.
 func (d *directMessaging) invokeWithRetry(
@@ -91,13 +156,13 @@ func (d *directMessaging) invokeWithRetry(
 			return resp, nil
 		}
 
-		code := status.Code(err)
-		if code == codes.Unavailable || code == codes.Unauthenticated {
+		if d.retryConditions.retries(err) {
 			address, addErr := d.getAddressFromMessageRequest(targetID)
 			if addErr != nil {
 				return nil, addErr
 			}
-			_, connErr := d.connectionCreatorFn(address, targetID, false, true)
+			id, _, _ := d.requestAppIDAndNamespace(targetID)
+			_, connErr := d.connectionCreatorFn(address, id, false, true)
 			if connErr != nil {
 				return nil, connErr
 			}
@@ -113,7 +178,7 @@ func (d *directMessaging) invokeLocal(ctx context.Context, req *invokev1.InvokeM
 		return nil, errors.New("cannot invoke local endpoint: app channel not initialized")
 	}
 
-	return d.appChannel.InvokeMethod(ctx, req)
+	return d.appChannel.InvokeMethod(channel.WithPath(ctx, channel.InvocationPath), req)
 }
 
 func (d *directMessaging) invokeRemote(ctx context.Context, targetID string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
@@ -122,7 +187,8 @@ func (d *directMessaging) invokeRemote(ctx context.Context, targetID string, req
 		return nil, err
 	}
 
-	conn, err := d.connectionCreatorFn(address, targetID, false, false)
+	id, _, _ := d.requestAppIDAndNamespace(targetID)
+	conn, err := d.connectionCreatorFn(address, id, false, false)
 	if err != nil {
 		return nil, err
 	}
@@ -136,9 +202,13 @@ func (d *directMessaging) invokeRemote(ctx context.Context, targetID string, req
 	defer span.End()
 
 	ctx = diag.AppendToOutgoingGRPCContext(ctx, span.SpanContext())
-	clientV1 := internalv1pb.NewDaprInternalClient(conn)
-	resp, err := clientV1.CallLocal(ctx, req.Proto())
+	// the peer is only set once the request is sent on a connection to the target
+	var p peer.Peer
+	resp, err := NewInvocationClient(conn).CallLocal(ctx, req.Proto(), grpc.Peer(&p))
 	if err != nil {
+		if p.Addr == nil && status.Code(err) == codes.Unavailable {
+			return nil, connectError{err}
+		}
 		return nil, err
 	}
 
@@ -148,6 +218,52 @@ func (d *directMessaging) invokeRemote(ctx context.Context, targetID string, req
 }
 
 func (d *directMessaging) getAddressFromMessageRequest(appID string) (string, error) {
-	request := servicediscovery.ResolveRequest{ID: appID, Namespace: d.namespace, Port: d.grpcPort}
+	id, namespace, err := d.requestAppIDAndNamespace(appID)
+	if err != nil {
+		return "", err
+	}
+	request := servicediscovery.ResolveRequest{ID: id, Namespace: namespace, Port: d.grpcPort}
 	return d.resolver.ResolveID(request)
 }
+
+// requestAppIDAndNamespace splits a target of the form "id.namespace" when cross-namespace invocation is enabled.
+// The namespace follows the last dot since namespaces can't contain dots, so "my.app.prod" targets "my.app" in "prod".
+// When it is disabled the target is used as is and the namespace of the runtime applies.
+func (d *directMessaging) requestAppIDAndNamespace(targetAppID string) (string, string, error) {
+	if !d.crossNamespaceSpec.Enabled {
+		return targetAppID, d.namespace, nil
+	}
+
+	i := strings.LastIndex(targetAppID, ".")
+	if i < 0 {
+		return targetAppID, d.namespace, nil
+	}
+	id, namespace := targetAppID[:i], targetAppID[i+1:]
+	if id == "" || namespace == "" {
+		return "", "", fmt.Errorf("invalid app id %s", targetAppID)
+	}
+	return id, namespace, nil
+}
+
+// crossNamespaceAction returns the action of the first cross-namespace rule matching the target, or the default action.
+// Cross-namespace calls are allowed when no policy is configured.
+func (d *directMessaging) crossNamespaceAction(appID, namespace string) string {
+	for _, rule := range d.crossNamespaceSpec.Rules {
+		if matchesPattern(rule.Namespace, namespace) && matchesPattern(rule.AppID, appID) {
+			return strings.ToLower(rule.Action)
+		}
+	}
+
+	if d.crossNamespaceSpec.DefaultAction == "" {
+		return config.AllowAction
+	}
+	return strings.ToLower(d.crossNamespaceSpec.DefaultAction)
+}
+
+func matchesPattern(pattern, value string) bool {
+	if pattern == "" {
+		return true
+	}
+	matched, err := path.Match(pattern, value)
+	return err == nil && matched
+}
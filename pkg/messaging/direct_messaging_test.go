@@ -0,0 +1,120 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package messaging
+
+import (
+	"context"
+	"testing"
+
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+)
+
+func newTestDirectMessaging(spec config.CrossNamespaceSpec) *directMessaging {
+	d, _ := NewDirectMessaging("app1", "default", 50002, modes.KubernetesMode, nil, nil, nil, config.TracingSpec{}, spec, config.HeaderForwardingSpec{}, config.InvocationCacheSpec{}, config.InvocationRetrySpec{})
+	return d.(*directMessaging)
+}
+
+func TestRequestAppIDAndNamespace(t *testing.T) {
+	t.Run("dotted app id without opt-in", func(t *testing.T) {
+		d := newTestDirectMessaging(config.CrossNamespaceSpec{})
+		id, namespace, err := d.requestAppIDAndNamespace("app2.prod")
+		assert.NoError(t, err)
+		assert.Equal(t, "app2.prod", id)
+		assert.Equal(t, "default", namespace)
+	})
+
+	d := newTestDirectMessaging(config.CrossNamespaceSpec{Enabled: true})
+
+	t.Run("app id only", func(t *testing.T) {
+		id, namespace, err := d.requestAppIDAndNamespace("app2")
+		assert.NoError(t, err)
+		assert.Equal(t, "app2", id)
+		assert.Equal(t, "default", namespace)
+	})
+
+	t.Run("app id with namespace", func(t *testing.T) {
+		id, namespace, err := d.requestAppIDAndNamespace("app2.prod")
+		assert.NoError(t, err)
+		assert.Equal(t, "app2", id)
+		assert.Equal(t, "prod", namespace)
+	})
+
+	t.Run("dotted app id with namespace", func(t *testing.T) {
+		id, namespace, err := d.requestAppIDAndNamespace("my.app2.prod")
+		assert.NoError(t, err)
+		assert.Equal(t, "my.app2", id)
+		assert.Equal(t, "prod", namespace)
+	})
+
+	t.Run("invalid app id", func(t *testing.T) {
+		_, _, err := d.requestAppIDAndNamespace("app2.")
+		assert.Error(t, err)
+		_, _, err = d.requestAppIDAndNamespace(".prod")
+		assert.Error(t, err)
+	})
+}
+
+func TestCrossNamespaceAction(t *testing.T) {
+	t.Run("allowed without policy", func(t *testing.T) {
+		d := newTestDirectMessaging(config.CrossNamespaceSpec{})
+		assert.Equal(t, config.AllowAction, d.crossNamespaceAction("app2", "prod"))
+	})
+
+	t.Run("first matching rule wins", func(t *testing.T) {
+		d := newTestDirectMessaging(config.CrossNamespaceSpec{
+			DefaultAction: config.DenyAction,
+			Rules: []config.CrossNamespaceRule{
+				{Namespace: "prod-*", AppID: "billing", Action: config.DenyAction},
+				{Namespace: "prod-*", Action: config.AllowAction},
+			},
+		})
+		assert.Equal(t, config.DenyAction, d.crossNamespaceAction("billing", "prod-east"))
+		assert.Equal(t, config.AllowAction, d.crossNamespaceAction("orders", "prod-east"))
+		assert.Equal(t, config.DenyAction, d.crossNamespaceAction("orders", "staging"))
+	})
+
+	t.Run("denied call is rejected before name resolution", func(t *testing.T) {
+		d := newTestDirectMessaging(config.CrossNamespaceSpec{Enabled: true, DefaultAction: config.DenyAction})
+		_, err := d.Invoke(context.Background(), "app2.prod", invokev1.NewInvokeMethodRequest("method"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "denied")
+	})
+}
+
+func TestInvokeHeaderForwarding(t *testing.T) {
+	var forwarded invokev1.DaprInternalMetadata
+	mockAppChannel := new(channelt.MockAppChannel)
+	mockAppChannel.On("InvokeMethod", mock.Anything, mock.AnythingOfType("*v1.InvokeMethodRequest")).
+		Run(func(args mock.Arguments) {
+			forwarded = args.Get(1).(*invokev1.InvokeMethodRequest).Metadata()
+		}).
+		Return(invokev1.NewInvokeMethodResponse(200, "OK", nil).WithHeaders(metadata.Pairs("x-internal-user", "u", "x-app", "a")), nil)
+
+	d, err := NewDirectMessaging("app1", "default", 50002, modes.KubernetesMode, mockAppChannel, nil, nil, config.TracingSpec{}, config.CrossNamespaceSpec{},
+		config.HeaderForwardingSpec{
+			Request:  config.HeaderRulesSpec{Deny: []string{"authorization"}, Rename: map[string]string{"x-user": "x-forwarded-user"}},
+			Response: config.HeaderRulesSpec{Deny: []string{"x-internal-*"}},
+		}, config.InvocationCacheSpec{}, config.InvocationRetrySpec{})
+	assert.NoError(t, err)
+
+	req := invokev1.NewInvokeMethodRequest("method").WithMetadata(map[string][]string{"Authorization": {"token"}, "x-user": {"u"}})
+	resp, err := d.Invoke(context.Background(), "app1", req)
+	assert.NoError(t, err)
+	assert.Len(t, forwarded, 1)
+	assert.Equal(t, []string{"u"}, forwarded["x-forwarded-user"].Values)
+	assert.Len(t, resp.Headers(), 1)
+	assert.Equal(t, []string{"a"}, resp.Headers()["x-app"].Values)
+
+	_, err = NewDirectMessaging("app1", "default", 50002, modes.KubernetesMode, nil, nil, nil, config.TracingSpec{}, config.CrossNamespaceSpec{},
+		config.HeaderForwardingSpec{Request: config.HeaderRulesSpec{Deny: []string{"["}}}, config.InvocationCacheSpec{}, config.InvocationRetrySpec{})
+	assert.Error(t, err)
+}
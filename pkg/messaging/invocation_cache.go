@@ -0,0 +1,326 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package messaging
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dapr/dapr/pkg/config"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// AgeHeader is the header with the number of seconds a response served from the invocation cache has been cached for
+	AgeHeader = "Age"
+	// CacheStatusHeader is the header reporting how the invocation cache handled the request, as defined by RFC 9211
+	CacheStatusHeader = "Cache-Status"
+
+	cacheControlHeader  = "Cache-Control"
+	authorizationHeader = "Authorization"
+	varyHeader          = "Vary"
+	cacheStatusName     = "dapr"
+
+	cacheResultHit    = "hit"
+	cacheResultMiss   = "miss"
+	cacheResultBypass = "bypass"
+
+	defaultInvocationCacheTTL        = 5 * time.Second
+	defaultInvocationCacheMaxEntries = 1000
+)
+
+// invocationCache caches the responses of HTTP GET service invocations for the freshness lifetime the target app sets
+// with max-age, or for a TTL, evicting the least recently used response when it is full. A nil invocationCache caches
+// nothing.
+type invocationCache struct {
+	ttl         time.Duration
+	maxEntries  int
+	varyHeaders []string
+
+	lock    sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type invocationCacheEntry struct {
+	key      string
+	resp     *internalv1pb.InternalInvokeResponse
+	storedAt time.Time
+	ttl      time.Duration
+	// vary lists the request headers the response varies on, per its Vary header, and variant their values
+	vary    []string
+	variant string
+}
+
+// newInvocationCache returns the invocation cache configured by the spec, or nil when caching is disabled
+func newInvocationCache(spec config.InvocationCacheSpec) (*invocationCache, error) {
+	if !spec.Enabled {
+		return nil, nil
+	}
+
+	c := &invocationCache{
+		ttl:        defaultInvocationCacheTTL,
+		maxEntries: defaultInvocationCacheMaxEntries,
+		entries:    map[string]*list.Element{},
+		lru:        list.New(),
+	}
+	if spec.TTL != "" {
+		ttl, err := time.ParseDuration(spec.TTL)
+		if err != nil || ttl <= 0 {
+			return nil, fmt.Errorf("ttl must be a positive duration: %s", spec.TTL)
+		}
+		c.ttl = ttl
+	}
+	if spec.MaxEntries < 0 {
+		return nil, fmt.Errorf("maxEntries must not be negative: %v", spec.MaxEntries)
+	}
+	if spec.MaxEntries > 0 {
+		c.maxEntries = spec.MaxEntries
+	}
+	for _, h := range spec.VaryHeaders {
+		c.varyHeaders = append(c.varyHeaders, strings.ToLower(h))
+	}
+	return c, nil
+}
+
+// invoke serves an HTTP GET invocation from the cache, or invokes the target app and caches its successful response.
+// Other invocations are passed through untouched.
+func (c *invocationCache) invoke(
+	targetAppID string,
+	req *invokev1.InvokeMethodRequest,
+	fn func() (*invokev1.InvokeMethodResponse, error)) (*invokev1.InvokeMethodResponse, error) {
+	if c == nil || req.Message().GetHttpExtension().GetVerb() != commonv1pb.HTTPExtension_GET {
+		return fn()
+	}
+
+	if hasCacheDirective(req.Metadata(), "no-cache", "no-store") {
+		diag.DefaultMonitoring.InvocationCacheLookup(targetAppID, cacheResultBypass)
+		resp, err := fn()
+		if err == nil {
+			setHeader(resp, CacheStatusHeader, cacheStatusName+"; fwd=bypass")
+		}
+		return resp, err
+	}
+
+	key := c.key(targetAppID, req)
+	if resp, age, ttl, ok := c.get(key, req); ok {
+		diag.DefaultMonitoring.InvocationCacheLookup(targetAppID, cacheResultHit)
+		setHeader(resp, AgeHeader, strconv.Itoa(int(age.Seconds())))
+		setHeader(resp, CacheStatusHeader, fmt.Sprintf("%s; hit; ttl=%d", cacheStatusName, int((ttl-age).Seconds())))
+		return resp, nil
+	}
+
+	diag.DefaultMonitoring.InvocationCacheLookup(targetAppID, cacheResultMiss)
+	resp, err := fn()
+	if err != nil {
+		return resp, err
+	}
+	status := cacheStatusName + "; fwd=miss"
+	if ttl, ok := c.freshness(resp); ok && cacheable(resp) {
+		c.add(key, req, resp, ttl)
+		status += "; stored"
+	}
+	setHeader(resp, CacheStatusHeader, status)
+	return resp, nil
+}
+
+// key identifies a response by target app, method, query string, the values of the configured vary headers of the
+// request and its credentials, so a response is never served to a caller with other credentials.
+// The credentials are hashed to not hold them in memory.
+func (c *invocationCache) key(targetAppID string, req *invokev1.InvokeMethodRequest) string {
+	var b strings.Builder
+	b.WriteString(targetAppID)
+	b.WriteString("||")
+	b.WriteString(req.Message().GetMethod())
+	b.WriteString("?")
+	b.WriteString(req.EncodeHTTPQueryString())
+	for _, h := range c.varyHeaders {
+		b.WriteString("||")
+		b.WriteString(h)
+		b.WriteString("=")
+		b.WriteString(strings.Join(headerValues(req.Metadata(), h), ","))
+	}
+	if auth := headerValues(req.Metadata(), authorizationHeader); len(auth) > 0 {
+		sum := sha256.Sum256([]byte(strings.Join(auth, ",")))
+		b.WriteString("||authorization=")
+		b.WriteString(hex.EncodeToString(sum[:]))
+	}
+	return b.String()
+}
+
+// get returns a copy of the cached response matching the headers the response varies on, how long it has been
+// cached for and its freshness lifetime
+func (c *invocationCache) get(key string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, time.Duration, time.Duration, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, 0, 0, false
+	}
+	entry := elem.Value.(*invocationCacheEntry)
+	age := time.Since(entry.storedAt)
+	if age >= entry.ttl {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		return nil, 0, 0, false
+	}
+	if variant(req, entry.vary) != entry.variant {
+		return nil, 0, 0, false
+	}
+	c.lru.MoveToFront(elem)
+
+	resp, err := invokev1.InternalInvokeResponse(proto.Clone(entry.resp).(*internalv1pb.InternalInvokeResponse))
+	if err != nil {
+		return nil, 0, 0, false
+	}
+	return resp, age, entry.ttl, true
+}
+
+// add caches a copy of the response for ttl, evicting the least recently used response when the cache is full.
+// A response replaces the one cached for another variant of the request.
+func (c *invocationCache) add(key string, req *invokev1.InvokeMethodRequest, resp *invokev1.InvokeMethodResponse, ttl time.Duration) {
+	vary := varyHeaders(resp)
+	entry := &invocationCacheEntry{
+		key:      key,
+		resp:     proto.Clone(resp.Proto()).(*internalv1pb.InternalInvokeResponse),
+		storedAt: time.Now(),
+		ttl:      ttl,
+		vary:     vary,
+		variant:  variant(req, vary),
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*invocationCacheEntry).key)
+	}
+}
+
+// freshness returns how long a response may be cached for: the s-maxage or max-age the target app sets, or the TTL of
+// the cache when it sets neither. A response with a zero lifetime isn't cached.
+func (c *invocationCache) freshness(resp *invokev1.InvokeMethodResponse) (time.Duration, bool) {
+	for _, directive := range []string{"s-maxage", "max-age"} {
+		if v, ok := cacheDirectiveValue(resp.Headers(), directive); ok {
+			seconds, err := strconv.Atoi(v)
+			if err != nil || seconds <= 0 {
+				return 0, false
+			}
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+	return c.ttl, true
+}
+
+// cacheable returns true for 200 OK responses the target app doesn't forbid caching
+func cacheable(resp *invokev1.InvokeMethodResponse) bool {
+	if !resp.IsHTTPResponse() || resp.Status().Code != http.StatusOK {
+		return false
+	}
+	for _, h := range varyHeaders(resp) {
+		if h == "*" {
+			return false
+		}
+	}
+	return !hasCacheDirective(resp.Headers(), "no-store", "no-cache", "private")
+}
+
+// varyHeaders returns the lower case names of the request headers listed in the Vary header of the response
+func varyHeaders(resp *invokev1.InvokeMethodResponse) []string {
+	var names []string
+	for _, v := range headerValues(resp.Headers(), varyHeader) {
+		for _, name := range strings.Split(v, ",") {
+			if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// variant returns the values of the request headers a response varies on
+func variant(req *invokev1.InvokeMethodRequest, headers []string) string {
+	var b strings.Builder
+	for _, h := range headers {
+		b.WriteString(h)
+		b.WriteString("=")
+		b.WriteString(strings.Join(headerValues(req.Metadata(), h), ","))
+		b.WriteString("||")
+	}
+	return b.String()
+}
+
+// cacheDirectiveValue returns the value of a directive of the Cache-Control header, e.g. the seconds of max-age
+func cacheDirectiveValue(md invokev1.DaprInternalMetadata, directive string) (string, bool) {
+	for _, v := range headerValues(md, cacheControlHeader) {
+		for _, d := range strings.Split(v, ",") {
+			d = strings.TrimSpace(d)
+			if strings.HasPrefix(strings.ToLower(d), directive+"=") {
+				return strings.Trim(d[len(directive)+1:], `"`), true
+			}
+		}
+	}
+	return "", false
+}
+
+// hasCacheDirective returns true if the Cache-Control header contains one of the directives
+func hasCacheDirective(md invokev1.DaprInternalMetadata, directives ...string) bool {
+	for _, v := range headerValues(md, cacheControlHeader) {
+		for _, d := range strings.Split(v, ",") {
+			d = strings.ToLower(strings.TrimSpace(d))
+			for _, directive := range directives {
+				if d == directive || strings.HasPrefix(d, directive+"=") {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// headerValues returns the values of a header, matching its name case-insensitively
+func headerValues(md invokev1.DaprInternalMetadata, name string) []string {
+	for k, v := range md {
+		if strings.EqualFold(k, name) {
+			return v.GetValues()
+		}
+	}
+	return nil
+}
+
+// setHeader sets a header of the response, replacing any value the target app set
+func setHeader(resp *invokev1.InvokeMethodResponse, name, value string) {
+	pb := resp.Proto()
+	for k := range pb.Headers {
+		if strings.EqualFold(k, name) {
+			delete(pb.Headers, k)
+		}
+	}
+	if pb.Headers == nil {
+		pb.Headers = invokev1.DaprInternalMetadata{}
+	}
+	pb.Headers[name] = &internalv1pb.ListStringValue{Values: []string{value}}
+}
@@ -0,0 +1,258 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package messaging
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+)
+
+func newGetRequest(method, query string, headers map[string][]string) *invokev1.InvokeMethodRequest {
+	return invokev1.NewInvokeMethodRequest(method).WithHTTPExtension("GET", query).WithMetadata(headers)
+}
+
+func newOKResponse(body string, headers ...string) (*invokev1.InvokeMethodResponse, error) {
+	return invokev1.NewInvokeMethodResponse(200, "OK", nil).WithHeaders(metadata.Pairs(headers...)).WithRawData([]byte(body), "text/plain"), nil
+}
+
+func responseHeader(resp *invokev1.InvokeMethodResponse, name string) string {
+	values := headerValues(resp.Headers(), name)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func TestNewInvocationCache(t *testing.T) {
+	c, err := newInvocationCache(config.InvocationCacheSpec{})
+	assert.NoError(t, err)
+	assert.Nil(t, c)
+
+	c, err = newInvocationCache(config.InvocationCacheSpec{Enabled: true})
+	assert.NoError(t, err)
+	assert.Equal(t, defaultInvocationCacheTTL, c.ttl)
+	assert.Equal(t, defaultInvocationCacheMaxEntries, c.maxEntries)
+
+	_, err = newInvocationCache(config.InvocationCacheSpec{Enabled: true, TTL: "soon"})
+	assert.Error(t, err)
+	_, err = newInvocationCache(config.InvocationCacheSpec{Enabled: true, MaxEntries: -1})
+	assert.Error(t, err)
+}
+
+func TestInvocationCache(t *testing.T) {
+	newCache := func(spec config.InvocationCacheSpec) *invocationCache {
+		spec.Enabled = true
+		c, err := newInvocationCache(spec)
+		assert.NoError(t, err)
+		return c
+	}
+
+	t.Run("serves cached responses", func(t *testing.T) {
+		c := newCache(config.InvocationCacheSpec{TTL: "1m"})
+		calls := 0
+		fn := func() (*invokev1.InvokeMethodResponse, error) {
+			calls++
+			return newOKResponse("orders")
+		}
+
+		resp, err := c.invoke("app2", newGetRequest("orders", "page=1", nil), fn)
+		assert.NoError(t, err)
+		assert.Equal(t, "dapr; fwd=miss; stored", responseHeader(resp, CacheStatusHeader))
+		assert.Equal(t, "", responseHeader(resp, AgeHeader))
+
+		resp, err = c.invoke("app2", newGetRequest("orders", "page=1", nil), fn)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, "0", responseHeader(resp, AgeHeader))
+		assert.Contains(t, responseHeader(resp, CacheStatusHeader), "dapr; hit")
+		_, body := resp.RawData()
+		assert.Equal(t, "orders", string(body))
+
+		c.invoke("app2", newGetRequest("orders", "page=2", nil), fn)
+		c.invoke("app3", newGetRequest("orders", "page=1", nil), fn)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("caches only GET requests", func(t *testing.T) {
+		c := newCache(config.InvocationCacheSpec{})
+		calls := 0
+		fn := func() (*invokev1.InvokeMethodResponse, error) {
+			calls++
+			return newOKResponse("ok")
+		}
+
+		for i := 0; i < 2; i++ {
+			resp, _ := c.invoke("app2", invokev1.NewInvokeMethodRequest("orders").WithHTTPExtension("POST", ""), fn)
+			assert.Equal(t, "", responseHeader(resp, CacheStatusHeader))
+		}
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("expires responses after the TTL", func(t *testing.T) {
+		c := newCache(config.InvocationCacheSpec{TTL: "10ms"})
+		calls := 0
+		fn := func() (*invokev1.InvokeMethodResponse, error) {
+			calls++
+			return newOKResponse("ok")
+		}
+
+		c.invoke("app2", newGetRequest("orders", "", nil), fn)
+		time.Sleep(20 * time.Millisecond)
+		c.invoke("app2", newGetRequest("orders", "", nil), fn)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("evicts the least recently used response", func(t *testing.T) {
+		c := newCache(config.InvocationCacheSpec{MaxEntries: 2})
+		fn := func() (*invokev1.InvokeMethodResponse, error) { return newOKResponse("ok") }
+
+		c.invoke("app2", newGetRequest("a", "", nil), fn)
+		c.invoke("app2", newGetRequest("b", "", nil), fn)
+		c.invoke("app2", newGetRequest("a", "", nil), fn)
+		c.invoke("app2", newGetRequest("c", "", nil), fn)
+
+		assert.Equal(t, 2, c.lru.Len())
+		resp, _ := c.invoke("app2", newGetRequest("a", "", nil), fn)
+		assert.Contains(t, responseHeader(resp, CacheStatusHeader), "hit")
+		resp, _ = c.invoke("app2", newGetRequest("b", "", nil), fn)
+		assert.Contains(t, responseHeader(resp, CacheStatusHeader), "miss")
+	})
+
+	t.Run("varies on the configured headers", func(t *testing.T) {
+		c := newCache(config.InvocationCacheSpec{VaryHeaders: []string{"Accept-Language"}})
+		calls := 0
+		fn := func() (*invokev1.InvokeMethodResponse, error) {
+			calls++
+			return newOKResponse("ok")
+		}
+
+		c.invoke("app2", newGetRequest("orders", "", map[string][]string{"accept-language": {"en"}, "x-trace": {"1"}}), fn)
+		c.invoke("app2", newGetRequest("orders", "", map[string][]string{"Accept-Language": {"en"}, "x-trace": {"2"}}), fn)
+		assert.Equal(t, 1, calls)
+		c.invoke("app2", newGetRequest("orders", "", map[string][]string{"accept-language": {"fr"}}), fn)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("varies on the vary header of the response", func(t *testing.T) {
+		c := newCache(config.InvocationCacheSpec{})
+		calls := 0
+		fn := func() (*invokev1.InvokeMethodResponse, error) {
+			calls++
+			return newOKResponse("ok", "vary", "Accept-Encoding")
+		}
+
+		c.invoke("app2", newGetRequest("orders", "", map[string][]string{"accept-encoding": {"gzip"}}), fn)
+		c.invoke("app2", newGetRequest("orders", "", map[string][]string{"Accept-Encoding": {"gzip"}}), fn)
+		assert.Equal(t, 1, calls)
+		resp, _ := c.invoke("app2", newGetRequest("orders", "", nil), fn)
+		assert.Contains(t, responseHeader(resp, CacheStatusHeader), "miss")
+		assert.Equal(t, 2, calls)
+
+		varyAll := func() (*invokev1.InvokeMethodResponse, error) { return newOKResponse("ok", "vary", "*") }
+		resp, _ = c.invoke("app2", newGetRequest("all", "", nil), varyAll)
+		assert.Equal(t, "dapr; fwd=miss", responseHeader(resp, CacheStatusHeader))
+	})
+
+	t.Run("doesn't share responses across credentials", func(t *testing.T) {
+		c := newCache(config.InvocationCacheSpec{})
+		calls := 0
+		fn := func() (*invokev1.InvokeMethodResponse, error) {
+			calls++
+			return newOKResponse("ok")
+		}
+
+		c.invoke("app2", newGetRequest("orders", "", map[string][]string{"authorization": {"Bearer alice"}}), fn)
+		c.invoke("app2", newGetRequest("orders", "", map[string][]string{"Authorization": {"Bearer alice"}}), fn)
+		assert.Equal(t, 1, calls)
+		c.invoke("app2", newGetRequest("orders", "", map[string][]string{"authorization": {"Bearer bob"}}), fn)
+		c.invoke("app2", newGetRequest("orders", "", nil), fn)
+		assert.Equal(t, 3, calls)
+		for key := range c.entries {
+			assert.NotContains(t, key, "alice")
+		}
+	})
+
+	t.Run("caches for the max-age of the response", func(t *testing.T) {
+		c := newCache(config.InvocationCacheSpec{TTL: "10ms"})
+		calls := 0
+		fn := func() (*invokev1.InvokeMethodResponse, error) {
+			calls++
+			return newOKResponse("ok", "cache-control", "public, max-age=60")
+		}
+
+		c.invoke("app2", newGetRequest("orders", "", nil), fn)
+		time.Sleep(20 * time.Millisecond)
+		resp, _ := c.invoke("app2", newGetRequest("orders", "", nil), fn)
+		assert.Equal(t, 1, calls)
+		assert.Contains(t, responseHeader(resp, CacheStatusHeader), "ttl=59")
+
+		shared := func() (*invokev1.InvokeMethodResponse, error) {
+			return newOKResponse("ok", "cache-control", "max-age=60, s-maxage=0")
+		}
+		resp, _ = c.invoke("app2", newGetRequest("shared", "", nil), shared)
+		assert.Equal(t, "dapr; fwd=miss", responseHeader(resp, CacheStatusHeader))
+	})
+
+	t.Run("honors cache control", func(t *testing.T) {
+		c := newCache(config.InvocationCacheSpec{})
+		calls := 0
+		noStore := func() (*invokev1.InvokeMethodResponse, error) {
+			calls++
+			return newOKResponse("ok", "cache-control", "private, max-age=0")
+		}
+
+		resp, _ := c.invoke("app2", newGetRequest("private", "", nil), noStore)
+		assert.Equal(t, "dapr; fwd=miss", responseHeader(resp, CacheStatusHeader))
+		c.invoke("app2", newGetRequest("private", "", nil), noStore)
+		assert.Equal(t, 2, calls)
+
+		fn := func() (*invokev1.InvokeMethodResponse, error) { return newOKResponse("ok") }
+		c.invoke("app2", newGetRequest("orders", "", nil), fn)
+		resp, _ = c.invoke("app2", newGetRequest("orders", "", map[string][]string{"Cache-Control": {"no-cache"}}), fn)
+		assert.Equal(t, "dapr; fwd=bypass", responseHeader(resp, CacheStatusHeader))
+	})
+
+	t.Run("doesn't cache errors", func(t *testing.T) {
+		c := newCache(config.InvocationCacheSpec{})
+		fn := func() (*invokev1.InvokeMethodResponse, error) {
+			return invokev1.NewInvokeMethodResponse(500, "Internal Server Error", nil), nil
+		}
+
+		c.invoke("app2", newGetRequest("orders", "", nil), fn)
+		assert.Equal(t, 0, c.lru.Len())
+	})
+}
+
+func TestInvokeWithInvocationCache(t *testing.T) {
+	mockAppChannel := new(channelt.MockAppChannel)
+	mockAppChannel.On("InvokeMethod", mock.Anything, mock.AnythingOfType("*v1.InvokeMethodRequest")).
+		Return(newOKResponse("ok", "x-internal-user", "u"))
+
+	d, err := NewDirectMessaging("app1", "default", 50002, modes.KubernetesMode, mockAppChannel, nil, nil, config.TracingSpec{}, config.CrossNamespaceSpec{},
+		config.HeaderForwardingSpec{Response: config.HeaderRulesSpec{Deny: []string{"x-internal-*"}}},
+		config.InvocationCacheSpec{Enabled: true}, config.InvocationRetrySpec{})
+	assert.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		resp, err := d.Invoke(context.Background(), "app1", newGetRequest("orders", "", nil))
+		assert.NoError(t, err)
+		assert.Empty(t, headerValues(resp.Headers(), "x-internal-user"))
+	}
+	mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
+
+	_, err = NewDirectMessaging("app1", "default", 50002, modes.KubernetesMode, nil, nil, nil, config.TracingSpec{}, config.CrossNamespaceSpec{},
+		config.HeaderForwardingSpec{}, config.InvocationCacheSpec{Enabled: true, TTL: "-1s"}, config.InvocationRetrySpec{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,167 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package messaging
+
+import (
+	"context"
+	"time"
+
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultStreamThreshold is the size of request data above which requests are streamed in chunks
+	defaultStreamThreshold = 1 << 20
+	// defaultChunkSize is the size of the chunks of a streamed request
+	defaultChunkSize = 256 << 10
+	// defaultStreamAttempts is the number of streams used to transfer a request before giving up
+	defaultStreamAttempts = 3
+	// defaultStreamRetryBackoff is the delay before resuming an interrupted transfer
+	defaultStreamRetryBackoff = 100 * time.Millisecond
+)
+
+// InvocationClient calls the internal invocation API of another Dapr instance.
+// Requests with large data are streamed in checksummed chunks, and a transfer interrupted by a transient
+// connection loss is resumed from the data the callee already received instead of restarting from zero.
+// Requests are sent with a single call when the callee doesn't support the streaming protocol.
+type InvocationClient struct {
+	client          internalv1pb.DaprInternalClient
+	streamThreshold int
+	chunkSize       int
+	maxAttempts     int
+	retryBackoff    time.Duration
+}
+
+// NewInvocationClient returns a client of the internal invocation API served on conn
+func NewInvocationClient(conn *grpc.ClientConn) *InvocationClient {
+	return &InvocationClient{
+		client:          internalv1pb.NewDaprInternalClient(conn),
+		streamThreshold: defaultStreamThreshold,
+		chunkSize:       defaultChunkSize,
+		maxAttempts:     defaultStreamAttempts,
+		retryBackoff:    defaultStreamRetryBackoff,
+	}
+}
+
+// CallLocal invokes the app of the callee.
+// A streamed transfer is only resumed if it fails before the callee received the whole request data. Once it did,
+// the callee may have invoked its app, and errors, including the status returned by the app, are returned as is.
+func (c *InvocationClient) CallLocal(ctx context.Context, req *internalv1pb.InternalInvokeRequest, opts ...grpc.CallOption) (*internalv1pb.InternalInvokeResponse, error) {
+	if req.Message == nil || req.Message.Data == nil || len(req.Message.Data.Value) <= c.streamThreshold {
+		return c.client.CallLocal(ctx, req, opts...)
+	}
+
+	header, data := invokev1.SplitRequestData(req)
+	transferID := uuid.New().String()
+	for attempt := 1; ; attempt++ {
+		resp, received, err := c.callLocalStream(ctx, transferID, header, data, opts...)
+		if err == nil {
+			return resp, nil
+		}
+		if received {
+			return nil, err
+		}
+
+		code := status.Code(err)
+		if attempt == 1 && (code == codes.Unimplemented || code == codes.FailedPrecondition) {
+			// the callee doesn't support this version of the streaming protocol
+			return c.client.CallLocal(ctx, req, opts...)
+		}
+		if attempt >= c.maxAttempts || !isResumable(code) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(c.retryBackoff):
+		}
+	}
+}
+
+// callLocalStream opens a stream for a transfer and sends the data the callee is missing.
+// received is true if the callee received the whole request data.
+func (c *InvocationClient) callLocalStream(ctx context.Context, transferID string, header *internalv1pb.InternalInvokeRequest, data []byte, opts ...grpc.CallOption) (resp *internalv1pb.InternalInvokeResponse, received bool, err error) {
+	ctx, cancel := context.WithCancel(metadata.AppendToOutgoingContext(ctx, invokev1.StreamVersionMetadataKey, invokev1.StreamVersion))
+	defer cancel()
+
+	stream, err := c.client.CallLocalStream(ctx, opts...)
+	if err != nil {
+		return nil, false, err
+	}
+	err = stream.Send(&internalv1pb.InternalInvokeRequestChunk{
+		TransferId: transferID,
+		Request:    header,
+		TotalSize:  uint64(len(data)),
+	})
+	if err != nil {
+		return nil, false, recvError(stream, err)
+	}
+	ack, err := stream.Recv()
+	if err != nil {
+		return nil, false, err
+	}
+	if ack.Offset > uint64(len(data)) {
+		return nil, false, status.Errorf(codes.Internal, "callee received %v bytes of a %v bytes transfer", ack.Offset, len(data))
+	}
+
+	for offset := int(ack.Offset); offset < len(data); offset += c.chunkSize {
+		end := offset + c.chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		chunk := data[offset:end]
+		err = stream.Send(&internalv1pb.InternalInvokeRequestChunk{
+			TransferId: transferID,
+			Offset:     uint64(offset),
+			Data:       chunk,
+			Checksum:   invokev1.ChunkChecksum(chunk),
+		})
+		if err != nil {
+			return nil, false, recvError(stream, err)
+		}
+	}
+	err = stream.CloseSend()
+	if err != nil {
+		return nil, false, err
+	}
+
+	done, err := stream.Recv()
+	if err != nil {
+		return nil, false, err
+	}
+	if !done.Received {
+		return nil, false, status.Error(codes.Internal, "callee didn't acknowledge the request data")
+	}
+	final, err := stream.Recv()
+	if err != nil {
+		return nil, true, err
+	}
+	if final.Response == nil {
+		return nil, true, status.Error(codes.Internal, "callee didn't return a response")
+	}
+	return final.Response, true, nil
+}
+
+// recvError returns the status the callee ended the stream with when a send fails
+func recvError(stream internalv1pb.DaprInternal_CallLocalStreamClient, sendErr error) error {
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+	return sendErr
+}
+
+// isResumable returns true for the errors a transfer is resumed after, which are only seen before the callee received
+// the whole request data: the connection was lost, the transfer is still held by another stream, or a chunk was corrupted
+func isResumable(code codes.Code) bool {
+	return code == codes.Unavailable || code == codes.Aborted || code == codes.DataLoss
+}
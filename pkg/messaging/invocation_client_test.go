@@ -0,0 +1,192 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package messaging
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeInternalServer receives streamed requests and can interrupt the first streams
+type fakeInternalServer struct {
+	unsupported bool
+	// failStreams is the number of streams interrupted after receiving failAfter bytes
+	failStreams int
+	failAfter   int
+	// appErr is returned instead of the response once the whole request data is received
+	appErr error
+
+	lock         sync.Mutex
+	unaryCalls   int
+	streams      int
+	offsets      []uint64
+	data         []byte
+	receivedData []byte
+}
+
+func (f *fakeInternalServer) CallActor(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+func (f *fakeInternalServer) CallLocal(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
+	f.lock.Lock()
+	f.unaryCalls++
+	_, f.receivedData = invokev1.SplitRequestData(in)
+	f.lock.Unlock()
+	return invokev1.NewInvokeMethodResponse(200, "OK", nil).Proto(), nil
+}
+
+func (f *fakeInternalServer) CallLocalStream(stream internalv1pb.DaprInternal_CallLocalStreamServer) error {
+	if f.unsupported {
+		return status.Error(codes.Unimplemented, "not implemented")
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	f.lock.Lock()
+	f.streams++
+	interrupt := f.streams <= f.failStreams
+	f.offsets = append(f.offsets, uint64(len(f.data)))
+	f.lock.Unlock()
+
+	err = stream.Send(&internalv1pb.InternalInvokeResponseChunk{Offset: uint64(len(f.data))})
+	if err != nil {
+		return err
+	}
+	for uint64(len(f.data)) < first.TotalSize {
+		if interrupt && len(f.data) >= f.failAfter {
+			return status.Error(codes.Unavailable, "connection lost")
+		}
+		chunk, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if invokev1.ChunkChecksum(chunk.Data) != chunk.Checksum {
+			return status.Error(codes.DataLoss, "checksum mismatch")
+		}
+		f.data = append(f.data, chunk.Data...)
+	}
+
+	f.lock.Lock()
+	f.receivedData = f.data
+	f.lock.Unlock()
+	err = stream.Send(&internalv1pb.InternalInvokeResponseChunk{Received: true})
+	if err != nil {
+		return err
+	}
+	if f.appErr != nil {
+		return f.appErr
+	}
+	return stream.Send(&internalv1pb.InternalInvokeResponseChunk{Response: invokev1.NewInvokeMethodResponse(200, "OK", nil).Proto()})
+}
+
+func startFakeInternalServer(t *testing.T, f *fakeInternalServer) (*InvocationClient, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	server := grpc.NewServer()
+	internalv1pb.RegisterDaprInternalServer(server, f)
+	go server.Serve(lis) //nolint:errcheck
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	assert.NoError(t, err)
+
+	client := NewInvocationClient(conn)
+	client.streamThreshold = 10
+	client.chunkSize = 4
+	client.retryBackoff = 0
+	return client, func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+func TestInvocationClient(t *testing.T) {
+	large := []byte("the quick brown fox jumps over the lazy dog")
+	newRequest := func(data []byte) *internalv1pb.InternalInvokeRequest {
+		return invokev1.NewInvokeMethodRequest("method").WithRawData(data, "text/plain").Proto()
+	}
+
+	t.Run("small requests are sent with a single call", func(t *testing.T) {
+		f := &fakeInternalServer{}
+		client, stop := startFakeInternalServer(t, f)
+		defer stop()
+
+		resp, err := client.CallLocal(context.Background(), newRequest([]byte("small")))
+		assert.NoError(t, err)
+		assert.Equal(t, int32(200), resp.Status.Code)
+		assert.Equal(t, 1, f.unaryCalls)
+		assert.Equal(t, 0, f.streams)
+	})
+
+	t.Run("large requests are streamed", func(t *testing.T) {
+		f := &fakeInternalServer{}
+		client, stop := startFakeInternalServer(t, f)
+		defer stop()
+
+		resp, err := client.CallLocal(context.Background(), newRequest(large))
+		assert.NoError(t, err)
+		assert.Equal(t, int32(200), resp.Status.Code)
+		assert.Equal(t, 1, f.streams)
+		assert.True(t, bytes.Equal(large, f.receivedData))
+	})
+
+	t.Run("interrupted transfers are resumed", func(t *testing.T) {
+		f := &fakeInternalServer{failStreams: 1, failAfter: 12}
+		client, stop := startFakeInternalServer(t, f)
+		defer stop()
+
+		resp, err := client.CallLocal(context.Background(), newRequest(large))
+		assert.NoError(t, err)
+		assert.Equal(t, int32(200), resp.Status.Code)
+		assert.Equal(t, 2, f.streams)
+		assert.Equal(t, []uint64{0, 12}, f.offsets)
+		assert.True(t, bytes.Equal(large, f.receivedData))
+	})
+
+	t.Run("app errors are not resumed", func(t *testing.T) {
+		f := &fakeInternalServer{appErr: status.Error(codes.Unavailable, "circuit breaker is open")}
+		client, stop := startFakeInternalServer(t, f)
+		defer stop()
+
+		_, err := client.CallLocal(context.Background(), newRequest(large))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, 1, f.streams)
+	})
+
+	t.Run("gives up after the maximum attempts", func(t *testing.T) {
+		f := &fakeInternalServer{failStreams: defaultStreamAttempts, failAfter: 12}
+		client, stop := startFakeInternalServer(t, f)
+		defer stop()
+
+		_, err := client.CallLocal(context.Background(), newRequest(large))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, defaultStreamAttempts, f.streams)
+	})
+
+	t.Run("falls back to a single call when streaming is unsupported", func(t *testing.T) {
+		f := &fakeInternalServer{unsupported: true}
+		client, stop := startFakeInternalServer(t, f)
+		defer stop()
+
+		resp, err := client.CallLocal(context.Background(), newRequest(large))
+		assert.NoError(t, err)
+		assert.Equal(t, int32(200), resp.Status.Code)
+		assert.Equal(t, 1, f.unaryCalls)
+		assert.True(t, bytes.Equal(large, f.receivedData))
+	})
+}
@@ -0,0 +1,80 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package messaging
+
+import (
+	"fmt"
+
+	"github.com/dapr/dapr/pkg/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// failureKind classifies the failures of remote invocations to decide whether they are retried
+type failureKind int
+
+const (
+	// failureOther is a failure that isn't retried, e.g. an error returned by the invoked app
+	failureOther failureKind = iota
+	// failureConnect is a failure before the request was sent, safe to retry for any call
+	failureConnect
+	// failureSent is a connection loss after the request was sent, which may have reached the invoked app
+	failureSent
+)
+
+// connectError is the error of an invocation that failed before the request was sent to the target
+type connectError struct {
+	error
+}
+
+// GRPCStatus returns the status of the wrapped error
+func (e connectError) GRPCStatus() *status.Status {
+	return status.Convert(e.error)
+}
+
+// classifyFailure returns the kind of failure of a remote invocation
+func classifyFailure(err error) failureKind {
+	if _, ok := err.(connectError); ok {
+		return failureConnect
+	}
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		// the sidecar of the target rejected the credentials before the request reached the app
+		return failureConnect
+	case codes.Unavailable:
+		return failureSent
+	default:
+		return failureOther
+	}
+}
+
+// retryConditions holds the kinds of failures retried
+type retryConditions map[failureKind]bool
+
+// newRetryConditions returns the retry conditions of a spec, retrying both the failures before and after the request is
+// sent when the spec lists none
+func newRetryConditions(spec config.InvocationRetrySpec) (retryConditions, error) {
+	if len(spec.Conditions) == 0 {
+		return retryConditions{failureConnect: true, failureSent: true}, nil
+	}
+	conditions := retryConditions{}
+	for _, c := range spec.Conditions {
+		switch c {
+		case config.RetryOnConnect:
+			conditions[failureConnect] = true
+		case config.RetryOnSent:
+			conditions[failureSent] = true
+		default:
+			return nil, fmt.Errorf("unknown retry condition %s: must be %s or %s", c, config.RetryOnConnect, config.RetryOnSent)
+		}
+	}
+	return conditions, nil
+}
+
+// retries returns true if the failure of an invocation is retried
+func (r retryConditions) retries(err error) bool {
+	return r[classifyFailure(err)]
+}
@@ -0,0 +1,106 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dapr/components-contrib/servicediscovery"
+	"github.com/dapr/dapr/pkg/config"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/phayes/freeport"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeResolver struct {
+	address string
+}
+
+func (r fakeResolver) ResolveID(req servicediscovery.ResolveRequest) (string, error) {
+	return r.address, nil
+}
+
+func TestClassifyFailure(t *testing.T) {
+	assert.Equal(t, failureConnect, classifyFailure(connectError{status.Error(codes.Unavailable, "connection refused")}))
+	assert.Equal(t, failureConnect, classifyFailure(status.Error(codes.Unauthenticated, "bad certificate")))
+	assert.Equal(t, failureSent, classifyFailure(status.Error(codes.Unavailable, "transport is closing")))
+	assert.Equal(t, failureOther, classifyFailure(status.Error(codes.Internal, "app failed")))
+	assert.Equal(t, failureOther, classifyFailure(errors.New("app failed")))
+
+	// connect errors keep the status of the failure
+	assert.Equal(t, codes.Unavailable, status.Code(connectError{status.Error(codes.Unavailable, "connection refused")}))
+}
+
+func TestNewRetryConditions(t *testing.T) {
+	conditions, err := newRetryConditions(config.InvocationRetrySpec{})
+	assert.NoError(t, err)
+	assert.Equal(t, retryConditions{failureConnect: true, failureSent: true}, conditions)
+
+	conditions, err = newRetryConditions(config.InvocationRetrySpec{Conditions: []string{config.RetryOnConnect}})
+	assert.NoError(t, err)
+	assert.Equal(t, retryConditions{failureConnect: true}, conditions)
+
+	_, err = newRetryConditions(config.InvocationRetrySpec{Conditions: []string{"timeout"}})
+	assert.Error(t, err)
+}
+
+func TestInvokeWithRetryConditions(t *testing.T) {
+	newDirectMessaging := func(conditions ...string) *directMessaging {
+		retry, err := newRetryConditions(config.InvocationRetrySpec{Conditions: conditions})
+		assert.NoError(t, err)
+		return &directMessaging{
+			namespace: "default",
+			resolver:  fakeResolver{address: "localhost:50002"},
+			connectionCreatorFn: func(address, id string, skipTLS, recreateIfExists bool) (*grpc.ClientConn, error) {
+				return nil, nil
+			},
+			retryConditions: retry,
+		}
+	}
+	invoke := func(d *directMessaging, failure error) int {
+		calls := 0
+		d.invokeWithRetry(context.Background(), 3, "app2", func(ctx context.Context, targetAppID string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+			calls++
+			return nil, failure
+		}, invokev1.NewInvokeMethodRequest("method"))
+		return calls
+	}
+	connectFailure := connectError{status.Error(codes.Unavailable, "connection refused")}
+	sentFailure := status.Error(codes.Unavailable, "transport is closing")
+
+	d := newDirectMessaging(config.RetryOnConnect)
+	assert.Equal(t, 3, invoke(d, connectFailure))
+	assert.Equal(t, 1, invoke(d, sentFailure))
+	assert.Equal(t, 1, invoke(d, status.Error(codes.Internal, "app failed")))
+
+	d = newDirectMessaging()
+	assert.Equal(t, 3, invoke(d, connectFailure))
+	assert.Equal(t, 3, invoke(d, sentFailure))
+}
+
+func TestInvokeRemoteConnectFailure(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+	address := fmt.Sprintf("localhost:%v", port)
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	d := &directMessaging{
+		namespace: "default",
+		resolver:  fakeResolver{address: address},
+		connectionCreatorFn: func(address, id string, skipTLS, recreateIfExists bool) (*grpc.ClientConn, error) {
+			return conn, nil
+		},
+	}
+	_, err = d.invokeRemote(context.Background(), "app2", invokev1.NewInvokeMethodRequest("method"))
+	assert.Equal(t, failureConnect, classifyFailure(err))
+}
@@ -0,0 +1,184 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package v1
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
+)
+
+// HeaderRules select the headers and gRPC metadata forwarded on service invocation.
+// Header names are matched case-insensitively against patterns which may contain wildcards, e.g. "x-internal-*".
+type HeaderRules struct {
+	// Allow lists the headers forwarded. All headers are forwarded when it is empty.
+	Allow []string
+	// Deny lists the headers stripped, even when allowed
+	Deny []string
+	// Rename maps the names of headers to the names they are forwarded with.
+	// A pattern ending with a wildcard renames the prefix of the headers it matches, e.g. "grpc-*" to "dapr-grpc-*".
+	Rename map[string]string
+}
+
+type headerRename struct {
+	from string
+	to   string
+}
+
+// HeaderPolicy strips and renames the headers and gRPC metadata crossing a boundary
+type HeaderPolicy struct {
+	allow []string
+	deny  []string
+	// renames are sorted with exact names first, then by decreasing prefix length
+	renames []headerRename
+}
+
+// httpHeaderPolicy holds the reserved gRPC metadata renamed with the dapr- prefix and the metadata
+// never set as HTTP headers when a request or response is converted to HTTP
+var httpHeaderPolicy = mustHeaderPolicy(HeaderRules{
+	// binary metadata can't be set as HTTP headers
+	Deny: []string{"*" + gRPCBinaryMetadataSuffix, ContentTypeHeader, traceparentHeader, tracestateHeader},
+	// https://github.com/grpc/grpc/blob/master/doc/PROTOCOL-HTTP2.md
+	Rename: map[string]string{
+		":method":    DaprHeaderPrefix + "method",
+		":scheme":    DaprHeaderPrefix + "scheme",
+		":path":      DaprHeaderPrefix + "path",
+		":authority": DaprHeaderPrefix + "authority",
+		"grpc-*":     DaprHeaderPrefix + "grpc-*",
+	},
+})
+
+// grpcMetadataPolicy holds the metadata never set as gRPC metadata when a request or response is converted to gRPC
+var grpcMetadataPolicy = mustHeaderPolicy(HeaderRules{
+	Deny: []string{traceparentHeader, tracestateHeader, tracebinMetadata},
+})
+
+// NewHeaderPolicy returns the policy applying the given rules
+func NewHeaderPolicy(rules HeaderRules) (*HeaderPolicy, error) {
+	p := &HeaderPolicy{}
+	for _, pattern := range rules.Allow {
+		if err := validateHeaderPattern(pattern); err != nil {
+			return nil, err
+		}
+		p.allow = append(p.allow, strings.ToLower(pattern))
+	}
+	for _, pattern := range rules.Deny {
+		if err := validateHeaderPattern(pattern); err != nil {
+			return nil, err
+		}
+		p.deny = append(p.deny, strings.ToLower(pattern))
+	}
+	for from, to := range rules.Rename {
+		if err := validateHeaderRename(from, to); err != nil {
+			return nil, err
+		}
+		p.renames = append(p.renames, headerRename{from: strings.ToLower(from), to: strings.ToLower(to)})
+	}
+	sort.Slice(p.renames, func(i, j int) bool {
+		a, b := p.renames[i].from, p.renames[j].from
+		aPrefix, bPrefix := strings.HasSuffix(a, "*"), strings.HasSuffix(b, "*")
+		if aPrefix != bPrefix {
+			return !aPrefix
+		}
+		if len(a) != len(b) {
+			return len(a) > len(b)
+		}
+		return a < b
+	})
+	return p, nil
+}
+
+func mustHeaderPolicy(rules HeaderRules) *HeaderPolicy {
+	p, err := NewHeaderPolicy(rules)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func validateHeaderPattern(pattern string) error {
+	if pattern == "" {
+		return fmt.Errorf("header pattern must not be empty")
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid header pattern %s: %s", pattern, err)
+	}
+	return nil
+}
+
+func validateHeaderRename(from, to string) error {
+	if from == "" || to == "" {
+		return fmt.Errorf("header rename from %q to %q must name both headers", from, to)
+	}
+	fromPrefix := strings.TrimSuffix(from, "*")
+	toPrefix := strings.TrimSuffix(to, "*")
+	if strings.ContainsAny(fromPrefix, "*?[") || strings.ContainsAny(toPrefix, "*?[") {
+		return fmt.Errorf("header rename from %s to %s may only use a trailing wildcard", from, to)
+	}
+	if (fromPrefix != from) != (toPrefix != to) {
+		return fmt.Errorf("header rename from %s to %s must use a trailing wildcard on both names or neither", from, to)
+	}
+	return nil
+}
+
+// Forward returns the name a header is forwarded with, or false if the header is stripped.
+// A nil policy forwards every header unchanged.
+func (p *HeaderPolicy) Forward(key string) (string, bool) {
+	if p == nil {
+		return key, true
+	}
+
+	k := strings.ToLower(key)
+	if len(p.allow) > 0 && !matchesHeaderPattern(p.allow, k) {
+		return "", false
+	}
+	if matchesHeaderPattern(p.deny, k) {
+		return "", false
+	}
+	for _, r := range p.renames {
+		if r.from == k {
+			return r.to, true
+		}
+		if prefix := strings.TrimSuffix(r.from, "*"); prefix != r.from && strings.HasPrefix(k, prefix) {
+			return strings.TrimSuffix(r.to, "*") + k[len(prefix):], true
+		}
+	}
+	return key, true
+}
+
+// Apply returns the metadata forwarded by the policy
+func (p *HeaderPolicy) Apply(md DaprInternalMetadata) DaprInternalMetadata {
+	if p == nil || md == nil {
+		return md
+	}
+
+	forwarded := DaprInternalMetadata{}
+	for k, v := range md {
+		name, ok := p.Forward(k)
+		if !ok {
+			continue
+		}
+		if existing, ok := forwarded[name]; ok {
+			// a renamed header is merged with the header already using its new name
+			values := append(append([]string{}, existing.GetValues()...), v.GetValues()...)
+			v = &internalv1pb.ListStringValue{Values: values}
+		}
+		forwarded[name] = v
+	}
+	return forwarded
+}
+
+func matchesHeaderPattern(patterns []string, key string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, key); matched {
+			return true
+		}
+	}
+	return false
+}
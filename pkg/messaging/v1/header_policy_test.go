@@ -0,0 +1,80 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package v1
+
+import (
+	"testing"
+
+	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderPolicy(t *testing.T) {
+	t.Run("nil policy forwards everything", func(t *testing.T) {
+		var p *HeaderPolicy
+		name, ok := p.Forward("Authorization")
+		assert.True(t, ok)
+		assert.Equal(t, "Authorization", name)
+	})
+
+	t.Run("deny wins over allow", func(t *testing.T) {
+		p, err := NewHeaderPolicy(HeaderRules{Allow: []string{"x-*"}, Deny: []string{"X-Internal-*"}})
+		assert.NoError(t, err)
+		_, ok := p.Forward("x-internal-auth")
+		assert.False(t, ok)
+		_, ok = p.Forward("Authorization")
+		assert.False(t, ok)
+		name, ok := p.Forward("X-Request-Id")
+		assert.True(t, ok)
+		assert.Equal(t, "X-Request-Id", name)
+	})
+
+	t.Run("exact renames win over prefix renames", func(t *testing.T) {
+		p, err := NewHeaderPolicy(HeaderRules{Rename: map[string]string{
+			"x-*":        "app-*",
+			"x-tenant-*": "tenant-*",
+			"x-user":     "forwarded-user",
+		}})
+		assert.NoError(t, err)
+		name, _ := p.Forward("X-User")
+		assert.Equal(t, "forwarded-user", name)
+		name, _ = p.Forward("x-tenant-id")
+		assert.Equal(t, "tenant-id", name)
+		name, _ = p.Forward("x-trace")
+		assert.Equal(t, "app-trace", name)
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		_, err := NewHeaderPolicy(HeaderRules{Deny: []string{"["}})
+		assert.Error(t, err)
+		_, err = NewHeaderPolicy(HeaderRules{Rename: map[string]string{"x-*": "y"}})
+		assert.Error(t, err)
+		_, err = NewHeaderPolicy(HeaderRules{Rename: map[string]string{"*-id": "id-*"}})
+		assert.Error(t, err)
+	})
+
+	t.Run("apply strips, renames and merges metadata", func(t *testing.T) {
+		p, err := NewHeaderPolicy(HeaderRules{Deny: []string{"authorization"}, Rename: map[string]string{"x-old": "x-new"}})
+		assert.NoError(t, err)
+		md := DaprInternalMetadata{
+			"Authorization": {Values: []string{"secret"}},
+			"x-old":         {Values: []string{"a"}},
+			"x-new":         {Values: []string{"b"}},
+		}
+		forwarded := p.Apply(md)
+		assert.Len(t, forwarded, 1)
+		assert.ElementsMatch(t, []string{"a", "b"}, forwarded["x-new"].Values)
+		assert.Equal(t, []string{"b"}, md["x-new"].Values)
+	})
+
+	t.Run("request policy", func(t *testing.T) {
+		p, err := NewHeaderPolicy(HeaderRules{Deny: []string{"x-internal-token"}})
+		assert.NoError(t, err)
+		req := NewInvokeMethodRequest("method").WithMetadata(map[string][]string{"x-internal-token": {"t"}, "x-app": {"a"}})
+		req.WithHeaderPolicy(p)
+		assert.Equal(t, DaprInternalMetadata{"x-app": &internalv1pb.ListStringValue{Values: []string{"a"}}}, req.Metadata())
+	})
+}
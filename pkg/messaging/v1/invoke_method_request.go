@@ -70,6 +70,12 @@ func (imr *InvokeMethodRequest) WithMetadata(md map[string][]string) *InvokeMeth
 	return imr
 }
 
+// WithHeaderPolicy strips and renames the metadata of the request according to the policy
+func (imr *InvokeMethodRequest) WithHeaderPolicy(p *HeaderPolicy) *InvokeMethodRequest {
+	imr.r.Metadata = p.Apply(imr.r.Metadata)
+	return imr
+}
+
 // WithRawData sets message data and content_type
 func (imr *InvokeMethodRequest) WithRawData(data []byte, contentType string) *InvokeMethodRequest {
 	if contentType == "" {
@@ -85,6 +85,13 @@ func (imr *InvokeMethodResponse) WithTrailers(trailer metadata.MD) *InvokeMethod
 	return imr
 }
 
+// WithHeaderPolicy strips and renames the headers and trailers of the response according to the policy
+func (imr *InvokeMethodResponse) WithHeaderPolicy(p *HeaderPolicy) *InvokeMethodResponse {
+	imr.r.Headers = p.Apply(imr.r.Headers)
+	imr.r.Trailers = p.Apply(imr.r.Trailers)
+	return imr
+}
+
 // Status gets Response status
 func (imr *InvokeMethodResponse) Status() *internalv1pb.Status {
 	return imr.r.GetStatus()
@@ -0,0 +1,51 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package v1
+
+import (
+	"hash/crc32"
+
+	internalv1pb "github.com/dapr/dapr/pkg/proto/daprinternal/v1"
+	"github.com/golang/protobuf/ptypes/any"
+)
+
+const (
+	// StreamVersionMetadataKey is the gRPC metadata item with the version of the CallLocalStream protocol used by the caller
+	StreamVersionMetadataKey = "dapr-stream-version"
+	// StreamVersion is the version of the CallLocalStream protocol
+	StreamVersion = "1"
+)
+
+// ChunkChecksum returns the checksum of the data of a CallLocalStream chunk
+func ChunkChecksum(data []byte) uint32 {
+	return crc32.ChecksumIEEE(data)
+}
+
+// SplitRequestData returns a shallow copy of a request without its data, and the data.
+// The copy is sent in the first message of a CallLocalStream stream and shares all the fields of the request but
+// its data, so it must not be modified.
+func SplitRequestData(pb *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeRequest, []byte) {
+	if pb.Message == nil || pb.Message.Data == nil {
+		return pb, nil
+	}
+	header := *pb
+	message := *pb.Message
+	message.Data = &any.Any{TypeUrl: pb.Message.Data.TypeUrl}
+	header.Message = &message
+	return &header, pb.Message.Data.Value
+}
+
+// JoinRequestData sets the data received on a CallLocalStream stream on its request
+func JoinRequestData(header *internalv1pb.InternalInvokeRequest, data []byte) *internalv1pb.InternalInvokeRequest {
+	if header.Message == nil || (header.Message.Data == nil && len(data) == 0) {
+		return header
+	}
+	if header.Message.Data == nil {
+		header.Message.Data = &any.Any{}
+	}
+	header.Message.Data.Value = data
+	return header
+}
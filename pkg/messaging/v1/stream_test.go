@@ -0,0 +1,33 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitJoinRequestData(t *testing.T) {
+	req := NewInvokeMethodRequest("method").WithRawData([]byte("payload"), "text/plain").Proto()
+
+	header, data := SplitRequestData(req)
+	assert.Equal(t, []byte("payload"), data)
+	assert.Empty(t, header.Message.Data.Value)
+	assert.Equal(t, []byte("payload"), req.Message.Data.Value, "the request is left untouched")
+
+	joined := JoinRequestData(header, data)
+	assert.Equal(t, req.Message.Data.Value, joined.Message.Data.Value)
+	assert.Equal(t, req.Message.ContentType, joined.Message.ContentType)
+}
+
+func TestSplitRequestDataWithoutData(t *testing.T) {
+	req := NewInvokeMethodRequest("method").Proto()
+
+	header, data := SplitRequestData(req)
+	assert.Nil(t, data)
+	assert.Nil(t, JoinRequestData(header, data).Message.Data)
+}
@@ -104,16 +104,11 @@ func isPermanentHTTPHeader(hdr string) bool {
 	return false
 }
 
-func isTraceCorrleationHeaderKey(key string) bool {
-	k := strings.ToLower(key)
-	return k == tracestateHeader || k == traceparentHeader || k == tracebinMetadata
-}
-
 // InternalMetadataToGrpcMetadata converts internal metadata map to gRPC metadata
 func InternalMetadataToGrpcMetadata(internalMD DaprInternalMetadata, httpHeaderConversion bool) metadata.MD {
 	var md = metadata.MD{}
 	for k, listVal := range internalMD {
-		if isTraceCorrleationHeaderKey(k) {
+		if _, ok := grpcMetadataPolicy.Forward(k); !ok {
 			continue
 		}
 
@@ -135,26 +130,15 @@ func IsGRPCProtocol(internalMD DaprInternalMetadata) bool {
 	return strings.HasPrefix(originContentType, GRPCContentType)
 }
 
-func reservedGRPCMetadataToDaprPrefixHeader(key string) string {
-	// https://github.com/grpc/grpc/blob/master/doc/PROTOCOL-HTTP2.md
-	if key == ":method" || key == ":scheme" || key == ":path" || key == ":authority" {
-		return DaprHeaderPrefix + key[1:]
-	}
-	if strings.HasPrefix(key, "grpc-") {
-		return DaprHeaderPrefix + key
-	}
-
-	return key
-}
-
 // InternalMetadataToHTTPHeader converts internal metadata pb to HTTP headers
 func InternalMetadataToHTTPHeader(internalMD DaprInternalMetadata, setHeader func(string, string)) {
 	for k, listVal := range internalMD {
-		// Skip if the header key has -bin suffix
-		if len(listVal.Values) == 0 || strings.HasSuffix(k, gRPCBinaryMetadataSuffix) || k == ContentTypeHeader || isTraceCorrleationHeaderKey(k) {
+		if len(listVal.Values) == 0 {
 			continue
 		}
-		setHeader(reservedGRPCMetadataToDaprPrefixHeader(k), listVal.Values[0])
+		if name, ok := httpHeaderPolicy.Forward(k); ok {
+			setHeader(name, listVal.Values[0])
+		}
 	}
 }
 
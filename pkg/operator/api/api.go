@@ -20,28 +20,33 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
 const serverPort = 6500
 
 var log = logger.NewLogger("dapr.operator.api")
 
-//Server runs the Dapr API server for components and configurations
+// Server runs the Dapr API server for components and configurations
 type Server interface {
 	Run(certChain *dapr_credentials.CertChain)
 	OnComponentUpdated(component *v1alpha1.Component)
 }
 
 type apiServer struct {
-	Client     scheme.Interface
-	updateChan chan (*v1alpha1.Component)
+	Client        scheme.Interface
+	KubeClient    kubernetes.Interface
+	updateChan    chan (*v1alpha1.Component)
+	fleetMetadata *fleetMetadata
 }
 
 // NewAPIServer returns a new API server
-func NewAPIServer(client scheme.Interface) Server {
+func NewAPIServer(client scheme.Interface, kubeClient kubernetes.Interface) Server {
 	return &apiServer{
-		Client:     client,
-		updateChan: make(chan *v1alpha1.Component, 1),
+		Client:        client,
+		KubeClient:    kubeClient,
+		updateChan:    make(chan *v1alpha1.Component, 1),
+		fleetMetadata: newFleetMetadata(),
 	}
 }
 
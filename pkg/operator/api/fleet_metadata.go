@@ -0,0 +1,193 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	corev1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	daprEnabledAnnotationKey = "dapr.io/enabled"
+	appIDAnnotationKey       = "dapr.io/id"
+	configAnnotationKey      = "dapr.io/config"
+	sidecarContainerName     = "daprd"
+	sidecarHTTPPort          = 3500
+
+	defaultFleetMetadataMaxAge = 30 * time.Second
+	// sidecarMetadataTimeout bounds the fetch of the metadata of a sidecar
+	sidecarMetadataTimeout = 5 * time.Second
+	// fleetMetadataParallelism bounds the sidecars whose metadata is fetched at once
+	fleetMetadataParallelism = 10
+)
+
+// sidecarMetadataFetcher returns the metadata API response of the sidecar serving its HTTP API at address
+type sidecarMetadataFetcher func(ctx context.Context, address string) ([]byte, error)
+
+// fleetMetadata caches the metadata of the sidecars by pod, so the sidecars aren't called for every request
+type fleetMetadata struct {
+	fetch   sidecarMetadataFetcher
+	lock    sync.Mutex
+	entries map[types.UID]*operatorv1pb.SidecarMetadata
+}
+
+func newFleetMetadata() *fleetMetadata {
+	client := &http.Client{Timeout: sidecarMetadataTimeout}
+	return &fleetMetadata{
+		fetch: func(ctx context.Context, address string) ([]byte, error) {
+			req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s/v1.0/metadata", address), nil)
+			if err != nil {
+				return nil, err
+			}
+			resp, err := client.Do(req.WithContext(ctx))
+			if err != nil {
+				return nil, err
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				return nil, fmt.Errorf("metadata API returned status code %v", resp.StatusCode)
+			}
+			return ioutil.ReadAll(resp.Body)
+		},
+		entries: map[types.UID]*operatorv1pb.SidecarMetadata{},
+	}
+}
+
+// GetFleetMetadata returns the metadata of the Dapr sidecars of a namespace, sorted by namespace, app ID and pod.
+// The metadata of a sidecar is fetched again once older than the max age of the request. A sidecar failing to answer
+// is returned with the metadata it last answered and the error.
+func (a *apiServer) GetFleetMetadata(ctx context.Context, in *operatorv1pb.GetFleetMetadataRequest) (*operatorv1pb.GetFleetMetadataResponse, error) {
+	if in.MaxAgeSeconds < 0 {
+		return nil, fmt.Errorf("max age must not be negative")
+	}
+	maxAge := defaultFleetMetadataMaxAge
+	if in.MaxAgeSeconds > 0 {
+		maxAge = time.Duration(in.MaxAgeSeconds) * time.Second
+	}
+	namespace := in.Namespace
+	if namespace == "" {
+		namespace = meta_v1.NamespaceAll
+	}
+
+	pods, err := a.KubeClient.CoreV1().Pods(namespace).List(meta_v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing pods: %s", err)
+	}
+	sidecars := []corev1.Pod{}
+	for _, p := range pods.Items {
+		if p.Annotations[daprEnabledAnnotationKey] != "true" || p.Status.PodIP == "" || p.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		if in.AppId != "" && p.Annotations[appIDAnnotationKey] != in.AppId {
+			continue
+		}
+		sidecars = append(sidecars, p)
+	}
+
+	resp := &operatorv1pb.GetFleetMetadataResponse{
+		Sidecars: a.fleetMetadata.get(ctx, namespace, in.AppId, sidecars, maxAge),
+	}
+	sort.Slice(resp.Sidecars, func(i, j int) bool {
+		x, y := resp.Sidecars[i], resp.Sidecars[j]
+		if x.Namespace != y.Namespace {
+			return x.Namespace < y.Namespace
+		}
+		if x.AppId != y.AppId {
+			return x.AppId < y.AppId
+		}
+		return x.PodName < y.PodName
+	})
+	return resp, nil
+}
+
+// get returns the metadata of the sidecars of pods, fetching the metadata older than maxAge. The cached metadata of
+// the other pods of the namespace and app are dropped, as the pods are gone.
+func (f *fleetMetadata) get(ctx context.Context, namespace, appID string, pods []corev1.Pod, maxAge time.Duration) []*operatorv1pb.SidecarMetadata {
+	now := time.Now()
+	sidecars := make([]*operatorv1pb.SidecarMetadata, len(pods))
+	listed := make(map[types.UID]bool, len(pods))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, fleetMetadataParallelism)
+	for i := range pods {
+		pod := &pods[i]
+		listed[pod.UID] = true
+		f.lock.Lock()
+		cached, ok := f.entries[pod.UID]
+		f.lock.Unlock()
+		if ok && cached.Error == "" && cached.FetchedAt != nil {
+			if fetchedAt, err := ptypes.Timestamp(cached.FetchedAt); err == nil && now.Sub(fetchedAt) < maxAge {
+				sidecars[i] = cached
+				continue
+			}
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, pod *corev1.Pod, cached *operatorv1pb.SidecarMetadata) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			sidecars[i] = f.fetchSidecar(ctx, pod, cached)
+		}(i, pod, cached)
+	}
+	wg.Wait()
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for uid, s := range f.entries {
+		if !listed[uid] && (namespace == meta_v1.NamespaceAll || s.Namespace == namespace) && (appID == "" || s.AppId == appID) {
+			delete(f.entries, uid)
+		}
+	}
+	for i := range pods {
+		f.entries[pods[i].UID] = sidecars[i]
+	}
+	return sidecars
+}
+
+// fetchSidecar fetches the metadata of the sidecar of pod. If the sidecar fails to answer, the metadata it last
+// answered is kept with the error.
+func (f *fleetMetadata) fetchSidecar(ctx context.Context, pod *corev1.Pod, cached *operatorv1pb.SidecarMetadata) *operatorv1pb.SidecarMetadata {
+	s := &operatorv1pb.SidecarMetadata{
+		AppId:         pod.Annotations[appIDAnnotationKey],
+		Namespace:     pod.Namespace,
+		PodName:       pod.Name,
+		Configuration: pod.Annotations[configAnnotationKey],
+	}
+	for _, c := range pod.Spec.Containers {
+		if c.Name == sidecarContainerName {
+			s.SidecarImage = c.Image
+		}
+	}
+
+	b, err := f.fetch(ctx, fmt.Sprintf("%s:%v", pod.Status.PodIP, sidecarHTTPPort))
+	if err != nil {
+		log.Debugf("error fetching the metadata of the sidecar of pod %s/%s: %s", pod.Namespace, pod.Name, err)
+		if cached != nil {
+			s.Metadata = cached.Metadata
+			s.FetchedAt = cached.FetchedAt
+		}
+		s.Error = err.Error()
+		return s
+	}
+	s.Metadata = &any.Any{Value: b}
+	s.FetchedAt = ptypes.TimestampNow()
+	return s
+}
@@ -0,0 +1,98 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package api
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newSidecarPod(namespace, name, appID, ip string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        name,
+			UID:         types.UID(namespace + "/" + name),
+			Annotations: map[string]string{daprEnabledAnnotationKey: "true", appIDAnnotationKey: appID},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app", Image: "app:1"}, {Name: sidecarContainerName, Image: "daprio/daprd:0.8.0"}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning, PodIP: ip},
+	}
+}
+
+func TestGetFleetMetadata(t *testing.T) {
+	plain := newSidecarPod("default", "plain", "plain", "10.0.0.9")
+	delete(plain.Annotations, daprEnabledAnnotationKey)
+	kubeClient := fake.NewSimpleClientset(
+		newSidecarPod("default", "orders-1", "orders", "10.0.0.1"),
+		newSidecarPod("default", "carts-1", "carts", "10.0.0.2"),
+		newSidecarPod("other", "orders-2", "orders", "10.0.0.3"),
+		plain,
+	)
+
+	var lock sync.Mutex
+	fetches := map[string]int{}
+	failing := map[string]bool{}
+	server := &apiServer{KubeClient: kubeClient, fleetMetadata: newFleetMetadata()}
+	server.fleetMetadata.fetch = func(ctx context.Context, address string) ([]byte, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		fetches[address]++
+		if failing[address] {
+			return nil, errors.New("connection refused")
+		}
+		return []byte(`{"id":"` + address + `"}`), nil
+	}
+
+	t.Run("sidecars of a namespace", func(t *testing.T) {
+		resp, err := server.GetFleetMetadata(context.Background(), &operatorv1pb.GetFleetMetadataRequest{Namespace: "default"})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Sidecars, 2)
+		assert.Equal(t, "carts", resp.Sidecars[0].AppId)
+		assert.Equal(t, "orders", resp.Sidecars[1].AppId)
+		assert.Equal(t, "daprio/daprd:0.8.0", resp.Sidecars[1].SidecarImage)
+		assert.Equal(t, `{"id":"10.0.0.1:3500"}`, string(resp.Sidecars[1].Metadata.Value))
+		assert.NotNil(t, resp.Sidecars[1].FetchedAt)
+		assert.Empty(t, resp.Sidecars[1].Error)
+	})
+
+	t.Run("sidecars of an app in all namespaces", func(t *testing.T) {
+		resp, err := server.GetFleetMetadata(context.Background(), &operatorv1pb.GetFleetMetadataRequest{AppId: "orders"})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Sidecars, 2)
+		assert.Equal(t, "default", resp.Sidecars[0].Namespace)
+		assert.Equal(t, "other", resp.Sidecars[1].Namespace)
+	})
+
+	t.Run("fresh metadata is cached", func(t *testing.T) {
+		assert.Equal(t, 1, fetches["10.0.0.1:3500"])
+	})
+
+	t.Run("failing sidecar keeps its last metadata", func(t *testing.T) {
+		failing["10.0.0.1:3500"] = true
+		server.fleetMetadata.lock.Lock()
+		server.fleetMetadata.entries["default/orders-1"].FetchedAt.Seconds -= 60
+		server.fleetMetadata.lock.Unlock()
+
+		resp, err := server.GetFleetMetadata(context.Background(), &operatorv1pb.GetFleetMetadataRequest{Namespace: "default", AppId: "orders"})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Sidecars, 1)
+		assert.Equal(t, "connection refused", resp.Sidecars[0].Error)
+		assert.Equal(t, `{"id":"10.0.0.1:3500"}`, string(resp.Sidecars[0].Metadata.Value))
+		assert.Equal(t, 2, fetches["10.0.0.1:3500"])
+	})
+}
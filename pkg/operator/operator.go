@@ -116,7 +116,7 @@ func (o *operator) Run(ctx context.Context) {
 		cancel()
 	}()
 
-	o.apiServer = api.NewAPIServer(o.daprClient)
+	o.apiServer = api.NewAPIServer(o.daprClient, o.kubeClient)
 
 	var certChain *credentials.CertChain
 	if o.config.MTLSEnabled {
@@ -23,23 +23,35 @@ import (
 	blake2b "github.com/minio/blake2b-simd"
 )
 
-const replicationFactor = 10
+const (
+	replicationFactor = 10
+	// DefaultHostWeight is the weight of the hosts that don't report one
+	DefaultHostWeight = 1
+	// MaxHostWeight bounds the number of virtual nodes a single host can add to the ring
+	MaxHostWeight = 100
+)
 
 // ErrNoHosts is an error for no hosts
 var ErrNoHosts = errors.New("no hosts added")
 
+// ErrNoMatchingHosts is an error for no hosts with the labels of a lookup
+var ErrNoMatchingHosts = errors.New("no hosts with matching labels")
+
 // ConsistentHashTables is a table holding a map of consistent hashes with a given version
 type ConsistentHashTables struct {
 	Version string
 	Entries map[string]*Consistent
 }
 
-// Host represents a host of stateful entities with a given name, id, port and load
+// Host represents a host of stateful entities with a given name, id, port, load, weight and labels
 type Host struct {
-	Name  string
-	Port  int64
-	Load  int64
-	AppID string
+	Name   string
+	Port   int64
+	Load   int64
+	AppID  string
+	Weight int64
+	// Labels describe the host for the lookups requiring an affinity, e.g. pool=gpu
+	Labels map[string]string
 }
 
 // Consistent represents a data structure for consistent hashing
@@ -61,13 +73,26 @@ func NewPlacementTables(version string, entries map[string]*Consistent) *Consist
 }
 
 // NewHost returns a new host
-func NewHost(name, id string, load int64, port int64) *Host {
+func NewHost(name, id string, load int64, port int64, weight int64) *Host {
 	return &Host{
-		Name:  name,
-		Load:  load,
-		Port:  port,
-		AppID: id,
+		Name:   name,
+		Load:   load,
+		Port:   port,
+		AppID:  id,
+		Weight: NormalizeWeight(weight),
+	}
+}
+
+// NormalizeWeight returns the weight used for a host reporting the given weight.
+// Unset and invalid weights default to DefaultHostWeight and weights are capped at MaxHostWeight.
+func NormalizeWeight(weight int64) int64 {
+	if weight <= 0 {
+		return DefaultHostWeight
+	}
+	if weight > MaxHostWeight {
+		return MaxHostWeight
 	}
+	return weight
 }
 
 // NewConsistentHash returns a new consistent hash
@@ -96,8 +121,9 @@ func (c *Consistent) GetInternals() (map[uint64]string, []uint64, map[string]*Ho
 	return c.hosts, c.sortedSet, c.loadMap, c.totalLoad
 }
 
-// Add adds a host with port to the table
-func (c *Consistent) Add(host, id string, port int64) bool {
+// Add adds a host with port to the table.
+// The host gets replicationFactor virtual nodes per unit of weight.
+func (c *Consistent) Add(host, id string, port int64, weight int64) bool {
 	c.Lock()
 	defer c.Unlock()
 
@@ -105,8 +131,49 @@ func (c *Consistent) Add(host, id string, port int64) bool {
 		return true
 	}
 
-	c.loadMap[host] = &Host{Name: host, AppID: id, Load: 0, Port: port}
-	for i := 0; i < replicationFactor; i++ {
+	weight = NormalizeWeight(weight)
+	c.loadMap[host] = &Host{Name: host, AppID: id, Load: 0, Port: port, Weight: weight}
+	c.addVirtualNodes(host, weight)
+
+	return false
+}
+
+// UpdateWeight changes the weight of a host already in the ring, adding or removing its virtual nodes.
+// It returns true if the weight changed.
+func (c *Consistent) UpdateWeight(host string, weight int64) bool {
+	c.Lock()
+	defer c.Unlock()
+
+	h, ok := c.loadMap[host]
+	weight = NormalizeWeight(weight)
+	if !ok || h.Weight == weight {
+		return false
+	}
+
+	c.removeVirtualNodes(host, h.Weight)
+	h.Weight = weight
+	c.addVirtualNodes(host, weight)
+	return true
+}
+
+// UpdateLabels sets the labels of a host already in the ring.
+// It returns true if the labels changed.
+func (c *Consistent) UpdateLabels(host string, labels map[string]string) bool {
+	c.Lock()
+	defer c.Unlock()
+
+	h, ok := c.loadMap[host]
+	if !ok || EqualLabels(h.Labels, labels) {
+		return false
+	}
+	h.Labels = labels
+	return true
+}
+
+func (c *Consistent) addVirtualNodes(host string, weight int64) {
+	// the first replicationFactor nodes hash the same regardless of the weight
+	// so that hosts with the default weight keep their place in the ring
+	for i := 0; i < replicationFactor*int(weight); i++ {
 		h := c.hash(fmt.Sprintf("%s%d", host, i))
 		c.hosts[h] = host
 		c.sortedSet = append(c.sortedSet, h)
@@ -115,8 +182,14 @@ func (c *Consistent) Add(host, id string, port int64) bool {
 	sort.Slice(c.sortedSet, func(i int, j int) bool {
 		return c.sortedSet[i] < c.sortedSet[j]
 	})
+}
 
-	return false
+func (c *Consistent) removeVirtualNodes(host string, weight int64) {
+	for i := 0; i < replicationFactor*int(weight); i++ {
+		h := c.hash(fmt.Sprintf("%s%d", host, i))
+		delete(c.hosts, h)
+		c.delSlice(h)
+	}
 }
 
 // Get returns the host that owns `key`.
@@ -147,6 +220,32 @@ func (c *Consistent) GetHost(key string) (*Host, error) {
 	return c.loadMap[h], nil
 }
 
+// GetMatchingHost returns the host that owns `key` among the hosts having every label of `selector`.
+// The ring is walked from the position of the key, so every owner of the table agrees on the host.
+//
+// It returns ErrNoHosts if the ring has no hosts in it and ErrNoMatchingHosts if no host has the labels.
+func (c *Consistent) GetMatchingHost(key string, selector map[string]string) (*Host, error) {
+	if len(selector) == 0 {
+		return c.GetHost(key)
+	}
+
+	c.RLock()
+	defer c.RUnlock()
+
+	if len(c.hosts) == 0 {
+		return nil, ErrNoHosts
+	}
+
+	idx := c.search(c.hash(key))
+	for n := 0; n < len(c.sortedSet); n++ {
+		host := c.loadMap[c.hosts[c.sortedSet[(idx+n)%len(c.sortedSet)]]]
+		if host != nil && MatchLabels(host.Labels, selector) {
+			return host, nil
+		}
+	}
+	return nil, ErrNoMatchingHosts
+}
+
 // GetLeast uses Consistent Hashing With Bounded loads
 //
 // https://research.googleblog.com/2017/04/consistent-hashing-with-bounded-loads.html
@@ -233,11 +332,11 @@ func (c *Consistent) Remove(host string) bool {
 	c.Lock()
 	defer c.Unlock()
 
-	for i := 0; i < replicationFactor; i++ {
-		h := c.hash(fmt.Sprintf("%s%d", host, i))
-		delete(c.hosts, h)
-		c.delSlice(h)
+	weight := int64(DefaultHostWeight)
+	if h, ok := c.loadMap[host]; ok {
+		weight = h.Weight
 	}
+	c.removeVirtualNodes(host, weight)
 	delete(c.loadMap, host)
 	return true
 }
@@ -0,0 +1,111 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package placement
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func virtualNodes(c *Consistent, host string) int {
+	hosts, _, _, _ := c.GetInternals()
+	count := 0
+	for _, h := range hosts {
+		if h == host {
+			count++
+		}
+	}
+	return count
+}
+
+func TestAddWithWeight(t *testing.T) {
+	c := NewConsistentHash()
+	assert.False(t, c.Add("small", "app", 50002, 0))
+	assert.False(t, c.Add("big", "app", 50002, 4))
+	assert.False(t, c.Add("huge", "app", 50002, MaxHostWeight+1))
+	assert.True(t, c.Add("big", "app", 50002, 4))
+
+	assert.Equal(t, replicationFactor, virtualNodes(c, "small"))
+	assert.Equal(t, 4*replicationFactor, virtualNodes(c, "big"))
+	assert.Equal(t, MaxHostWeight*replicationFactor, virtualNodes(c, "huge"))
+	_, sortedSet, loadMap, _ := c.GetInternals()
+	assert.Len(t, sortedSet, (1+4+MaxHostWeight)*replicationFactor)
+	assert.Equal(t, int64(DefaultHostWeight), loadMap["small"].Weight)
+	assert.Equal(t, int64(4), loadMap["big"].Weight)
+}
+
+func TestWeightedDistribution(t *testing.T) {
+	c := NewConsistentHash()
+	c.Add("small", "app", 50002, 1)
+	c.Add("big", "app", 50002, 4)
+
+	owned := map[string]int{}
+	for i := 0; i < 10000; i++ {
+		host, err := c.Get(fmt.Sprintf("actor%d", i))
+		assert.NoError(t, err)
+		owned[host]++
+	}
+	assert.Greater(t, owned["big"], 2*owned["small"])
+}
+
+func TestUpdateWeight(t *testing.T) {
+	c := NewConsistentHash()
+	c.Add("a", "app", 50002, 1)
+	c.Add("b", "app", 50002, 1)
+
+	assert.False(t, c.UpdateWeight("a", 1))
+	assert.False(t, c.UpdateWeight("unknown", 2))
+	assert.True(t, c.UpdateWeight("a", 3))
+	assert.Equal(t, 3*replicationFactor, virtualNodes(c, "a"))
+	assert.True(t, c.UpdateWeight("a", 2))
+	assert.Equal(t, 2*replicationFactor, virtualNodes(c, "a"))
+
+	c.Remove("a")
+	hosts, sortedSet, loadMap, _ := c.GetInternals()
+	assert.Len(t, hosts, replicationFactor)
+	assert.Len(t, sortedSet, replicationFactor)
+	assert.Len(t, loadMap, 1)
+}
+
+func TestGetMatchingHost(t *testing.T) {
+	c := NewConsistentHash()
+	_, err := c.GetMatchingHost("actor1", map[string]string{"pool": "gpu"})
+	assert.Equal(t, ErrNoHosts, err)
+
+	c.Add("a", "app", 50002, 1)
+	c.Add("b", "app", 50002, 1)
+	c.Add("c", "app", 50002, 1)
+	assert.True(t, c.UpdateLabels("b", map[string]string{"pool": "gpu", "zone": "eu-1"}))
+	assert.True(t, c.UpdateLabels("c", map[string]string{"pool": "gpu", "zone": "us-1"}))
+	assert.False(t, c.UpdateLabels("c", map[string]string{"zone": "us-1", "pool": "gpu"}))
+	assert.False(t, c.UpdateLabels("unknown", map[string]string{"pool": "gpu"}))
+
+	owned := map[string]int{}
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("actor%d", i)
+		host, err := c.GetMatchingHost(key, map[string]string{"pool": "gpu"})
+		assert.NoError(t, err)
+		owned[host.Name]++
+
+		// without a selector, the owner of the key is returned
+		host, err = c.GetMatchingHost(key, nil)
+		assert.NoError(t, err)
+		owner, _ := c.Get(key)
+		assert.Equal(t, owner, host.Name)
+	}
+	assert.Zero(t, owned["a"])
+	assert.NotZero(t, owned["b"])
+	assert.NotZero(t, owned["c"])
+
+	host, err := c.GetMatchingHost("actor1", map[string]string{"pool": "gpu", "zone": "eu-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "b", host.Name)
+
+	_, err = c.GetMatchingHost("actor1", map[string]string{"pool": "tpu"})
+	assert.Equal(t, ErrNoMatchingHosts, err)
+}
@@ -0,0 +1,62 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package placement
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ParseLabels parses labels written as comma separated name=value pairs, e.g. "pool=gpu,zone=eu-1".
+// An empty string has no labels.
+func ParseLabels(s string) (map[string]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	labels := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		i := strings.Index(pair, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("label %q must be written as name=value", pair)
+		}
+		name, value := strings.TrimSpace(pair[:i]), strings.TrimSpace(pair[i+1:])
+		if name == "" {
+			return nil, fmt.Errorf("label %q has no name", pair)
+		}
+		if _, ok := labels[name]; ok {
+			return nil, fmt.Errorf("label %s is set twice", name)
+		}
+		labels[name] = value
+	}
+	return labels, nil
+}
+
+// FormatLabels writes labels as comma separated name=value pairs sorted by name, the format read by ParseLabels
+func FormatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for name, value := range labels {
+		pairs = append(pairs, name+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// MatchLabels returns true if labels has every label of selector with the same value
+func MatchLabels(labels, selector map[string]string) bool {
+	for name, value := range selector {
+		if v, ok := labels[name]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualLabels returns true if both labels have the same names and values
+func EqualLabels(a, b map[string]string) bool {
+	return len(a) == len(b) && MatchLabels(a, b)
+}
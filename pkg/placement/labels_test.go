@@ -0,0 +1,38 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package placement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLabels(t *testing.T) {
+	labels, err := ParseLabels("")
+	assert.NoError(t, err)
+	assert.Nil(t, labels)
+
+	labels, err = ParseLabels("pool=gpu, zone = eu-1,empty=")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"pool": "gpu", "zone": "eu-1", "empty": ""}, labels)
+	assert.Equal(t, "empty=,pool=gpu,zone=eu-1", FormatLabels(labels))
+
+	for _, s := range []string{"gpu", "=gpu", "pool=gpu,pool=cpu", "pool=gpu,"} {
+		_, err := ParseLabels(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestMatchLabels(t *testing.T) {
+	labels := map[string]string{"pool": "gpu", "zone": "eu-1"}
+	assert.True(t, MatchLabels(labels, nil))
+	assert.True(t, MatchLabels(labels, map[string]string{"pool": "gpu"}))
+	assert.False(t, MatchLabels(labels, map[string]string{"pool": "cpu"}))
+	assert.False(t, MatchLabels(nil, map[string]string{"pool": "gpu"}))
+	assert.False(t, EqualLabels(labels, map[string]string{"pool": "gpu"}))
+	assert.True(t, EqualLabels(labels, map[string]string{"zone": "eu-1", "pool": "gpu"}))
+}
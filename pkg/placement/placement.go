@@ -142,10 +142,12 @@ func (p *Service) PerformTablesUpdate(hosts []placementv1pb.PlacementService_Rep
 
 		for lk, lv := range loadMap {
 			h := placementv1pb.Host{
-				Name: lv.Name,
-				Load: lv.Load,
-				Port: lv.Port,
-				Id:   lv.AppID,
+				Name:   lv.Name,
+				Load:   lv.Load,
+				Port:   lv.Port,
+				Id:     lv.AppID,
+				Weight: lv.Weight,
+				Labels: lv.Labels,
 			}
 			table.LoadMap[lk] = &h
 		}
@@ -205,10 +207,13 @@ func (p *Service) ProcessHost(host *placementv1pb.Host) {
 			p.entries[e] = NewConsistentHash()
 		}
 
-		exists := p.entries[e].Add(host.Name, host.Id, host.Port)
+		exists := p.entries[e].Add(host.Name, host.Id, host.Port, host.Weight)
+		labelsChanged := p.entries[e].UpdateLabels(host.Name, host.Labels)
 		if !exists {
 			updateRequired = true
 			monitoring.RecordPerActorTypeReplicasCount(e, host.Name)
+		} else if p.entries[e].UpdateWeight(host.Name, host.Weight) || labelsChanged {
+			updateRequired = true
 		}
 		p.entriesLock.Unlock()
 	}
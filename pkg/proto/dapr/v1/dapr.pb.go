@@ -11,6 +11,7 @@ import (
 	any "github.com/golang/protobuf/ptypes/any"
 	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -28,6 +29,68 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type StateChangeEvent_Operation int32
+
+const (
+	StateChangeEvent_UPSERT StateChangeEvent_Operation = 0
+	StateChangeEvent_DELETE StateChangeEvent_Operation = 1
+)
+
+var StateChangeEvent_Operation_name = map[int32]string{
+	0: "UPSERT",
+	1: "DELETE",
+}
+
+var StateChangeEvent_Operation_value = map[string]int32{
+	"UPSERT": 0,
+	"DELETE": 1,
+}
+
+func (x StateChangeEvent_Operation) String() string {
+	return proto.EnumName(StateChangeEvent_Operation_name, int32(x))
+}
+
+func (StateChangeEvent_Operation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{13, 0}
+}
+
+type CrossStoreResult_Status int32
+
+const (
+	CrossStoreResult_NOT_ATTEMPTED CrossStoreResult_Status = 0
+	CrossStoreResult_COMMITTED     CrossStoreResult_Status = 1
+	// FAILED stores are left as they were before the transaction.
+	CrossStoreResult_FAILED CrossStoreResult_Status = 2
+	// COMPENSATED stores were restored after another store failed.
+	CrossStoreResult_COMPENSATED CrossStoreResult_Status = 3
+	// COMPENSATION_FAILED stores couldn't be restored and need to be repaired.
+	CrossStoreResult_COMPENSATION_FAILED CrossStoreResult_Status = 4
+)
+
+var CrossStoreResult_Status_name = map[int32]string{
+	0: "NOT_ATTEMPTED",
+	1: "COMMITTED",
+	2: "FAILED",
+	3: "COMPENSATED",
+	4: "COMPENSATION_FAILED",
+}
+
+var CrossStoreResult_Status_value = map[string]int32{
+	"NOT_ATTEMPTED":       0,
+	"COMMITTED":           1,
+	"FAILED":              2,
+	"COMPENSATED":         3,
+	"COMPENSATION_FAILED": 4,
+}
+
+func (x CrossStoreResult_Status) String() string {
+	return proto.EnumName(CrossStoreResult_Status_name, int32(x))
+}
+
+func (CrossStoreResult_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{21, 0}
+}
+
 // InvokeServiceRequest represents the request message for Service invocation.
 type InvokeServiceRequest struct {
 	// id specifies callee's app id.
@@ -83,13 +146,15 @@ func (m *InvokeServiceRequest) GetMessage() *v1.InvokeRequest {
 }
 
 type DeleteStateEnvelope struct {
-	StoreName            string        `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
-	Key                  string        `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Etag                 string        `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
-	Options              *StateOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	StoreName string        `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	Key       string        `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Etag      string        `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	Options   *StateOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	// etags are more ETags the key is deleted with, tried in order after etag until one of them is current.
+	Etags                []string `protobuf:"bytes,5,rep,name=etags,proto3" json:"etags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DeleteStateEnvelope) Reset()         { *m = DeleteStateEnvelope{} }
@@ -145,6 +210,13 @@ func (m *DeleteStateEnvelope) GetOptions() *StateOptions {
 	return nil
 }
 
+func (m *DeleteStateEnvelope) GetEtags() []string {
+	if m != nil {
+		return m.Etags
+	}
+	return nil
+}
+
 type SaveStateEnvelope struct {
 	StoreName            string          `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
 	Requests             []*StateRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
@@ -192,20 +264,112 @@ func (m *SaveStateEnvelope) GetRequests() []*StateRequest {
 	return nil
 }
 
-type GetStateEnvelope struct {
-	StoreName            string   `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
-	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Consistency          string   `protobuf:"bytes,3,opt,name=consistency,proto3" json:"consistency,omitempty"`
+// SaveBulkStateResponse holds the results of a SaveBulkStateAlpha1 request, in the order of its requests
+type SaveBulkStateResponse struct {
+	Results              []*SaveStateResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SaveBulkStateResponse) Reset()         { *m = SaveBulkStateResponse{} }
+func (m *SaveBulkStateResponse) String() string { return proto.CompactTextString(m) }
+func (*SaveBulkStateResponse) ProtoMessage()    {}
+func (*SaveBulkStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{3}
+}
+
+func (m *SaveBulkStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SaveBulkStateResponse.Unmarshal(m, b)
+}
+func (m *SaveBulkStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SaveBulkStateResponse.Marshal(b, m, deterministic)
+}
+func (m *SaveBulkStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveBulkStateResponse.Merge(m, src)
+}
+func (m *SaveBulkStateResponse) XXX_Size() int {
+	return xxx_messageInfo_SaveBulkStateResponse.Size(m)
+}
+func (m *SaveBulkStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveBulkStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveBulkStateResponse proto.InternalMessageInfo
+
+func (m *SaveBulkStateResponse) GetResults() []*SaveStateResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// SaveStateResult is the result of saving a key
+type SaveStateResult struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// error is set when the key couldn't be saved.
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *SaveStateResult) Reset()         { *m = SaveStateResult{} }
+func (m *SaveStateResult) String() string { return proto.CompactTextString(m) }
+func (*SaveStateResult) ProtoMessage()    {}
+func (*SaveStateResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{4}
+}
+
+func (m *SaveStateResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SaveStateResult.Unmarshal(m, b)
+}
+func (m *SaveStateResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SaveStateResult.Marshal(b, m, deterministic)
+}
+func (m *SaveStateResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveStateResult.Merge(m, src)
+}
+func (m *SaveStateResult) XXX_Size() int {
+	return xxx_messageInfo_SaveStateResult.Size(m)
+}
+func (m *SaveStateResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveStateResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveStateResult proto.InternalMessageInfo
+
+func (m *SaveStateResult) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SaveStateResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetStateEnvelope struct {
+	StoreName   string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	Key         string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Consistency string `protobuf:"bytes,3,opt,name=consistency,proto3" json:"consistency,omitempty"`
+	// metadata is passed to the state store. The projection item selects the fields of a JSON value returned, with a
+	// comma-separated list of JSONPath member paths, e.g. "$.customer.id".
+	Metadata             map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
 func (m *GetStateEnvelope) Reset()         { *m = GetStateEnvelope{} }
 func (m *GetStateEnvelope) String() string { return proto.CompactTextString(m) }
 func (*GetStateEnvelope) ProtoMessage()    {}
 func (*GetStateEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f3c232bd8a4c7dd, []int{3}
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{5}
 }
 
 func (m *GetStateEnvelope) XXX_Unmarshal(b []byte) error {
@@ -247,6 +411,13 @@ func (m *GetStateEnvelope) GetConsistency() string {
 	return ""
 }
 
+func (m *GetStateEnvelope) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 type GetStateResponseEnvelope struct {
 	Data                 *any.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Etag                 string   `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
@@ -259,7 +430,7 @@ func (m *GetStateResponseEnvelope) Reset()         { *m = GetStateResponseEnvelo
 func (m *GetStateResponseEnvelope) String() string { return proto.CompactTextString(m) }
 func (*GetStateResponseEnvelope) ProtoMessage()    {}
 func (*GetStateResponseEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f3c232bd8a4c7dd, []int{4}
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{6}
 }
 
 func (m *GetStateResponseEnvelope) XXX_Unmarshal(b []byte) error {
@@ -294,623 +465,2886 @@ func (m *GetStateResponseEnvelope) GetEtag() string {
 	return ""
 }
 
-type GetSecretEnvelope struct {
-	StoreName            string            `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
-	Key                  string            `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Metadata             map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+// GetBulkStateRequest holds the keys of a GetBulkStateStreamAlpha1 request
+type GetBulkStateRequest struct {
+	StoreName   string   `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	Keys        []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Consistency string   `protobuf:"bytes,3,opt,name=consistency,proto3" json:"consistency,omitempty"`
+	// parallelism is the number of keys fetched at once, 10 by default.
+	Parallelism int32 `protobuf:"varint,4,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	// metadata is passed to the state store. The projection item selects the fields of the JSON values returned.
+	Metadata             map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetSecretEnvelope) Reset()         { *m = GetSecretEnvelope{} }
-func (m *GetSecretEnvelope) String() string { return proto.CompactTextString(m) }
-func (*GetSecretEnvelope) ProtoMessage()    {}
-func (*GetSecretEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f3c232bd8a4c7dd, []int{5}
+func (m *GetBulkStateRequest) Reset()         { *m = GetBulkStateRequest{} }
+func (m *GetBulkStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBulkStateRequest) ProtoMessage()    {}
+func (*GetBulkStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{7}
 }
 
-func (m *GetSecretEnvelope) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSecretEnvelope.Unmarshal(m, b)
+func (m *GetBulkStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBulkStateRequest.Unmarshal(m, b)
 }
-func (m *GetSecretEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSecretEnvelope.Marshal(b, m, deterministic)
+func (m *GetBulkStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBulkStateRequest.Marshal(b, m, deterministic)
 }
-func (m *GetSecretEnvelope) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSecretEnvelope.Merge(m, src)
+func (m *GetBulkStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBulkStateRequest.Merge(m, src)
 }
-func (m *GetSecretEnvelope) XXX_Size() int {
-	return xxx_messageInfo_GetSecretEnvelope.Size(m)
+func (m *GetBulkStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBulkStateRequest.Size(m)
 }
-func (m *GetSecretEnvelope) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSecretEnvelope.DiscardUnknown(m)
+func (m *GetBulkStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBulkStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSecretEnvelope proto.InternalMessageInfo
+var xxx_messageInfo_GetBulkStateRequest proto.InternalMessageInfo
 
-func (m *GetSecretEnvelope) GetStoreName() string {
+func (m *GetBulkStateRequest) GetStoreName() string {
 	if m != nil {
 		return m.StoreName
 	}
 	return ""
 }
 
-func (m *GetSecretEnvelope) GetKey() string {
+func (m *GetBulkStateRequest) GetKeys() []string {
 	if m != nil {
-		return m.Key
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *GetBulkStateRequest) GetConsistency() string {
+	if m != nil {
+		return m.Consistency
 	}
 	return ""
 }
 
-func (m *GetSecretEnvelope) GetMetadata() map[string]string {
+func (m *GetBulkStateRequest) GetParallelism() int32 {
+	if m != nil {
+		return m.Parallelism
+	}
+	return 0
+}
+
+func (m *GetBulkStateRequest) GetMetadata() map[string]string {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-type GetSecretResponseEnvelope struct {
-	Data                 map[string]string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+// BulkStateItem is the state of a key of a GetBulkStateStreamAlpha1 request.
+// Items are streamed in the order they are fetched, not in the order of the keys.
+type BulkStateItem struct {
+	Key  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Data *any.Any `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Etag string   `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	// error is set when the key couldn't be fetched.
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetSecretResponseEnvelope) Reset()         { *m = GetSecretResponseEnvelope{} }
-func (m *GetSecretResponseEnvelope) String() string { return proto.CompactTextString(m) }
-func (*GetSecretResponseEnvelope) ProtoMessage()    {}
-func (*GetSecretResponseEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f3c232bd8a4c7dd, []int{6}
+func (m *BulkStateItem) Reset()         { *m = BulkStateItem{} }
+func (m *BulkStateItem) String() string { return proto.CompactTextString(m) }
+func (*BulkStateItem) ProtoMessage()    {}
+func (*BulkStateItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{8}
 }
 
-func (m *GetSecretResponseEnvelope) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSecretResponseEnvelope.Unmarshal(m, b)
+func (m *BulkStateItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkStateItem.Unmarshal(m, b)
 }
-func (m *GetSecretResponseEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSecretResponseEnvelope.Marshal(b, m, deterministic)
+func (m *BulkStateItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkStateItem.Marshal(b, m, deterministic)
 }
-func (m *GetSecretResponseEnvelope) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSecretResponseEnvelope.Merge(m, src)
+func (m *BulkStateItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkStateItem.Merge(m, src)
 }
-func (m *GetSecretResponseEnvelope) XXX_Size() int {
-	return xxx_messageInfo_GetSecretResponseEnvelope.Size(m)
+func (m *BulkStateItem) XXX_Size() int {
+	return xxx_messageInfo_BulkStateItem.Size(m)
 }
-func (m *GetSecretResponseEnvelope) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSecretResponseEnvelope.DiscardUnknown(m)
+func (m *BulkStateItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkStateItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSecretResponseEnvelope proto.InternalMessageInfo
+var xxx_messageInfo_BulkStateItem proto.InternalMessageInfo
 
-func (m *GetSecretResponseEnvelope) GetData() map[string]string {
+func (m *BulkStateItem) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *BulkStateItem) GetData() *any.Any {
 	if m != nil {
 		return m.Data
 	}
 	return nil
 }
 
-type InvokeBindingEnvelope struct {
-	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Data                 *any.Any          `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+func (m *BulkStateItem) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *BulkStateItem) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// GetBulkStateTransactionalRequest holds the keys of a GetBulkStateTransactionalAlpha1 request
+type GetBulkStateTransactionalRequest struct {
+	StoreName string   `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	Keys      []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	// metadata is passed to the state store.
 	Metadata             map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *InvokeBindingEnvelope) Reset()         { *m = InvokeBindingEnvelope{} }
-func (m *InvokeBindingEnvelope) String() string { return proto.CompactTextString(m) }
-func (*InvokeBindingEnvelope) ProtoMessage()    {}
-func (*InvokeBindingEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f3c232bd8a4c7dd, []int{7}
+func (m *GetBulkStateTransactionalRequest) Reset()         { *m = GetBulkStateTransactionalRequest{} }
+func (m *GetBulkStateTransactionalRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBulkStateTransactionalRequest) ProtoMessage()    {}
+func (*GetBulkStateTransactionalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{9}
 }
 
-func (m *InvokeBindingEnvelope) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InvokeBindingEnvelope.Unmarshal(m, b)
+func (m *GetBulkStateTransactionalRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBulkStateTransactionalRequest.Unmarshal(m, b)
 }
-func (m *InvokeBindingEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InvokeBindingEnvelope.Marshal(b, m, deterministic)
+func (m *GetBulkStateTransactionalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBulkStateTransactionalRequest.Marshal(b, m, deterministic)
 }
-func (m *InvokeBindingEnvelope) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InvokeBindingEnvelope.Merge(m, src)
+func (m *GetBulkStateTransactionalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBulkStateTransactionalRequest.Merge(m, src)
 }
-func (m *InvokeBindingEnvelope) XXX_Size() int {
-	return xxx_messageInfo_InvokeBindingEnvelope.Size(m)
+func (m *GetBulkStateTransactionalRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBulkStateTransactionalRequest.Size(m)
 }
-func (m *InvokeBindingEnvelope) XXX_DiscardUnknown() {
-	xxx_messageInfo_InvokeBindingEnvelope.DiscardUnknown(m)
+func (m *GetBulkStateTransactionalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBulkStateTransactionalRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InvokeBindingEnvelope proto.InternalMessageInfo
+var xxx_messageInfo_GetBulkStateTransactionalRequest proto.InternalMessageInfo
 
-func (m *InvokeBindingEnvelope) GetName() string {
+func (m *GetBulkStateTransactionalRequest) GetStoreName() string {
 	if m != nil {
-		return m.Name
+		return m.StoreName
 	}
 	return ""
 }
 
-func (m *InvokeBindingEnvelope) GetData() *any.Any {
+func (m *GetBulkStateTransactionalRequest) GetKeys() []string {
 	if m != nil {
-		return m.Data
+		return m.Keys
 	}
 	return nil
 }
 
-func (m *InvokeBindingEnvelope) GetMetadata() map[string]string {
+func (m *GetBulkStateTransactionalRequest) GetMetadata() map[string]string {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-type PublishEventEnvelope struct {
-	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+// GetBulkStateTransactionalResponse holds the states of the keys of a GetBulkStateTransactionalAlpha1 request, in the
+// order of the keys.
+type GetBulkStateTransactionalResponse struct {
+	Items                []*TransactionalStateItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetBulkStateTransactionalResponse) Reset()         { *m = GetBulkStateTransactionalResponse{} }
+func (m *GetBulkStateTransactionalResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBulkStateTransactionalResponse) ProtoMessage()    {}
+func (*GetBulkStateTransactionalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{10}
+}
+
+func (m *GetBulkStateTransactionalResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBulkStateTransactionalResponse.Unmarshal(m, b)
+}
+func (m *GetBulkStateTransactionalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBulkStateTransactionalResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBulkStateTransactionalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBulkStateTransactionalResponse.Merge(m, src)
+}
+func (m *GetBulkStateTransactionalResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBulkStateTransactionalResponse.Size(m)
+}
+func (m *GetBulkStateTransactionalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBulkStateTransactionalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBulkStateTransactionalResponse proto.InternalMessageInfo
+
+func (m *GetBulkStateTransactionalResponse) GetItems() []*TransactionalStateItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// TransactionalStateItem is the state of a key of a GetBulkStateTransactionalAlpha1 request
+type TransactionalStateItem struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// data is empty when the key doesn't exist.
 	Data                 *any.Any `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Etag                 string   `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PublishEventEnvelope) Reset()         { *m = PublishEventEnvelope{} }
-func (m *PublishEventEnvelope) String() string { return proto.CompactTextString(m) }
-func (*PublishEventEnvelope) ProtoMessage()    {}
-func (*PublishEventEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f3c232bd8a4c7dd, []int{8}
+func (m *TransactionalStateItem) Reset()         { *m = TransactionalStateItem{} }
+func (m *TransactionalStateItem) String() string { return proto.CompactTextString(m) }
+func (*TransactionalStateItem) ProtoMessage()    {}
+func (*TransactionalStateItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{11}
 }
 
-func (m *PublishEventEnvelope) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PublishEventEnvelope.Unmarshal(m, b)
+func (m *TransactionalStateItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionalStateItem.Unmarshal(m, b)
 }
-func (m *PublishEventEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PublishEventEnvelope.Marshal(b, m, deterministic)
+func (m *TransactionalStateItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionalStateItem.Marshal(b, m, deterministic)
 }
-func (m *PublishEventEnvelope) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PublishEventEnvelope.Merge(m, src)
+func (m *TransactionalStateItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionalStateItem.Merge(m, src)
 }
-func (m *PublishEventEnvelope) XXX_Size() int {
-	return xxx_messageInfo_PublishEventEnvelope.Size(m)
+func (m *TransactionalStateItem) XXX_Size() int {
+	return xxx_messageInfo_TransactionalStateItem.Size(m)
 }
-func (m *PublishEventEnvelope) XXX_DiscardUnknown() {
-	xxx_messageInfo_PublishEventEnvelope.DiscardUnknown(m)
+func (m *TransactionalStateItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionalStateItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PublishEventEnvelope proto.InternalMessageInfo
+var xxx_messageInfo_TransactionalStateItem proto.InternalMessageInfo
 
-func (m *PublishEventEnvelope) GetTopic() string {
+func (m *TransactionalStateItem) GetKey() string {
 	if m != nil {
-		return m.Topic
+		return m.Key
 	}
 	return ""
 }
 
-func (m *PublishEventEnvelope) GetData() *any.Any {
+func (m *TransactionalStateItem) GetData() *any.Any {
 	if m != nil {
 		return m.Data
 	}
 	return nil
 }
 
-type State struct {
-	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value                *any.Any          `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Etag                 string            `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
-	Metadata             map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Options              *StateOptions     `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
-}
-
-func (m *State) Reset()         { *m = State{} }
-func (m *State) String() string { return proto.CompactTextString(m) }
-func (*State) ProtoMessage()    {}
-func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f3c232bd8a4c7dd, []int{9}
+func (m *TransactionalStateItem) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
 }
 
-func (m *State) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_State.Unmarshal(m, b)
+// SubscribeStateRequest selects the keys a SubscribeStateAlpha1 stream reports the changes of
+type SubscribeStateRequest struct {
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// key_prefix selects the keys starting with it, all the keys of the app when empty.
+	KeyPrefix            string   `protobuf:"bytes,2,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *State) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_State.Marshal(b, m, deterministic)
+
+func (m *SubscribeStateRequest) Reset()         { *m = SubscribeStateRequest{} }
+func (m *SubscribeStateRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeStateRequest) ProtoMessage()    {}
+func (*SubscribeStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{12}
 }
-func (m *State) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_State.Merge(m, src)
+
+func (m *SubscribeStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeStateRequest.Unmarshal(m, b)
 }
-func (m *State) XXX_Size() int {
-	return xxx_messageInfo_State.Size(m)
+func (m *SubscribeStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeStateRequest.Marshal(b, m, deterministic)
 }
-func (m *State) XXX_DiscardUnknown() {
-	xxx_messageInfo_State.DiscardUnknown(m)
+func (m *SubscribeStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeStateRequest.Merge(m, src)
+}
+func (m *SubscribeStateRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeStateRequest.Size(m)
+}
+func (m *SubscribeStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_State proto.InternalMessageInfo
+var xxx_messageInfo_SubscribeStateRequest proto.InternalMessageInfo
 
-func (m *State) GetKey() string {
+func (m *SubscribeStateRequest) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
+}
+
+func (m *SubscribeStateRequest) GetKeyPrefix() string {
+	if m != nil {
+		return m.KeyPrefix
+	}
+	return ""
+}
+
+// StateChangeEvent is a change of a key streamed by SubscribeStateAlpha1
+type StateChangeEvent struct {
+	Key       string                     `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operation StateChangeEvent_Operation `protobuf:"varint,2,opt,name=operation,proto3,enum=dapr.proto.dapr.v1.StateChangeEvent_Operation" json:"operation,omitempty"`
+	// data and etag are empty for deleted keys.
+	Data *any.Any `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Etag string   `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	// time is when the store applied the change, unset if the store doesn't report it.
+	Time                 *timestamp.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *StateChangeEvent) Reset()         { *m = StateChangeEvent{} }
+func (m *StateChangeEvent) String() string { return proto.CompactTextString(m) }
+func (*StateChangeEvent) ProtoMessage()    {}
+func (*StateChangeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{13}
+}
+
+func (m *StateChangeEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateChangeEvent.Unmarshal(m, b)
+}
+func (m *StateChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateChangeEvent.Marshal(b, m, deterministic)
+}
+func (m *StateChangeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateChangeEvent.Merge(m, src)
+}
+func (m *StateChangeEvent) XXX_Size() int {
+	return xxx_messageInfo_StateChangeEvent.Size(m)
+}
+func (m *StateChangeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateChangeEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateChangeEvent proto.InternalMessageInfo
+
+func (m *StateChangeEvent) GetKey() string {
 	if m != nil {
 		return m.Key
 	}
 	return ""
 }
 
-func (m *State) GetValue() *any.Any {
+func (m *StateChangeEvent) GetOperation() StateChangeEvent_Operation {
 	if m != nil {
-		return m.Value
+		return m.Operation
+	}
+	return StateChangeEvent_UPSERT
+}
+
+func (m *StateChangeEvent) GetData() *any.Any {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-func (m *State) GetEtag() string {
+func (m *StateChangeEvent) GetEtag() string {
 	if m != nil {
 		return m.Etag
 	}
 	return ""
 }
 
-func (m *State) GetMetadata() map[string]string {
+func (m *StateChangeEvent) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// QueryStateKeysRequest selects the keys of a QueryStateKeysAlpha1 request
+type QueryStateKeysRequest struct {
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// prefix selects the keys starting with it, all the keys of the app when empty.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// page_token is the next_page_token of the previous page, empty for the first page. It is only valid for the same
+	// store and prefix.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// page_size is the number of keys of every page but the last, 100 when 0 and 1000 at most.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// metadata is passed to the state store.
+	Metadata             map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *QueryStateKeysRequest) Reset()         { *m = QueryStateKeysRequest{} }
+func (m *QueryStateKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStateKeysRequest) ProtoMessage()    {}
+func (*QueryStateKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{14}
+}
+
+func (m *QueryStateKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryStateKeysRequest.Unmarshal(m, b)
+}
+func (m *QueryStateKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryStateKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryStateKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateKeysRequest.Merge(m, src)
+}
+func (m *QueryStateKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryStateKeysRequest.Size(m)
+}
+func (m *QueryStateKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStateKeysRequest proto.InternalMessageInfo
+
+func (m *QueryStateKeysRequest) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
+}
+
+func (m *QueryStateKeysRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *QueryStateKeysRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *QueryStateKeysRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *QueryStateKeysRequest) GetMetadata() map[string]string {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *State) GetOptions() *StateOptions {
+// QueryStateKeysResponse is a page of the keys of a QueryStateKeysAlpha1 request
+type QueryStateKeysResponse struct {
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// next_page_token is an opaque token requesting the next page, empty on the last page.
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryStateKeysResponse) Reset()         { *m = QueryStateKeysResponse{} }
+func (m *QueryStateKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStateKeysResponse) ProtoMessage()    {}
+func (*QueryStateKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{15}
+}
+
+func (m *QueryStateKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryStateKeysResponse.Unmarshal(m, b)
+}
+func (m *QueryStateKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryStateKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryStateKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateKeysResponse.Merge(m, src)
+}
+func (m *QueryStateKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryStateKeysResponse.Size(m)
+}
+func (m *QueryStateKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStateKeysResponse proto.InternalMessageInfo
+
+func (m *QueryStateKeysResponse) GetKeys() []string {
 	if m != nil {
-		return m.Options
+		return m.Keys
 	}
 	return nil
 }
 
-type StateOptions struct {
-	Concurrency          string       `protobuf:"bytes,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
-	Consistency          string       `protobuf:"bytes,2,opt,name=consistency,proto3" json:"consistency,omitempty"`
-	RetryPolicy          *RetryPolicy `protobuf:"bytes,3,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *QueryStateKeysResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
 }
 
-func (m *StateOptions) Reset()         { *m = StateOptions{} }
-func (m *StateOptions) String() string { return proto.CompactTextString(m) }
-func (*StateOptions) ProtoMessage()    {}
-func (*StateOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f3c232bd8a4c7dd, []int{10}
+// MigrateStateRequest selects the state stores of a MigrateStateAlpha1 request
+type MigrateStateRequest struct {
+	// source_store_name must support listing keys.
+	SourceStoreName      string `protobuf:"bytes,1,opt,name=source_store_name,json=sourceStoreName,proto3" json:"source_store_name,omitempty"`
+	DestinationStoreName string `protobuf:"bytes,2,opt,name=destination_store_name,json=destinationStoreName,proto3" json:"destination_store_name,omitempty"`
+	// rekey saves the keys with the key prefix of the destination store instead of the prefix of the source store.
+	Rekey bool `protobuf:"varint,3,opt,name=rekey,proto3" json:"rekey,omitempty"`
+	// page_size is the number of keys copied between two progress messages, 100 when 0 and 1000 at most.
+	PageSize             int32    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StateOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StateOptions.Unmarshal(m, b)
+func (m *MigrateStateRequest) Reset()         { *m = MigrateStateRequest{} }
+func (m *MigrateStateRequest) String() string { return proto.CompactTextString(m) }
+func (*MigrateStateRequest) ProtoMessage()    {}
+func (*MigrateStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{16}
 }
-func (m *StateOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StateOptions.Marshal(b, m, deterministic)
+
+func (m *MigrateStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateStateRequest.Unmarshal(m, b)
 }
-func (m *StateOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StateOptions.Merge(m, src)
+func (m *MigrateStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateStateRequest.Marshal(b, m, deterministic)
 }
-func (m *StateOptions) XXX_Size() int {
-	return xxx_messageInfo_StateOptions.Size(m)
+func (m *MigrateStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateStateRequest.Merge(m, src)
 }
-func (m *StateOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_StateOptions.DiscardUnknown(m)
+func (m *MigrateStateRequest) XXX_Size() int {
+	return xxx_messageInfo_MigrateStateRequest.Size(m)
+}
+func (m *MigrateStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StateOptions proto.InternalMessageInfo
+var xxx_messageInfo_MigrateStateRequest proto.InternalMessageInfo
 
-func (m *StateOptions) GetConcurrency() string {
+func (m *MigrateStateRequest) GetSourceStoreName() string {
 	if m != nil {
-		return m.Concurrency
+		return m.SourceStoreName
 	}
 	return ""
 }
 
-func (m *StateOptions) GetConsistency() string {
+func (m *MigrateStateRequest) GetDestinationStoreName() string {
 	if m != nil {
-		return m.Consistency
+		return m.DestinationStoreName
 	}
 	return ""
 }
 
-func (m *StateOptions) GetRetryPolicy() *RetryPolicy {
+func (m *MigrateStateRequest) GetRekey() bool {
 	if m != nil {
-		return m.RetryPolicy
+		return m.Rekey
 	}
-	return nil
+	return false
 }
 
-type RetryPolicy struct {
-	Threshold            int32              `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	Pattern              string             `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
-	Interval             *duration.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *MigrateStateRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
 
-func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
-func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
-func (*RetryPolicy) ProtoMessage()    {}
-func (*RetryPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f3c232bd8a4c7dd, []int{11}
+// MigrateStateProgress reports the keys copied so far by a MigrateStateAlpha1 request. The last message has done set,
+// a failed migration ends the stream with an error instead.
+type MigrateStateProgress struct {
+	Copied int64 `protobuf:"varint,1,opt,name=copied,proto3" json:"copied,omitempty"`
+	// skipped counts the keys deleted from the source store before they were copied.
+	Skipped int64 `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// last_key is the last key copied, the keys are copied in the order of the source store.
+	LastKey              string   `protobuf:"bytes,3,opt,name=last_key,json=lastKey,proto3" json:"last_key,omitempty"`
+	Done                 bool     `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RetryPolicy.Unmarshal(m, b)
+func (m *MigrateStateProgress) Reset()         { *m = MigrateStateProgress{} }
+func (m *MigrateStateProgress) String() string { return proto.CompactTextString(m) }
+func (*MigrateStateProgress) ProtoMessage()    {}
+func (*MigrateStateProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{17}
 }
-func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+
+func (m *MigrateStateProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateStateProgress.Unmarshal(m, b)
 }
-func (m *RetryPolicy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetryPolicy.Merge(m, src)
+func (m *MigrateStateProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateStateProgress.Marshal(b, m, deterministic)
 }
-func (m *RetryPolicy) XXX_Size() int {
-	return xxx_messageInfo_RetryPolicy.Size(m)
+func (m *MigrateStateProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateStateProgress.Merge(m, src)
 }
-func (m *RetryPolicy) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+func (m *MigrateStateProgress) XXX_Size() int {
+	return xxx_messageInfo_MigrateStateProgress.Size(m)
+}
+func (m *MigrateStateProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateStateProgress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+var xxx_messageInfo_MigrateStateProgress proto.InternalMessageInfo
 
-func (m *RetryPolicy) GetThreshold() int32 {
+func (m *MigrateStateProgress) GetCopied() int64 {
 	if m != nil {
-		return m.Threshold
+		return m.Copied
 	}
 	return 0
 }
 
-func (m *RetryPolicy) GetPattern() string {
+func (m *MigrateStateProgress) GetSkipped() int64 {
 	if m != nil {
-		return m.Pattern
+		return m.Skipped
+	}
+	return 0
+}
+
+func (m *MigrateStateProgress) GetLastKey() string {
+	if m != nil {
+		return m.LastKey
 	}
 	return ""
 }
 
-func (m *RetryPolicy) GetInterval() *duration.Duration {
+func (m *MigrateStateProgress) GetDone() bool {
 	if m != nil {
-		return m.Interval
+		return m.Done
 	}
-	return nil
+	return false
 }
 
-type StateRequest struct {
-	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value                *any.Any          `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Etag                 string            `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
-	Metadata             map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Options              *StateOptions     `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+// CrossStoreTransactionRequest holds the operations of an ExecuteCrossStoreTransactionAlpha1 request.
+// The operations of each store are applied in one transaction if the store supports transactions, and the stores
+// are changed in the order they are first referenced.
+type CrossStoreTransactionRequest struct {
+	Operations           []*CrossStoreOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *StateRequest) Reset()         { *m = StateRequest{} }
-func (m *StateRequest) String() string { return proto.CompactTextString(m) }
-func (*StateRequest) ProtoMessage()    {}
-func (*StateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0f3c232bd8a4c7dd, []int{12}
+func (m *CrossStoreTransactionRequest) Reset()         { *m = CrossStoreTransactionRequest{} }
+func (m *CrossStoreTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CrossStoreTransactionRequest) ProtoMessage()    {}
+func (*CrossStoreTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{18}
 }
 
-func (m *StateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StateRequest.Unmarshal(m, b)
+func (m *CrossStoreTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CrossStoreTransactionRequest.Unmarshal(m, b)
 }
-func (m *StateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StateRequest.Marshal(b, m, deterministic)
+func (m *CrossStoreTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CrossStoreTransactionRequest.Marshal(b, m, deterministic)
 }
-func (m *StateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StateRequest.Merge(m, src)
+func (m *CrossStoreTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossStoreTransactionRequest.Merge(m, src)
 }
-func (m *StateRequest) XXX_Size() int {
-	return xxx_messageInfo_StateRequest.Size(m)
+func (m *CrossStoreTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_CrossStoreTransactionRequest.Size(m)
 }
-func (m *StateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StateRequest.DiscardUnknown(m)
+func (m *CrossStoreTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossStoreTransactionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StateRequest proto.InternalMessageInfo
+var xxx_messageInfo_CrossStoreTransactionRequest proto.InternalMessageInfo
 
-func (m *StateRequest) GetKey() string {
+func (m *CrossStoreTransactionRequest) GetOperations() []*CrossStoreOperation {
 	if m != nil {
-		return m.Key
+		return m.Operations
 	}
-	return ""
+	return nil
 }
 
-func (m *StateRequest) GetValue() *any.Any {
+// CrossStoreOperation is an upsert or delete of a key of a state store
+type CrossStoreOperation struct {
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// operation_type is upsert or delete.
+	OperationType string `protobuf:"bytes,2,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"`
+	// request holds the key, and the value of upserts.
+	Request              *StateRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CrossStoreOperation) Reset()         { *m = CrossStoreOperation{} }
+func (m *CrossStoreOperation) String() string { return proto.CompactTextString(m) }
+func (*CrossStoreOperation) ProtoMessage()    {}
+func (*CrossStoreOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{19}
+}
+
+func (m *CrossStoreOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CrossStoreOperation.Unmarshal(m, b)
+}
+func (m *CrossStoreOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CrossStoreOperation.Marshal(b, m, deterministic)
+}
+func (m *CrossStoreOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossStoreOperation.Merge(m, src)
+}
+func (m *CrossStoreOperation) XXX_Size() int {
+	return xxx_messageInfo_CrossStoreOperation.Size(m)
+}
+func (m *CrossStoreOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossStoreOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossStoreOperation proto.InternalMessageInfo
+
+func (m *CrossStoreOperation) GetStoreName() string {
 	if m != nil {
-		return m.Value
+		return m.StoreName
 	}
-	return nil
+	return ""
 }
 
-func (m *StateRequest) GetEtag() string {
+func (m *CrossStoreOperation) GetOperationType() string {
 	if m != nil {
-		return m.Etag
+		return m.OperationType
 	}
 	return ""
 }
 
-func (m *StateRequest) GetMetadata() map[string]string {
+func (m *CrossStoreOperation) GetRequest() *StateRequest {
 	if m != nil {
-		return m.Metadata
+		return m.Request
 	}
 	return nil
 }
 
-func (m *StateRequest) GetOptions() *StateOptions {
+// CrossStoreTransactionResponse is the outcome of an ExecuteCrossStoreTransactionAlpha1 request
+type CrossStoreTransactionResponse struct {
+	// committed is true when the operations were applied to every store.
+	Committed            bool                `protobuf:"varint,1,opt,name=committed,proto3" json:"committed,omitempty"`
+	Stores               []*CrossStoreResult `protobuf:"bytes,2,rep,name=stores,proto3" json:"stores,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CrossStoreTransactionResponse) Reset()         { *m = CrossStoreTransactionResponse{} }
+func (m *CrossStoreTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*CrossStoreTransactionResponse) ProtoMessage()    {}
+func (*CrossStoreTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{20}
+}
+
+func (m *CrossStoreTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CrossStoreTransactionResponse.Unmarshal(m, b)
+}
+func (m *CrossStoreTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CrossStoreTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *CrossStoreTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossStoreTransactionResponse.Merge(m, src)
+}
+func (m *CrossStoreTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_CrossStoreTransactionResponse.Size(m)
+}
+func (m *CrossStoreTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossStoreTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossStoreTransactionResponse proto.InternalMessageInfo
+
+func (m *CrossStoreTransactionResponse) GetCommitted() bool {
 	if m != nil {
-		return m.Options
+		return m.Committed
 	}
-	return nil
+	return false
 }
 
-func init() {
-	proto.RegisterType((*InvokeServiceRequest)(nil), "dapr.proto.dapr.v1.InvokeServiceRequest")
+func (m *CrossStoreTransactionResponse) GetStores() []*CrossStoreResult {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
+// CrossStoreResult is the outcome of a cross-store transaction on a state store
+type CrossStoreResult struct {
+	StoreName            string                  `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	Status               CrossStoreResult_Status `protobuf:"varint,2,opt,name=status,proto3,enum=dapr.proto.dapr.v1.CrossStoreResult_Status" json:"status,omitempty"`
+	Error                string                  `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CrossStoreResult) Reset()         { *m = CrossStoreResult{} }
+func (m *CrossStoreResult) String() string { return proto.CompactTextString(m) }
+func (*CrossStoreResult) ProtoMessage()    {}
+func (*CrossStoreResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{21}
+}
+
+func (m *CrossStoreResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CrossStoreResult.Unmarshal(m, b)
+}
+func (m *CrossStoreResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CrossStoreResult.Marshal(b, m, deterministic)
+}
+func (m *CrossStoreResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossStoreResult.Merge(m, src)
+}
+func (m *CrossStoreResult) XXX_Size() int {
+	return xxx_messageInfo_CrossStoreResult.Size(m)
+}
+func (m *CrossStoreResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossStoreResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossStoreResult proto.InternalMessageInfo
+
+func (m *CrossStoreResult) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
+}
+
+func (m *CrossStoreResult) GetStatus() CrossStoreResult_Status {
+	if m != nil {
+		return m.Status
+	}
+	return CrossStoreResult_NOT_ATTEMPTED
+}
+
+func (m *CrossStoreResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetSecretEnvelope struct {
+	StoreName            string            `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	Key                  string            `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetSecretEnvelope) Reset()         { *m = GetSecretEnvelope{} }
+func (m *GetSecretEnvelope) String() string { return proto.CompactTextString(m) }
+func (*GetSecretEnvelope) ProtoMessage()    {}
+func (*GetSecretEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{22}
+}
+
+func (m *GetSecretEnvelope) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSecretEnvelope.Unmarshal(m, b)
+}
+func (m *GetSecretEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSecretEnvelope.Marshal(b, m, deterministic)
+}
+func (m *GetSecretEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSecretEnvelope.Merge(m, src)
+}
+func (m *GetSecretEnvelope) XXX_Size() int {
+	return xxx_messageInfo_GetSecretEnvelope.Size(m)
+}
+func (m *GetSecretEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSecretEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSecretEnvelope proto.InternalMessageInfo
+
+func (m *GetSecretEnvelope) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
+}
+
+func (m *GetSecretEnvelope) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetSecretEnvelope) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type GetSecretResponseEnvelope struct {
+	Data                 map[string]string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetSecretResponseEnvelope) Reset()         { *m = GetSecretResponseEnvelope{} }
+func (m *GetSecretResponseEnvelope) String() string { return proto.CompactTextString(m) }
+func (*GetSecretResponseEnvelope) ProtoMessage()    {}
+func (*GetSecretResponseEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{23}
+}
+
+func (m *GetSecretResponseEnvelope) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSecretResponseEnvelope.Unmarshal(m, b)
+}
+func (m *GetSecretResponseEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSecretResponseEnvelope.Marshal(b, m, deterministic)
+}
+func (m *GetSecretResponseEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSecretResponseEnvelope.Merge(m, src)
+}
+func (m *GetSecretResponseEnvelope) XXX_Size() int {
+	return xxx_messageInfo_GetSecretResponseEnvelope.Size(m)
+}
+func (m *GetSecretResponseEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSecretResponseEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSecretResponseEnvelope proto.InternalMessageInfo
+
+func (m *GetSecretResponseEnvelope) GetData() map[string]string {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type InvokeBindingEnvelope struct {
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data                 *any.Any          `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InvokeBindingEnvelope) Reset()         { *m = InvokeBindingEnvelope{} }
+func (m *InvokeBindingEnvelope) String() string { return proto.CompactTextString(m) }
+func (*InvokeBindingEnvelope) ProtoMessage()    {}
+func (*InvokeBindingEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{24}
+}
+
+func (m *InvokeBindingEnvelope) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeBindingEnvelope.Unmarshal(m, b)
+}
+func (m *InvokeBindingEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeBindingEnvelope.Marshal(b, m, deterministic)
+}
+func (m *InvokeBindingEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeBindingEnvelope.Merge(m, src)
+}
+func (m *InvokeBindingEnvelope) XXX_Size() int {
+	return xxx_messageInfo_InvokeBindingEnvelope.Size(m)
+}
+func (m *InvokeBindingEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeBindingEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvokeBindingEnvelope proto.InternalMessageInfo
+
+func (m *InvokeBindingEnvelope) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InvokeBindingEnvelope) GetData() *any.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *InvokeBindingEnvelope) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// InvokeBindingBulkRequest holds the requests of an InvokeBindingBulkAlpha1 call
+type InvokeBindingBulkRequest struct {
+	Name    string                           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Entries []*InvokeBindingBulkRequestEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// metadata applies to every entry. Entry metadata overrides it.
+	Metadata             map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InvokeBindingBulkRequest) Reset()         { *m = InvokeBindingBulkRequest{} }
+func (m *InvokeBindingBulkRequest) String() string { return proto.CompactTextString(m) }
+func (*InvokeBindingBulkRequest) ProtoMessage()    {}
+func (*InvokeBindingBulkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{25}
+}
+
+func (m *InvokeBindingBulkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeBindingBulkRequest.Unmarshal(m, b)
+}
+func (m *InvokeBindingBulkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeBindingBulkRequest.Marshal(b, m, deterministic)
+}
+func (m *InvokeBindingBulkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeBindingBulkRequest.Merge(m, src)
+}
+func (m *InvokeBindingBulkRequest) XXX_Size() int {
+	return xxx_messageInfo_InvokeBindingBulkRequest.Size(m)
+}
+func (m *InvokeBindingBulkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeBindingBulkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvokeBindingBulkRequest proto.InternalMessageInfo
+
+func (m *InvokeBindingBulkRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InvokeBindingBulkRequest) GetEntries() []*InvokeBindingBulkRequestEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *InvokeBindingBulkRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type InvokeBindingBulkRequestEntry struct {
+	// entry_id is chosen by the app to match the entry with its result, it must be unique in the request.
+	EntryId              string            `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Data                 *any.Any          `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InvokeBindingBulkRequestEntry) Reset()         { *m = InvokeBindingBulkRequestEntry{} }
+func (m *InvokeBindingBulkRequestEntry) String() string { return proto.CompactTextString(m) }
+func (*InvokeBindingBulkRequestEntry) ProtoMessage()    {}
+func (*InvokeBindingBulkRequestEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{26}
+}
+
+func (m *InvokeBindingBulkRequestEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeBindingBulkRequestEntry.Unmarshal(m, b)
+}
+func (m *InvokeBindingBulkRequestEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeBindingBulkRequestEntry.Marshal(b, m, deterministic)
+}
+func (m *InvokeBindingBulkRequestEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeBindingBulkRequestEntry.Merge(m, src)
+}
+func (m *InvokeBindingBulkRequestEntry) XXX_Size() int {
+	return xxx_messageInfo_InvokeBindingBulkRequestEntry.Size(m)
+}
+func (m *InvokeBindingBulkRequestEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeBindingBulkRequestEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvokeBindingBulkRequestEntry proto.InternalMessageInfo
+
+func (m *InvokeBindingBulkRequestEntry) GetEntryId() string {
+	if m != nil {
+		return m.EntryId
+	}
+	return ""
+}
+
+func (m *InvokeBindingBulkRequestEntry) GetData() *any.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *InvokeBindingBulkRequestEntry) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// InvokeBindingBulkResponse has the result of every entry of an InvokeBindingBulkAlpha1 request, in the order of the request
+type InvokeBindingBulkResponse struct {
+	Results              []*InvokeBindingBulkResponseEntry `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *InvokeBindingBulkResponse) Reset()         { *m = InvokeBindingBulkResponse{} }
+func (m *InvokeBindingBulkResponse) String() string { return proto.CompactTextString(m) }
+func (*InvokeBindingBulkResponse) ProtoMessage()    {}
+func (*InvokeBindingBulkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{27}
+}
+
+func (m *InvokeBindingBulkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeBindingBulkResponse.Unmarshal(m, b)
+}
+func (m *InvokeBindingBulkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeBindingBulkResponse.Marshal(b, m, deterministic)
+}
+func (m *InvokeBindingBulkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeBindingBulkResponse.Merge(m, src)
+}
+func (m *InvokeBindingBulkResponse) XXX_Size() int {
+	return xxx_messageInfo_InvokeBindingBulkResponse.Size(m)
+}
+func (m *InvokeBindingBulkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeBindingBulkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvokeBindingBulkResponse proto.InternalMessageInfo
+
+func (m *InvokeBindingBulkResponse) GetResults() []*InvokeBindingBulkResponseEntry {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type InvokeBindingBulkResponseEntry struct {
+	EntryId string `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	// error is empty when the entry was sent to the binding.
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvokeBindingBulkResponseEntry) Reset()         { *m = InvokeBindingBulkResponseEntry{} }
+func (m *InvokeBindingBulkResponseEntry) String() string { return proto.CompactTextString(m) }
+func (*InvokeBindingBulkResponseEntry) ProtoMessage()    {}
+func (*InvokeBindingBulkResponseEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{28}
+}
+
+func (m *InvokeBindingBulkResponseEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeBindingBulkResponseEntry.Unmarshal(m, b)
+}
+func (m *InvokeBindingBulkResponseEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeBindingBulkResponseEntry.Marshal(b, m, deterministic)
+}
+func (m *InvokeBindingBulkResponseEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeBindingBulkResponseEntry.Merge(m, src)
+}
+func (m *InvokeBindingBulkResponseEntry) XXX_Size() int {
+	return xxx_messageInfo_InvokeBindingBulkResponseEntry.Size(m)
+}
+func (m *InvokeBindingBulkResponseEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeBindingBulkResponseEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvokeBindingBulkResponseEntry proto.InternalMessageInfo
+
+func (m *InvokeBindingBulkResponseEntry) GetEntryId() string {
+	if m != nil {
+		return m.EntryId
+	}
+	return ""
+}
+
+func (m *InvokeBindingBulkResponseEntry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type InvokeActorEnvelope struct {
+	ActorType string   `protobuf:"bytes,1,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	ActorId   string   `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Method    string   `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Data      *any.Any `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// metadata is forwarded to the actor method of the app, filtered by the actorMetadata allow-list of the app configuration.
+	Metadata             map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InvokeActorEnvelope) Reset()         { *m = InvokeActorEnvelope{} }
+func (m *InvokeActorEnvelope) String() string { return proto.CompactTextString(m) }
+func (*InvokeActorEnvelope) ProtoMessage()    {}
+func (*InvokeActorEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{29}
+}
+
+func (m *InvokeActorEnvelope) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeActorEnvelope.Unmarshal(m, b)
+}
+func (m *InvokeActorEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeActorEnvelope.Marshal(b, m, deterministic)
+}
+func (m *InvokeActorEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeActorEnvelope.Merge(m, src)
+}
+func (m *InvokeActorEnvelope) XXX_Size() int {
+	return xxx_messageInfo_InvokeActorEnvelope.Size(m)
+}
+func (m *InvokeActorEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeActorEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvokeActorEnvelope proto.InternalMessageInfo
+
+func (m *InvokeActorEnvelope) GetActorType() string {
+	if m != nil {
+		return m.ActorType
+	}
+	return ""
+}
+
+func (m *InvokeActorEnvelope) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *InvokeActorEnvelope) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *InvokeActorEnvelope) GetData() *any.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *InvokeActorEnvelope) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type InvokeActorResponseEnvelope struct {
+	Data *any.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// metadata holds the headers set by the actor method of the app.
+	Metadata             map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InvokeActorResponseEnvelope) Reset()         { *m = InvokeActorResponseEnvelope{} }
+func (m *InvokeActorResponseEnvelope) String() string { return proto.CompactTextString(m) }
+func (*InvokeActorResponseEnvelope) ProtoMessage()    {}
+func (*InvokeActorResponseEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{30}
+}
+
+func (m *InvokeActorResponseEnvelope) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeActorResponseEnvelope.Unmarshal(m, b)
+}
+func (m *InvokeActorResponseEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeActorResponseEnvelope.Marshal(b, m, deterministic)
+}
+func (m *InvokeActorResponseEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeActorResponseEnvelope.Merge(m, src)
+}
+func (m *InvokeActorResponseEnvelope) XXX_Size() int {
+	return xxx_messageInfo_InvokeActorResponseEnvelope.Size(m)
+}
+func (m *InvokeActorResponseEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeActorResponseEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvokeActorResponseEnvelope proto.InternalMessageInfo
+
+func (m *InvokeActorResponseEnvelope) GetData() *any.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *InvokeActorResponseEnvelope) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type PublishEventEnvelope struct {
+	Topic                string            `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Data                 *any.Any          `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PublishEventEnvelope) Reset()         { *m = PublishEventEnvelope{} }
+func (m *PublishEventEnvelope) String() string { return proto.CompactTextString(m) }
+func (*PublishEventEnvelope) ProtoMessage()    {}
+func (*PublishEventEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{31}
+}
+
+func (m *PublishEventEnvelope) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishEventEnvelope.Unmarshal(m, b)
+}
+func (m *PublishEventEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishEventEnvelope.Marshal(b, m, deterministic)
+}
+func (m *PublishEventEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishEventEnvelope.Merge(m, src)
+}
+func (m *PublishEventEnvelope) XXX_Size() int {
+	return xxx_messageInfo_PublishEventEnvelope.Size(m)
+}
+func (m *PublishEventEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishEventEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishEventEnvelope proto.InternalMessageInfo
+
+func (m *PublishEventEnvelope) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *PublishEventEnvelope) GetData() *any.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *PublishEventEnvelope) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// PublishEventStreamRequest is an event sent on a PublishEventStreamAlpha1 stream
+type PublishEventStreamRequest struct {
+	// id is chosen by the app to match the event with its acknowledgement.
+	Id                   string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event                *PublishEventEnvelope `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PublishEventStreamRequest) Reset()         { *m = PublishEventStreamRequest{} }
+func (m *PublishEventStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*PublishEventStreamRequest) ProtoMessage()    {}
+func (*PublishEventStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{32}
+}
+
+func (m *PublishEventStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishEventStreamRequest.Unmarshal(m, b)
+}
+func (m *PublishEventStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishEventStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *PublishEventStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishEventStreamRequest.Merge(m, src)
+}
+func (m *PublishEventStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_PublishEventStreamRequest.Size(m)
+}
+func (m *PublishEventStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishEventStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishEventStreamRequest proto.InternalMessageInfo
+
+func (m *PublishEventStreamRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PublishEventStreamRequest) GetEvent() *PublishEventEnvelope {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+// PublishEventStreamResponse acknowledges an event sent on a PublishEventStreamAlpha1 stream
+type PublishEventStreamResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// error is empty when the event was published.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// message_id is the ID the broker assigned to the event, when the pubsub component reports it.
+	MessageId            string   `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublishEventStreamResponse) Reset()         { *m = PublishEventStreamResponse{} }
+func (m *PublishEventStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*PublishEventStreamResponse) ProtoMessage()    {}
+func (*PublishEventStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{33}
+}
+
+func (m *PublishEventStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishEventStreamResponse.Unmarshal(m, b)
+}
+func (m *PublishEventStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishEventStreamResponse.Marshal(b, m, deterministic)
+}
+func (m *PublishEventStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishEventStreamResponse.Merge(m, src)
+}
+func (m *PublishEventStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_PublishEventStreamResponse.Size(m)
+}
+func (m *PublishEventStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishEventStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishEventStreamResponse proto.InternalMessageInfo
+
+func (m *PublishEventStreamResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PublishEventStreamResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *PublishEventStreamResponse) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+// BulkPublishRequest holds the events of a BulkPublishEventAlpha1 request
+type BulkPublishRequest struct {
+	Topic   string                     `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Entries []*BulkPublishRequestEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// metadata applies to every entry. Entry metadata overrides it.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// transactional publishes either all the entries or none of them.
+	// It requires a pubsub component with the BULK_PUBLISH_TRANSACTIONAL feature.
+	Transactional bool `protobuf:"varint,4,opt,name=transactional,proto3" json:"transactional,omitempty"`
+	// return_succeeded lists the entries that were published in the response too.
+	ReturnSucceeded      bool     `protobuf:"varint,5,opt,name=return_succeeded,json=returnSucceeded,proto3" json:"return_succeeded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BulkPublishRequest) Reset()         { *m = BulkPublishRequest{} }
+func (m *BulkPublishRequest) String() string { return proto.CompactTextString(m) }
+func (*BulkPublishRequest) ProtoMessage()    {}
+func (*BulkPublishRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{34}
+}
+
+func (m *BulkPublishRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkPublishRequest.Unmarshal(m, b)
+}
+func (m *BulkPublishRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkPublishRequest.Marshal(b, m, deterministic)
+}
+func (m *BulkPublishRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkPublishRequest.Merge(m, src)
+}
+func (m *BulkPublishRequest) XXX_Size() int {
+	return xxx_messageInfo_BulkPublishRequest.Size(m)
+}
+func (m *BulkPublishRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkPublishRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkPublishRequest proto.InternalMessageInfo
+
+func (m *BulkPublishRequest) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *BulkPublishRequest) GetEntries() []*BulkPublishRequestEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *BulkPublishRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *BulkPublishRequest) GetTransactional() bool {
+	if m != nil {
+		return m.Transactional
+	}
+	return false
+}
+
+func (m *BulkPublishRequest) GetReturnSucceeded() bool {
+	if m != nil {
+		return m.ReturnSucceeded
+	}
+	return false
+}
+
+type BulkPublishRequestEntry struct {
+	// entry_id is chosen by the app to match the entry with its failure, it must be unique in the request.
+	EntryId              string            `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Data                 *any.Any          `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BulkPublishRequestEntry) Reset()         { *m = BulkPublishRequestEntry{} }
+func (m *BulkPublishRequestEntry) String() string { return proto.CompactTextString(m) }
+func (*BulkPublishRequestEntry) ProtoMessage()    {}
+func (*BulkPublishRequestEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{35}
+}
+
+func (m *BulkPublishRequestEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkPublishRequestEntry.Unmarshal(m, b)
+}
+func (m *BulkPublishRequestEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkPublishRequestEntry.Marshal(b, m, deterministic)
+}
+func (m *BulkPublishRequestEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkPublishRequestEntry.Merge(m, src)
+}
+func (m *BulkPublishRequestEntry) XXX_Size() int {
+	return xxx_messageInfo_BulkPublishRequestEntry.Size(m)
+}
+func (m *BulkPublishRequestEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkPublishRequestEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkPublishRequestEntry proto.InternalMessageInfo
+
+func (m *BulkPublishRequestEntry) GetEntryId() string {
+	if m != nil {
+		return m.EntryId
+	}
+	return ""
+}
+
+func (m *BulkPublishRequestEntry) GetData() *any.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *BulkPublishRequestEntry) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// BulkPublishResponse lists the entries of a BulkPublishEventAlpha1 request that failed to be published, and the
+// entries that were published when the request sets return_succeeded
+type BulkPublishResponse struct {
+	FailedEntries        []*BulkPublishResponseFailedEntry    `protobuf:"bytes,1,rep,name=failed_entries,json=failedEntries,proto3" json:"failed_entries,omitempty"`
+	SucceededEntries     []*BulkPublishResponseSucceededEntry `protobuf:"bytes,2,rep,name=succeeded_entries,json=succeededEntries,proto3" json:"succeeded_entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *BulkPublishResponse) Reset()         { *m = BulkPublishResponse{} }
+func (m *BulkPublishResponse) String() string { return proto.CompactTextString(m) }
+func (*BulkPublishResponse) ProtoMessage()    {}
+func (*BulkPublishResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{36}
+}
+
+func (m *BulkPublishResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkPublishResponse.Unmarshal(m, b)
+}
+func (m *BulkPublishResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkPublishResponse.Marshal(b, m, deterministic)
+}
+func (m *BulkPublishResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkPublishResponse.Merge(m, src)
+}
+func (m *BulkPublishResponse) XXX_Size() int {
+	return xxx_messageInfo_BulkPublishResponse.Size(m)
+}
+func (m *BulkPublishResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkPublishResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkPublishResponse proto.InternalMessageInfo
+
+func (m *BulkPublishResponse) GetFailedEntries() []*BulkPublishResponseFailedEntry {
+	if m != nil {
+		return m.FailedEntries
+	}
+	return nil
+}
+
+func (m *BulkPublishResponse) GetSucceededEntries() []*BulkPublishResponseSucceededEntry {
+	if m != nil {
+		return m.SucceededEntries
+	}
+	return nil
+}
+
+type BulkPublishResponseFailedEntry struct {
+	EntryId              string   `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BulkPublishResponseFailedEntry) Reset()         { *m = BulkPublishResponseFailedEntry{} }
+func (m *BulkPublishResponseFailedEntry) String() string { return proto.CompactTextString(m) }
+func (*BulkPublishResponseFailedEntry) ProtoMessage()    {}
+func (*BulkPublishResponseFailedEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{37}
+}
+
+func (m *BulkPublishResponseFailedEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkPublishResponseFailedEntry.Unmarshal(m, b)
+}
+func (m *BulkPublishResponseFailedEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkPublishResponseFailedEntry.Marshal(b, m, deterministic)
+}
+func (m *BulkPublishResponseFailedEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkPublishResponseFailedEntry.Merge(m, src)
+}
+func (m *BulkPublishResponseFailedEntry) XXX_Size() int {
+	return xxx_messageInfo_BulkPublishResponseFailedEntry.Size(m)
+}
+func (m *BulkPublishResponseFailedEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkPublishResponseFailedEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkPublishResponseFailedEntry proto.InternalMessageInfo
+
+func (m *BulkPublishResponseFailedEntry) GetEntryId() string {
+	if m != nil {
+		return m.EntryId
+	}
+	return ""
+}
+
+func (m *BulkPublishResponseFailedEntry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type BulkPublishResponseSucceededEntry struct {
+	EntryId string `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	// message_id is the ID the broker assigned to the event. It is empty when the pubsub component doesn't report it.
+	MessageId string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// published_at is when the broker acknowledged the event.
+	PublishedAt          *timestamp.Timestamp `protobuf:"bytes,3,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BulkPublishResponseSucceededEntry) Reset()         { *m = BulkPublishResponseSucceededEntry{} }
+func (m *BulkPublishResponseSucceededEntry) String() string { return proto.CompactTextString(m) }
+func (*BulkPublishResponseSucceededEntry) ProtoMessage()    {}
+func (*BulkPublishResponseSucceededEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{38}
+}
+
+func (m *BulkPublishResponseSucceededEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkPublishResponseSucceededEntry.Unmarshal(m, b)
+}
+func (m *BulkPublishResponseSucceededEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkPublishResponseSucceededEntry.Marshal(b, m, deterministic)
+}
+func (m *BulkPublishResponseSucceededEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkPublishResponseSucceededEntry.Merge(m, src)
+}
+func (m *BulkPublishResponseSucceededEntry) XXX_Size() int {
+	return xxx_messageInfo_BulkPublishResponseSucceededEntry.Size(m)
+}
+func (m *BulkPublishResponseSucceededEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkPublishResponseSucceededEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkPublishResponseSucceededEntry proto.InternalMessageInfo
+
+func (m *BulkPublishResponseSucceededEntry) GetEntryId() string {
+	if m != nil {
+		return m.EntryId
+	}
+	return ""
+}
+
+func (m *BulkPublishResponseSucceededEntry) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *BulkPublishResponseSucceededEntry) GetPublishedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.PublishedAt
+	}
+	return nil
+}
+
+type State struct {
+	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                *any.Any          `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Etag                 string            `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Options              *StateOptions     `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *State) Reset()         { *m = State{} }
+func (m *State) String() string { return proto.CompactTextString(m) }
+func (*State) ProtoMessage()    {}
+func (*State) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{39}
+}
+
+func (m *State) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_State.Unmarshal(m, b)
+}
+func (m *State) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_State.Marshal(b, m, deterministic)
+}
+func (m *State) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_State.Merge(m, src)
+}
+func (m *State) XXX_Size() int {
+	return xxx_messageInfo_State.Size(m)
+}
+func (m *State) XXX_DiscardUnknown() {
+	xxx_messageInfo_State.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_State proto.InternalMessageInfo
+
+func (m *State) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *State) GetValue() *any.Any {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *State) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *State) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *State) GetOptions() *StateOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type StateOptions struct {
+	Concurrency          string       `protobuf:"bytes,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Consistency          string       `protobuf:"bytes,2,opt,name=consistency,proto3" json:"consistency,omitempty"`
+	RetryPolicy          *RetryPolicy `protobuf:"bytes,3,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *StateOptions) Reset()         { *m = StateOptions{} }
+func (m *StateOptions) String() string { return proto.CompactTextString(m) }
+func (*StateOptions) ProtoMessage()    {}
+func (*StateOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{40}
+}
+
+func (m *StateOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateOptions.Unmarshal(m, b)
+}
+func (m *StateOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateOptions.Marshal(b, m, deterministic)
+}
+func (m *StateOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateOptions.Merge(m, src)
+}
+func (m *StateOptions) XXX_Size() int {
+	return xxx_messageInfo_StateOptions.Size(m)
+}
+func (m *StateOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateOptions proto.InternalMessageInfo
+
+func (m *StateOptions) GetConcurrency() string {
+	if m != nil {
+		return m.Concurrency
+	}
+	return ""
+}
+
+func (m *StateOptions) GetConsistency() string {
+	if m != nil {
+		return m.Consistency
+	}
+	return ""
+}
+
+func (m *StateOptions) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+type RetryPolicy struct {
+	Threshold            int32              `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Pattern              string             `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Interval             *duration.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{41}
+}
+
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryPolicy.Unmarshal(m, b)
+}
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+}
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
+}
+func (m *RetryPolicy) XXX_Size() int {
+	return xxx_messageInfo_RetryPolicy.Size(m)
+}
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+
+func (m *RetryPolicy) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *RetryPolicy) GetInterval() *duration.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+type StateRequest struct {
+	Key      string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value    *any.Any          `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Etag     string            `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Options  *StateOptions     `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	// ttl is the time the key expires after, in whole seconds. It requires a state store with the TTL feature.
+	Ttl *duration.Duration `protobuf:"bytes,6,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// etags are more ETags the key is saved with, tried in order after etag until one of them is current.
+	Etags                []string `protobuf:"bytes,7,rep,name=etags,proto3" json:"etags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateRequest) Reset()         { *m = StateRequest{} }
+func (m *StateRequest) String() string { return proto.CompactTextString(m) }
+func (*StateRequest) ProtoMessage()    {}
+func (*StateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{42}
+}
+
+func (m *StateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateRequest.Unmarshal(m, b)
+}
+func (m *StateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateRequest.Marshal(b, m, deterministic)
+}
+func (m *StateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateRequest.Merge(m, src)
+}
+func (m *StateRequest) XXX_Size() int {
+	return xxx_messageInfo_StateRequest.Size(m)
+}
+func (m *StateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateRequest proto.InternalMessageInfo
+
+func (m *StateRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StateRequest) GetValue() *any.Any {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StateRequest) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *StateRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *StateRequest) GetOptions() *StateOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *StateRequest) GetTtl() *duration.Duration {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+func (m *StateRequest) GetEtags() []string {
+	if m != nil {
+		return m.Etags
+	}
+	return nil
+}
+
+// ListActorRemindersRequest selects the reminders of a ListActorRemindersAlpha1 request
+type ListActorRemindersRequest struct {
+	ActorType string `protobuf:"bytes,1,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	// actor_id selects the reminders of an actor, the reminders of every actor of the type when empty.
+	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// due_after selects the reminders firing next at due_after or later, unbounded when unset.
+	DueAfter *timestamp.Timestamp `protobuf:"bytes,3,opt,name=due_after,json=dueAfter,proto3" json:"due_after,omitempty"`
+	// due_before selects the reminders firing next before due_before, unbounded when unset.
+	DueBefore *timestamp.Timestamp `protobuf:"bytes,4,opt,name=due_before,json=dueBefore,proto3" json:"due_before,omitempty"`
+	// page_token is the next_page_token of the previous page, empty for the first page. It is only valid for the same
+	// actor type and actor_id.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// page_size is the number of reminders of every page but the last, 100 when 0 and 1000 at most.
+	PageSize             int32    `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListActorRemindersRequest) Reset()         { *m = ListActorRemindersRequest{} }
+func (m *ListActorRemindersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListActorRemindersRequest) ProtoMessage()    {}
+func (*ListActorRemindersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{43}
+}
+
+func (m *ListActorRemindersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListActorRemindersRequest.Unmarshal(m, b)
+}
+func (m *ListActorRemindersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListActorRemindersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListActorRemindersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListActorRemindersRequest.Merge(m, src)
+}
+func (m *ListActorRemindersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListActorRemindersRequest.Size(m)
+}
+func (m *ListActorRemindersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListActorRemindersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListActorRemindersRequest proto.InternalMessageInfo
+
+func (m *ListActorRemindersRequest) GetActorType() string {
+	if m != nil {
+		return m.ActorType
+	}
+	return ""
+}
+
+func (m *ListActorRemindersRequest) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *ListActorRemindersRequest) GetDueAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.DueAfter
+	}
+	return nil
+}
+
+func (m *ListActorRemindersRequest) GetDueBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.DueBefore
+	}
+	return nil
+}
+
+func (m *ListActorRemindersRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListActorRemindersRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+// ActorReminder is a reminder of a ListActorRemindersAlpha1 response
+type ActorReminder struct {
+	ActorType string `protobuf:"bytes,1,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	ActorId   string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// data is the JSON data of the reminder.
+	Data             []byte               `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	DueTime          string               `protobuf:"bytes,5,opt,name=due_time,json=dueTime,proto3" json:"due_time,omitempty"`
+	Period           string               `protobuf:"bytes,6,opt,name=period,proto3" json:"period,omitempty"`
+	Schedule         string               `protobuf:"bytes,7,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Timezone         string               `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	MissedFirePolicy string               `protobuf:"bytes,9,opt,name=missed_fire_policy,json=missedFirePolicy,proto3" json:"missed_fire_policy,omitempty"`
+	RegisteredTime   *timestamp.Timestamp `protobuf:"bytes,10,opt,name=registered_time,json=registeredTime,proto3" json:"registered_time,omitempty"`
+	// next_fire_time is unset when the time the reminder fires next can't be computed.
+	NextFireTime         *timestamp.Timestamp `protobuf:"bytes,11,opt,name=next_fire_time,json=nextFireTime,proto3" json:"next_fire_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ActorReminder) Reset()         { *m = ActorReminder{} }
+func (m *ActorReminder) String() string { return proto.CompactTextString(m) }
+func (*ActorReminder) ProtoMessage()    {}
+func (*ActorReminder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{44}
+}
+
+func (m *ActorReminder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActorReminder.Unmarshal(m, b)
+}
+func (m *ActorReminder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActorReminder.Marshal(b, m, deterministic)
+}
+func (m *ActorReminder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorReminder.Merge(m, src)
+}
+func (m *ActorReminder) XXX_Size() int {
+	return xxx_messageInfo_ActorReminder.Size(m)
+}
+func (m *ActorReminder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorReminder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorReminder proto.InternalMessageInfo
+
+func (m *ActorReminder) GetActorType() string {
+	if m != nil {
+		return m.ActorType
+	}
+	return ""
+}
+
+func (m *ActorReminder) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *ActorReminder) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ActorReminder) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ActorReminder) GetDueTime() string {
+	if m != nil {
+		return m.DueTime
+	}
+	return ""
+}
+
+func (m *ActorReminder) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *ActorReminder) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *ActorReminder) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *ActorReminder) GetMissedFirePolicy() string {
+	if m != nil {
+		return m.MissedFirePolicy
+	}
+	return ""
+}
+
+func (m *ActorReminder) GetRegisteredTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.RegisteredTime
+	}
+	return nil
+}
+
+func (m *ActorReminder) GetNextFireTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextFireTime
+	}
+	return nil
+}
+
+// ListActorRemindersResponse is a page of the reminders of a ListActorRemindersAlpha1 request, ordered by actor ID and
+// name
+type ListActorRemindersResponse struct {
+	Reminders []*ActorReminder `protobuf:"bytes,1,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	// next_page_token is an opaque token requesting the next page, empty on the last page.
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListActorRemindersResponse) Reset()         { *m = ListActorRemindersResponse{} }
+func (m *ListActorRemindersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListActorRemindersResponse) ProtoMessage()    {}
+func (*ListActorRemindersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3c232bd8a4c7dd, []int{45}
+}
+
+func (m *ListActorRemindersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListActorRemindersResponse.Unmarshal(m, b)
+}
+func (m *ListActorRemindersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListActorRemindersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListActorRemindersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListActorRemindersResponse.Merge(m, src)
+}
+func (m *ListActorRemindersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListActorRemindersResponse.Size(m)
+}
+func (m *ListActorRemindersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListActorRemindersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListActorRemindersResponse proto.InternalMessageInfo
+
+func (m *ListActorRemindersResponse) GetReminders() []*ActorReminder {
+	if m != nil {
+		return m.Reminders
+	}
+	return nil
+}
+
+func (m *ListActorRemindersResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("dapr.proto.dapr.v1.StateChangeEvent_Operation", StateChangeEvent_Operation_name, StateChangeEvent_Operation_value)
+	proto.RegisterEnum("dapr.proto.dapr.v1.CrossStoreResult_Status", CrossStoreResult_Status_name, CrossStoreResult_Status_value)
+	proto.RegisterType((*InvokeServiceRequest)(nil), "dapr.proto.dapr.v1.InvokeServiceRequest")
 	proto.RegisterType((*DeleteStateEnvelope)(nil), "dapr.proto.dapr.v1.DeleteStateEnvelope")
 	proto.RegisterType((*SaveStateEnvelope)(nil), "dapr.proto.dapr.v1.SaveStateEnvelope")
+	proto.RegisterType((*SaveBulkStateResponse)(nil), "dapr.proto.dapr.v1.SaveBulkStateResponse")
+	proto.RegisterType((*SaveStateResult)(nil), "dapr.proto.dapr.v1.SaveStateResult")
 	proto.RegisterType((*GetStateEnvelope)(nil), "dapr.proto.dapr.v1.GetStateEnvelope")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.GetStateEnvelope.MetadataEntry")
 	proto.RegisterType((*GetStateResponseEnvelope)(nil), "dapr.proto.dapr.v1.GetStateResponseEnvelope")
+	proto.RegisterType((*GetBulkStateRequest)(nil), "dapr.proto.dapr.v1.GetBulkStateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.GetBulkStateRequest.MetadataEntry")
+	proto.RegisterType((*BulkStateItem)(nil), "dapr.proto.dapr.v1.BulkStateItem")
+	proto.RegisterType((*GetBulkStateTransactionalRequest)(nil), "dapr.proto.dapr.v1.GetBulkStateTransactionalRequest")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.GetBulkStateTransactionalRequest.MetadataEntry")
+	proto.RegisterType((*GetBulkStateTransactionalResponse)(nil), "dapr.proto.dapr.v1.GetBulkStateTransactionalResponse")
+	proto.RegisterType((*TransactionalStateItem)(nil), "dapr.proto.dapr.v1.TransactionalStateItem")
+	proto.RegisterType((*SubscribeStateRequest)(nil), "dapr.proto.dapr.v1.SubscribeStateRequest")
+	proto.RegisterType((*StateChangeEvent)(nil), "dapr.proto.dapr.v1.StateChangeEvent")
+	proto.RegisterType((*QueryStateKeysRequest)(nil), "dapr.proto.dapr.v1.QueryStateKeysRequest")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.QueryStateKeysRequest.MetadataEntry")
+	proto.RegisterType((*QueryStateKeysResponse)(nil), "dapr.proto.dapr.v1.QueryStateKeysResponse")
+	proto.RegisterType((*MigrateStateRequest)(nil), "dapr.proto.dapr.v1.MigrateStateRequest")
+	proto.RegisterType((*MigrateStateProgress)(nil), "dapr.proto.dapr.v1.MigrateStateProgress")
+	proto.RegisterType((*CrossStoreTransactionRequest)(nil), "dapr.proto.dapr.v1.CrossStoreTransactionRequest")
+	proto.RegisterType((*CrossStoreOperation)(nil), "dapr.proto.dapr.v1.CrossStoreOperation")
+	proto.RegisterType((*CrossStoreTransactionResponse)(nil), "dapr.proto.dapr.v1.CrossStoreTransactionResponse")
+	proto.RegisterType((*CrossStoreResult)(nil), "dapr.proto.dapr.v1.CrossStoreResult")
 	proto.RegisterType((*GetSecretEnvelope)(nil), "dapr.proto.dapr.v1.GetSecretEnvelope")
 	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.GetSecretEnvelope.MetadataEntry")
 	proto.RegisterType((*GetSecretResponseEnvelope)(nil), "dapr.proto.dapr.v1.GetSecretResponseEnvelope")
 	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.GetSecretResponseEnvelope.DataEntry")
 	proto.RegisterType((*InvokeBindingEnvelope)(nil), "dapr.proto.dapr.v1.InvokeBindingEnvelope")
 	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.InvokeBindingEnvelope.MetadataEntry")
+	proto.RegisterType((*InvokeBindingBulkRequest)(nil), "dapr.proto.dapr.v1.InvokeBindingBulkRequest")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.InvokeBindingBulkRequest.MetadataEntry")
+	proto.RegisterType((*InvokeBindingBulkRequestEntry)(nil), "dapr.proto.dapr.v1.InvokeBindingBulkRequestEntry")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.InvokeBindingBulkRequestEntry.MetadataEntry")
+	proto.RegisterType((*InvokeBindingBulkResponse)(nil), "dapr.proto.dapr.v1.InvokeBindingBulkResponse")
+	proto.RegisterType((*InvokeBindingBulkResponseEntry)(nil), "dapr.proto.dapr.v1.InvokeBindingBulkResponseEntry")
+	proto.RegisterType((*InvokeActorEnvelope)(nil), "dapr.proto.dapr.v1.InvokeActorEnvelope")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.InvokeActorEnvelope.MetadataEntry")
+	proto.RegisterType((*InvokeActorResponseEnvelope)(nil), "dapr.proto.dapr.v1.InvokeActorResponseEnvelope")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.InvokeActorResponseEnvelope.MetadataEntry")
 	proto.RegisterType((*PublishEventEnvelope)(nil), "dapr.proto.dapr.v1.PublishEventEnvelope")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.PublishEventEnvelope.MetadataEntry")
+	proto.RegisterType((*PublishEventStreamRequest)(nil), "dapr.proto.dapr.v1.PublishEventStreamRequest")
+	proto.RegisterType((*PublishEventStreamResponse)(nil), "dapr.proto.dapr.v1.PublishEventStreamResponse")
+	proto.RegisterType((*BulkPublishRequest)(nil), "dapr.proto.dapr.v1.BulkPublishRequest")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.BulkPublishRequest.MetadataEntry")
+	proto.RegisterType((*BulkPublishRequestEntry)(nil), "dapr.proto.dapr.v1.BulkPublishRequestEntry")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.BulkPublishRequestEntry.MetadataEntry")
+	proto.RegisterType((*BulkPublishResponse)(nil), "dapr.proto.dapr.v1.BulkPublishResponse")
+	proto.RegisterType((*BulkPublishResponseFailedEntry)(nil), "dapr.proto.dapr.v1.BulkPublishResponseFailedEntry")
+	proto.RegisterType((*BulkPublishResponseSucceededEntry)(nil), "dapr.proto.dapr.v1.BulkPublishResponseSucceededEntry")
 	proto.RegisterType((*State)(nil), "dapr.proto.dapr.v1.State")
 	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.State.MetadataEntry")
 	proto.RegisterType((*StateOptions)(nil), "dapr.proto.dapr.v1.StateOptions")
 	proto.RegisterType((*RetryPolicy)(nil), "dapr.proto.dapr.v1.RetryPolicy")
 	proto.RegisterType((*StateRequest)(nil), "dapr.proto.dapr.v1.StateRequest")
 	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.dapr.v1.StateRequest.MetadataEntry")
+	proto.RegisterType((*ListActorRemindersRequest)(nil), "dapr.proto.dapr.v1.ListActorRemindersRequest")
+	proto.RegisterType((*ActorReminder)(nil), "dapr.proto.dapr.v1.ActorReminder")
+	proto.RegisterType((*ListActorRemindersResponse)(nil), "dapr.proto.dapr.v1.ListActorRemindersResponse")
+}
+
+func init() { proto.RegisterFile("dapr/proto/dapr/v1/dapr.proto", fileDescriptor_0f3c232bd8a4c7dd) }
+
+var fileDescriptor_0f3c232bd8a4c7dd = []byte{
+	// 2620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x1a, 0x4d, 0x73, 0xdb, 0xc6,
+	0x55, 0xe0, 0x87, 0x44, 0x3e, 0x7d, 0xd1, 0x2b, 0x59, 0xa1, 0xe0, 0x38, 0x91, 0x11, 0xc7, 0x96,
+	0x1d, 0x9b, 0xb6, 0x14, 0xbb, 0xae, 0xdd, 0xb8, 0x89, 0x3e, 0x68, 0x8f, 0x6a, 0xcb, 0x92, 0x41,
+	0x3a, 0xd3, 0xb4, 0x33, 0x61, 0x20, 0x62, 0x45, 0xa1, 0x24, 0x01, 0x74, 0xb1, 0x50, 0x4d, 0xa7,
+	0x33, 0x3d, 0xf9, 0xd4, 0xe9, 0x4c, 0x7a, 0x69, 0x2e, 0xb9, 0xe4, 0x9a, 0xe9, 0x9f, 0x69, 0xa7,
+	0xf7, 0x1e, 0xd3, 0x53, 0x0f, 0xf9, 0x01, 0x9d, 0xce, 0x2e, 0x16, 0x20, 0x48, 0x80, 0x24, 0x68,
+	0x99, 0x17, 0x89, 0xbb, 0xfb, 0xbe, 0xdf, 0xdb, 0x87, 0xb7, 0x6f, 0x17, 0x2e, 0xea, 0x9a, 0x4d,
+	0x6e, 0xd9, 0xc4, 0xa2, 0xd6, 0x2d, 0xfe, 0xf3, 0x74, 0x83, 0xff, 0x2f, 0xf1, 0x29, 0x84, 0xba,
+	0xbf, 0x4b, 0xfc, 0xe7, 0xe9, 0x86, 0xbc, 0xda, 0xb0, 0xac, 0x46, 0x0b, 0x7b, 0x48, 0x47, 0xee,
+	0xf1, 0x2d, 0xcd, 0xec, 0x78, 0x20, 0xf2, 0x85, 0xfe, 0x25, 0xdc, 0xb6, 0xa9, 0xbf, 0xf8, 0x5e,
+	0xff, 0xa2, 0xee, 0x12, 0x8d, 0x1a, 0x96, 0x29, 0xd6, 0xdf, 0xef, 0x5f, 0xa7, 0x46, 0x1b, 0x3b,
+	0x54, 0x6b, 0xdb, 0x02, 0xe0, 0x52, 0x48, 0xd6, 0xba, 0xd5, 0x6e, 0x5b, 0x26, 0x93, 0xd6, 0xfb,
+	0xe5, 0x81, 0x28, 0x18, 0x96, 0xf7, 0xcc, 0x53, 0xab, 0x89, 0x2b, 0x98, 0x9c, 0x1a, 0x75, 0xac,
+	0xe2, 0xdf, 0xbb, 0xd8, 0xa1, 0x68, 0x01, 0x52, 0x86, 0x5e, 0x94, 0xd6, 0xa4, 0xf5, 0xbc, 0x9a,
+	0x32, 0x74, 0xf4, 0x10, 0x66, 0xda, 0xd8, 0x71, 0xb4, 0x06, 0x2e, 0xa6, 0xd7, 0xa4, 0xf5, 0xd9,
+	0xcd, 0x0f, 0x4a, 0x21, 0x4d, 0x05, 0xc9, 0xd3, 0x8d, 0x92, 0x47, 0x4c, 0x50, 0x51, 0x7d, 0x1c,
+	0xe5, 0xef, 0x12, 0x2c, 0xed, 0xe2, 0x16, 0xa6, 0xb8, 0x42, 0x35, 0x8a, 0xcb, 0xe6, 0x29, 0x6e,
+	0x59, 0x36, 0x46, 0x17, 0x01, 0x1c, 0x6a, 0x11, 0x5c, 0x33, 0xb5, 0x36, 0x16, 0xec, 0xf2, 0x7c,
+	0xe6, 0x99, 0xd6, 0xc6, 0xa8, 0x00, 0xe9, 0x26, 0xee, 0x14, 0x53, 0x7c, 0x9e, 0xfd, 0x44, 0x08,
+	0x32, 0x98, 0x6a, 0x0d, 0x2e, 0x44, 0x5e, 0xe5, 0xbf, 0xd1, 0x03, 0x98, 0xb1, 0x6c, 0x66, 0x17,
+	0xa7, 0x98, 0xe1, 0xb2, 0xad, 0x95, 0xa2, 0x5e, 0x28, 0x71, 0xc6, 0x07, 0x1e, 0x9c, 0xea, 0x23,
+	0xa0, 0x65, 0xc8, 0x32, 0x1a, 0x4e, 0x31, 0xbb, 0x96, 0x5e, 0xcf, 0xab, 0xde, 0x40, 0xb1, 0xe1,
+	0x5c, 0x45, 0x3b, 0x1d, 0x4f, 0xd6, 0x4f, 0x20, 0x47, 0x3c, 0xb5, 0x9d, 0x62, 0x6a, 0x2d, 0x3d,
+	0x54, 0x0c, 0xdf, 0x3e, 0x01, 0x86, 0xf2, 0x39, 0x9c, 0x67, 0x1c, 0xb7, 0xdd, 0x56, 0x53, 0x40,
+	0x38, 0xb6, 0x65, 0x3a, 0x98, 0x19, 0x9e, 0x60, 0xc7, 0x6d, 0x51, 0xa7, 0x28, 0xad, 0xa5, 0xfb,
+	0x0d, 0x1f, 0x50, 0xf5, 0xa5, 0x55, 0x39, 0xac, 0xea, 0xe3, 0x28, 0xf7, 0x61, 0xb1, 0x6f, 0xcd,
+	0x37, 0xaa, 0xd4, 0x35, 0x2a, 0x33, 0x02, 0x21, 0x16, 0x11, 0x86, 0xf6, 0x06, 0xca, 0x4f, 0x12,
+	0x14, 0x1e, 0x63, 0x7a, 0x46, 0x87, 0xad, 0xc1, 0x6c, 0xdd, 0x32, 0x1d, 0xc3, 0xa1, 0xd8, 0xac,
+	0x77, 0x84, 0xdf, 0xc2, 0x53, 0xe8, 0x19, 0xe4, 0xda, 0x98, 0x6a, 0xba, 0x46, 0xb5, 0x62, 0x86,
+	0xab, 0xb8, 0x19, 0xa7, 0x62, 0xbf, 0x28, 0xa5, 0x7d, 0x81, 0x54, 0x36, 0x29, 0xe9, 0xa8, 0x01,
+	0x0d, 0xf9, 0x17, 0x30, 0xdf, 0xb3, 0x14, 0xaf, 0xf0, 0xa9, 0xd6, 0x72, 0xb1, 0xaf, 0x30, 0x1f,
+	0x3c, 0x48, 0xfd, 0x5c, 0x52, 0x7e, 0x0d, 0x45, 0x9f, 0x91, 0xef, 0x82, 0x40, 0xf7, 0x75, 0xc8,
+	0x70, 0x21, 0x25, 0x1e, 0x64, 0xcb, 0x25, 0x6f, 0xfb, 0x95, 0xfc, 0xed, 0x57, 0xda, 0x32, 0x3b,
+	0x2a, 0x87, 0x08, 0xa2, 0x34, 0xd5, 0x8d, 0x52, 0xe5, 0xbb, 0x14, 0x2c, 0x3d, 0xc6, 0x34, 0xe4,
+	0x61, 0x6f, 0xa7, 0x8d, 0xb0, 0x28, 0x82, 0x4c, 0x13, 0x77, 0xbc, 0x90, 0xca, 0xab, 0xfc, 0x77,
+	0x02, 0x9b, 0xae, 0xc1, 0xac, 0xad, 0x11, 0xad, 0xd5, 0xc2, 0x2d, 0xc3, 0x69, 0xf3, 0x6d, 0x91,
+	0x55, 0xc3, 0x53, 0xe8, 0x79, 0xc8, 0xea, 0x59, 0x6e, 0xf5, 0xbb, 0x03, 0xac, 0xde, 0x2f, 0xf1,
+	0x64, 0x0c, 0xef, 0xc2, 0x7c, 0xc0, 0x68, 0x8f, 0xe2, 0x76, 0x0c, 0xb2, 0x6f, 0xff, 0x54, 0x62,
+	0xfb, 0x87, 0xb3, 0x44, 0x10, 0xe4, 0x99, 0xbe, 0x20, 0x5f, 0x0b, 0xeb, 0x58, 0x25, 0x9a, 0xe9,
+	0x68, 0x75, 0x96, 0x1c, 0xb4, 0xd6, 0x19, 0x5c, 0xf4, 0x65, 0xc8, 0xbc, 0x69, 0x6e, 0xde, 0xed,
+	0x51, 0xe6, 0x8d, 0x63, 0x3d, 0x19, 0x5b, 0x63, 0xb8, 0x34, 0x84, 0xb1, 0x48, 0x3c, 0x9f, 0x41,
+	0xd6, 0xa0, 0xb8, 0xed, 0xa7, 0x9d, 0xeb, 0x71, 0xe2, 0xf7, 0x60, 0x06, 0xae, 0x53, 0x3d, 0x44,
+	0xe5, 0x04, 0x56, 0xe2, 0x01, 0xde, 0xb6, 0x6f, 0x95, 0x17, 0x70, 0xbe, 0xe2, 0x1e, 0x39, 0x75,
+	0x62, 0x1c, 0xe1, 0x71, 0x36, 0xd7, 0x45, 0x80, 0x26, 0xee, 0xd4, 0x6c, 0x82, 0x8f, 0x8d, 0x97,
+	0xc2, 0x4e, 0xf9, 0x26, 0xee, 0x1c, 0xf2, 0x09, 0xe5, 0x75, 0x0a, 0x0a, 0x9c, 0xdc, 0xce, 0x89,
+	0x66, 0x36, 0x70, 0xf9, 0x14, 0x9b, 0x71, 0xe9, 0xf3, 0x29, 0xe4, 0x2d, 0x1b, 0x7b, 0x9f, 0x66,
+	0x4e, 0x64, 0x61, 0xb3, 0x34, 0x30, 0xf5, 0x87, 0x48, 0x95, 0x0e, 0x7c, 0x2c, 0xb5, 0x4b, 0x20,
+	0xb0, 0x44, 0x3a, 0xb1, 0x25, 0x32, 0xa1, 0x28, 0x2f, 0x41, 0x86, 0x55, 0x01, 0xc5, 0x2c, 0xc7,
+	0x96, 0x23, 0xd8, 0x55, 0xbf, 0x44, 0x50, 0x39, 0x9c, 0xf2, 0x01, 0xe4, 0x03, 0x29, 0x10, 0xc0,
+	0xf4, 0x8b, 0xc3, 0x4a, 0x59, 0xad, 0x16, 0xa6, 0xd8, 0xef, 0xdd, 0xf2, 0xd3, 0x72, 0xb5, 0x5c,
+	0x90, 0x58, 0xea, 0x3a, 0xff, 0xdc, 0xc5, 0xa4, 0xc3, 0x35, 0x78, 0x82, 0x3b, 0x4e, 0x42, 0xfb,
+	0xae, 0xc0, 0x74, 0x8f, 0x6d, 0xc5, 0x88, 0xa1, 0xd9, 0x5a, 0x03, 0xd7, 0xa8, 0xd5, 0xc4, 0xa6,
+	0xf0, 0x64, 0x9e, 0xcd, 0x54, 0xd9, 0x04, 0xba, 0x00, 0x7c, 0x50, 0x73, 0x8c, 0x57, 0x58, 0xe4,
+	0xae, 0x1c, 0x9b, 0xa8, 0x18, 0xaf, 0x30, 0xaa, 0x44, 0x12, 0xd7, 0xbd, 0x38, 0x63, 0xc7, 0xca,
+	0x3b, 0x99, 0xed, 0x54, 0x85, 0x95, 0x7e, 0x6e, 0x62, 0x0f, 0xf9, 0x99, 0x41, 0x0a, 0x65, 0x86,
+	0x2b, 0xb0, 0x68, 0xe2, 0x97, 0xb4, 0x16, 0x32, 0x80, 0x47, 0x71, 0x9e, 0x4d, 0x1f, 0xfa, 0x46,
+	0x50, 0x7e, 0x90, 0x60, 0x69, 0xdf, 0x68, 0x10, 0x8d, 0xf6, 0x86, 0xf4, 0x75, 0x38, 0xe7, 0x58,
+	0x2e, 0xa9, 0xe3, 0x5a, 0xc4, 0xf2, 0x8b, 0xde, 0x42, 0x25, 0xb0, 0xff, 0x1d, 0x58, 0xd1, 0xb1,
+	0x43, 0x0d, 0x93, 0xfb, 0x37, 0x8c, 0xe0, 0xb1, 0x5c, 0x0e, 0xad, 0x76, 0xb1, 0x96, 0x21, 0x4b,
+	0x30, 0xd3, 0x9e, 0x39, 0x26, 0xa7, 0x7a, 0x83, 0xa1, 0x4e, 0x51, 0xfe, 0x00, 0xcb, 0x61, 0x59,
+	0x0f, 0x89, 0xd5, 0x20, 0xd8, 0x71, 0x58, 0x00, 0xd4, 0x2d, 0xdb, 0xc0, 0x5e, 0x29, 0x99, 0x56,
+	0xc5, 0x08, 0x15, 0x61, 0xc6, 0x69, 0x1a, 0xb6, 0x8d, 0x75, 0x2e, 0x49, 0x5a, 0xf5, 0x87, 0x68,
+	0x15, 0x72, 0x2d, 0xcd, 0xa1, 0x35, 0x9f, 0x7f, 0x5e, 0x9d, 0x61, 0xe3, 0x27, 0x5e, 0xed, 0xa7,
+	0x5b, 0xa6, 0xc7, 0x3c, 0xa7, 0xf2, 0xdf, 0x4a, 0x03, 0xde, 0xdd, 0x21, 0x96, 0xe3, 0x70, 0xe9,
+	0x43, 0xd9, 0xc6, 0xb7, 0xd6, 0x63, 0x80, 0x60, 0x6b, 0xf9, 0xa9, 0xec, 0x6a, 0x5c, 0xbc, 0x74,
+	0xa9, 0x74, 0x77, 0x65, 0x08, 0x55, 0xf9, 0x56, 0x82, 0xa5, 0x18, 0x98, 0x51, 0x3b, 0xe0, 0x43,
+	0x58, 0x08, 0x88, 0xd4, 0x68, 0xc7, 0xf6, 0x2d, 0x3f, 0x1f, 0xcc, 0x56, 0x3b, 0x36, 0x66, 0x25,
+	0xac, 0x28, 0x05, 0xc5, 0xbe, 0x1f, 0x5d, 0x3b, 0xfa, 0x08, 0xca, 0xd7, 0x70, 0x71, 0x80, 0x09,
+	0x44, 0x14, 0xbe, 0x0b, 0x79, 0x56, 0xa0, 0x1b, 0x94, 0x0a, 0x3f, 0xe4, 0xd4, 0xee, 0x04, 0xfa,
+	0x04, 0xa6, 0xb9, 0xb8, 0x7e, 0xd5, 0x7a, 0x79, 0xb8, 0x75, 0x44, 0x81, 0x29, 0x70, 0x94, 0xff,
+	0x4a, 0x50, 0xe8, 0x5f, 0x1c, 0x65, 0x93, 0x1d, 0xc6, 0x51, 0xa3, 0xae, 0x23, 0x92, 0xe5, 0x47,
+	0x49, 0x38, 0x72, 0xe5, 0x5d, 0x47, 0x15, 0xa8, 0xdd, 0xcf, 0x79, 0x3a, 0xfc, 0x39, 0xff, 0x0a,
+	0xa6, 0x3d, 0x38, 0x74, 0x0e, 0xe6, 0x9f, 0x1d, 0x54, 0x6b, 0x5b, 0xd5, 0x6a, 0x79, 0xff, 0xb0,
+	0x5a, 0xde, 0x2d, 0x4c, 0xa1, 0x79, 0xc8, 0xef, 0x1c, 0xec, 0xef, 0xef, 0x55, 0xd9, 0x50, 0x62,
+	0x19, 0xee, 0xd1, 0xd6, 0xde, 0xd3, 0xf2, 0x6e, 0x21, 0x85, 0x16, 0x61, 0x76, 0xe7, 0x60, 0xff,
+	0xb0, 0xfc, 0xac, 0xb2, 0xc5, 0x16, 0xd3, 0xe8, 0x1d, 0x58, 0x0a, 0x26, 0xf6, 0x0e, 0x9e, 0xd5,
+	0x04, 0x64, 0x46, 0xf9, 0xa7, 0x04, 0xe7, 0x58, 0x85, 0x88, 0xeb, 0x04, 0xd3, 0x37, 0x2f, 0x8b,
+	0x0f, 0x22, 0xf5, 0xc1, 0xc7, 0x83, 0x8a, 0xde, 0x1e, 0x4e, 0x93, 0xc9, 0x60, 0xdf, 0x4b, 0xb0,
+	0x1a, 0xb0, 0x8a, 0xd4, 0xbd, 0x4f, 0x82, 0xba, 0x77, 0x60, 0xb6, 0x1d, 0x88, 0x5c, 0xda, 0x0d,
+	0x64, 0xe5, 0x44, 0xe4, 0x7b, 0x90, 0xdf, 0x7d, 0x23, 0x19, 0x7f, 0x94, 0xe0, 0xbc, 0x77, 0xba,
+	0xdc, 0x36, 0x4c, 0xdd, 0x30, 0x1b, 0x81, 0x7c, 0x08, 0x32, 0x21, 0xb3, 0xf3, 0xdf, 0x63, 0xd4,
+	0x13, 0x95, 0x88, 0x27, 0x62, 0x35, 0x8c, 0x65, 0x3d, 0x19, 0x6f, 0x7c, 0x93, 0x82, 0x62, 0x0f,
+	0x3b, 0x56, 0xa9, 0xf9, 0x09, 0x2d, 0x4e, 0xd9, 0x27, 0x30, 0x83, 0x4d, 0x4a, 0x8c, 0x60, 0x0f,
+	0x6f, 0x8c, 0xd4, 0x20, 0x44, 0xd2, 0x93, 0xdd, 0xa7, 0x80, 0x3e, 0x8f, 0xd8, 0xe3, 0xc1, 0x38,
+	0xd4, 0x26, 0x63, 0x92, 0xff, 0x49, 0x70, 0x71, 0xa8, 0xfc, 0xec, 0xbb, 0xc1, 0x34, 0xe8, 0xd4,
+	0x82, 0xb6, 0x05, 0xd7, 0xa8, 0xb3, 0xa7, 0x8f, 0x11, 0x0b, 0xbf, 0x8d, 0xe8, 0xfe, 0xe9, 0xd8,
+	0x96, 0x9c, 0x8c, 0x01, 0x0c, 0x58, 0x8d, 0xe1, 0x2a, 0x12, 0xfc, 0xd3, 0xfe, 0x1e, 0xc1, 0x66,
+	0x42, 0xa9, 0xfd, 0xbd, 0xca, 0x03, 0xc0, 0x6f, 0x19, 0x3c, 0x87, 0xf7, 0x86, 0x83, 0x0e, 0xb3,
+	0x75, 0x7c, 0x2b, 0xe1, 0xfb, 0x14, 0x2c, 0x79, 0x34, 0xb7, 0xea, 0xd4, 0x22, 0xe1, 0xb4, 0xa9,
+	0xb1, 0x09, 0xef, 0xcb, 0x28, 0xd2, 0x26, 0x9f, 0xe1, 0x5f, 0xc5, 0x55, 0xc8, 0x79, 0xcb, 0x86,
+	0x2e, 0xe8, 0xcd, 0xf0, 0xf1, 0x9e, 0xce, 0x0a, 0x8b, 0x36, 0xa6, 0x27, 0x96, 0x2e, 0xf2, 0xbf,
+	0x18, 0x05, 0xbe, 0xce, 0x8c, 0xf4, 0x75, 0xc2, 0x03, 0x70, 0x8c, 0xd8, 0x93, 0xf1, 0xf0, 0xbf,
+	0x25, 0xb8, 0x10, 0x62, 0x76, 0x86, 0xee, 0xc3, 0x17, 0x21, 0xcd, 0xbc, 0x7c, 0xf0, 0x70, 0x84,
+	0x66, 0x91, 0xac, 0x3d, 0x11, 0x0d, 0x7f, 0x94, 0x60, 0xf9, 0xd0, 0x3d, 0x6a, 0x19, 0xce, 0x09,
+	0x3f, 0xff, 0x04, 0xaa, 0x2d, 0x43, 0x96, 0x5a, 0xb6, 0x51, 0x17, 0x64, 0xbc, 0xc1, 0x18, 0xdb,
+	0x56, 0x8d, 0x6c, 0xdb, 0x9f, 0xc5, 0x29, 0x1c, 0xc7, 0x7b, 0x32, 0x9a, 0x36, 0x61, 0x35, 0xcc,
+	0xac, 0x42, 0x09, 0xd6, 0xda, 0x83, 0x5a, 0xab, 0xbf, 0x84, 0x2c, 0x66, 0x50, 0x42, 0xd1, 0xf5,
+	0xa4, 0xa2, 0xab, 0x1e, 0x9a, 0xa2, 0x81, 0x1c, 0xc7, 0x4c, 0xe4, 0x86, 0x7e, 0x6e, 0xb1, 0x1b,
+	0x94, 0x6d, 0x44, 0xd1, 0xaa, 0xad, 0x19, 0xfe, 0x96, 0xca, 0x8b, 0x99, 0x3d, 0x5d, 0xf9, 0x47,
+	0x0a, 0x10, 0x4b, 0x03, 0x82, 0x8f, 0xaf, 0x49, 0xbc, 0xdf, 0xca, 0xfd, 0x5f, 0xa3, 0xd8, 0xfa,
+	0x2e, 0x4a, 0xae, 0xef, 0x3b, 0x74, 0x18, 0x71, 0xea, 0x9d, 0x64, 0x74, 0x06, 0xb9, 0x14, 0x5d,
+	0x86, 0x79, 0x1a, 0xee, 0x47, 0x88, 0x83, 0x44, 0xef, 0x24, 0xba, 0x06, 0x05, 0x82, 0xa9, 0x4b,
+	0xcc, 0x9a, 0xe3, 0xd6, 0xeb, 0x18, 0xeb, 0x58, 0xe7, 0xa7, 0xe9, 0x9c, 0xba, 0xe8, 0xcd, 0x57,
+	0xfc, 0xe9, 0xb3, 0xc5, 0xc8, 0x4f, 0x12, 0xbc, 0x33, 0xc0, 0x08, 0x6f, 0xe7, 0x63, 0xf6, 0x22,
+	0x62, 0xc0, 0xfb, 0x63, 0x38, 0x62, 0x32, 0x1b, 0xe3, 0x5f, 0x12, 0x2c, 0xf5, 0x30, 0x14, 0x51,
+	0xfa, 0x05, 0x2c, 0x1c, 0x6b, 0x46, 0x0b, 0xeb, 0x35, 0x3f, 0x74, 0x86, 0x7c, 0xc8, 0x62, 0x08,
+	0x3c, 0xe2, 0xc8, 0x9e, 0xa8, 0xf3, 0xc7, 0xc1, 0x80, 0xc5, 0xd1, 0x11, 0x9c, 0x0b, 0x1c, 0x59,
+	0xeb, 0x0d, 0xcc, 0xbb, 0x09, 0xa9, 0x07, 0x1e, 0xf7, 0x18, 0x14, 0x9c, 0xf0, 0xd8, 0xc0, 0xfc,
+	0x93, 0x39, 0x5c, 0xa8, 0xf1, 0x3f, 0x99, 0xdf, 0x49, 0x70, 0x69, 0xa4, 0x28, 0xc3, 0xc8, 0xf6,
+	0x6e, 0xe9, 0x54, 0xdf, 0x96, 0x46, 0x0f, 0x61, 0xce, 0xf6, 0x48, 0x63, 0xbd, 0xa6, 0xf9, 0xc7,
+	0xce, 0x61, 0x0d, 0xa3, 0xd9, 0x00, 0x7e, 0x8b, 0x2a, 0xdf, 0xa6, 0x20, 0xcb, 0x8f, 0xa3, 0x31,
+	0xee, 0xbf, 0x1e, 0x76, 0xff, 0xa0, 0x18, 0xf5, 0x40, 0x62, 0x3b, 0xb5, 0x3b, 0x91, 0x0b, 0x81,
+	0xab, 0x03, 0x4f, 0xc3, 0x03, 0x37, 0x7b, 0xe8, 0x52, 0x28, 0x3b, 0xe6, 0xa5, 0xd0, 0xd9, 0x42,
+	0xfc, 0x6f, 0x12, 0xcc, 0x85, 0xc9, 0x8a, 0x6e, 0x7d, 0xdd, 0x25, 0x84, 0x77, 0xeb, 0xa5, 0xa0,
+	0x5b, 0xef, 0x4f, 0xf5, 0xf7, 0xf3, 0x53, 0xd1, 0x7e, 0xfe, 0x36, 0xcc, 0x11, 0xcc, 0xfc, 0x6c,
+	0x5b, 0x2d, 0x43, 0xb4, 0xfc, 0x67, 0x37, 0xdf, 0x8f, 0x53, 0x49, 0x65, 0x70, 0x87, 0x1c, 0x4c,
+	0x9d, 0x25, 0xdd, 0x81, 0xf2, 0x47, 0x98, 0x0d, 0xad, 0xb1, 0xae, 0x00, 0x3d, 0x21, 0xd8, 0x39,
+	0xb1, 0x5a, 0x5e, 0xec, 0x64, 0xd5, 0xee, 0x04, 0x6b, 0xd0, 0xd8, 0x1a, 0xa5, 0x98, 0xf8, 0xdd,
+	0x29, 0x7f, 0x88, 0xee, 0x42, 0xce, 0x30, 0x29, 0x26, 0xa7, 0x5a, 0x4b, 0x88, 0xb1, 0x1a, 0x71,
+	0xf0, 0xae, 0xb8, 0xa8, 0x54, 0x03, 0x50, 0xe5, 0x3f, 0x29, 0x61, 0x16, 0xff, 0xe3, 0xf1, 0xf6,
+	0xe3, 0xe6, 0x57, 0x91, 0xb8, 0x29, 0x8d, 0xea, 0xa2, 0x4c, 0x22, 0x7c, 0xd0, 0x47, 0x90, 0xa6,
+	0xb4, 0x55, 0x9c, 0x1e, 0x65, 0x1c, 0x06, 0xd5, 0xbd, 0x80, 0x9c, 0x09, 0x5d, 0x40, 0x9e, 0x2d,
+	0x02, 0x5f, 0xa7, 0x60, 0xf5, 0xa9, 0xe1, 0x50, 0x51, 0xda, 0xb5, 0x0d, 0x53, 0xc7, 0x24, 0xdc,
+	0xb2, 0x7d, 0xc3, 0x9a, 0xfb, 0x1e, 0xe4, 0x75, 0x17, 0xd7, 0xb4, 0x63, 0x8a, 0x49, 0x82, 0x7c,
+	0x91, 0xd3, 0x5d, 0xbc, 0xc5, 0x60, 0xd1, 0x7d, 0x00, 0x86, 0x78, 0x84, 0x8f, 0x2d, 0x82, 0x8b,
+	0x99, 0x91, 0x98, 0x8c, 0xcd, 0x36, 0x07, 0xee, 0xeb, 0x14, 0x67, 0x87, 0x76, 0x8a, 0xa7, 0xfb,
+	0x9a, 0x92, 0x7f, 0x4d, 0xc3, 0x7c, 0x8f, 0x0d, 0xce, 0xa0, 0xbb, 0x7f, 0xec, 0x4e, 0x87, 0x8e,
+	0xdd, 0x28, 0x74, 0xd6, 0x98, 0x13, 0x1f, 0xdd, 0x55, 0x60, 0x6a, 0xd7, 0x82, 0x1e, 0x7c, 0x5e,
+	0x9d, 0xd1, 0x5d, 0xcc, 0x54, 0xe3, 0xcd, 0x70, 0x4c, 0x0c, 0x4b, 0x2f, 0x4e, 0x8b, 0x66, 0x38,
+	0x1f, 0x21, 0x19, 0x72, 0x4e, 0xfd, 0x04, 0xeb, 0x6e, 0x0b, 0x17, 0x67, 0xf8, 0x4a, 0x30, 0x66,
+	0x6b, 0x8c, 0xd4, 0x2b, 0xd6, 0xf6, 0xcc, 0x79, 0x6b, 0xfe, 0x18, 0xdd, 0x00, 0xd4, 0x36, 0x1c,
+	0x07, 0xeb, 0xb5, 0x63, 0x83, 0x60, 0x3f, 0x33, 0xe4, 0x39, 0x54, 0xc1, 0x5b, 0x79, 0x64, 0x10,
+	0x2c, 0xb6, 0xfb, 0x0e, 0x2c, 0x12, 0xdc, 0x60, 0xf9, 0x84, 0x60, 0xdd, 0x93, 0x0f, 0x46, 0x3a,
+	0x62, 0xa1, 0x8b, 0xc2, 0x55, 0xf8, 0x0c, 0x16, 0x78, 0xef, 0x9a, 0x33, 0xe4, 0x34, 0x66, 0x47,
+	0xd2, 0x98, 0x63, 0x18, 0x4c, 0x10, 0x36, 0xa5, 0xbc, 0x96, 0x40, 0x8e, 0x8b, 0x4d, 0x51, 0x07,
+	0x7c, 0x0a, 0x79, 0xe2, 0x4f, 0x8a, 0x12, 0xe0, 0x52, 0xdc, 0xc6, 0xeb, 0x41, 0x57, 0xbb, 0x38,
+	0x49, 0xbb, 0xeb, 0x9b, 0x7f, 0x29, 0x40, 0x66, 0x57, 0xb3, 0x09, 0x52, 0x61, 0x2e, 0x5c, 0x3d,
+	0xa3, 0xc4, 0xe5, 0xb7, 0xbc, 0x12, 0x51, 0xba, 0xcc, 0x1e, 0x6f, 0x28, 0x53, 0x48, 0x83, 0xf9,
+	0x9e, 0x47, 0x15, 0xf1, 0x44, 0xe3, 0xde, 0x5d, 0xc8, 0x97, 0x87, 0x3f, 0xab, 0xf0, 0xcc, 0xa4,
+	0x4c, 0xa1, 0x2a, 0xcc, 0xf7, 0x1c, 0xd2, 0xd1, 0xb5, 0xc4, 0x4d, 0xab, 0x21, 0x82, 0x7f, 0x05,
+	0x39, 0xff, 0xf6, 0x1b, 0x5d, 0x4e, 0x72, 0x09, 0x2f, 0xdf, 0x18, 0x06, 0xd5, 0x7f, 0xac, 0x54,
+	0xa6, 0x50, 0x1d, 0xf2, 0x41, 0xaf, 0x10, 0x7d, 0x98, 0xa8, 0xe5, 0x29, 0xdf, 0x1c, 0xab, 0xe3,
+	0xa8, 0x4c, 0xb1, 0x0b, 0xb9, 0xe0, 0xd1, 0x43, 0x3c, 0x93, 0xc8, 0xeb, 0x8e, 0x21, 0x46, 0x39,
+	0x84, 0xd9, 0xd0, 0xd3, 0x15, 0x14, 0x5b, 0x8b, 0xc4, 0xbc, 0x6d, 0x19, 0x42, 0xf1, 0x4f, 0x50,
+	0x8c, 0x9e, 0xd8, 0xb6, 0x5a, 0xf6, 0x89, 0xb6, 0x81, 0x6e, 0x8e, 0x8a, 0xbf, 0x9e, 0xc3, 0xa4,
+	0x5c, 0x4a, 0x0a, 0xee, 0x47, 0xce, 0xba, 0x74, 0x5b, 0x42, 0x06, 0xcc, 0x86, 0x4e, 0xff, 0xf1,
+	0x2a, 0xc5, 0x34, 0x3e, 0xe4, 0x5b, 0x63, 0xf6, 0x11, 0x94, 0x29, 0xd4, 0x84, 0x95, 0x50, 0x19,
+	0xcb, 0x45, 0x12, 0x9a, 0x5e, 0x49, 0x76, 0x1a, 0x91, 0xaf, 0x26, 0xac, 0xd2, 0x95, 0x29, 0xf4,
+	0x12, 0xde, 0x89, 0xb4, 0xae, 0x04, 0xb7, 0x1b, 0xe3, 0x34, 0xf2, 0xe4, 0x9b, 0x09, 0xa1, 0x03,
+	0xce, 0xbf, 0xe3, 0xef, 0x46, 0x82, 0x2b, 0xf5, 0x1e, 0x97, 0x5e, 0x4d, 0xf8, 0xb0, 0x42, 0xbe,
+	0x34, 0x48, 0xd3, 0xe0, 0xe6, 0x5c, 0x99, 0xba, 0x2d, 0xa1, 0x26, 0x2c, 0xf7, 0xde, 0x76, 0x0b,
+	0x3e, 0xb1, 0x29, 0x20, 0xf6, 0x5e, 0x5c, 0xbe, 0x9c, 0xe4, 0x7e, 0x9a, 0x33, 0xfb, 0xb3, 0x04,
+	0x4a, 0xf9, 0x25, 0xae, 0xbb, 0x14, 0xc7, 0xde, 0x32, 0x09, 0xde, 0xb7, 0x87, 0xdf, 0xe1, 0x44,
+	0x6f, 0xe6, 0xe4, 0x8d, 0x31, 0x30, 0x02, 0x33, 0x5b, 0xb0, 0xdc, 0x7b, 0xd5, 0x3a, 0x4c, 0xf5,
+	0xd8, 0x2b, 0x60, 0xf9, 0x7a, 0x12, 0xd0, 0x80, 0x61, 0x13, 0x50, 0xf8, 0x62, 0x73, 0x98, 0x47,
+	0x63, 0x2e, 0x6b, 0xe5, 0xf5, 0x51, 0x80, 0xfe, 0x4d, 0x29, 0xb7, 0xb5, 0x01, 0x4b, 0x3d, 0x8f,
+	0xc0, 0x04, 0xb7, 0x84, 0x19, 0xec, 0xda, 0x20, 0xb0, 0xc8, 0xa3, 0x32, 0x65, 0x0a, 0x7d, 0x0d,
+	0xc5, 0xe8, 0x67, 0x78, 0x58, 0x0a, 0x1a, 0x58, 0x50, 0xca, 0xa5, 0xa4, 0xe0, 0x01, 0xf3, 0x6f,
+	0x24, 0x78, 0x7f, 0xe0, 0x03, 0x14, 0x21, 0xc4, 0x9d, 0x37, 0x79, 0x2e, 0x23, 0xdf, 0x1d, 0x13,
+	0xcb, 0x17, 0x69, 0xfb, 0x4b, 0x00, 0x23, 0xc0, 0xd8, 0x06, 0x56, 0x1a, 0x1c, 0x32, 0x22, 0xce,
+	0x6f, 0xae, 0x34, 0x0c, 0x7a, 0xe2, 0x1e, 0xb1, 0x8f, 0xb1, 0xf7, 0xe0, 0x93, 0xff, 0xb1, 0x9b,
+	0x8d, 0xde, 0x47, 0xa0, 0x3f, 0xa4, 0x2e, 0x30, 0xa4, 0xd2, 0x4e, 0xcb, 0xc0, 0x26, 0x2d, 0x6d,
+	0xb9, 0xd4, 0x6a, 0x60, 0xb3, 0xf4, 0x98, 0xd8, 0xf5, 0xd2, 0xe9, 0xc6, 0xd1, 0x34, 0x07, 0xfe,
+	0xf8, 0xff, 0x03, 0x00, 0x1d, 0x67, 0x3d, 0xa2, 0x3f, 0x2a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DaprClient is the client API for Dapr service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DaprClient interface {
+	// PublishEvent returns the ID the broker assigned to the event in the dapr-message-id response header, when the
+	// pubsub component reports it.
+	PublishEvent(ctx context.Context, in *PublishEventEnvelope, opts ...grpc.CallOption) (*empty.Empty, error)
+	InvokeService(ctx context.Context, in *InvokeServiceRequest, opts ...grpc.CallOption) (*v1.InvokeResponse, error)
+	InvokeBinding(ctx context.Context, in *InvokeBindingEnvelope, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetState(ctx context.Context, in *GetStateEnvelope, opts ...grpc.CallOption) (*GetStateResponseEnvelope, error)
+	GetSecret(ctx context.Context, in *GetSecretEnvelope, opts ...grpc.CallOption) (*GetSecretResponseEnvelope, error)
+	SaveState(ctx context.Context, in *SaveStateEnvelope, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteState(ctx context.Context, in *DeleteStateEnvelope, opts ...grpc.CallOption) (*empty.Empty, error)
+	// PublishEventStreamAlpha1 publishes the events sent on the stream in batches and acknowledges every event on the stream.
+	PublishEventStreamAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_PublishEventStreamAlpha1Client, error)
+	// InvokeActor invokes a method of a virtual actor.
+	InvokeActor(ctx context.Context, in *InvokeActorEnvelope, opts ...grpc.CallOption) (*InvokeActorResponseEnvelope, error)
+	// BulkPublishEventAlpha1 publishes several events to a topic in one request.
+	BulkPublishEventAlpha1(ctx context.Context, in *BulkPublishRequest, opts ...grpc.CallOption) (*BulkPublishResponse, error)
+	// InvokeBindingBulkAlpha1 sends several requests to an output binding in one call.
+	InvokeBindingBulkAlpha1(ctx context.Context, in *InvokeBindingBulkRequest, opts ...grpc.CallOption) (*InvokeBindingBulkResponse, error)
+	// GetBulkStateStreamAlpha1 streams the state of several keys as they are fetched.
+	GetBulkStateStreamAlpha1(ctx context.Context, in *GetBulkStateRequest, opts ...grpc.CallOption) (Dapr_GetBulkStateStreamAlpha1Client, error)
+	// SubscribeStateAlpha1 streams the changes of the keys of a state store that supports change feeds.
+	SubscribeStateAlpha1(ctx context.Context, in *SubscribeStateRequest, opts ...grpc.CallOption) (Dapr_SubscribeStateAlpha1Client, error)
+	// ExecuteCrossStoreTransactionAlpha1 applies operations spanning several state stores, restoring the stores already
+	// changed when the operations of a store fail.
+	ExecuteCrossStoreTransactionAlpha1(ctx context.Context, in *CrossStoreTransactionRequest, opts ...grpc.CallOption) (*CrossStoreTransactionResponse, error)
+	// QueryStateKeysAlpha1 lists the keys of the app in a state store that supports listing keys, a page at a time.
+	QueryStateKeysAlpha1(ctx context.Context, in *QueryStateKeysRequest, opts ...grpc.CallOption) (*QueryStateKeysResponse, error)
+	// MigrateStateAlpha1 copies the keys of the app from a state store to another and streams the progress. It is an
+	// admin API, only served when an admin API token is set.
+	MigrateStateAlpha1(ctx context.Context, in *MigrateStateRequest, opts ...grpc.CallOption) (Dapr_MigrateStateAlpha1Client, error)
+	// SaveBulkStateAlpha1 saves every key of the request on its own and returns the result of every key, instead of
+	// failing the whole request when a key fails like SaveState.
+	SaveBulkStateAlpha1(ctx context.Context, in *SaveStateEnvelope, opts ...grpc.CallOption) (*SaveBulkStateResponse, error)
+	// ListActorRemindersAlpha1 lists the reminders of an actor type with the time they fire next, a page at a time.
+	ListActorRemindersAlpha1(ctx context.Context, in *ListActorRemindersRequest, opts ...grpc.CallOption) (*ListActorRemindersResponse, error)
+	// GetBulkStateTransactionalAlpha1 reads several keys at a single point in time, in one transaction or snapshot of a
+	// state store that supports snapshot reads.
+	GetBulkStateTransactionalAlpha1(ctx context.Context, in *GetBulkStateTransactionalRequest, opts ...grpc.CallOption) (*GetBulkStateTransactionalResponse, error)
+}
+
+type daprClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDaprClient(cc *grpc.ClientConn) DaprClient {
+	return &daprClient{cc}
+}
+
+func (c *daprClient) PublishEvent(ctx context.Context, in *PublishEventEnvelope, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/PublishEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) InvokeService(ctx context.Context, in *InvokeServiceRequest, opts ...grpc.CallOption) (*v1.InvokeResponse, error) {
+	out := new(v1.InvokeResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/InvokeService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) InvokeBinding(ctx context.Context, in *InvokeBindingEnvelope, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/InvokeBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) GetState(ctx context.Context, in *GetStateEnvelope, opts ...grpc.CallOption) (*GetStateResponseEnvelope, error) {
+	out := new(GetStateResponseEnvelope)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/GetState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) GetSecret(ctx context.Context, in *GetSecretEnvelope, opts ...grpc.CallOption) (*GetSecretResponseEnvelope, error) {
+	out := new(GetSecretResponseEnvelope)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/GetSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) SaveState(ctx context.Context, in *SaveStateEnvelope, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/SaveState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) DeleteState(ctx context.Context, in *DeleteStateEnvelope, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/DeleteState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) PublishEventStreamAlpha1(ctx context.Context, opts ...grpc.CallOption) (Dapr_PublishEventStreamAlpha1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &_Dapr_serviceDesc.Streams[0], "/dapr.proto.dapr.v1.Dapr/PublishEventStreamAlpha1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprPublishEventStreamAlpha1Client{stream}
+	return x, nil
+}
+
+type Dapr_PublishEventStreamAlpha1Client interface {
+	Send(*PublishEventStreamRequest) error
+	Recv() (*PublishEventStreamResponse, error)
+	grpc.ClientStream
+}
+
+type daprPublishEventStreamAlpha1Client struct {
+	grpc.ClientStream
+}
+
+func (x *daprPublishEventStreamAlpha1Client) Send(m *PublishEventStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *daprPublishEventStreamAlpha1Client) Recv() (*PublishEventStreamResponse, error) {
+	m := new(PublishEventStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daprClient) InvokeActor(ctx context.Context, in *InvokeActorEnvelope, opts ...grpc.CallOption) (*InvokeActorResponseEnvelope, error) {
+	out := new(InvokeActorResponseEnvelope)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/InvokeActor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) BulkPublishEventAlpha1(ctx context.Context, in *BulkPublishRequest, opts ...grpc.CallOption) (*BulkPublishResponse, error) {
+	out := new(BulkPublishResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/BulkPublishEventAlpha1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) InvokeBindingBulkAlpha1(ctx context.Context, in *InvokeBindingBulkRequest, opts ...grpc.CallOption) (*InvokeBindingBulkResponse, error) {
+	out := new(InvokeBindingBulkResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/InvokeBindingBulkAlpha1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) GetBulkStateStreamAlpha1(ctx context.Context, in *GetBulkStateRequest, opts ...grpc.CallOption) (Dapr_GetBulkStateStreamAlpha1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &_Dapr_serviceDesc.Streams[1], "/dapr.proto.dapr.v1.Dapr/GetBulkStateStreamAlpha1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprGetBulkStateStreamAlpha1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func init() { proto.RegisterFile("dapr/proto/dapr/v1/dapr.proto", fileDescriptor_0f3c232bd8a4c7dd) }
+type Dapr_GetBulkStateStreamAlpha1Client interface {
+	Recv() (*BulkStateItem, error)
+	grpc.ClientStream
+}
 
-var fileDescriptor_0f3c232bd8a4c7dd = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0x8e, 0x9d, 0x5d, 0x92, 0x3d, 0x9b, 0xa2, 0x76, 0x58, 0xd0, 0xc6, 0xa5, 0xb0, 0x98, 0x02,
-	0x01, 0xc1, 0x44, 0x49, 0x85, 0x8a, 0x0a, 0x5c, 0x34, 0xdd, 0xa8, 0xe2, 0xb7, 0x91, 0x83, 0x10,
-	0xe2, 0x82, 0x32, 0xf1, 0x1e, 0xbc, 0x56, 0xbc, 0x33, 0x66, 0x3c, 0xb6, 0xb4, 0x12, 0xcf, 0x51,
-	0xae, 0xb9, 0xe0, 0x86, 0xc7, 0xe1, 0x25, 0x78, 0x07, 0xae, 0x90, 0x67, 0x6c, 0xaf, 0xb3, 0xf6,
-	0x6e, 0x93, 0x16, 0x24, 0xae, 0x3c, 0x9e, 0x39, 0x3f, 0xdf, 0x7c, 0x33, 0xf3, 0x9d, 0x03, 0xb7,
-	0x26, 0x2c, 0x96, 0xfb, 0xb1, 0x14, 0x4a, 0xec, 0xeb, 0x61, 0x76, 0xa0, 0xbf, 0x54, 0x4f, 0x11,
-	0xb2, 0x18, 0x53, 0x3d, 0xcc, 0x0e, 0x9c, 0xdd, 0x40, 0x88, 0x20, 0x42, 0xe3, 0x74, 0x96, 0xfe,
-	0xb4, 0xcf, 0xf8, 0xdc, 0x98, 0x38, 0x37, 0x97, 0x97, 0x70, 0x16, 0xab, 0x72, 0xf1, 0xb5, 0xe5,
-	0xc5, 0x49, 0x2a, 0x99, 0x0a, 0x05, 0x2f, 0xd6, 0xdf, 0xa8, 0x41, 0xf1, 0xc5, 0x6c, 0x26, 0x78,
-	0x0e, 0xc6, 0x8c, 0x8c, 0x89, 0x8b, 0x30, 0xf8, 0x8c, 0x67, 0xe2, 0x1c, 0x4f, 0x51, 0x66, 0xa1,
-	0x8f, 0x1e, 0xfe, 0x9c, 0x62, 0xa2, 0xc8, 0x8b, 0x60, 0x87, 0x93, 0xa1, 0x35, 0xb2, 0xf6, 0x7a,
-	0x9e, 0x1d, 0x4e, 0xc8, 0xa7, 0xb0, 0x35, 0xc3, 0x24, 0x61, 0x01, 0x0e, 0x37, 0x47, 0xd6, 0x5e,
-	0xff, 0xf0, 0x4d, 0x5a, 0xdb, 0x48, 0x11, 0x32, 0x3b, 0xa0, 0x26, 0x58, 0x11, 0xc5, 0x2b, 0x7d,
-	0xdc, 0x27, 0x16, 0xbc, 0x34, 0xc6, 0x08, 0x15, 0x9e, 0x2a, 0xa6, 0xf0, 0x98, 0x67, 0x18, 0x89,
-	0x18, 0xc9, 0x2d, 0x80, 0x44, 0x09, 0x89, 0x8f, 0x39, 0x9b, 0x61, 0x91, 0xae, 0xa7, 0x67, 0xbe,
-	0x66, 0x33, 0x24, 0xd7, 0x61, 0xf3, 0x1c, 0xe7, 0x43, 0x5b, 0xcf, 0xe7, 0x43, 0x42, 0xa0, 0x83,
-	0x8a, 0x05, 0x1a, 0x44, 0xcf, 0xd3, 0x63, 0x72, 0x0f, 0xb6, 0x44, 0x9c, 0x6f, 0x3b, 0x19, 0x76,
-	0x34, 0xb6, 0x11, 0x6d, 0x92, 0x4c, 0x75, 0xe2, 0x47, 0xc6, 0xce, 0x2b, 0x1d, 0xdc, 0x18, 0x6e,
-	0x9c, 0xb2, 0xec, 0x6a, 0xa8, 0x3e, 0x81, 0x6d, 0x69, 0x36, 0x98, 0x0c, 0xed, 0xd1, 0xe6, 0xda,
-	0x84, 0x25, 0x13, 0x95, 0x87, 0x8b, 0x70, 0xfd, 0x21, 0xaa, 0xe7, 0xa4, 0x61, 0x04, 0x7d, 0x5f,
-	0xf0, 0x24, 0x4c, 0x14, 0x72, 0x7f, 0x5e, 0xb0, 0x51, 0x9f, 0x72, 0xbf, 0x83, 0x61, 0x99, 0xc6,
-	0xc3, 0x24, 0x16, 0x3c, 0x59, 0xa4, 0xdb, 0x83, 0xce, 0x84, 0x29, 0xa6, 0x13, 0xf5, 0x0f, 0x07,
-	0xd4, 0x5c, 0x23, 0x5a, 0x5e, 0x23, 0x7a, 0x9f, 0xcf, 0x3d, 0x6d, 0x51, 0xd1, 0x6d, 0x2f, 0xe8,
-	0x76, 0xff, 0xb4, 0xe0, 0x46, 0x1e, 0x1a, 0x7d, 0x89, 0xea, 0xd9, 0xb7, 0xf0, 0x08, 0xb6, 0x67,
-	0xa8, 0x98, 0x06, 0xb2, 0xa9, 0x59, 0xbc, 0xd3, 0xc6, 0x62, 0x23, 0x13, 0xfd, 0xaa, 0xf0, 0x3a,
-	0xe6, 0x4a, 0xce, 0xbd, 0x2a, 0x88, 0xf3, 0x31, 0x5c, 0xbb, 0xb0, 0x54, 0xe6, 0xb4, 0x16, 0x39,
-	0x07, 0xd0, 0xcd, 0x58, 0x94, 0x62, 0x81, 0xc3, 0xfc, 0xdc, 0xb3, 0x3f, 0xb2, 0xdc, 0xdf, 0x2c,
-	0xd8, 0xad, 0x52, 0x35, 0x08, 0xfb, 0xa2, 0x22, 0x2c, 0xc7, 0x79, 0x77, 0x2d, 0xce, 0x65, 0x67,
-	0x3a, 0xae, 0xb0, 0xea, 0x20, 0xce, 0x5d, 0xe8, 0x8d, 0x9f, 0x09, 0xe3, 0x5f, 0x16, 0xbc, 0x6c,
-	0xde, 0xd7, 0x51, 0xc8, 0x27, 0x21, 0x0f, 0x2a, 0x7c, 0x04, 0x3a, 0x35, 0xda, 0xf5, 0xb8, 0x3a,
-	0x64, 0xfb, 0xa9, 0x87, 0x7c, 0xda, 0x38, 0x89, 0xd6, 0x1d, 0xb6, 0xa6, 0xfe, 0x6f, 0x4e, 0xe3,
-	0x5b, 0x18, 0x9c, 0xa4, 0x67, 0x51, 0x98, 0x4c, 0x8f, 0x33, 0xe4, 0x8b, 0x4b, 0x36, 0x80, 0xae,
-	0x12, 0x71, 0xe8, 0x17, 0x51, 0xcc, 0xcf, 0xe5, 0x77, 0xea, 0xfe, 0x6a, 0x43, 0x57, 0x3f, 0x89,
-	0x16, 0x34, 0xef, 0xd5, 0xd1, 0xac, 0x0a, 0x63, 0x4c, 0x5a, 0x55, 0xe8, 0x41, 0x8d, 0xc5, 0x8e,
-	0x66, 0xf1, 0x9d, 0x95, 0xaa, 0xb0, 0x8a, 0xb5, 0xba, 0x94, 0x75, 0xaf, 0x28, 0x65, 0xcf, 0xc7,
-	0xf8, 0x13, 0x0b, 0x76, 0xea, 0x61, 0x0b, 0x85, 0xf1, 0x53, 0x29, 0xb5, 0xc2, 0x58, 0x95, 0xc2,
-	0x94, 0x53, 0xcb, 0x1a, 0x64, 0x37, 0x34, 0x88, 0x1c, 0xc1, 0x8e, 0x44, 0x25, 0xe7, 0x8f, 0x63,
-	0x11, 0x85, 0x85, 0x4c, 0xf5, 0x0f, 0x5f, 0x6f, 0xdb, 0x92, 0x97, 0xdb, 0x9d, 0x68, 0x33, 0xaf,
-	0x2f, 0x17, 0x3f, 0xee, 0x2f, 0xd0, 0xaf, 0xad, 0x91, 0x57, 0xa1, 0xa7, 0xa6, 0x12, 0x93, 0xa9,
-	0x88, 0x4c, 0x79, 0xea, 0x7a, 0x8b, 0x09, 0x32, 0x84, 0xad, 0x98, 0x29, 0x85, 0x92, 0x17, 0x70,
-	0xca, 0x5f, 0xf2, 0x21, 0x6c, 0x87, 0x5c, 0xa1, 0xcc, 0x58, 0x54, 0xc0, 0xd8, 0x6d, 0x1c, 0xf0,
-	0xb8, 0xa8, 0x9e, 0x5e, 0x65, 0xea, 0xfe, 0x6e, 0x17, 0xb4, 0x94, 0x75, 0xf1, 0xdf, 0xbf, 0x37,
-	0x9f, 0x37, 0xee, 0x0d, 0x7d, 0x5a, 0x35, 0xf9, 0xdf, 0x5d, 0x9f, 0xc3, 0xbf, 0x3b, 0xd0, 0x19,
-	0xb3, 0x58, 0x12, 0x0f, 0x76, 0xea, 0x2f, 0x97, 0xec, 0xb5, 0x01, 0x68, 0x7b, 0xdb, 0xce, 0x2b,
-	0x0d, 0xe2, 0x8e, 0xf3, 0x56, 0xc7, 0xdd, 0x20, 0x0c, 0xae, 0x5d, 0xe8, 0x51, 0xda, 0x83, 0xb6,
-	0xb5, 0x31, 0xce, 0xed, 0xf5, 0x5d, 0x8a, 0x51, 0x6a, 0x77, 0x83, 0x7c, 0x53, 0xa6, 0x28, 0xe4,
-	0x8d, 0xbc, 0x7b, 0x69, 0x05, 0x5c, 0x03, 0xfc, 0x47, 0xd8, 0x2e, 0x6b, 0x30, 0xb9, 0xbd, 0xaa,
-	0x68, 0xd4, 0x1b, 0x01, 0xe7, 0xfd, 0x75, 0x56, 0xcb, 0x95, 0xc5, 0xdd, 0x20, 0x3e, 0xf4, 0xaa,
-	0xc2, 0x43, 0xde, 0xba, 0x54, 0xfd, 0x74, 0x3e, 0xb8, 0x52, 0xf9, 0x72, 0x37, 0xc8, 0x97, 0xd0,
-	0xab, 0x7a, 0xa4, 0xf6, 0x24, 0x8d, 0x16, 0x6a, 0x0d, 0x29, 0x27, 0xd0, 0xaf, 0x75, 0x82, 0xa4,
-	0x55, 0x24, 0x5b, 0x5a, 0xc5, 0xd5, 0x11, 0x8f, 0x7e, 0x00, 0x08, 0x2b, 0xdf, 0x23, 0xc8, 0xef,
-	0xe1, 0x49, 0x6e, 0x93, 0x7c, 0xff, 0x76, 0x10, 0xaa, 0x69, 0x7a, 0x96, 0x9f, 0xbc, 0xe9, 0xc5,
-	0x4d, 0x43, 0x7c, 0x1e, 0x5c, 0xec, 0xcf, 0xff, 0xb0, 0x6f, 0xe6, 0x4e, 0xf4, 0x41, 0x14, 0x22,
-	0x57, 0xf4, 0x7e, 0xaa, 0x44, 0x80, 0x9c, 0x3e, 0x94, 0xb1, 0x4f, 0xb3, 0x83, 0xb3, 0x17, 0xb4,
-	0xf1, 0x9d, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x41, 0x75, 0x5a, 0xfa, 0xda, 0x0b, 0x00, 0x00,
+type daprGetBulkStateStreamAlpha1Client struct {
+	grpc.ClientStream
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (x *daprGetBulkStateStreamAlpha1Client) Recv() (*BulkStateItem, error) {
+	m := new(BulkStateItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (c *daprClient) SubscribeStateAlpha1(ctx context.Context, in *SubscribeStateRequest, opts ...grpc.CallOption) (Dapr_SubscribeStateAlpha1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &_Dapr_serviceDesc.Streams[2], "/dapr.proto.dapr.v1.Dapr/SubscribeStateAlpha1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprSubscribeStateAlpha1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
 
-// DaprClient is the client API for Dapr service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DaprClient interface {
-	PublishEvent(ctx context.Context, in *PublishEventEnvelope, opts ...grpc.CallOption) (*empty.Empty, error)
-	InvokeService(ctx context.Context, in *InvokeServiceRequest, opts ...grpc.CallOption) (*v1.InvokeResponse, error)
-	InvokeBinding(ctx context.Context, in *InvokeBindingEnvelope, opts ...grpc.CallOption) (*empty.Empty, error)
-	GetState(ctx context.Context, in *GetStateEnvelope, opts ...grpc.CallOption) (*GetStateResponseEnvelope, error)
-	GetSecret(ctx context.Context, in *GetSecretEnvelope, opts ...grpc.CallOption) (*GetSecretResponseEnvelope, error)
-	SaveState(ctx context.Context, in *SaveStateEnvelope, opts ...grpc.CallOption) (*empty.Empty, error)
-	DeleteState(ctx context.Context, in *DeleteStateEnvelope, opts ...grpc.CallOption) (*empty.Empty, error)
+type Dapr_SubscribeStateAlpha1Client interface {
+	Recv() (*StateChangeEvent, error)
+	grpc.ClientStream
 }
 
-type daprClient struct {
-	cc *grpc.ClientConn
+type daprSubscribeStateAlpha1Client struct {
+	grpc.ClientStream
 }
 
-func NewDaprClient(cc *grpc.ClientConn) DaprClient {
-	return &daprClient{cc}
+func (x *daprSubscribeStateAlpha1Client) Recv() (*StateChangeEvent, error) {
+	m := new(StateChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (c *daprClient) PublishEvent(ctx context.Context, in *PublishEventEnvelope, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/PublishEvent", in, out, opts...)
+func (c *daprClient) ExecuteCrossStoreTransactionAlpha1(ctx context.Context, in *CrossStoreTransactionRequest, opts ...grpc.CallOption) (*CrossStoreTransactionResponse, error) {
+	out := new(CrossStoreTransactionResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/ExecuteCrossStoreTransactionAlpha1", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daprClient) InvokeService(ctx context.Context, in *InvokeServiceRequest, opts ...grpc.CallOption) (*v1.InvokeResponse, error) {
-	out := new(v1.InvokeResponse)
-	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/InvokeService", in, out, opts...)
+func (c *daprClient) QueryStateKeysAlpha1(ctx context.Context, in *QueryStateKeysRequest, opts ...grpc.CallOption) (*QueryStateKeysResponse, error) {
+	out := new(QueryStateKeysResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/QueryStateKeysAlpha1", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daprClient) InvokeBinding(ctx context.Context, in *InvokeBindingEnvelope, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/InvokeBinding", in, out, opts...)
+func (c *daprClient) MigrateStateAlpha1(ctx context.Context, in *MigrateStateRequest, opts ...grpc.CallOption) (Dapr_MigrateStateAlpha1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &_Dapr_serviceDesc.Streams[3], "/dapr.proto.dapr.v1.Dapr/MigrateStateAlpha1", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &daprMigrateStateAlpha1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *daprClient) GetState(ctx context.Context, in *GetStateEnvelope, opts ...grpc.CallOption) (*GetStateResponseEnvelope, error) {
-	out := new(GetStateResponseEnvelope)
-	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/GetState", in, out, opts...)
-	if err != nil {
+type Dapr_MigrateStateAlpha1Client interface {
+	Recv() (*MigrateStateProgress, error)
+	grpc.ClientStream
+}
+
+type daprMigrateStateAlpha1Client struct {
+	grpc.ClientStream
+}
+
+func (x *daprMigrateStateAlpha1Client) Recv() (*MigrateStateProgress, error) {
+	m := new(MigrateStateProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *daprClient) GetSecret(ctx context.Context, in *GetSecretEnvelope, opts ...grpc.CallOption) (*GetSecretResponseEnvelope, error) {
-	out := new(GetSecretResponseEnvelope)
-	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/GetSecret", in, out, opts...)
+func (c *daprClient) SaveBulkStateAlpha1(ctx context.Context, in *SaveStateEnvelope, opts ...grpc.CallOption) (*SaveBulkStateResponse, error) {
+	out := new(SaveBulkStateResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/SaveBulkStateAlpha1", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daprClient) SaveState(ctx context.Context, in *SaveStateEnvelope, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/SaveState", in, out, opts...)
+func (c *daprClient) ListActorRemindersAlpha1(ctx context.Context, in *ListActorRemindersRequest, opts ...grpc.CallOption) (*ListActorRemindersResponse, error) {
+	out := new(ListActorRemindersResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/ListActorRemindersAlpha1", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daprClient) DeleteState(ctx context.Context, in *DeleteStateEnvelope, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/DeleteState", in, out, opts...)
+func (c *daprClient) GetBulkStateTransactionalAlpha1(ctx context.Context, in *GetBulkStateTransactionalRequest, opts ...grpc.CallOption) (*GetBulkStateTransactionalResponse, error) {
+	out := new(GetBulkStateTransactionalResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.dapr.v1.Dapr/GetBulkStateTransactionalAlpha1", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -919,6 +3353,8 @@ func (c *daprClient) DeleteState(ctx context.Context, in *DeleteStateEnvelope, o
 
 // DaprServer is the server API for Dapr service.
 type DaprServer interface {
+	// PublishEvent returns the ID the broker assigned to the event in the dapr-message-id response header, when the
+	// pubsub component reports it.
 	PublishEvent(context.Context, *PublishEventEnvelope) (*empty.Empty, error)
 	InvokeService(context.Context, *InvokeServiceRequest) (*v1.InvokeResponse, error)
 	InvokeBinding(context.Context, *InvokeBindingEnvelope) (*empty.Empty, error)
@@ -926,6 +3362,34 @@ type DaprServer interface {
 	GetSecret(context.Context, *GetSecretEnvelope) (*GetSecretResponseEnvelope, error)
 	SaveState(context.Context, *SaveStateEnvelope) (*empty.Empty, error)
 	DeleteState(context.Context, *DeleteStateEnvelope) (*empty.Empty, error)
+	// PublishEventStreamAlpha1 publishes the events sent on the stream in batches and acknowledges every event on the stream.
+	PublishEventStreamAlpha1(Dapr_PublishEventStreamAlpha1Server) error
+	// InvokeActor invokes a method of a virtual actor.
+	InvokeActor(context.Context, *InvokeActorEnvelope) (*InvokeActorResponseEnvelope, error)
+	// BulkPublishEventAlpha1 publishes several events to a topic in one request.
+	BulkPublishEventAlpha1(context.Context, *BulkPublishRequest) (*BulkPublishResponse, error)
+	// InvokeBindingBulkAlpha1 sends several requests to an output binding in one call.
+	InvokeBindingBulkAlpha1(context.Context, *InvokeBindingBulkRequest) (*InvokeBindingBulkResponse, error)
+	// GetBulkStateStreamAlpha1 streams the state of several keys as they are fetched.
+	GetBulkStateStreamAlpha1(*GetBulkStateRequest, Dapr_GetBulkStateStreamAlpha1Server) error
+	// SubscribeStateAlpha1 streams the changes of the keys of a state store that supports change feeds.
+	SubscribeStateAlpha1(*SubscribeStateRequest, Dapr_SubscribeStateAlpha1Server) error
+	// ExecuteCrossStoreTransactionAlpha1 applies operations spanning several state stores, restoring the stores already
+	// changed when the operations of a store fail.
+	ExecuteCrossStoreTransactionAlpha1(context.Context, *CrossStoreTransactionRequest) (*CrossStoreTransactionResponse, error)
+	// QueryStateKeysAlpha1 lists the keys of the app in a state store that supports listing keys, a page at a time.
+	QueryStateKeysAlpha1(context.Context, *QueryStateKeysRequest) (*QueryStateKeysResponse, error)
+	// MigrateStateAlpha1 copies the keys of the app from a state store to another and streams the progress. It is an
+	// admin API, only served when an admin API token is set.
+	MigrateStateAlpha1(*MigrateStateRequest, Dapr_MigrateStateAlpha1Server) error
+	// SaveBulkStateAlpha1 saves every key of the request on its own and returns the result of every key, instead of
+	// failing the whole request when a key fails like SaveState.
+	SaveBulkStateAlpha1(context.Context, *SaveStateEnvelope) (*SaveBulkStateResponse, error)
+	// ListActorRemindersAlpha1 lists the reminders of an actor type with the time they fire next, a page at a time.
+	ListActorRemindersAlpha1(context.Context, *ListActorRemindersRequest) (*ListActorRemindersResponse, error)
+	// GetBulkStateTransactionalAlpha1 reads several keys at a single point in time, in one transaction or snapshot of a
+	// state store that supports snapshot reads.
+	GetBulkStateTransactionalAlpha1(context.Context, *GetBulkStateTransactionalRequest) (*GetBulkStateTransactionalResponse, error)
 }
 
 // UnimplementedDaprServer can be embedded to have forward compatible implementations.
@@ -953,6 +3417,42 @@ func (*UnimplementedDaprServer) SaveState(ctx context.Context, req *SaveStateEnv
 func (*UnimplementedDaprServer) DeleteState(ctx context.Context, req *DeleteStateEnvelope) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteState not implemented")
 }
+func (*UnimplementedDaprServer) PublishEventStreamAlpha1(srv Dapr_PublishEventStreamAlpha1Server) error {
+	return status.Errorf(codes.Unimplemented, "method PublishEventStreamAlpha1 not implemented")
+}
+func (*UnimplementedDaprServer) InvokeActor(ctx context.Context, req *InvokeActorEnvelope) (*InvokeActorResponseEnvelope, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvokeActor not implemented")
+}
+func (*UnimplementedDaprServer) BulkPublishEventAlpha1(ctx context.Context, req *BulkPublishRequest) (*BulkPublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkPublishEventAlpha1 not implemented")
+}
+func (*UnimplementedDaprServer) InvokeBindingBulkAlpha1(ctx context.Context, req *InvokeBindingBulkRequest) (*InvokeBindingBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvokeBindingBulkAlpha1 not implemented")
+}
+func (*UnimplementedDaprServer) GetBulkStateStreamAlpha1(req *GetBulkStateRequest, srv Dapr_GetBulkStateStreamAlpha1Server) error {
+	return status.Errorf(codes.Unimplemented, "method GetBulkStateStreamAlpha1 not implemented")
+}
+func (*UnimplementedDaprServer) SubscribeStateAlpha1(req *SubscribeStateRequest, srv Dapr_SubscribeStateAlpha1Server) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeStateAlpha1 not implemented")
+}
+func (*UnimplementedDaprServer) ExecuteCrossStoreTransactionAlpha1(ctx context.Context, req *CrossStoreTransactionRequest) (*CrossStoreTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteCrossStoreTransactionAlpha1 not implemented")
+}
+func (*UnimplementedDaprServer) QueryStateKeysAlpha1(ctx context.Context, req *QueryStateKeysRequest) (*QueryStateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryStateKeysAlpha1 not implemented")
+}
+func (*UnimplementedDaprServer) MigrateStateAlpha1(req *MigrateStateRequest, srv Dapr_MigrateStateAlpha1Server) error {
+	return status.Errorf(codes.Unimplemented, "method MigrateStateAlpha1 not implemented")
+}
+func (*UnimplementedDaprServer) SaveBulkStateAlpha1(ctx context.Context, req *SaveStateEnvelope) (*SaveBulkStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveBulkStateAlpha1 not implemented")
+}
+func (*UnimplementedDaprServer) ListActorRemindersAlpha1(ctx context.Context, req *ListActorRemindersRequest) (*ListActorRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActorRemindersAlpha1 not implemented")
+}
+func (*UnimplementedDaprServer) GetBulkStateTransactionalAlpha1(ctx context.Context, req *GetBulkStateTransactionalRequest) (*GetBulkStateTransactionalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBulkStateTransactionalAlpha1 not implemented")
+}
 
 func RegisterDaprServer(s *grpc.Server, srv DaprServer) {
 	s.RegisterService(&_Dapr_serviceDesc, srv)
@@ -1084,6 +3584,239 @@ func _Dapr_DeleteState_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_PublishEventStreamAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DaprServer).PublishEventStreamAlpha1(&daprPublishEventStreamAlpha1Server{stream})
+}
+
+type Dapr_PublishEventStreamAlpha1Server interface {
+	Send(*PublishEventStreamResponse) error
+	Recv() (*PublishEventStreamRequest, error)
+	grpc.ServerStream
+}
+
+type daprPublishEventStreamAlpha1Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprPublishEventStreamAlpha1Server) Send(m *PublishEventStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *daprPublishEventStreamAlpha1Server) Recv() (*PublishEventStreamRequest, error) {
+	m := new(PublishEventStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Dapr_InvokeActor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvokeActorEnvelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).InvokeActor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.dapr.v1.Dapr/InvokeActor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).InvokeActor(ctx, req.(*InvokeActorEnvelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_BulkPublishEventAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkPublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).BulkPublishEventAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.dapr.v1.Dapr/BulkPublishEventAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).BulkPublishEventAlpha1(ctx, req.(*BulkPublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_InvokeBindingBulkAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvokeBindingBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).InvokeBindingBulkAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.dapr.v1.Dapr/InvokeBindingBulkAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).InvokeBindingBulkAlpha1(ctx, req.(*InvokeBindingBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_GetBulkStateStreamAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBulkStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaprServer).GetBulkStateStreamAlpha1(m, &daprGetBulkStateStreamAlpha1Server{stream})
+}
+
+type Dapr_GetBulkStateStreamAlpha1Server interface {
+	Send(*BulkStateItem) error
+	grpc.ServerStream
+}
+
+type daprGetBulkStateStreamAlpha1Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprGetBulkStateStreamAlpha1Server) Send(m *BulkStateItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Dapr_SubscribeStateAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaprServer).SubscribeStateAlpha1(m, &daprSubscribeStateAlpha1Server{stream})
+}
+
+type Dapr_SubscribeStateAlpha1Server interface {
+	Send(*StateChangeEvent) error
+	grpc.ServerStream
+}
+
+type daprSubscribeStateAlpha1Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprSubscribeStateAlpha1Server) Send(m *StateChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Dapr_ExecuteCrossStoreTransactionAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrossStoreTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ExecuteCrossStoreTransactionAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.dapr.v1.Dapr/ExecuteCrossStoreTransactionAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ExecuteCrossStoreTransactionAlpha1(ctx, req.(*CrossStoreTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_QueryStateKeysAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).QueryStateKeysAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.dapr.v1.Dapr/QueryStateKeysAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).QueryStateKeysAlpha1(ctx, req.(*QueryStateKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_MigrateStateAlpha1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MigrateStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaprServer).MigrateStateAlpha1(m, &daprMigrateStateAlpha1Server{stream})
+}
+
+type Dapr_MigrateStateAlpha1Server interface {
+	Send(*MigrateStateProgress) error
+	grpc.ServerStream
+}
+
+type daprMigrateStateAlpha1Server struct {
+	grpc.ServerStream
+}
+
+func (x *daprMigrateStateAlpha1Server) Send(m *MigrateStateProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Dapr_SaveBulkStateAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveStateEnvelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).SaveBulkStateAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.dapr.v1.Dapr/SaveBulkStateAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).SaveBulkStateAlpha1(ctx, req.(*SaveStateEnvelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_ListActorRemindersAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActorRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ListActorRemindersAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.dapr.v1.Dapr/ListActorRemindersAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ListActorRemindersAlpha1(ctx, req.(*ListActorRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_GetBulkStateTransactionalAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBulkStateTransactionalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).GetBulkStateTransactionalAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.dapr.v1.Dapr/GetBulkStateTransactionalAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).GetBulkStateTransactionalAlpha1(ctx, req.(*GetBulkStateTransactionalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Dapr_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dapr.proto.dapr.v1.Dapr",
 	HandlerType: (*DaprServer)(nil),
@@ -1116,7 +3849,61 @@ var _Dapr_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteState",
 			Handler:    _Dapr_DeleteState_Handler,
 		},
+		{
+			MethodName: "InvokeActor",
+			Handler:    _Dapr_InvokeActor_Handler,
+		},
+		{
+			MethodName: "BulkPublishEventAlpha1",
+			Handler:    _Dapr_BulkPublishEventAlpha1_Handler,
+		},
+		{
+			MethodName: "InvokeBindingBulkAlpha1",
+			Handler:    _Dapr_InvokeBindingBulkAlpha1_Handler,
+		},
+		{
+			MethodName: "ExecuteCrossStoreTransactionAlpha1",
+			Handler:    _Dapr_ExecuteCrossStoreTransactionAlpha1_Handler,
+		},
+		{
+			MethodName: "QueryStateKeysAlpha1",
+			Handler:    _Dapr_QueryStateKeysAlpha1_Handler,
+		},
+		{
+			MethodName: "SaveBulkStateAlpha1",
+			Handler:    _Dapr_SaveBulkStateAlpha1_Handler,
+		},
+		{
+			MethodName: "ListActorRemindersAlpha1",
+			Handler:    _Dapr_ListActorRemindersAlpha1_Handler,
+		},
+		{
+			MethodName: "GetBulkStateTransactionalAlpha1",
+			Handler:    _Dapr_GetBulkStateTransactionalAlpha1_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PublishEventStreamAlpha1",
+			Handler:       _Dapr_PublishEventStreamAlpha1_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetBulkStateStreamAlpha1",
+			Handler:       _Dapr_GetBulkStateStreamAlpha1_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeStateAlpha1",
+			Handler:       _Dapr_SubscribeStateAlpha1_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "MigrateStateAlpha1",
+			Handler:       _Dapr_MigrateStateAlpha1_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "dapr/proto/dapr/v1/dapr.proto",
 }
@@ -6,8 +6,6 @@ package v1
 import (
 	context "context"
 	fmt "fmt"
-	math "math"
-
 	v1 "github.com/dapr/dapr/pkg/proto/common/v1"
 	proto "github.com/golang/protobuf/proto"
 	any "github.com/golang/protobuf/ptypes/any"
@@ -16,6 +14,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -274,11 +273,20 @@ func (m *GetTopicSubscriptionsEnvelope) GetSubscriptions() []*TopicSubscriptionE
 }
 
 type TopicSubscriptionEnvelope struct {
-	Topic                string            `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
-	Metadata             map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Topic    string            `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// filter is a CEL expression evaluated against the cloud event, as `event`, before delivering it to the app.
+	// Events the expression doesn't match are acknowledged without being delivered.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// max_concurrent_handlers is the maximum number of events of the topic delivered to the app at the same time.
+	// 0 doesn't limit the concurrency.
+	MaxConcurrentHandlers int32 `protobuf:"varint,4,opt,name=max_concurrent_handlers,json=maxConcurrentHandlers,proto3" json:"max_concurrent_handlers,omitempty"`
+	// data_fields projects the data of the events of the topic on these fields before delivering them to the app, with
+	// dots separating nested fields, e.g. customer.id.
+	DataFields           []string `protobuf:"bytes,5,rep,name=data_fields,json=dataFields,proto3" json:"data_fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *TopicSubscriptionEnvelope) Reset()         { *m = TopicSubscriptionEnvelope{} }
@@ -320,6 +328,27 @@ func (m *TopicSubscriptionEnvelope) GetMetadata() map[string]string {
 	return nil
 }
 
+func (m *TopicSubscriptionEnvelope) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *TopicSubscriptionEnvelope) GetMaxConcurrentHandlers() int32 {
+	if m != nil {
+		return m.MaxConcurrentHandlers
+	}
+	return 0
+}
+
+func (m *TopicSubscriptionEnvelope) GetDataFields() []string {
+	if m != nil {
+		return m.DataFields
+	}
+	return nil
+}
+
 type GetBindingsSubscriptionsEnvelope struct {
 	Bindings             []string `protobuf:"bytes,1,rep,name=bindings,proto3" json:"bindings,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -560,62 +589,66 @@ func init() {
 }
 
 var fileDescriptor_bb919fe08a3c35cb = []byte{
-	// 869 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xd9, 0x6e, 0xeb, 0x44,
-	0x18, 0x8e, 0x9d, 0xa4, 0x4d, 0xff, 0xf4, 0x94, 0xc3, 0xa8, 0x1c, 0x5c, 0x1f, 0x96, 0x60, 0x16,
-	0x85, 0xa3, 0xe2, 0x28, 0xa9, 0x2a, 0x50, 0x41, 0x88, 0x2e, 0x51, 0xe9, 0x05, 0x4a, 0xe5, 0x56,
-	0x65, 0x91, 0x50, 0xe5, 0x38, 0x43, 0xea, 0xd6, 0x99, 0x31, 0xe3, 0xb1, 0x25, 0x23, 0x9e, 0x82,
-	0x6b, 0xee, 0xb8, 0x43, 0xbc, 0x0a, 0xaf, 0xc0, 0x0b, 0xf0, 0x12, 0x68, 0x66, 0xec, 0xc4, 0x4d,
-	0xe2, 0x44, 0x80, 0xce, 0x8d, 0xf5, 0x2f, 0x9f, 0xbf, 0x7f, 0xf5, 0x8c, 0xe1, 0xc3, 0x91, 0x1b,
-	0xb2, 0x4e, 0xc8, 0x28, 0xa7, 0x1d, 0x21, 0x7a, 0x81, 0x8f, 0x09, 0xef, 0x24, 0xdd, 0x82, 0x66,
-	0x4b, 0x37, 0x32, 0x84, 0x45, 0xc9, 0x76, 0xc1, 0x99, 0x74, 0xcd, 0xbd, 0x31, 0xa5, 0xe3, 0x00,
-	0x2b, 0x9a, 0x61, 0xfc, 0x43, 0xc7, 0x25, 0xa9, 0x02, 0x9a, 0xcf, 0xe7, 0x5d, 0x78, 0x12, 0xf2,
-	0xdc, 0xf9, 0xd6, 0xbc, 0x73, 0x14, 0x33, 0x97, 0xfb, 0x94, 0x64, 0xfe, 0x77, 0x0a, 0xc9, 0x79,
-	0x74, 0x32, 0xa1, 0x44, 0x24, 0xa6, 0x24, 0x05, 0xb1, 0xfe, 0xd2, 0x00, 0x9d, 0x06, 0x34, 0x1e,
-	0xf5, 0x13, 0x4c, 0x78, 0x9f, 0x24, 0x38, 0xa0, 0x21, 0x46, 0x3b, 0xa0, 0xfb, 0x23, 0x43, 0x6b,
-	0x69, 0xed, 0x2d, 0x47, 0xf7, 0x47, 0xe8, 0x19, 0x6c, 0x44, 0x34, 0x66, 0x1e, 0x36, 0x74, 0x69,
-	0xcb, 0x34, 0x84, 0xa0, 0xc6, 0xd3, 0x10, 0x1b, 0x55, 0x69, 0x95, 0x32, 0x6a, 0x41, 0x33, 0x0a,
-	0xb1, 0x77, 0x83, 0x59, 0xe4, 0x53, 0x62, 0xd4, 0xa4, 0xab, 0x68, 0x42, 0x2f, 0xe0, 0xd5, 0x91,
-	0xcb, 0xdd, 0x5b, 0x8f, 0x12, 0x8e, 0x09, 0xbf, 0x95, 0x14, 0x75, 0x89, 0x7b, 0x45, 0x38, 0x4e,
-	0x95, 0xfd, 0x5a, 0xb0, 0xed, 0x42, 0x9d, 0xd3, 0xd0, 0xf7, 0x8c, 0x0d, 0xe9, 0x57, 0x0a, 0x6a,
-	0x43, 0x4d, 0x00, 0x8d, 0xcd, 0x96, 0xd6, 0x6e, 0xf6, 0x76, 0x6d, 0xd5, 0x08, 0x3b, 0x6f, 0x84,
-	0x7d, 0x4c, 0x52, 0x47, 0x22, 0xac, 0xbf, 0x35, 0xd8, 0x3d, 0xf1, 0xc9, 0xc8, 0x27, 0xe3, 0xc7,
-	0x25, 0x22, 0xa8, 0x11, 0x77, 0x82, 0xb3, 0x22, 0xa5, 0x3c, 0xa5, 0xd5, 0xd7, 0xd1, 0xa2, 0x6f,
-	0xa0, 0x31, 0xc1, 0xdc, 0x95, 0xe8, 0x6a, 0xab, 0xda, 0x6e, 0xf6, 0x3e, 0xb3, 0xcb, 0xe6, 0x6b,
-	0x2f, 0x8b, 0x6f, 0x7f, 0x95, 0xbd, 0xde, 0x27, 0x9c, 0xa5, 0xce, 0x94, 0xcd, 0xfc, 0x14, 0x9e,
-	0x3c, 0x72, 0xa1, 0xa7, 0x50, 0x7d, 0xc0, 0x69, 0x96, 0xa7, 0x10, 0x45, 0x4f, 0x12, 0x37, 0x88,
-	0xf3, 0x61, 0x28, 0xe5, 0x48, 0xff, 0x44, 0xb3, 0xfe, 0xd0, 0xe0, 0xf5, 0x2c, 0x9a, 0x83, 0xa3,
-	0x90, 0x92, 0x08, 0x4f, 0x0b, 0xce, 0x8b, 0xd3, 0xd6, 0x16, 0xb7, 0x03, 0x3a, 0xa7, 0x86, 0xde,
-	0xaa, 0x8a, 0xe9, 0x73, 0x8a, 0x0e, 0xa1, 0x1e, 0x71, 0x97, 0xe3, 0xac, 0xd2, 0xb7, 0xcb, 0x2b,
-	0xbd, 0x12, 0x30, 0x47, 0xa1, 0xc5, 0x22, 0x78, 0x94, 0x78, 0x31, 0x63, 0x98, 0x78, 0x69, 0xbe,
-	0x08, 0x05, 0x93, 0xf5, 0x13, 0xbc, 0x79, 0x8e, 0xf9, 0xb5, 0x18, 0xe9, 0x55, 0x3c, 0x8c, 0x3c,
-	0xe6, 0x87, 0x62, 0x7d, 0xa3, 0x69, 0xce, 0xdf, 0xc2, 0x93, 0xa8, 0xe8, 0x30, 0x34, 0x99, 0xc1,
-	0x41, 0x79, 0x06, 0x0b, 0x64, 0x39, 0x97, 0xf3, 0x98, 0xc9, 0xfa, 0x53, 0x83, 0xbd, 0x52, 0xf0,
-	0x6c, 0xed, 0xb4, 0xe2, 0xda, 0x7d, 0x5f, 0x98, 0xba, 0x2e, 0x33, 0x39, 0xfe, 0x0f, 0x99, 0xbc,
-	0x9c, 0xd1, 0x7f, 0x0e, 0xad, 0x73, 0xcc, 0xb3, 0xe1, 0x47, 0xcb, 0xdb, 0x69, 0x42, 0x63, 0x98,
-	0x01, 0x64, 0x27, 0xb7, 0x9c, 0xa9, 0x6e, 0xfd, 0xa6, 0x43, 0x5d, 0x8e, 0x6f, 0x49, 0xd4, 0x17,
-	0xc5, 0xa8, 0x65, 0xbb, 0xa3, 0x20, 0xe2, 0xbb, 0xc2, 0xdc, 0x1d, 0xe7, 0x47, 0x82, 0x90, 0xd1,
-	0x45, 0xa1, 0x6f, 0x35, 0xd9, 0xb7, 0x8f, 0xd6, 0xec, 0x50, 0x59, 0x8f, 0xd0, 0x17, 0xb0, 0x49,
-	0xb3, 0x5d, 0xa8, 0xcb, 0x64, 0x3e, 0x58, 0xc3, 0x34, 0x50, 0x68, 0x27, 0x7f, 0xed, 0xff, 0x75,
-	0xf9, 0x57, 0x0d, 0xb6, 0x8b, 0xb4, 0xf3, 0x4b, 0xae, 0x2d, 0x2c, 0x79, 0x86, 0x88, 0xfc, 0x88,
-	0x4b, 0x84, 0x3e, 0x45, 0xe4, 0x26, 0xf4, 0x25, 0x6c, 0x33, 0xcc, 0x59, 0x7a, 0x1b, 0xd2, 0xc0,
-	0xf7, 0x52, 0xd9, 0xba, 0x66, 0xef, 0xfd, 0xf2, 0xc2, 0x1c, 0x81, 0xbe, 0x94, 0x60, 0xa7, 0xc9,
-	0x66, 0x8a, 0xf5, 0x33, 0x34, 0x0b, 0x3e, 0xf4, 0x06, 0x6c, 0xf1, 0x3b, 0x86, 0xa3, 0x3b, 0x1a,
-	0xa8, 0xd3, 0xbc, 0xee, 0xcc, 0x0c, 0xc8, 0x80, 0xcd, 0xd0, 0xe5, 0x1c, 0x33, 0x92, 0x25, 0x95,
-	0xab, 0xe8, 0x10, 0x1a, 0x3e, 0xe1, 0x98, 0x25, 0x6e, 0x90, 0x25, 0xb3, 0xb7, 0x30, 0xf2, 0xb3,
-	0xec, 0xae, 0x71, 0xa6, 0xd0, 0xde, 0x2f, 0x35, 0x80, 0x33, 0x37, 0x64, 0xa7, 0x32, 0x51, 0xf4,
-	0x35, 0x34, 0x06, 0xe4, 0x82, 0x24, 0xf4, 0x01, 0xa3, 0x77, 0x8b, 0xc5, 0x64, 0x37, 0x50, 0xd2,
-	0xb5, 0x95, 0xd7, 0xc1, 0x3f, 0xc6, 0x38, 0xe2, 0xe6, 0x7b, 0xab, 0x41, 0xea, 0x3c, 0xb3, 0x2a,
-	0xe8, 0x1e, 0x5e, 0x5b, 0x7a, 0x6c, 0xa0, 0x67, 0x0b, 0x59, 0xf6, 0xc5, 0x75, 0x69, 0x7e, 0x5c,
-	0xde, 0xca, 0x95, 0xe7, 0x8f, 0x55, 0x41, 0x21, 0x18, 0x65, 0x9f, 0x55, 0x69, 0xb8, 0xa3, 0x95,
-	0xe1, 0x56, 0x7e, 0xa2, 0x56, 0x05, 0xc5, 0xb0, 0x33, 0x20, 0xc5, 0x2b, 0x03, 0xd9, 0xff, 0xee,
-	0x6a, 0x31, 0xbb, 0x6b, 0xf1, 0xf3, 0x97, 0x83, 0x55, 0x41, 0x37, 0xb0, 0x3d, 0x20, 0xb2, 0x15,
-	0x2a, 0xe8, 0x7e, 0x39, 0xc9, 0xe2, 0x0f, 0x83, 0x59, 0xd2, 0x0a, 0xab, 0x72, 0x72, 0x0f, 0xe0,
-	0x2b, 0x02, 0x3b, 0xe9, 0x9e, 0x3c, 0x9d, 0xed, 0xc7, 0xa5, 0x40, 0x46, 0xdf, 0xed, 0x8f, 0x7d,
-	0x7e, 0x17, 0x0f, 0xc5, 0xbc, 0xe5, 0x3f, 0x93, 0x7a, 0x84, 0x0f, 0xe3, 0x65, 0x7f, 0x55, 0xbf,
-	0xeb, 0xcf, 0x05, 0x81, 0xad, 0x18, 0xec, 0xe3, 0x98, 0xd3, 0x31, 0x26, 0xf6, 0x39, 0x0b, 0x3d,
-	0x3b, 0xe9, 0x0e, 0x37, 0xe4, 0x2b, 0x07, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc5, 0xd8, 0xc1,
-	0xd9, 0x96, 0x09, 0x00, 0x00,
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdb, 0x6e, 0xdc, 0x44,
+	0x18, 0x5e, 0x7b, 0x77, 0x73, 0xf8, 0x37, 0x0d, 0x65, 0x94, 0xb6, 0xce, 0x16, 0xe8, 0x62, 0x0e,
+	0x0a, 0x55, 0x71, 0xb4, 0xa9, 0x0a, 0xa8, 0x20, 0x44, 0x92, 0x86, 0xb4, 0x17, 0x28, 0x95, 0x5b,
+	0x95, 0x83, 0x84, 0x56, 0x5e, 0x7b, 0xb2, 0x71, 0xe3, 0x9d, 0x31, 0x33, 0x63, 0xab, 0x46, 0x3c,
+	0x05, 0xd7, 0xdc, 0x71, 0x87, 0x78, 0x06, 0x1e, 0x85, 0x17, 0xe0, 0x25, 0xd0, 0x1c, 0xec, 0x38,
+	0xd9, 0xf5, 0xae, 0xa0, 0x37, 0xab, 0x99, 0xff, 0xfb, 0xf6, 0x9b, 0xff, 0x38, 0x63, 0xf8, 0x28,
+	0x0a, 0x52, 0xb6, 0x9b, 0x32, 0x2a, 0xe8, 0xae, 0x5c, 0x86, 0x49, 0x8c, 0x89, 0xd8, 0xcd, 0x87,
+	0xb5, 0x9d, 0xa7, 0x60, 0xe4, 0x48, 0x8b, 0x5e, 0x7b, 0x35, 0x30, 0x1f, 0xf6, 0xb7, 0x27, 0x94,
+	0x4e, 0x12, 0xac, 0x65, 0xc6, 0xd9, 0xe9, 0x6e, 0x40, 0x0a, 0x4d, 0xec, 0xdf, 0xbe, 0x0a, 0xe1,
+	0x69, 0x2a, 0x4a, 0xf0, 0x9d, 0xab, 0x60, 0x94, 0xb1, 0x40, 0xc4, 0x94, 0x18, 0xfc, 0xdd, 0x9a,
+	0x73, 0x21, 0x9d, 0x4e, 0x29, 0x91, 0x8e, 0xe9, 0x95, 0xa6, 0xb8, 0x7f, 0x5b, 0x80, 0x0e, 0x13,
+	0x9a, 0x45, 0x47, 0x39, 0x26, 0xe2, 0x88, 0xe4, 0x38, 0xa1, 0x29, 0x46, 0x9b, 0x60, 0xc7, 0x91,
+	0x63, 0x0d, 0xac, 0x9d, 0x75, 0xdf, 0x8e, 0x23, 0x74, 0x13, 0x56, 0x38, 0xcd, 0x58, 0x88, 0x1d,
+	0x5b, 0xd9, 0xcc, 0x0e, 0x21, 0xe8, 0x88, 0x22, 0xc5, 0x4e, 0x5b, 0x59, 0xd5, 0x1a, 0x0d, 0xa0,
+	0xc7, 0x53, 0x1c, 0xbe, 0xc0, 0x8c, 0xc7, 0x94, 0x38, 0x1d, 0x05, 0xd5, 0x4d, 0xe8, 0x2e, 0xbc,
+	0x19, 0x05, 0x22, 0x18, 0x85, 0x94, 0x08, 0x4c, 0xc4, 0x48, 0x49, 0x74, 0x15, 0xef, 0x0d, 0x09,
+	0x1c, 0x6a, 0xfb, 0x73, 0xa9, 0xb6, 0x05, 0x5d, 0x41, 0xd3, 0x38, 0x74, 0x56, 0x14, 0xae, 0x37,
+	0x68, 0x07, 0x3a, 0x92, 0xe8, 0xac, 0x0e, 0xac, 0x9d, 0xde, 0xde, 0x96, 0xa7, 0x13, 0xe1, 0x95,
+	0x89, 0xf0, 0xf6, 0x49, 0xe1, 0x2b, 0x86, 0xfb, 0x8f, 0x05, 0x5b, 0x07, 0x31, 0x89, 0x62, 0x32,
+	0xb9, 0x1c, 0x22, 0x82, 0x0e, 0x09, 0xa6, 0xd8, 0x04, 0xa9, 0xd6, 0x95, 0xac, 0xbd, 0x4c, 0x16,
+	0x7d, 0x07, 0x6b, 0x53, 0x2c, 0x02, 0xc5, 0x6e, 0x0f, 0xda, 0x3b, 0xbd, 0xbd, 0x2f, 0xbc, 0xa6,
+	0xfa, 0x7a, 0xf3, 0xce, 0xf7, 0xbe, 0x31, 0x7f, 0x3f, 0x22, 0x82, 0x15, 0x7e, 0xa5, 0xd6, 0xff,
+	0x1c, 0xae, 0x5d, 0x82, 0xd0, 0x75, 0x68, 0x9f, 0xe3, 0xc2, 0xf8, 0x29, 0x97, 0x32, 0x27, 0x79,
+	0x90, 0x64, 0x65, 0x31, 0xf4, 0xe6, 0xa1, 0xfd, 0x99, 0xe5, 0xfe, 0x69, 0xc1, 0x2d, 0x73, 0x9a,
+	0x8f, 0x79, 0x4a, 0x09, 0xc7, 0x55, 0xc0, 0x65, 0x70, 0xd6, 0xd2, 0xe0, 0x36, 0xc1, 0x16, 0xd4,
+	0xb1, 0x07, 0x6d, 0x59, 0x7d, 0x41, 0xd1, 0x03, 0xe8, 0x72, 0x11, 0x08, 0x6c, 0x22, 0xbd, 0xd3,
+	0x1c, 0xe9, 0x33, 0x49, 0xf3, 0x35, 0x5b, 0x36, 0x42, 0x48, 0x49, 0x98, 0x31, 0x86, 0x49, 0x58,
+	0x94, 0x8d, 0x50, 0x33, 0xb9, 0x3f, 0xc3, 0xdb, 0xc7, 0x58, 0x3c, 0x97, 0x25, 0x7d, 0x96, 0x8d,
+	0x79, 0xc8, 0xe2, 0x54, 0xb6, 0x2f, 0xaf, 0x7c, 0xfe, 0x1e, 0xae, 0xf1, 0x3a, 0xe0, 0x58, 0xca,
+	0x83, 0xfb, 0xcd, 0x1e, 0xcc, 0x88, 0x95, 0x5a, 0xfe, 0x65, 0x25, 0xf7, 0x2f, 0x1b, 0xb6, 0x1b,
+	0xc9, 0x17, 0x6d, 0x67, 0xd5, 0xdb, 0xee, 0xc7, 0x5a, 0xd5, 0x6d, 0xe5, 0xc9, 0xfe, 0xff, 0xf0,
+	0xa4, 0xa9, 0xf4, 0x72, 0xca, 0x4e, 0xe3, 0x44, 0x60, 0x66, 0xe6, 0xc9, 0xec, 0xd0, 0x27, 0x70,
+	0x6b, 0x1a, 0xbc, 0x1a, 0x55, 0x99, 0x13, 0xa3, 0xb3, 0x80, 0x44, 0x09, 0x66, 0x5c, 0x25, 0xb5,
+	0xeb, 0xdf, 0x98, 0x06, 0xaf, 0x0e, 0x2b, 0xf4, 0xb1, 0x01, 0xd1, 0x1d, 0xe8, 0xa9, 0x39, 0x3b,
+	0x8d, 0x71, 0x12, 0x71, 0xa7, 0xab, 0x0a, 0x0a, 0xd2, 0xf4, 0xb5, 0xb2, 0xbc, 0x5e, 0xaf, 0x7d,
+	0x09, 0x83, 0x63, 0x2c, 0x4c, 0xb7, 0xf1, 0xf9, 0xf5, 0xeb, 0xc3, 0xda, 0xd8, 0x10, 0x54, 0xe9,
+	0xd6, 0xfd, 0x6a, 0xef, 0xfe, 0x6e, 0x43, 0x57, 0xf5, 0xcb, 0x9c, 0x53, 0xef, 0xd6, 0x4f, 0x6d,
+	0x6a, 0x56, 0x4d, 0x91, 0x83, 0x8c, 0x45, 0x30, 0x29, 0xef, 0x20, 0xb9, 0x46, 0x4f, 0x6a, 0x85,
+	0xea, 0xa8, 0x42, 0x7d, 0xbc, 0xa4, 0x69, 0x1b, 0x8b, 0xf2, 0x15, 0xac, 0x52, 0xd3, 0x7c, 0x5d,
+	0xe5, 0xcc, 0x87, 0x4b, 0x94, 0x4e, 0x34, 0xdb, 0x2f, 0xff, 0xf6, 0x7a, 0x59, 0xfe, 0xcd, 0x82,
+	0x8d, 0xba, 0xec, 0xd5, 0xa9, 0xb2, 0x66, 0xa6, 0xca, 0x30, 0x78, 0xcc, 0x85, 0x62, 0xd8, 0x15,
+	0xa3, 0x34, 0xa1, 0xc7, 0xb0, 0xc1, 0xb0, 0x60, 0xc5, 0x28, 0xa5, 0x49, 0x1c, 0x16, 0x2a, 0x75,
+	0xbd, 0xbd, 0x0f, 0x9a, 0x03, 0xf3, 0x25, 0xfb, 0xa9, 0x22, 0xfb, 0x3d, 0x76, 0xb1, 0x71, 0x7f,
+	0x81, 0x5e, 0x0d, 0x43, 0x6f, 0xc1, 0xba, 0x38, 0x63, 0x98, 0x9f, 0xd1, 0x44, 0x3f, 0x1f, 0x5d,
+	0xff, 0xc2, 0x80, 0x1c, 0x58, 0x4d, 0x03, 0x21, 0x30, 0x23, 0xc6, 0xa9, 0x72, 0x8b, 0x1e, 0xc0,
+	0x5a, 0x4c, 0x04, 0x66, 0x79, 0x90, 0x18, 0x67, 0xb6, 0x67, 0x4a, 0xfe, 0xc8, 0x3c, 0x6e, 0x7e,
+	0x45, 0xdd, 0xfb, 0xb5, 0x03, 0xf0, 0x28, 0x48, 0xd9, 0xa1, 0x72, 0x14, 0x7d, 0x0b, 0x6b, 0x27,
+	0xe4, 0x09, 0xc9, 0xe9, 0x39, 0x46, 0xef, 0xd5, 0x83, 0x31, 0x4f, 0x5e, 0x3e, 0xf4, 0x34, 0xea,
+	0xe3, 0x9f, 0x32, 0xcc, 0x45, 0xff, 0xfd, 0xc5, 0x24, 0x7d, 0x81, 0xba, 0x2d, 0xf4, 0x12, 0x6e,
+	0xcc, 0xbd, 0xa7, 0xd0, 0xcd, 0x19, 0x2f, 0x8f, 0xe4, 0xfb, 0xdc, 0xff, 0xb4, 0x39, 0x95, 0x0b,
+	0x2f, 0x3c, 0xb7, 0x85, 0x52, 0x70, 0x9a, 0xc6, 0xaa, 0xf1, 0xb8, 0x87, 0x0b, 0x8f, 0x5b, 0x38,
+	0xa2, 0x6e, 0x0b, 0x65, 0xb0, 0x79, 0x42, 0xea, 0x6f, 0x14, 0xf2, 0xfe, 0xdb, 0x5b, 0xd6, 0x1f,
+	0x2e, 0xe5, 0x5f, 0x7d, 0x8d, 0xdc, 0x16, 0x7a, 0x01, 0x1b, 0x27, 0x44, 0xa5, 0x42, 0x1f, 0x7a,
+	0xaf, 0x59, 0x64, 0xf6, 0x0b, 0xa5, 0xdf, 0x90, 0x0a, 0xb7, 0x75, 0xf0, 0x12, 0x20, 0xd6, 0x02,
+	0x5e, 0x3e, 0x3c, 0xb8, 0x7e, 0xd1, 0x1f, 0x4f, 0x25, 0x93, 0xff, 0x70, 0x6f, 0x12, 0x8b, 0xb3,
+	0x6c, 0x2c, 0xeb, 0xad, 0x3e, 0xd2, 0xf4, 0x4f, 0x7a, 0x3e, 0x99, 0xf7, 0x19, 0xf7, 0x87, 0x7d,
+	0x5b, 0x0a, 0x78, 0x5a, 0xc1, 0xdb, 0xcf, 0x04, 0x9d, 0x60, 0xe2, 0x1d, 0xb3, 0x34, 0xf4, 0xf2,
+	0xe1, 0x78, 0x45, 0xfd, 0xe5, 0xfe, 0xbf, 0x03, 0x00, 0x8a, 0xbd, 0x9f, 0xe2, 0x07, 0x0a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -275,6 +275,152 @@ func (m *ListStringValue) GetValues() []string {
 	return nil
 }
 
+// InternalInvokeRequestChunk is a part of a request sent with CallLocalStream
+type InternalInvokeRequestChunk struct {
+	// Required. transfer_id identifies the request across the streams used to transfer it.
+	TransferId string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	// request is the request without its data. It is only set in the first message of a stream.
+	Request *InternalInvokeRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// total_size is the size of the request data. It is only set in the first message of a stream.
+	TotalSize uint64 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// offset is the position of data in the request data.
+	Offset uint64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data   []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// checksum is the CRC-32 (IEEE) checksum of data.
+	Checksum             uint32   `protobuf:"varint,6,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InternalInvokeRequestChunk) Reset()         { *m = InternalInvokeRequestChunk{} }
+func (m *InternalInvokeRequestChunk) String() string { return proto.CompactTextString(m) }
+func (*InternalInvokeRequestChunk) ProtoMessage()    {}
+func (*InternalInvokeRequestChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6da3b6bd4beea4, []int{4}
+}
+
+func (m *InternalInvokeRequestChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InternalInvokeRequestChunk.Unmarshal(m, b)
+}
+func (m *InternalInvokeRequestChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InternalInvokeRequestChunk.Marshal(b, m, deterministic)
+}
+func (m *InternalInvokeRequestChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InternalInvokeRequestChunk.Merge(m, src)
+}
+func (m *InternalInvokeRequestChunk) XXX_Size() int {
+	return xxx_messageInfo_InternalInvokeRequestChunk.Size(m)
+}
+func (m *InternalInvokeRequestChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_InternalInvokeRequestChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InternalInvokeRequestChunk proto.InternalMessageInfo
+
+func (m *InternalInvokeRequestChunk) GetTransferId() string {
+	if m != nil {
+		return m.TransferId
+	}
+	return ""
+}
+
+func (m *InternalInvokeRequestChunk) GetRequest() *InternalInvokeRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *InternalInvokeRequestChunk) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *InternalInvokeRequestChunk) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *InternalInvokeRequestChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *InternalInvokeRequestChunk) GetChecksum() uint32 {
+	if m != nil {
+		return m.Checksum
+	}
+	return 0
+}
+
+// InternalInvokeResponseChunk is a message sent by the callee on a CallLocalStream stream
+type InternalInvokeResponseChunk struct {
+	// offset is the size of the request data the callee already received.
+	// It is sent once, in reply to the first message of a stream.
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// response is the response of the callee app, sent once the callee received the whole request data.
+	Response *InternalInvokeResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	// received is sent once the callee received the whole request data, before it invokes its app.
+	// A stream failing after it is a failure of the call and must not be resumed.
+	Received             bool     `protobuf:"varint,3,opt,name=received,proto3" json:"received,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InternalInvokeResponseChunk) Reset()         { *m = InternalInvokeResponseChunk{} }
+func (m *InternalInvokeResponseChunk) String() string { return proto.CompactTextString(m) }
+func (*InternalInvokeResponseChunk) ProtoMessage()    {}
+func (*InternalInvokeResponseChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6da3b6bd4beea4, []int{5}
+}
+
+func (m *InternalInvokeResponseChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InternalInvokeResponseChunk.Unmarshal(m, b)
+}
+func (m *InternalInvokeResponseChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InternalInvokeResponseChunk.Marshal(b, m, deterministic)
+}
+func (m *InternalInvokeResponseChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InternalInvokeResponseChunk.Merge(m, src)
+}
+func (m *InternalInvokeResponseChunk) XXX_Size() int {
+	return xxx_messageInfo_InternalInvokeResponseChunk.Size(m)
+}
+func (m *InternalInvokeResponseChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_InternalInvokeResponseChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InternalInvokeResponseChunk proto.InternalMessageInfo
+
+func (m *InternalInvokeResponseChunk) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *InternalInvokeResponseChunk) GetResponse() *InternalInvokeResponse {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *InternalInvokeResponseChunk) GetReceived() bool {
+	if m != nil {
+		return m.Received
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Actor)(nil), "dapr.proto.daprinternal.v1.Actor")
 	proto.RegisterType((*InternalInvokeRequest)(nil), "dapr.proto.daprinternal.v1.InternalInvokeRequest")
@@ -283,6 +429,8 @@ func init() {
 	proto.RegisterMapType((map[string]*ListStringValue)(nil), "dapr.proto.daprinternal.v1.InternalInvokeResponse.HeadersEntry")
 	proto.RegisterMapType((map[string]*ListStringValue)(nil), "dapr.proto.daprinternal.v1.InternalInvokeResponse.TrailersEntry")
 	proto.RegisterType((*ListStringValue)(nil), "dapr.proto.daprinternal.v1.ListStringValue")
+	proto.RegisterType((*InternalInvokeRequestChunk)(nil), "dapr.proto.daprinternal.v1.InternalInvokeRequestChunk")
+	proto.RegisterType((*InternalInvokeResponseChunk)(nil), "dapr.proto.daprinternal.v1.InternalInvokeResponseChunk")
 }
 
 func init() {
@@ -290,40 +438,51 @@ func init() {
 }
 
 var fileDescriptor_3c6da3b6bd4beea4 = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xe9, 0x6a, 0xdb, 0x40,
-	0x10, 0xc7, 0x2b, 0x2b, 0xbe, 0xc6, 0xe9, 0xc1, 0x42, 0x83, 0x2a, 0x28, 0x38, 0x6a, 0x69, 0x5d,
-	0x42, 0xe5, 0x5a, 0xfd, 0xd0, 0x10, 0xe8, 0xe1, 0x1e, 0x50, 0x43, 0x0a, 0x65, 0x13, 0x02, 0x3d,
-	0xa0, 0x6c, 0xac, 0x45, 0x16, 0x96, 0x25, 0x75, 0x77, 0x2d, 0xf0, 0x5b, 0xf4, 0x11, 0xfa, 0x9c,
-	0xfd, 0x54, 0xf6, 0x90, 0x6b, 0x85, 0x54, 0x90, 0x40, 0xf2, 0xc5, 0x8c, 0x76, 0x66, 0x7e, 0xb3,
-	0x33, 0xff, 0xf1, 0xc2, 0xd3, 0x90, 0xe4, 0x6c, 0x98, 0xb3, 0x4c, 0x64, 0x43, 0x69, 0xc6, 0xa9,
-	0xa0, 0x2c, 0x25, 0xc9, 0xb0, 0x18, 0x55, 0xbe, 0x7d, 0x15, 0x82, 0x5c, 0x79, 0xa6, 0x6d, 0xbf,
-	0xe2, 0x2e, 0x46, 0xee, 0xee, 0x06, 0x6a, 0x9a, 0x2d, 0x16, 0x59, 0x2a, 0x21, 0xda, 0xd2, 0x29,
-	0xee, 0x5e, 0x4d, 0x35, 0x92, 0xc7, 0x05, 0x65, 0x3c, 0x5e, 0x07, 0x3f, 0xae, 0x09, 0xe6, 0x82,
-	0x88, 0x25, 0xd7, 0x81, 0xde, 0x18, 0x9a, 0xe3, 0xa9, 0xc8, 0x18, 0xba, 0x0f, 0x40, 0xa4, 0xf1,
-	0x43, 0xac, 0x72, 0xea, 0x58, 0x7d, 0x6b, 0xd0, 0xc5, 0x5d, 0x75, 0x72, 0xbc, 0xca, 0x29, 0xba,
-	0x07, 0x1d, 0xed, 0x8e, 0x43, 0xa7, 0xa1, 0x9c, 0x6d, 0xf5, 0x3d, 0x09, 0xbd, 0x5f, 0x36, 0xdc,
-	0x9d, 0x18, 0xfe, 0x24, 0x2d, 0xb2, 0x39, 0xc5, 0xf4, 0xe7, 0x92, 0x72, 0x81, 0xf6, 0xc1, 0x2e,
-	0x28, 0x53, 0xb0, 0x5b, 0xc1, 0x23, 0xff, 0xff, 0xfd, 0xfb, 0xe3, 0xcf, 0x93, 0x13, 0xdd, 0x00,
-	0x96, 0x29, 0xe8, 0x1b, 0x74, 0x16, 0x54, 0x90, 0x90, 0x08, 0xe2, 0x34, 0xfa, 0xf6, 0xa0, 0x17,
-	0xbc, 0xae, 0x4b, 0x3f, 0xb7, 0xbc, 0xff, 0xc9, 0x10, 0x3e, 0xa4, 0x82, 0xad, 0xf0, 0x1a, 0x88,
-	0x5e, 0x42, 0x7b, 0x41, 0x39, 0x27, 0x11, 0x75, 0xec, 0xbe, 0x35, 0xe8, 0x05, 0x0f, 0x36, 0xd9,
-	0x66, 0xe8, 0x8a, 0xba, 0x41, 0xc3, 0x65, 0x0e, 0x7a, 0x01, 0x4d, 0xd5, 0xba, 0xb3, 0xa5, 0x92,
-	0x77, 0x6b, 0xfb, 0x92, 0x81, 0x58, 0xc7, 0xbb, 0x33, 0xb8, 0x59, 0xb9, 0x12, 0xba, 0x03, 0xf6,
-	0x9c, 0xae, 0xcc, 0xb0, 0xa5, 0x89, 0xc6, 0xd0, 0x2c, 0x48, 0xb2, 0xa4, 0x6a, 0xc6, 0xbd, 0x60,
-	0xaf, 0x8e, 0x7d, 0x18, 0x73, 0x71, 0x24, 0x58, 0x9c, 0x46, 0x27, 0x32, 0x05, 0xeb, 0xcc, 0x83,
-	0xc6, 0xbe, 0xe5, 0xfd, 0xde, 0x82, 0x9d, 0xb3, 0x33, 0xe1, 0x79, 0x96, 0x72, 0x8a, 0x0e, 0xa0,
-	0xa5, 0x17, 0x40, 0x95, 0xed, 0x05, 0x5e, 0x5d, 0x89, 0x23, 0x15, 0x89, 0x4d, 0x06, 0xfa, 0x02,
-	0xed, 0x19, 0x25, 0x21, 0x65, 0xfc, 0x32, 0xa2, 0xe8, 0x0b, 0xf8, 0x1f, 0x35, 0x41, 0x8b, 0x52,
-	0xf2, 0xd0, 0x77, 0xe8, 0x08, 0x46, 0xe2, 0x44, 0xb2, 0x6d, 0xc5, 0x7e, 0x73, 0x09, 0xf6, 0xb1,
-	0x41, 0x18, 0xc5, 0x4b, 0x22, 0x7a, 0xf5, 0x4f, 0x71, 0x2d, 0xda, 0xc3, 0x7a, 0xc5, 0x35, 0x6e,
-	0x2d, 0xb9, 0x1b, 0xc1, 0xf6, 0xe6, 0xb5, 0xaf, 0x4c, 0x38, 0xb9, 0x22, 0x95, 0x1e, 0xae, 0x6e,
-	0x45, 0x9e, 0xc0, 0xed, 0x33, 0x5e, 0xb4, 0x03, 0x2d, 0xe5, 0x97, 0xab, 0x61, 0x0f, 0xba, 0xd8,
-	0x7c, 0x05, 0x7f, 0x2c, 0xd8, 0x7e, 0x4f, 0x72, 0x56, 0x0e, 0x1d, 0x09, 0xe8, 0xbe, 0x23, 0x49,
-	0xa2, 0x1f, 0x8e, 0xd1, 0x85, 0xff, 0x98, 0x6e, 0x70, 0x71, 0x69, 0xbd, 0x1b, 0x65, 0xd5, 0xc3,
-	0x6c, 0x4a, 0x92, 0x6b, 0xab, 0xfa, 0xf6, 0xd9, 0x57, 0x3f, 0x8a, 0xc5, 0x6c, 0x79, 0x2a, 0xd7,
-	0x44, 0xbd, 0xa5, 0xfa, 0x27, 0x9f, 0x47, 0xe7, 0xbf, 0xaf, 0xa7, 0x2d, 0x75, 0xfc, 0xfc, 0x6f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xfa, 0xc0, 0x72, 0x2e, 0x1f, 0x06, 0x00, 0x00,
+	// 692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x6d, 0x6b, 0x13, 0x4f,
+	0x10, 0xc0, 0x7b, 0xbd, 0x3c, 0x4e, 0xda, 0x7f, 0xff, 0x2c, 0x58, 0xe2, 0x89, 0x98, 0x9e, 0xa2,
+	0x91, 0x62, 0xda, 0x9e, 0x60, 0x4b, 0xc1, 0x87, 0x58, 0x05, 0x83, 0x55, 0x64, 0x53, 0x0a, 0x3e,
+	0x40, 0xd9, 0xe6, 0xb6, 0xc9, 0x91, 0xcb, 0xdd, 0xb9, 0xbb, 0x39, 0x48, 0xdf, 0x0b, 0xbe, 0xf4,
+	0x23, 0xe8, 0x47, 0xf4, 0x1b, 0xc8, 0xee, 0xde, 0x5d, 0x93, 0x12, 0x0f, 0x52, 0xa8, 0x6f, 0x8e,
+	0x99, 0xdd, 0x99, 0xdf, 0xcc, 0xec, 0xcc, 0xee, 0xc1, 0x23, 0x97, 0x44, 0x6c, 0x2b, 0x62, 0xa1,
+	0x08, 0xb7, 0xa4, 0xe8, 0x05, 0x82, 0xb2, 0x80, 0xf8, 0x5b, 0xf1, 0xce, 0x8c, 0xde, 0x52, 0x26,
+	0xc8, 0x92, 0x6b, 0x5a, 0x6e, 0xcd, 0x6c, 0xc7, 0x3b, 0xd6, 0xc6, 0x14, 0xaa, 0x17, 0x8e, 0x46,
+	0x61, 0x20, 0x21, 0x5a, 0xd2, 0x2e, 0xd6, 0x66, 0x4e, 0x34, 0x12, 0x79, 0x31, 0x65, 0xdc, 0xcb,
+	0x8c, 0x1f, 0xe4, 0x18, 0x73, 0x41, 0xc4, 0x98, 0x6b, 0x43, 0xbb, 0x0d, 0xc5, 0x76, 0x4f, 0x84,
+	0x0c, 0xdd, 0x06, 0x20, 0x52, 0x38, 0x11, 0x93, 0x88, 0xd6, 0x8d, 0x86, 0xd1, 0xac, 0xe2, 0xaa,
+	0x5a, 0x39, 0x9a, 0x44, 0x14, 0xdd, 0x84, 0x8a, 0xde, 0xf6, 0xdc, 0xfa, 0xb2, 0xda, 0x2c, 0x2b,
+	0xbd, 0xe3, 0xda, 0x3f, 0x4c, 0xb8, 0xd1, 0x49, 0xf8, 0x9d, 0x20, 0x0e, 0x87, 0x14, 0xd3, 0xaf,
+	0x63, 0xca, 0x05, 0xda, 0x03, 0x33, 0xa6, 0x4c, 0xc1, 0xfe, 0x73, 0xee, 0xb7, 0xfe, 0x5e, 0x7f,
+	0xab, 0xfd, 0xa1, 0x73, 0xac, 0x0b, 0xc0, 0xd2, 0x05, 0x7d, 0x86, 0xca, 0x88, 0x0a, 0xe2, 0x12,
+	0x41, 0xea, 0xcb, 0x0d, 0xb3, 0x59, 0x73, 0x9e, 0xe7, 0xb9, 0xcf, 0x0d, 0xdf, 0x7a, 0x97, 0x10,
+	0x5e, 0x07, 0x82, 0x4d, 0x70, 0x06, 0x44, 0x4f, 0xa1, 0x3c, 0xa2, 0x9c, 0x93, 0x3e, 0xad, 0x9b,
+	0x0d, 0xa3, 0x59, 0x73, 0xee, 0x4e, 0xb3, 0x93, 0x43, 0x57, 0xd4, 0x29, 0x1a, 0x4e, 0x7d, 0xd0,
+	0x2e, 0x14, 0x55, 0xe9, 0xf5, 0x82, 0x72, 0xde, 0xc8, 0xad, 0x4b, 0x1a, 0x62, 0x6d, 0x6f, 0x0d,
+	0x60, 0x75, 0x26, 0x25, 0xf4, 0x3f, 0x98, 0x43, 0x3a, 0x49, 0x0e, 0x5b, 0x8a, 0xa8, 0x0d, 0xc5,
+	0x98, 0xf8, 0x63, 0xaa, 0xce, 0xb8, 0xe6, 0x6c, 0xe6, 0xb1, 0x0f, 0x3d, 0x2e, 0xba, 0x82, 0x79,
+	0x41, 0xff, 0x58, 0xba, 0x60, 0xed, 0xb9, 0xbf, 0xbc, 0x67, 0xd8, 0x3f, 0x0b, 0xb0, 0x7e, 0xf9,
+	0x4c, 0x78, 0x14, 0x06, 0x9c, 0xa2, 0x7d, 0x28, 0xe9, 0x01, 0x50, 0x61, 0x6b, 0x8e, 0x9d, 0x17,
+	0xa2, 0xab, 0x2c, 0x71, 0xe2, 0x81, 0x3e, 0x42, 0x79, 0x40, 0x89, 0x4b, 0x19, 0xbf, 0x4a, 0x53,
+	0x74, 0x02, 0xad, 0x37, 0x9a, 0xa0, 0x9b, 0x92, 0xf2, 0xd0, 0x17, 0xa8, 0x08, 0x46, 0x3c, 0x5f,
+	0xb2, 0x4d, 0xc5, 0x7e, 0x71, 0x05, 0xf6, 0x51, 0x82, 0x48, 0x3a, 0x9e, 0x12, 0xd1, 0xb3, 0x8b,
+	0x8e, 0xeb, 0xa6, 0xdd, 0xcb, 0xef, 0xb8, 0xc6, 0x65, 0x2d, 0xb7, 0xfa, 0xb0, 0x32, 0x9d, 0xf6,
+	0xb5, 0x35, 0x4e, 0x8e, 0xc8, 0x4c, 0x0d, 0xd7, 0x37, 0x22, 0x0f, 0x61, 0xed, 0xd2, 0x2e, 0x5a,
+	0x87, 0x92, 0xda, 0x97, 0xa3, 0x61, 0x36, 0xab, 0x38, 0xd1, 0xec, 0xdf, 0x06, 0x58, 0x73, 0x6f,
+	0xd8, 0xc1, 0x60, 0x1c, 0x0c, 0xd1, 0x1d, 0xa8, 0x09, 0x46, 0x02, 0x7e, 0x46, 0xd5, 0xeb, 0xa0,
+	0x53, 0x85, 0x74, 0xa9, 0xe3, 0xa2, 0xb7, 0x50, 0x66, 0xda, 0x21, 0xc9, 0x79, 0x67, 0xe1, 0xbb,
+	0x8c, 0x53, 0x82, 0x7c, 0xa7, 0x44, 0x28, 0x88, 0x7f, 0xc2, 0xbd, 0x73, 0x7d, 0x7f, 0x0b, 0xb8,
+	0xaa, 0x56, 0xba, 0xde, 0xb9, 0xaa, 0x21, 0x3c, 0x3b, 0xe3, 0x54, 0xa8, 0x46, 0x17, 0x70, 0xa2,
+	0x21, 0x04, 0x05, 0xf5, 0x98, 0x14, 0x1b, 0x46, 0x73, 0x05, 0x2b, 0x19, 0x59, 0x50, 0xe9, 0x0d,
+	0x68, 0x6f, 0xc8, 0xc7, 0xa3, 0x7a, 0xa9, 0x61, 0x34, 0x57, 0x71, 0xa6, 0xdb, 0xbf, 0x0c, 0xb8,
+	0x35, 0x7f, 0xc8, 0x74, 0xd1, 0x17, 0x71, 0x8c, 0x99, 0x38, 0xef, 0xa1, 0xc2, 0x12, 0xc3, 0xa4,
+	0x58, 0x67, 0xf1, 0x39, 0xc6, 0x19, 0x43, 0xe6, 0xc8, 0x68, 0x8f, 0x7a, 0x31, 0x75, 0x55, 0xb1,
+	0x15, 0x9c, 0xe9, 0xce, 0x37, 0x13, 0x56, 0x5e, 0x91, 0x88, 0xa5, 0x10, 0x24, 0xa0, 0x7a, 0x40,
+	0x7c, 0x5f, 0x3f, 0xe8, 0x8b, 0x1f, 0xb2, 0x75, 0x85, 0x54, 0xed, 0xa5, 0x34, 0xea, 0x61, 0xd8,
+	0x23, 0xfe, 0xbf, 0x8b, 0xfa, 0xdd, 0x80, 0xb5, 0x2c, 0x6c, 0x57, 0x30, 0x4a, 0x46, 0xe8, 0xc9,
+	0xc2, 0xc1, 0x55, 0x33, 0xad, 0xdd, 0xc5, 0x33, 0x50, 0x8e, 0xf6, 0x52, 0xd3, 0xd8, 0x36, 0x5e,
+	0x6e, 0x7f, 0x6a, 0xf5, 0x3d, 0x31, 0x18, 0x9f, 0xca, 0x97, 0x44, 0xfd, 0x6e, 0xf5, 0x27, 0x1a,
+	0xf6, 0xe7, 0xff, 0x82, 0x4f, 0x4b, 0x6a, 0xf9, 0xf1, 0x9f, 0x01, 0x00, 0xeb, 0xb1, 0xb6, 0x99,
+	0x42, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -340,6 +499,11 @@ const _ = grpc.SupportPackageIsVersion4
 type DaprInternalClient interface {
 	CallActor(ctx context.Context, in *InternalInvokeRequest, opts ...grpc.CallOption) (*InternalInvokeResponse, error)
 	CallLocal(ctx context.Context, in *InternalInvokeRequest, opts ...grpc.CallOption) (*InternalInvokeResponse, error)
+	// CallLocalStream sends a large request to the callee app in checksummed chunks.
+	// A transfer interrupted by a connection loss is resumed on a new stream from
+	// the data the callee already received. Callers send the version of the protocol
+	// in the dapr-stream-version metadata item.
+	CallLocalStream(ctx context.Context, opts ...grpc.CallOption) (DaprInternal_CallLocalStreamClient, error)
 }
 
 type daprInternalClient struct {
@@ -368,10 +532,46 @@ func (c *daprInternalClient) CallLocal(ctx context.Context, in *InternalInvokeRe
 	return out, nil
 }
 
+func (c *daprInternalClient) CallLocalStream(ctx context.Context, opts ...grpc.CallOption) (DaprInternal_CallLocalStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DaprInternal_serviceDesc.Streams[0], "/dapr.proto.daprinternal.v1.DaprInternal/CallLocalStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daprInternalCallLocalStreamClient{stream}
+	return x, nil
+}
+
+type DaprInternal_CallLocalStreamClient interface {
+	Send(*InternalInvokeRequestChunk) error
+	Recv() (*InternalInvokeResponseChunk, error)
+	grpc.ClientStream
+}
+
+type daprInternalCallLocalStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *daprInternalCallLocalStreamClient) Send(m *InternalInvokeRequestChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *daprInternalCallLocalStreamClient) Recv() (*InternalInvokeResponseChunk, error) {
+	m := new(InternalInvokeResponseChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DaprInternalServer is the server API for DaprInternal service.
 type DaprInternalServer interface {
 	CallActor(context.Context, *InternalInvokeRequest) (*InternalInvokeResponse, error)
 	CallLocal(context.Context, *InternalInvokeRequest) (*InternalInvokeResponse, error)
+	// CallLocalStream sends a large request to the callee app in checksummed chunks.
+	// A transfer interrupted by a connection loss is resumed on a new stream from
+	// the data the callee already received. Callers send the version of the protocol
+	// in the dapr-stream-version metadata item.
+	CallLocalStream(DaprInternal_CallLocalStreamServer) error
 }
 
 // UnimplementedDaprInternalServer can be embedded to have forward compatible implementations.
@@ -384,6 +584,9 @@ func (*UnimplementedDaprInternalServer) CallActor(ctx context.Context, req *Inte
 func (*UnimplementedDaprInternalServer) CallLocal(ctx context.Context, req *InternalInvokeRequest) (*InternalInvokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CallLocal not implemented")
 }
+func (*UnimplementedDaprInternalServer) CallLocalStream(srv DaprInternal_CallLocalStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method CallLocalStream not implemented")
+}
 
 func RegisterDaprInternalServer(s *grpc.Server, srv DaprInternalServer) {
 	s.RegisterService(&_DaprInternal_serviceDesc, srv)
@@ -425,6 +628,32 @@ func _DaprInternal_CallLocal_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaprInternal_CallLocalStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DaprInternalServer).CallLocalStream(&daprInternalCallLocalStreamServer{stream})
+}
+
+type DaprInternal_CallLocalStreamServer interface {
+	Send(*InternalInvokeResponseChunk) error
+	Recv() (*InternalInvokeRequestChunk, error)
+	grpc.ServerStream
+}
+
+type daprInternalCallLocalStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *daprInternalCallLocalStreamServer) Send(m *InternalInvokeResponseChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *daprInternalCallLocalStreamServer) Recv() (*InternalInvokeRequestChunk, error) {
+	m := new(InternalInvokeRequestChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _DaprInternal_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dapr.proto.daprinternal.v1.DaprInternal",
 	HandlerType: (*DaprInternalServer)(nil),
@@ -438,6 +667,13 @@ var _DaprInternal_serviceDesc = grpc.ServiceDesc{
 			Handler:    _DaprInternal_CallLocal_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CallLocalStream",
+			Handler:       _DaprInternal_CallLocalStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "dapr/proto/daprinternal/v1/daprinternal.proto",
 }
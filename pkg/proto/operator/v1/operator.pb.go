@@ -9,6 +9,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	any "github.com/golang/protobuf/ptypes/any"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -190,11 +191,213 @@ func (m *GetConfigurationResponse) GetConfiguration() *any.Any {
 	return nil
 }
 
+type GetFleetMetadataRequest struct {
+	// namespace selects the sidecars of a namespace, of all the namespaces when empty.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// app_id selects the sidecars of an app, of all the apps when empty.
+	AppId string `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// max_age_seconds is the age of the metadata of a sidecar after which it is fetched again, 30 when 0.
+	MaxAgeSeconds        int32    `protobuf:"varint,3,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFleetMetadataRequest) Reset()         { *m = GetFleetMetadataRequest{} }
+func (m *GetFleetMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFleetMetadataRequest) ProtoMessage()    {}
+func (*GetFleetMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e6e6e3126ef3d27, []int{4}
+}
+
+func (m *GetFleetMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFleetMetadataRequest.Unmarshal(m, b)
+}
+func (m *GetFleetMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFleetMetadataRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFleetMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFleetMetadataRequest.Merge(m, src)
+}
+func (m *GetFleetMetadataRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFleetMetadataRequest.Size(m)
+}
+func (m *GetFleetMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFleetMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFleetMetadataRequest proto.InternalMessageInfo
+
+func (m *GetFleetMetadataRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetFleetMetadataRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *GetFleetMetadataRequest) GetMaxAgeSeconds() int32 {
+	if m != nil {
+		return m.MaxAgeSeconds
+	}
+	return 0
+}
+
+type GetFleetMetadataResponse struct {
+	Sidecars             []*SidecarMetadata `protobuf:"bytes,1,rep,name=sidecars,proto3" json:"sidecars,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetFleetMetadataResponse) Reset()         { *m = GetFleetMetadataResponse{} }
+func (m *GetFleetMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFleetMetadataResponse) ProtoMessage()    {}
+func (*GetFleetMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e6e6e3126ef3d27, []int{5}
+}
+
+func (m *GetFleetMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFleetMetadataResponse.Unmarshal(m, b)
+}
+func (m *GetFleetMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFleetMetadataResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFleetMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFleetMetadataResponse.Merge(m, src)
+}
+func (m *GetFleetMetadataResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFleetMetadataResponse.Size(m)
+}
+func (m *GetFleetMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFleetMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFleetMetadataResponse proto.InternalMessageInfo
+
+func (m *GetFleetMetadataResponse) GetSidecars() []*SidecarMetadata {
+	if m != nil {
+		return m.Sidecars
+	}
+	return nil
+}
+
+// SidecarMetadata is the metadata of a Dapr sidecar and the pod running it
+type SidecarMetadata struct {
+	AppId     string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PodName   string `protobuf:"bytes,3,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	// sidecar_image is the image of the sidecar container, holding the version the pod was created with.
+	SidecarImage string `protobuf:"bytes,4,opt,name=sidecar_image,json=sidecarImage,proto3" json:"sidecar_image,omitempty"`
+	// configuration is the name of the Dapr configuration of the sidecar.
+	Configuration string `protobuf:"bytes,5,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	// metadata is the JSON response of the metadata API of the sidecar, with its runtime version, components and
+	// subscriptions. It is empty if the sidecar never answered.
+	Metadata *any.Any `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// fetched_at is when metadata was fetched from the sidecar.
+	FetchedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	// error is the error of the last fetch, which failed after fetched_at.
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SidecarMetadata) Reset()         { *m = SidecarMetadata{} }
+func (m *SidecarMetadata) String() string { return proto.CompactTextString(m) }
+func (*SidecarMetadata) ProtoMessage()    {}
+func (*SidecarMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e6e6e3126ef3d27, []int{6}
+}
+
+func (m *SidecarMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SidecarMetadata.Unmarshal(m, b)
+}
+func (m *SidecarMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SidecarMetadata.Marshal(b, m, deterministic)
+}
+func (m *SidecarMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SidecarMetadata.Merge(m, src)
+}
+func (m *SidecarMetadata) XXX_Size() int {
+	return xxx_messageInfo_SidecarMetadata.Size(m)
+}
+func (m *SidecarMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_SidecarMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SidecarMetadata proto.InternalMessageInfo
+
+func (m *SidecarMetadata) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *SidecarMetadata) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *SidecarMetadata) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *SidecarMetadata) GetSidecarImage() string {
+	if m != nil {
+		return m.SidecarImage
+	}
+	return ""
+}
+
+func (m *SidecarMetadata) GetConfiguration() string {
+	if m != nil {
+		return m.Configuration
+	}
+	return ""
+}
+
+func (m *SidecarMetadata) GetMetadata() *any.Any {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SidecarMetadata) GetFetchedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.FetchedAt
+	}
+	return nil
+}
+
+func (m *SidecarMetadata) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ComponentUpdateEvent)(nil), "dapr.proto.operator.v1.ComponentUpdateEvent")
 	proto.RegisterType((*GetComponentResponse)(nil), "dapr.proto.operator.v1.GetComponentResponse")
 	proto.RegisterType((*GetConfigurationRequest)(nil), "dapr.proto.operator.v1.GetConfigurationRequest")
 	proto.RegisterType((*GetConfigurationResponse)(nil), "dapr.proto.operator.v1.GetConfigurationResponse")
+	proto.RegisterType((*GetFleetMetadataRequest)(nil), "dapr.proto.operator.v1.GetFleetMetadataRequest")
+	proto.RegisterType((*GetFleetMetadataResponse)(nil), "dapr.proto.operator.v1.GetFleetMetadataResponse")
+	proto.RegisterType((*SidecarMetadata)(nil), "dapr.proto.operator.v1.SidecarMetadata")
 }
 
 func init() {
@@ -202,29 +405,43 @@ func init() {
 }
 
 var fileDescriptor_4e6e6e3126ef3d27 = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x51, 0x4b, 0xfb, 0x30,
-	0x14, 0xc5, 0xd7, 0xfd, 0xff, 0x88, 0xbb, 0x32, 0x94, 0x30, 0x66, 0xad, 0x3e, 0x8c, 0x82, 0x20,
-	0x32, 0x92, 0x6d, 0xfa, 0xe4, 0x9b, 0xca, 0x10, 0x54, 0x10, 0x0a, 0x2a, 0xe8, 0x53, 0xd6, 0xdd,
-	0xd5, 0xa1, 0x4d, 0x62, 0x93, 0x4e, 0xf6, 0xd1, 0xfc, 0x76, 0xb2, 0x74, 0xeb, 0xe6, 0x3a, 0x87,
-	0x3e, 0xf5, 0x72, 0xcf, 0xe9, 0xaf, 0xe9, 0x3d, 0xb9, 0x70, 0xd8, 0xe7, 0x2a, 0x61, 0x2a, 0x91,
-	0x46, 0x32, 0xa9, 0x30, 0xe1, 0x46, 0x26, 0x6c, 0xd4, 0xce, 0x6b, 0x6a, 0x25, 0x52, 0x9f, 0xd8,
-	0xb2, 0x9a, 0xe6, 0xd2, 0xa8, 0xed, 0xed, 0x45, 0x52, 0x46, 0x6f, 0x98, 0x01, 0x7a, 0xe9, 0x80,
-	0x71, 0x31, 0xce, 0x6c, 0xde, 0xfe, 0xb2, 0x84, 0xb1, 0x32, 0x53, 0xd1, 0xbf, 0x86, 0xda, 0xa5,
-	0x8c, 0x95, 0x14, 0x28, 0xcc, 0xbd, 0xea, 0x73, 0x83, 0xdd, 0x11, 0x0a, 0x43, 0x3a, 0x50, 0x09,
-	0x67, 0x7d, 0xd7, 0x69, 0x38, 0x47, 0x5b, 0x9d, 0x1a, 0xcd, 0x40, 0x74, 0x06, 0xa2, 0xe7, 0x62,
-	0x1c, 0xcc, 0x6d, 0xfe, 0x2d, 0xd4, 0xae, 0xd0, 0xe4, 0xb8, 0x00, 0xb5, 0x92, 0x42, 0x23, 0x39,
-	0x05, 0xc8, 0x4d, 0xda, 0x75, 0x1a, 0xff, 0x7e, 0x84, 0x2d, 0xf8, 0xfc, 0x1b, 0xd8, 0xb5, 0x34,
-	0x31, 0x18, 0x46, 0x69, 0xc2, 0xcd, 0x50, 0x8a, 0x00, 0xdf, 0x53, 0xd4, 0x86, 0x10, 0xf8, 0x2f,
-	0x78, 0x8c, 0xf6, 0x5c, 0x95, 0xc0, 0xd6, 0xe4, 0x00, 0x2a, 0x93, 0xa7, 0x56, 0x3c, 0x44, 0xb7,
-	0x6c, 0x85, 0x79, 0xc3, 0x7f, 0x00, 0xb7, 0x08, 0x9b, 0x1e, 0xef, 0x0c, 0xaa, 0xe1, 0xa2, 0xb0,
-	0xf6, 0x77, 0xbf, 0x5b, 0x3b, 0x9f, 0x65, 0xd8, 0xbc, 0x9b, 0xc6, 0x40, 0x9e, 0x61, 0x7b, 0x69,
-	0x96, 0xa4, 0x5e, 0x80, 0x74, 0x27, 0xc3, 0xf7, 0x9a, 0x74, 0x75, 0x8e, 0x74, 0x55, 0x18, 0x7e,
-	0xa9, 0xe5, 0x90, 0x47, 0xa8, 0x2e, 0x0e, 0x57, 0xff, 0x1d, 0xbd, 0x2a, 0x1b, 0xbf, 0x44, 0x3e,
-	0x60, 0x67, 0x79, 0x34, 0x84, 0xad, 0x65, 0x14, 0x13, 0xf1, 0x5a, 0xbf, 0x7f, 0x61, 0xf6, 0xe1,
-	0x8b, 0xe6, 0xd3, 0x71, 0x34, 0x34, 0x2f, 0x69, 0x8f, 0x86, 0x32, 0x66, 0xf6, 0xfa, 0x67, 0x3b,
-	0xf0, 0x1a, 0x15, 0xf7, 0xa0, 0xb7, 0x61, 0x5b, 0x27, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xea,
-	0xf2, 0xe0, 0x80, 0x28, 0x03, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdb, 0x6e, 0xd3, 0x40,
+	0x10, 0xad, 0x9b, 0x26, 0x4d, 0x06, 0xa2, 0xa0, 0x55, 0x28, 0xae, 0x41, 0x22, 0x32, 0xb7, 0x0a,
+	0x55, 0x76, 0x1a, 0x78, 0x81, 0xb7, 0x50, 0x15, 0x54, 0xae, 0x92, 0xcb, 0x45, 0x82, 0x07, 0x6b,
+	0x63, 0x4f, 0x5c, 0x8b, 0xda, 0xbb, 0x78, 0x37, 0xa1, 0xfd, 0x2a, 0x24, 0xbe, 0x10, 0x65, 0x7d,
+	0x69, 0x62, 0xa7, 0x56, 0x79, 0x69, 0x37, 0x73, 0xce, 0x9c, 0x39, 0xde, 0xf1, 0x31, 0x3c, 0xf2,
+	0x29, 0x4f, 0x6c, 0x9e, 0x30, 0xc9, 0x6c, 0xc6, 0x31, 0xa1, 0x92, 0x25, 0xf6, 0xfc, 0xa0, 0x38,
+	0x5b, 0x0a, 0x22, 0x3b, 0x0b, 0x5a, 0x7a, 0xb6, 0x0a, 0x68, 0x7e, 0x60, 0xec, 0x06, 0x8c, 0x05,
+	0x67, 0x98, 0x0a, 0x4c, 0x66, 0x53, 0x9b, 0xc6, 0x17, 0x29, 0xcd, 0xb8, 0x5b, 0x86, 0x30, 0xe2,
+	0x32, 0x07, 0xef, 0x97, 0x41, 0x19, 0x46, 0x28, 0x24, 0x8d, 0x78, 0x4a, 0x30, 0xdf, 0x42, 0xff,
+	0x90, 0x45, 0x9c, 0xc5, 0x18, 0xcb, 0x2f, 0xdc, 0xa7, 0x12, 0x8f, 0xe6, 0x18, 0x4b, 0x32, 0x82,
+	0x8e, 0x97, 0xd7, 0x75, 0x6d, 0xa0, 0xed, 0xdd, 0x18, 0xf5, 0xad, 0x54, 0xcc, 0xca, 0xc5, 0xac,
+	0x71, 0x7c, 0xe1, 0x5c, 0xd2, 0xcc, 0xf7, 0xd0, 0x7f, 0x83, 0xb2, 0x90, 0x73, 0x50, 0x70, 0x16,
+	0x0b, 0x24, 0xcf, 0x01, 0x0a, 0x92, 0xd0, 0xb5, 0x41, 0xe3, 0x4a, 0xb1, 0x25, 0x9e, 0xf9, 0x0e,
+	0xee, 0x28, 0xb5, 0x78, 0x1a, 0x06, 0xb3, 0x84, 0xca, 0x90, 0xc5, 0x0e, 0xfe, 0x9a, 0xa1, 0x90,
+	0x84, 0xc0, 0x56, 0x4c, 0x23, 0x54, 0xbe, 0x3a, 0x8e, 0x3a, 0x93, 0x7b, 0xd0, 0x59, 0xfc, 0x17,
+	0x9c, 0x7a, 0xa8, 0x6f, 0x2a, 0xe0, 0xb2, 0x60, 0x7e, 0x05, 0xbd, 0x2a, 0x96, 0xd9, 0x7b, 0x09,
+	0x5d, 0x6f, 0x19, 0xa8, 0x7d, 0xdc, 0x55, 0xaa, 0x39, 0x57, 0x26, 0x5f, 0x9f, 0x21, 0xca, 0x0f,
+	0x28, 0xa9, 0x4f, 0x25, 0xcd, 0x4d, 0xae, 0x18, 0xd2, 0x4a, 0x86, 0xc8, 0x6d, 0x68, 0x51, 0xce,
+	0xdd, 0xd0, 0xcf, 0xbc, 0x36, 0x29, 0xe7, 0xc7, 0x3e, 0x79, 0x0c, 0xbd, 0x88, 0x9e, 0xbb, 0x34,
+	0x40, 0x57, 0xa0, 0xc7, 0x62, 0x5f, 0xe8, 0x8d, 0x81, 0xb6, 0xd7, 0x74, 0xba, 0x11, 0x3d, 0x1f,
+	0x07, 0x78, 0x92, 0x16, 0x4d, 0x17, 0xf4, 0xea, 0xdc, 0xec, 0x79, 0x0e, 0xa1, 0x2d, 0x42, 0x1f,
+	0x3d, 0x9a, 0xe4, 0x97, 0xfd, 0xc4, 0x5a, 0xff, 0x5a, 0x59, 0x27, 0x29, 0xaf, 0x90, 0x28, 0x1a,
+	0xcd, 0x3f, 0x9b, 0xd0, 0x2b, 0xa1, 0x4b, 0x9e, 0xb5, 0x65, 0xcf, 0xb5, 0x37, 0x4f, 0x76, 0xa1,
+	0xcd, 0x99, 0xef, 0xaa, 0x7d, 0x35, 0x14, 0xb8, 0xcd, 0x99, 0xff, 0x71, 0xb1, 0xb2, 0x07, 0xd0,
+	0xcd, 0xe6, 0xb9, 0x61, 0x44, 0x03, 0xd4, 0xb7, 0x14, 0x7e, 0x33, 0x2b, 0x1e, 0x2f, 0x6a, 0xe4,
+	0x61, 0x79, 0x3b, 0x4d, 0x45, 0x5a, 0x2d, 0x92, 0x21, 0xb4, 0xa3, 0xcc, 0xa6, 0xde, 0xaa, 0x59,
+	0x5f, 0xc1, 0x22, 0x2f, 0x00, 0xa6, 0x28, 0xbd, 0x53, 0xf4, 0x5d, 0x2a, 0xf5, 0x6d, 0xd5, 0x63,
+	0x54, 0x7a, 0x3e, 0xe7, 0x71, 0x71, 0x3a, 0x19, 0x7b, 0x2c, 0x49, 0x1f, 0x9a, 0x98, 0x24, 0x2c,
+	0xd1, 0xdb, 0xe9, 0x35, 0xa8, 0x1f, 0xa3, 0xbf, 0x0d, 0x68, 0x7f, 0xca, 0xee, 0x96, 0xfc, 0x80,
+	0x5e, 0x29, 0x56, 0x64, 0xa7, 0x22, 0x7e, 0xb4, 0x08, 0xaa, 0xb1, 0x7f, 0xd5, 0x72, 0xd6, 0xe5,
+	0xd2, 0xdc, 0x18, 0x6a, 0xe4, 0x1b, 0x74, 0x97, 0x73, 0x26, 0xfe, 0x5f, 0x7a, 0x5d, 0x4c, 0xcd,
+	0x0d, 0xf2, 0x1b, 0x6e, 0x95, 0x53, 0x42, 0xec, 0x5a, 0x8d, 0x6a, 0x38, 0x8d, 0xe1, 0xf5, 0x1b,
+	0x4a, 0x83, 0x57, 0x5e, 0xe7, 0xda, 0xc1, 0xeb, 0x02, 0x67, 0x0c, 0xaf, 0xdf, 0x90, 0x0f, 0x7e,
+	0xb5, 0xff, 0xfd, 0x69, 0x10, 0xca, 0xd3, 0xd9, 0xc4, 0xf2, 0x58, 0x64, 0xab, 0x6f, 0xb4, 0xfa,
+	0xc3, 0x7f, 0x06, 0xd5, 0x8f, 0xf5, 0xa4, 0xa5, 0x4a, 0xcf, 0xfe, 0x0d, 0x00, 0xb1, 0x4e, 0xb4,
+	0xc2, 0xcd, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -245,6 +462,8 @@ type OperatorClient interface {
 	GetComponents(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetComponentResponse, error)
 	// GetConfiguration returns a given configuration by name
 	GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error)
+	// GetFleetMetadata returns the metadata of the Dapr sidecars of a namespace, fetched from the sidecars
+	GetFleetMetadata(ctx context.Context, in *GetFleetMetadataRequest, opts ...grpc.CallOption) (*GetFleetMetadataResponse, error)
 }
 
 type operatorClient struct {
@@ -305,6 +524,15 @@ func (c *operatorClient) GetConfiguration(ctx context.Context, in *GetConfigurat
 	return out, nil
 }
 
+func (c *operatorClient) GetFleetMetadata(ctx context.Context, in *GetFleetMetadataRequest, opts ...grpc.CallOption) (*GetFleetMetadataResponse, error) {
+	out := new(GetFleetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.operator.v1.Operator/GetFleetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OperatorServer is the server API for Operator service.
 type OperatorServer interface {
 	// ComponentUpdate sends events to Dapr sidecars upon component changes.
@@ -313,6 +541,8 @@ type OperatorServer interface {
 	GetComponents(context.Context, *empty.Empty) (*GetComponentResponse, error)
 	// GetConfiguration returns a given configuration by name
 	GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error)
+	// GetFleetMetadata returns the metadata of the Dapr sidecars of a namespace, fetched from the sidecars
+	GetFleetMetadata(context.Context, *GetFleetMetadataRequest) (*GetFleetMetadataResponse, error)
 }
 
 // UnimplementedOperatorServer can be embedded to have forward compatible implementations.
@@ -328,6 +558,9 @@ func (*UnimplementedOperatorServer) GetComponents(ctx context.Context, req *empt
 func (*UnimplementedOperatorServer) GetConfiguration(ctx context.Context, req *GetConfigurationRequest) (*GetConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
 }
+func (*UnimplementedOperatorServer) GetFleetMetadata(ctx context.Context, req *GetFleetMetadataRequest) (*GetFleetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFleetMetadata not implemented")
+}
 
 func RegisterOperatorServer(s *grpc.Server, srv OperatorServer) {
 	s.RegisterService(&_Operator_serviceDesc, srv)
@@ -390,6 +623,24 @@ func _Operator_GetConfiguration_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Operator_GetFleetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFleetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).GetFleetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.operator.v1.Operator/GetFleetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).GetFleetMetadata(ctx, req.(*GetFleetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Operator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dapr.proto.operator.v1.Operator",
 	HandlerType: (*OperatorServer)(nil),
@@ -402,6 +653,10 @@ var _Operator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfiguration",
 			Handler:    _Operator_GetConfiguration_Handler,
 		},
+		{
+			MethodName: "GetFleetMetadata",
+			Handler:    _Operator_GetFleetMetadata_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
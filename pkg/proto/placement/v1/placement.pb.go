@@ -182,14 +182,20 @@ func (m *PlacementTable) GetTotalLoad() int64 {
 }
 
 type Host struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Port                 int64    `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Load                 int64    `protobuf:"varint,3,opt,name=load,proto3" json:"load,omitempty"`
-	Entities             []string `protobuf:"bytes,4,rep,name=entities,proto3" json:"entities,omitempty"`
-	Id                   string   `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Port     int64    `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Load     int64    `protobuf:"varint,3,opt,name=load,proto3" json:"load,omitempty"`
+	Entities []string `protobuf:"bytes,4,rep,name=entities,proto3" json:"entities,omitempty"`
+	Id       string   `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// weight is the capacity of the host relative to the other hosts of its actor types.
+	// Hosts get a number of virtual nodes in the placement ring proportional to their weight.
+	Weight int64 `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	// labels describe the host for the actor calls and reminders requiring an affinity, e.g. pool=gpu.
+	// Those actors are placed on the first host of the ring with the labels, from the position of their key.
+	Labels               map[string]string `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Host) Reset()         { *m = Host{} }
@@ -252,6 +258,20 @@ func (m *Host) GetId() string {
 	return ""
 }
 
+func (m *Host) GetWeight() int64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *Host) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PlacementOrder)(nil), "dapr.proto.placement.v1.PlacementOrder")
 	proto.RegisterType((*PlacementTables)(nil), "dapr.proto.placement.v1.PlacementTables")
@@ -260,6 +280,7 @@ func init() {
 	proto.RegisterMapType((map[uint64]string)(nil), "dapr.proto.placement.v1.PlacementTable.HostsEntry")
 	proto.RegisterMapType((map[string]*Host)(nil), "dapr.proto.placement.v1.PlacementTable.LoadMapEntry")
 	proto.RegisterType((*Host)(nil), "dapr.proto.placement.v1.Host")
+	proto.RegisterMapType((map[string]string)(nil), "dapr.proto.placement.v1.Host.LabelsEntry")
 }
 
 func init() {
@@ -267,37 +288,40 @@ func init() {
 }
 
 var fileDescriptor_9480df3fa18b8da3 = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdf, 0x6b, 0xdb, 0x30,
-	0x10, 0x9e, 0x7f, 0xa4, 0xad, 0x2f, 0xa5, 0x0b, 0x62, 0x30, 0x63, 0x56, 0x30, 0x79, 0xa9, 0x61,
-	0xcc, 0x59, 0xdd, 0x0d, 0xca, 0x60, 0x30, 0xc6, 0x06, 0x7d, 0xd8, 0xc8, 0x50, 0xf6, 0xb2, 0xbd,
-	0x64, 0x4a, 0x2c, 0x5a, 0x53, 0xc7, 0x12, 0xf2, 0xc5, 0xd0, 0x3f, 0x77, 0xff, 0xc8, 0x18, 0x92,
-	0xe2, 0xd8, 0x1b, 0x6d, 0xf1, 0x93, 0xef, 0x3e, 0xe9, 0xfb, 0xee, 0xee, 0x93, 0x2c, 0x38, 0xcb,
-	0x99, 0x54, 0x33, 0xa9, 0x04, 0x8a, 0x99, 0x2c, 0xd9, 0x9a, 0x6f, 0x78, 0x85, 0xb3, 0xe6, 0xbc,
-	0x4b, 0x52, 0xb3, 0x48, 0x9e, 0xeb, 0x8d, 0x36, 0x4e, 0xbb, 0xb5, 0xe6, 0x7c, 0x2a, 0xe1, 0xe4,
-	0x5b, 0x9b, 0xcf, 0x55, 0xce, 0x15, 0xf9, 0x00, 0x07, 0xc8, 0x56, 0x25, 0xaf, 0x43, 0x27, 0x76,
-	0x92, 0x71, 0x96, 0xa4, 0x0f, 0x70, 0xd3, 0x3d, 0xf1, 0xbb, 0xd9, 0x4f, 0x77, 0x3c, 0xf2, 0x02,
-	0x02, 0x21, 0xb9, 0x62, 0x58, 0x88, 0x2a, 0x74, 0x63, 0x27, 0x09, 0x68, 0x07, 0x4c, 0x7f, 0x3b,
-	0xf0, 0xf4, 0x3f, 0x26, 0x99, 0xc3, 0x21, 0xaf, 0x50, 0x15, 0xa6, 0xa8, 0x97, 0x8c, 0xb3, 0xb7,
-	0x43, 0x8b, 0xa6, 0x9f, 0x2d, 0x4f, 0x7f, 0xee, 0x68, 0xab, 0x42, 0x42, 0x38, 0x6c, 0xb8, 0xaa,
-	0xbb, 0x06, 0xda, 0x34, 0x5a, 0xc3, 0x71, 0x9f, 0x42, 0x26, 0xe0, 0xdd, 0xf2, 0x3b, 0x33, 0x6b,
-	0x40, 0x75, 0x48, 0xde, 0xc3, 0xa8, 0x61, 0xe5, 0x96, 0x1b, 0xe6, 0x38, 0x3b, 0x1b, 0xd8, 0x0a,
-	0xb5, 0xac, 0x77, 0xee, 0xa5, 0x33, 0xfd, 0xe3, 0xf6, 0x6c, 0x35, 0xab, 0xe4, 0x0a, 0x46, 0x37,
-	0xa2, 0xc6, 0x76, 0xc0, 0x6c, 0xa0, 0x6a, 0x7a, 0xa5, 0x49, 0x76, 0x3a, 0x2b, 0x40, 0x4e, 0x01,
-	0x6a, 0xa1, 0x90, 0xe7, 0xcb, 0x9a, 0x63, 0xe8, 0xc6, 0x5e, 0xe2, 0xd3, 0xc0, 0x22, 0x0b, 0x8e,
-	0x64, 0x0e, 0x47, 0xa5, 0x60, 0xf9, 0x72, 0xc3, 0x64, 0xe8, 0x99, 0x5a, 0x6f, 0x86, 0xd6, 0xfa,
-	0x22, 0x58, 0xfe, 0x95, 0xc9, 0x9d, 0x97, 0xa5, 0xcd, 0x74, 0x3d, 0x14, 0xc8, 0xca, 0xa5, 0x06,
-	0x42, 0x3f, 0x76, 0x12, 0x8f, 0x06, 0x06, 0xd1, 0xfb, 0xa3, 0x4b, 0x80, 0xae, 0xc7, 0xbe, 0x9d,
-	0xbe, 0xb5, 0xf3, 0x59, 0xdf, 0xce, 0xa0, 0xe7, 0x52, 0xf4, 0x03, 0x8e, 0xfb, 0x15, 0xef, 0x39,
-	0x8a, 0x8b, 0x7f, 0x8f, 0xe2, 0xf4, 0xc1, 0x41, 0x74, 0x07, 0xfd, 0x03, 0xa8, 0xc0, 0xd7, 0x10,
-	0x21, 0xe0, 0x57, 0x6c, 0xc3, 0x77, 0x9a, 0x26, 0xd6, 0x98, 0x14, 0x0a, 0x8d, 0xa6, 0x47, 0x4d,
-	0xac, 0x31, 0x33, 0x9d, 0x67, 0x31, 0x1d, 0x93, 0x08, 0x8e, 0x78, 0x85, 0x05, 0xea, 0x5b, 0xe9,
-	0xc7, 0x5e, 0x12, 0xd0, 0x7d, 0x4e, 0x4e, 0xc0, 0x2d, 0xf2, 0x70, 0x64, 0x54, 0xdd, 0x22, 0xcf,
-	0x10, 0x26, 0x7b, 0x2f, 0x17, 0x5c, 0x35, 0xc5, 0x9a, 0x93, 0x5f, 0x30, 0xa1, 0x5c, 0xab, 0x7f,
-	0x62, 0x52, 0x2d, 0x90, 0xe1, 0xb6, 0x26, 0x8f, 0x4f, 0x10, 0x0d, 0xb8, 0x6b, 0xe6, 0x27, 0x9d,
-	0x3e, 0x49, 0x9c, 0xd7, 0xce, 0xc7, 0x57, 0x3f, 0x5f, 0x5e, 0x17, 0x78, 0xb3, 0x5d, 0xa5, 0x6b,
-	0xb1, 0x99, 0x99, 0xb7, 0xc0, 0x3e, 0x08, 0xb7, 0xd7, 0xf7, 0x3c, 0x0a, 0xab, 0x03, 0x83, 0x5d,
-	0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x17, 0x0f, 0xbb, 0x36, 0x04, 0x00, 0x00,
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x8b, 0xd3, 0x40,
+	0x14, 0x35, 0x1f, 0xdb, 0x6e, 0x6e, 0x97, 0x5a, 0x06, 0xd1, 0x10, 0x5c, 0x28, 0x7d, 0xd9, 0x88,
+	0x98, 0xba, 0x59, 0x85, 0x55, 0x10, 0x54, 0x14, 0xf6, 0x61, 0xa5, 0x32, 0xf5, 0x45, 0x5f, 0xea,
+	0xb4, 0x19, 0xda, 0xb0, 0x69, 0x66, 0x98, 0x4c, 0x23, 0xfb, 0xee, 0x1f, 0xf5, 0x8f, 0x88, 0xcc,
+	0x4c, 0xda, 0x64, 0xa5, 0x5d, 0xf2, 0x92, 0xdc, 0x7b, 0xe6, 0x9e, 0xfb, 0x71, 0x6e, 0x32, 0x70,
+	0x96, 0x10, 0x2e, 0xc6, 0x5c, 0x30, 0xc9, 0xc6, 0x3c, 0x23, 0x0b, 0xba, 0xa6, 0xb9, 0x1c, 0x97,
+	0xe7, 0xb5, 0x13, 0xe9, 0x43, 0xf4, 0x44, 0x05, 0x1a, 0x3b, 0xaa, 0xcf, 0xca, 0xf3, 0x11, 0x87,
+	0xfe, 0xd7, 0xad, 0x3f, 0x11, 0x09, 0x15, 0xe8, 0x3d, 0x74, 0x24, 0x99, 0x67, 0xb4, 0xf0, 0xad,
+	0xa1, 0x15, 0xf6, 0xe2, 0x30, 0x3a, 0xc0, 0x8d, 0x76, 0xc4, 0x6f, 0x3a, 0x1e, 0x57, 0x3c, 0xf4,
+	0x14, 0x3c, 0xc6, 0xa9, 0x20, 0x32, 0x65, 0xb9, 0x6f, 0x0f, 0xad, 0xd0, 0xc3, 0x35, 0x30, 0xfa,
+	0x63, 0xc1, 0xc3, 0xff, 0x98, 0x68, 0x02, 0x5d, 0x9a, 0x4b, 0x91, 0xea, 0xa2, 0x4e, 0xd8, 0x8b,
+	0x5f, 0xb7, 0x2d, 0x1a, 0x7d, 0x36, 0x3c, 0xf5, 0xba, 0xc5, 0xdb, 0x2c, 0xc8, 0x87, 0x6e, 0x49,
+	0x45, 0x51, 0x37, 0xb0, 0x75, 0x83, 0x05, 0x9c, 0x34, 0x29, 0x68, 0x00, 0xce, 0x0d, 0xbd, 0xd5,
+	0xb3, 0x7a, 0x58, 0x99, 0xe8, 0x1d, 0x1c, 0x95, 0x24, 0xdb, 0x50, 0xcd, 0xec, 0xc5, 0x67, 0x2d,
+	0x5b, 0xc1, 0x86, 0xf5, 0xd6, 0xbe, 0xb4, 0x46, 0x7f, 0x6d, 0xe8, 0xdf, 0x3d, 0x45, 0x57, 0x70,
+	0xb4, 0x62, 0x85, 0xdc, 0x0e, 0x18, 0xb7, 0xcc, 0x1a, 0x5d, 0x29, 0x92, 0x99, 0xce, 0x24, 0x40,
+	0xa7, 0x00, 0x05, 0x13, 0x92, 0x26, 0xb3, 0x82, 0x4a, 0xdf, 0x1e, 0x3a, 0xa1, 0x8b, 0x3d, 0x83,
+	0x4c, 0xa9, 0x44, 0x13, 0x38, 0xce, 0x18, 0x49, 0x66, 0x6b, 0xc2, 0x7d, 0x47, 0xd7, 0x7a, 0xd5,
+	0xb6, 0xd6, 0x35, 0x23, 0xc9, 0x17, 0xc2, 0x2b, 0x2d, 0x33, 0xe3, 0xa9, 0x7a, 0x92, 0x49, 0x92,
+	0xcd, 0x14, 0xe0, 0xbb, 0x43, 0x2b, 0x74, 0xb0, 0xa7, 0x11, 0x15, 0x1f, 0x5c, 0x02, 0xd4, 0x3d,
+	0x36, 0xe5, 0x74, 0x8d, 0x9c, 0x8f, 0x9a, 0x72, 0x7a, 0x0d, 0x95, 0x82, 0xef, 0x70, 0xd2, 0xac,
+	0xb8, 0x67, 0x15, 0x17, 0x77, 0x57, 0x71, 0x7a, 0x70, 0x10, 0xd5, 0x41, 0x73, 0x01, 0xbf, 0x6d,
+	0x70, 0x15, 0x86, 0x10, 0xb8, 0x39, 0x59, 0xd3, 0x2a, 0xa9, 0xb6, 0x15, 0xc6, 0x99, 0x90, 0x3a,
+	0xa9, 0x83, 0xb5, 0xad, 0x30, 0x3d, 0x9e, 0x63, 0x30, 0x65, 0xa3, 0x00, 0x8e, 0x69, 0x2e, 0x53,
+	0xa9, 0x3e, 0x4b, 0x77, 0xe8, 0x84, 0x1e, 0xde, 0xf9, 0xa8, 0x0f, 0x76, 0x9a, 0xf8, 0x47, 0x3a,
+	0xab, 0x9d, 0x26, 0xe8, 0x31, 0x74, 0x7e, 0xd1, 0x74, 0xb9, 0x92, 0x7e, 0x47, 0x67, 0xa8, 0x3c,
+	0xf4, 0x01, 0x3a, 0x19, 0x99, 0xd3, 0xac, 0xf0, 0xbb, 0x7a, 0x17, 0xcf, 0xee, 0x1d, 0x21, 0xba,
+	0xd6, 0xb1, 0x66, 0x01, 0x15, 0x31, 0x78, 0x03, 0xbd, 0x06, 0xbc, 0x47, 0xa5, 0x83, 0x0a, 0xc7,
+	0x12, 0x06, 0xbb, 0x15, 0x4f, 0xa9, 0x28, 0xd3, 0x05, 0x45, 0x3f, 0x61, 0x80, 0xa9, 0x9a, 0xf9,
+	0x13, 0xe1, 0x62, 0x2a, 0x89, 0xdc, 0x14, 0xe8, 0x7e, 0x61, 0x83, 0x16, 0xbf, 0x80, 0xbe, 0x3b,
+	0x46, 0x0f, 0x42, 0xeb, 0xa5, 0xf5, 0xf1, 0xc5, 0x8f, 0xe7, 0xcb, 0x54, 0xae, 0x36, 0xf3, 0x68,
+	0xc1, 0xd6, 0x63, 0x7d, 0x45, 0xe9, 0x07, 0xbf, 0x59, 0xee, 0xb9, 0xab, 0xe6, 0x1d, 0x8d, 0x5d,
+	0xfc, 0x1b, 0x00, 0x2f, 0x92, 0xe4, 0x1e, 0xcd, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
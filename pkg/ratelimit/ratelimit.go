@@ -0,0 +1,206 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package ratelimit
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+const (
+	// RateMetadataKey is the metadata item of a component with the number of calls per second the component accepts
+	// from all the sidecars of the app together
+	RateMetadataKey = "rateLimit"
+	// BurstMetadataKey is the metadata item of a component with the number of calls a sidecar takes from the shared
+	// limit at once, and makes without reaching the state store holding the limit
+	BurstMetadataKey = "rateLimitBurst"
+	// StateStoreMetadataKey is the metadata item of a component with the name of the state store sharing the limit
+	// between the sidecars of the app
+	StateStoreMetadataKey = "rateLimitStateStore"
+
+	// leaseDuration is how long a sidecar can make the calls it took from the shared limit
+	leaseDuration = time.Second
+	// maxLeaseAttempts bounds the concurrent updates of the shared limit a sidecar retries
+	maxLeaseAttempts = 5
+)
+
+// Config configures the rate limit of the calls to a component shared by the sidecars of an app
+type Config struct {
+	Rate       float64
+	Burst      int
+	StateStore string
+}
+
+// ConfigFromMetadata reads the rate limit of a component from its metadata.
+// It returns false if the component doesn't declare a rate limit.
+func ConfigFromMetadata(properties map[string]string) (Config, bool, error) {
+	c := Config{StateStore: properties[StateStoreMetadataKey]}
+	v, ok := properties[RateMetadataKey]
+	if !ok {
+		return c, false, nil
+	}
+
+	rate, err := strconv.ParseFloat(v, 64)
+	if err != nil || rate <= 0 {
+		return c, true, fmt.Errorf("%s must be a positive number of calls per second", RateMetadataKey)
+	}
+	c.Rate = rate
+	c.Burst = int(math.Max(1, math.Floor(rate/10)))
+	if v := properties[BurstMetadataKey]; v != "" {
+		burst, err := strconv.Atoi(v)
+		if err != nil || burst <= 0 {
+			return c, true, fmt.Errorf("%s must be a positive integer", BurstMetadataKey)
+		}
+		c.Burst = burst
+	}
+	if c.StateStore == "" {
+		return c, true, fmt.Errorf("%s is required to share the rate limit", StateStoreMetadataKey)
+	}
+	return c, true, nil
+}
+
+// Error is returned for the calls exceeding the rate limit of a component
+type Error struct {
+	Component string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("rate limit of component %s exceeded", e.Component)
+}
+
+// bucket is the token bucket of a rate limit, saved to the state store sharing it
+type bucket struct {
+	Tokens  float64   `json:"tokens"`
+	Updated time.Time `json:"updated"`
+}
+
+// refill adds the tokens of the time elapsed since the bucket was last updated, up to capacity
+func (b *bucket) refill(now time.Time, rate, capacity float64) {
+	if elapsed := now.Sub(b.Updated).Seconds(); elapsed > 0 {
+		b.Tokens = math.Min(capacity, b.Tokens+elapsed*rate)
+		b.Updated = now
+	}
+}
+
+// Limiter limits the calls to a component with a token bucket shared by the sidecars of an app in a state store.
+// A sidecar takes up to Burst tokens from the shared bucket at once, so most calls don't reach the state store.
+// While the state store fails, each sidecar falls back to a bucket of its own with the same rate.
+type Limiter struct {
+	component string
+	key       string
+	config    Config
+	store     state.Store
+
+	lock         sync.Mutex
+	tokens       int
+	leaseExpires time.Time
+	emptyUntil   time.Time
+	local        bucket
+}
+
+// NewLimiter returns the limiter of the calls to component, sharing its bucket in the key of store
+func NewLimiter(component, key string, config Config, store state.Store) *Limiter {
+	return &Limiter{
+		component: component,
+		key:       key,
+		config:    config,
+		store:     store,
+		local:     bucket{Tokens: float64(config.Burst), Updated: time.Now()},
+	}
+}
+
+// Allow takes a token for a call to the component, or returns an Error if the rate limit is exceeded.
+// A nil Limiter allows every call.
+func (l *Limiter) Allow() error {
+	if l == nil {
+		return nil
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	if l.tokens > 0 && now.Before(l.leaseExpires) {
+		l.tokens--
+		return nil
+	}
+	if now.Before(l.emptyUntil) {
+		return &Error{Component: l.component}
+	}
+
+	granted, err := l.lease(now)
+	if err != nil {
+		// the shared bucket can't be reached, limit the calls of this sidecar alone
+		l.local.refill(now, l.config.Rate, float64(l.config.Burst))
+		if l.local.Tokens < 1 {
+			return &Error{Component: l.component}
+		}
+		l.local.Tokens--
+		return nil
+	}
+	if granted == 0 {
+		return &Error{Component: l.component}
+	}
+	l.tokens = granted - 1
+	l.leaseExpires = now.Add(leaseDuration)
+	return nil
+}
+
+// capacity is the number of tokens of the shared bucket, the calls of one second but one burst at least
+func (l *Limiter) capacity() float64 {
+	return math.Max(l.config.Rate, float64(l.config.Burst))
+}
+
+// lease takes up to Burst tokens from the shared bucket. It retries the concurrent updates of the bucket by other
+// sidecars and returns an error if the state store fails.
+func (l *Limiter) lease(now time.Time) (int, error) {
+	for i := 0; i < maxLeaseAttempts; i++ {
+		resp, err := l.store.Get(&state.GetRequest{Key: l.key})
+		if err != nil {
+			return 0, err
+		}
+		b := bucket{Tokens: l.capacity(), Updated: now}
+		etag := ""
+		if resp != nil && len(resp.Data) > 0 {
+			if err := json.Unmarshal(resp.Data, &b); err != nil {
+				return 0, fmt.Errorf("invalid rate limit bucket %s: %s", l.key, err)
+			}
+			etag = resp.ETag
+		}
+
+		b.refill(now, l.config.Rate, l.capacity())
+		granted := int(math.Min(float64(l.config.Burst), math.Floor(b.Tokens)))
+		if granted == 0 {
+			// no need to reach the state store again before the bucket has a token
+			wait := (1 - b.Tokens) / l.config.Rate
+			l.emptyUntil = now.Add(time.Duration(wait * float64(time.Second)))
+			return 0, nil
+		}
+		b.Tokens -= float64(granted)
+
+		data, err := json.Marshal(b)
+		if err != nil {
+			return 0, err
+		}
+		err = l.store.Set(&state.SetRequest{
+			Key:     l.key,
+			Value:   data,
+			ETag:    etag,
+			Options: state.SetStateOption{Concurrency: state.FirstWrite},
+		})
+		if err == nil {
+			return granted, nil
+		}
+	}
+	// the bucket is updated by other sidecars concurrently, the limit is reached meanwhile
+	return 0, nil
+}
@@ -0,0 +1,139 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package ratelimit
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/stretchr/testify/assert"
+)
+
+// etagStore is an in-memory state store rejecting the writes with an outdated ETag, shared by the limiters of a test
+type etagStore struct {
+	state.Store
+	lock    sync.Mutex
+	values  map[string][]byte
+	etags   map[string]int
+	gets    int
+	failing bool
+}
+
+func newETagStore() *etagStore {
+	return &etagStore{values: map[string][]byte{}, etags: map[string]int{}}
+}
+
+func (s *etagStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.gets++
+	if s.failing {
+		return nil, errors.New("store unavailable")
+	}
+	resp := &state.GetResponse{Data: s.values[req.Key]}
+	if e, ok := s.etags[req.Key]; ok {
+		resp.ETag = strconv.Itoa(e)
+	}
+	return resp, nil
+}
+
+func (s *etagStore) Set(req *state.SetRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	current, exists := s.etags[req.Key]
+	if exists && req.ETag != strconv.Itoa(current) || !exists && req.ETag != "" {
+		return errors.New("etag mismatch")
+	}
+	s.values[req.Key] = req.Value.([]byte)
+	s.etags[req.Key] = current + 1
+	return nil
+}
+
+func countAllowed(l *Limiter, calls int) int {
+	allowed := 0
+	for i := 0; i < calls; i++ {
+		if l.Allow() == nil {
+			allowed++
+		}
+	}
+	return allowed
+}
+
+func TestConfigFromMetadata(t *testing.T) {
+	_, ok, err := ConfigFromMetadata(map[string]string{})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	c, ok, err := ConfigFromMetadata(map[string]string{RateMetadataKey: "50", StateStoreMetadataKey: "redis"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, Config{Rate: 50, Burst: 5, StateStore: "redis"}, c)
+
+	c, _, err = ConfigFromMetadata(map[string]string{RateMetadataKey: "0.5", BurstMetadataKey: "2", StateStoreMetadataKey: "redis"})
+	assert.NoError(t, err)
+	assert.Equal(t, Config{Rate: 0.5, Burst: 2, StateStore: "redis"}, c)
+
+	invalid := []map[string]string{
+		{RateMetadataKey: "fast", StateStoreMetadataKey: "redis"},
+		{RateMetadataKey: "-1", StateStoreMetadataKey: "redis"},
+		{RateMetadataKey: "10", BurstMetadataKey: "0", StateStoreMetadataKey: "redis"},
+		{RateMetadataKey: "10"},
+	}
+	for _, properties := range invalid {
+		_, ok, err := ConfigFromMetadata(properties)
+		assert.True(t, ok)
+		assert.Error(t, err, properties)
+	}
+}
+
+func TestLimiterSharedAcrossSidecars(t *testing.T) {
+	store := newETagStore()
+	config := Config{Rate: 10, Burst: 2, StateStore: "store"}
+	a := NewLimiter("db", "app||dapr-ratelimit||db", config, store)
+	b := NewLimiter("db", "app||dapr-ratelimit||db", config, store)
+
+	// the sidecars share the 10 tokens of the bucket, whatever their number
+	allowed := countAllowed(a, 8) + countAllowed(b, 8)
+	assert.Equal(t, 10, allowed)
+
+	err := a.Allow()
+	assert.IsType(t, &Error{}, err)
+	assert.Equal(t, "rate limit of component db exceeded", err.Error())
+
+	t.Run("burst calls don't reach the store", func(t *testing.T) {
+		store := newETagStore()
+		l := NewLimiter("db", "key", Config{Rate: 100, Burst: 10}, store)
+		assert.Equal(t, 10, countAllowed(l, 10))
+		assert.Equal(t, 1, store.gets)
+	})
+
+	t.Run("empty bucket isn't read again before it refills", func(t *testing.T) {
+		gets := store.gets
+		countAllowed(b, 5)
+		assert.Equal(t, gets, store.gets)
+
+		time.Sleep(250 * time.Millisecond)
+		assert.NoError(t, b.Allow())
+	})
+}
+
+func TestLimiterFallback(t *testing.T) {
+	store := newETagStore()
+	store.failing = true
+	l := NewLimiter("db", "key", Config{Rate: 1, Burst: 3}, store)
+
+	// without the shared bucket, the sidecar limits its own calls
+	assert.Equal(t, 3, countAllowed(l, 5))
+}
+
+func TestNilLimiter(t *testing.T) {
+	var l *Limiter
+	assert.NoError(t, l.Allow())
+}
@@ -0,0 +1,183 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package recorder
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/dapr/dapr/pkg/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.recorder")
+
+const redacted = "<redacted>"
+
+// sensitiveHeaders are dropped from recorded requests and responses
+var sensitiveHeaders = map[string]bool{
+	"authorization":       true,
+	"proxy-authorization": true,
+	"cookie":              true,
+	"set-cookie":          true,
+	"dapr-api-token":      true,
+	"forwarded":           true,
+	"x-forwarded-for":     true,
+	"x-forwarded-host":    true,
+	"x-forwarded-proto":   true,
+}
+
+// sensitivePathPrefixes are the API paths whose response bodies are never recorded
+var sensitivePathPrefixes = []string{
+	"/v1.0/secrets/",
+}
+
+// Entry is a recorded Dapr API call
+type Entry struct {
+	Time            time.Time         `json:"time"`
+	Method          string            `json:"method"`
+	Path            string            `json:"path"`
+	Query           string            `json:"query,omitempty"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	Body            []byte            `json:"body,omitempty"`
+	StatusCode      int               `json:"statusCode"`
+	ResponseHeaders map[string]string `json:"responseHeaders,omitempty"`
+	ResponseBody    []byte            `json:"responseBody,omitempty"`
+	Duration        time.Duration     `json:"duration"`
+	// BodyRedacted is set when the request had a body that wasn't recorded
+	BodyRedacted bool `json:"bodyRedacted,omitempty"`
+}
+
+// Sink stores recorded entries
+type Sink interface {
+	Write(entry *Entry) error
+	Close() error
+}
+
+// Recorder sanitizes Dapr API calls and writes them to a sink
+type Recorder struct {
+	sink         Sink
+	recordBodies bool
+	lock         sync.Mutex
+}
+
+// NewRecorder returns a recorder writing to a sink.
+// Request and response bodies may hold state values, messages and binding payloads, so they are only recorded if recordBodies is set.
+func NewRecorder(sink Sink, recordBodies bool) *Recorder {
+	return &Recorder{sink: sink, recordBodies: recordBodies}
+}
+
+// Record sanitizes an entry and writes it to the sink. Failures are logged and don't affect the recorded call.
+func (r *Recorder) Record(entry *Entry) {
+	Sanitize(entry)
+	if !r.recordBodies {
+		RedactBodies(entry)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err := r.sink.Write(entry); err != nil {
+		log.Warnf("failed to record %s %s: %s", entry.Method, entry.Path, err)
+	}
+}
+
+// Close flushes and closes the sink
+func (r *Recorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.sink.Close()
+}
+
+// Sanitize removes credentials and secret values from an entry
+func Sanitize(entry *Entry) {
+	for k := range entry.Headers {
+		if sensitiveHeaders[strings.ToLower(k)] {
+			delete(entry.Headers, k)
+		}
+	}
+	for k := range entry.ResponseHeaders {
+		if sensitiveHeaders[strings.ToLower(k)] {
+			delete(entry.ResponseHeaders, k)
+		}
+	}
+	for _, prefix := range sensitivePathPrefixes {
+		if strings.HasPrefix(entry.Path, prefix) && len(entry.ResponseBody) > 0 {
+			entry.ResponseBody = []byte(redacted)
+		}
+	}
+}
+
+// RedactBodies drops the request and response bodies of an entry
+func RedactBodies(entry *Entry) {
+	if len(entry.Body) > 0 {
+		entry.Body = nil
+		entry.BodyRedacted = true
+	}
+	entry.ResponseBody = nil
+}
+
+// fileSink writes entries to a file as JSON lines
+type fileSink struct {
+	file   *os.File
+	writer *bufio.Writer
+}
+
+// NewFileSink returns a sink appending entries to a file, one JSON document per line
+func NewFileSink(path string) (Sink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening record file: %s", err)
+	}
+	return &fileSink{file: f, writer: bufio.NewWriter(f)}, nil
+}
+
+func (s *fileSink) Write(entry *Entry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	if _, err = s.writer.Write(b); err != nil {
+		return err
+	}
+	return s.writer.Flush()
+}
+
+func (s *fileSink) Close() error {
+	if err := s.writer.Flush(); err != nil {
+		s.file.Close()
+		return err
+	}
+	return s.file.Close()
+}
+
+// bindingSink sends entries to an output binding
+type bindingSink struct {
+	name                  string
+	sendToOutputBindingFn func(name string, req *bindings.WriteRequest) error
+}
+
+// NewBindingSink returns a sink sending each entry as JSON to an output binding
+func NewBindingSink(name string, sendToOutputBindingFn func(name string, req *bindings.WriteRequest) error) Sink {
+	return &bindingSink{name: name, sendToOutputBindingFn: sendToOutputBindingFn}
+}
+
+func (s *bindingSink) Write(entry *Entry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return s.sendToOutputBindingFn(s.name, &bindings.WriteRequest{Data: b})
+}
+
+func (s *bindingSink) Close() error {
+	return nil
+}
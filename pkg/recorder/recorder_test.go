@@ -0,0 +1,97 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package recorder
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitize(t *testing.T) {
+	entry := &Entry{
+		Path: "/v1.0/secrets/vault/password",
+		Headers: map[string]string{
+			"Authorization":  "Bearer token",
+			"Dapr-Api-Token": "token",
+			"Content-Type":   "application/json",
+		},
+		ResponseHeaders: map[string]string{
+			"Set-Cookie": "session=1",
+		},
+		ResponseBody: []byte(`{"password":"secret"}`),
+	}
+
+	Sanitize(entry)
+	assert.Equal(t, map[string]string{"Content-Type": "application/json"}, entry.Headers)
+	assert.Empty(t, entry.ResponseHeaders)
+	assert.Equal(t, redacted, string(entry.ResponseBody))
+}
+
+func TestRedactBodies(t *testing.T) {
+	entry := &Entry{Method: "GET", Path: "/v1.0/state/store1/key1", ResponseBody: []byte(`"value"`)}
+	RedactBodies(entry)
+	assert.Empty(t, entry.ResponseBody)
+	assert.False(t, entry.BodyRedacted)
+
+	entry = &Entry{Method: "POST", Path: "/v1.0/publish/pubsub/topic", Body: []byte(`{"card":"1234"}`)}
+	RedactBodies(entry)
+	assert.Empty(t, entry.Body)
+	assert.True(t, entry.BodyRedacted)
+}
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "capture.jsonl")
+	sink, err := NewFileSink(path)
+	assert.NoError(t, err)
+
+	r := NewRecorder(sink, true)
+	now := time.Now().UTC()
+	r.Record(&Entry{Time: now, Method: "GET", Path: "/v1.0/state/store1/key1", StatusCode: 204})
+	r.Record(&Entry{Time: now.Add(time.Second), Method: "POST", Path: "/v1.0/state/store1", Body: []byte(`[{"key":"key1"}]`), StatusCode: 201})
+	assert.NoError(t, r.Close())
+
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	entries, err := ReadEntries(f)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "/v1.0/state/store1/key1", entries[0].Path)
+	assert.Equal(t, `[{"key":"key1"}]`, string(entries[1].Body))
+	assert.True(t, now.Add(time.Second).Equal(entries[1].Time))
+}
+
+func TestBindingSink(t *testing.T) {
+	var sent *bindings.WriteRequest
+	sink := NewBindingSink("capture", func(name string, req *bindings.WriteRequest) error {
+		assert.Equal(t, "capture", name)
+		sent = req
+		return nil
+	})
+
+	assert.NoError(t, sink.Write(&Entry{Method: "GET", Path: "/v1.0/metadata", StatusCode: 200}))
+	var entry Entry
+	assert.NoError(t, json.Unmarshal(sent.Data, &entry))
+	assert.Equal(t, "/v1.0/metadata", entry.Path)
+
+	failing := NewBindingSink("capture", func(name string, req *bindings.WriteRequest) error {
+		return errors.New("binding unavailable")
+	})
+	assert.Error(t, failing.Write(&Entry{}))
+}
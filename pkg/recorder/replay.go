@@ -0,0 +1,154 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package recorder
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+)
+
+// maxEntrySize caps the size of a single line of a capture
+const maxEntrySize = 16 * 1024 * 1024
+
+// ReplayResult summarizes a replay
+type ReplayResult struct {
+	Total int
+	// Failed is the number of calls that couldn't be sent
+	Failed int
+	// Mismatched is the number of calls answered with a different status code than the recorded one
+	Mismatched int
+	// Skipped is the number of calls not sent because their request body wasn't recorded
+	Skipped int
+}
+
+// Replayer sends recorded calls to a Dapr HTTP API
+type Replayer struct {
+	baseURL string
+	speed   float64
+	client  *http.Client
+}
+
+// NewReplayer returns a replayer sending calls to baseURL.
+// speed scales the recorded delays between calls: 1 keeps the recorded timing, 2 replays twice as fast and 0 sends the calls without delay.
+func NewReplayer(baseURL string, speed float64) *Replayer {
+	return &Replayer{
+		baseURL: baseURL,
+		speed:   speed,
+		client:  &http.Client{Timeout: time.Minute},
+	}
+}
+
+// ReadEntries reads a capture written by a file sink
+func ReadEntries(r io.Reader) ([]*Entry, error) {
+	var entries []*Entry
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxEntrySize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("error parsing capture line %v: %s", line, err)
+		}
+		entries = append(entries, &entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading capture: %s", err)
+	}
+	return entries, nil
+}
+
+// ReplayFile replays the capture stored in a file
+func (r *Replayer) ReplayFile(ctx context.Context, path string) (ReplayResult, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return ReplayResult{}, fmt.Errorf("error opening replay file: %s", err)
+	}
+	defer f.Close()
+
+	entries, err := ReadEntries(f)
+	if err != nil {
+		return ReplayResult{}, err
+	}
+	return r.Replay(ctx, entries), nil
+}
+
+// Replay sends the recorded calls in order, keeping their relative timing scaled by the replayer speed
+func (r *Replayer) Replay(ctx context.Context, entries []*Entry) ReplayResult {
+	result := ReplayResult{}
+	if len(entries) == 0 {
+		return result
+	}
+
+	start := time.Now()
+	first := entries[0].Time
+	for _, entry := range entries {
+		if r.speed > 0 {
+			offset := time.Duration(float64(entry.Time.Sub(first)) / r.speed)
+			if wait := time.Until(start.Add(offset)); wait > 0 {
+				select {
+				case <-time.After(wait):
+				case <-ctx.Done():
+					return result
+				}
+			}
+		}
+		if ctx.Err() != nil {
+			return result
+		}
+
+		result.Total++
+		if entry.BodyRedacted {
+			result.Skipped++
+			continue
+		}
+		statusCode, err := r.send(ctx, entry)
+		if err != nil {
+			result.Failed++
+			log.Warnf("failed to replay %s %s: %s", entry.Method, entry.Path, err)
+			continue
+		}
+		if statusCode != entry.StatusCode {
+			result.Mismatched++
+			log.Warnf("replayed %s %s returned status code %v, recorded %v", entry.Method, entry.Path, statusCode, entry.StatusCode)
+		}
+	}
+	return result
+}
+
+func (r *Replayer) send(ctx context.Context, entry *Entry) (int, error) {
+	url := r.baseURL + entry.Path
+	if entry.Query != "" {
+		url += "?" + entry.Query
+	}
+	req, err := http.NewRequest(entry.Method, url, bytes.NewReader(entry.Body))
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+	for k, v := range entry.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,89 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package recorder
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplay(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received = append(received, r.Method+" "+r.URL.RequestURI()+" "+string(body)+" "+r.Header.Get("Content-Type"))
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	entries := []*Entry{
+		{Time: now, Method: "POST", Path: "/v1.0/state/store1", Body: []byte("[]"), Headers: map[string]string{"Content-Type": "application/json"}, StatusCode: 200},
+		{Time: now.Add(100 * time.Millisecond), Method: "GET", Path: "/v1.0/state/store1/key1", Query: "consistency=strong", StatusCode: 200},
+		{Time: now.Add(200 * time.Millisecond), Method: "DELETE", Path: "/v1.0/state/store1/key1", StatusCode: 200},
+	}
+
+	t.Run("recorded timing", func(t *testing.T) {
+		received = nil
+		start := time.Now()
+		result := NewReplayer(server.URL, 1).Replay(context.Background(), entries)
+		assert.True(t, time.Since(start) >= 200*time.Millisecond)
+		assert.Equal(t, ReplayResult{Total: 3, Mismatched: 1}, result)
+		assert.Equal(t, []string{
+			"POST /v1.0/state/store1 [] application/json",
+			"GET /v1.0/state/store1/key1?consistency=strong  ",
+			"DELETE /v1.0/state/store1/key1  ",
+		}, received)
+	})
+
+	t.Run("without delay", func(t *testing.T) {
+		received = nil
+		start := time.Now()
+		result := NewReplayer(server.URL, 0).Replay(context.Background(), entries)
+		assert.True(t, time.Since(start) < 200*time.Millisecond)
+		assert.Equal(t, 3, result.Total)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		result := NewReplayer(server.URL, 1).Replay(ctx, entries)
+		assert.Equal(t, 0, result.Total)
+	})
+
+	t.Run("skips calls without a recorded body", func(t *testing.T) {
+		received = nil
+		redacted := []*Entry{
+			{Time: now, Method: "POST", Path: "/v1.0/state/store1", BodyRedacted: true, StatusCode: 200},
+			{Time: now, Method: "GET", Path: "/v1.0/state/store1/key1", StatusCode: 200},
+		}
+		result := NewReplayer(server.URL, 0).Replay(context.Background(), redacted)
+		assert.Equal(t, ReplayResult{Total: 2, Skipped: 1}, result)
+		assert.Equal(t, []string{"GET /v1.0/state/store1/key1  "}, received)
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		result := NewReplayer("http://127.0.0.1:1", 0).Replay(context.Background(), entries[:1])
+		assert.Equal(t, ReplayResult{Total: 1, Failed: 1}, result)
+	})
+}
+
+func TestReadEntriesError(t *testing.T) {
+	_, err := ReadEntries(strings.NewReader("{\"method\":\"GET\"}\nnot json\n"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "line 2")
+}
@@ -0,0 +1,24 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"context"
+
+	"github.com/dapr/dapr/pkg/channel"
+)
+
+// callAppGRPC holds a call made to a gRPC app without the app channel, like the delivery of pubsub messages and input
+// binding events, to the budget of path
+func (a *DaprRuntime) callAppGRPC(ctx context.Context, path string, call func() error) error {
+	release, err := a.appChannelBudgets.Acquire(ctx, path)
+	if err != nil {
+		return err
+	}
+	err = call()
+	release(channel.FailedError(err))
+	return err
+}
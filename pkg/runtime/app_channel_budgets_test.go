@@ -0,0 +1,57 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dapr/dapr/pkg/channel"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateAppChannelBudgets(t *testing.T) {
+	t.Run("no budgets", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		assert.NoError(t, rt.createAppChannel())
+		assert.Equal(t, 0, rt.appChannelBudgets.Len())
+	})
+
+	t.Run("budgets", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		rt.globalConfig.Spec.AppChannelSpec = config.AppChannelSpec{Budgets: []config.AppChannelBudget{
+			{Path: channel.PubSubPath, FailureThreshold: 1, OpenDuration: "1m"},
+		}}
+		assert.NoError(t, rt.createAppChannel())
+		assert.Equal(t, 1, rt.appChannelBudgets.Len())
+
+		calls := 0
+		call := func() error {
+			calls++
+			return status.Error(codes.Unavailable, "app overloaded")
+		}
+		ctx := context.Background()
+		assert.Error(t, rt.callAppGRPC(ctx, channel.PubSubPath, call))
+		err := rt.callAppGRPC(ctx, channel.PubSubPath, call)
+		assert.Contains(t, err.Error(), "circuit")
+		assert.Equal(t, 1, calls)
+
+		assert.Error(t, rt.callAppGRPC(ctx, channel.BindingsPath, call))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("invalid budgets", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		rt.globalConfig.Spec.AppChannelSpec = config.AppChannelSpec{Budgets: []config.AppChannelBudget{
+			{Path: "workflows"},
+		}}
+		assert.Error(t, rt.createAppChannel())
+	})
+}
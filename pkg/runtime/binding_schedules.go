@@ -0,0 +1,49 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"time"
+
+	runtime_bindings "github.com/dapr/dapr/pkg/runtime/bindings"
+)
+
+// bindingScheduleRecheckInterval bounds the wait of a paused input binding whose schedule reports no next change
+const bindingScheduleRecheckInterval = time.Minute
+
+func (a *DaprRuntime) getBindingSchedule(name string) *runtime_bindings.Schedule {
+	a.componentsLock.Lock()
+	defer a.componentsLock.Unlock()
+	return a.bindingSchedules[name]
+}
+
+// getBindingLanes returns the lanes ordering the events of an input binding, nil if it doesn't order them
+func (a *DaprRuntime) getBindingLanes(name string) *runtime_bindings.Lanes {
+	a.componentsLock.Lock()
+	defer a.componentsLock.Unlock()
+	return a.bindingLanes[name]
+}
+
+// waitForBindingSchedule blocks until the schedule of an input binding delivers events.
+// The binding doesn't receive more events while its handler is blocked, so it stops consuming outside of its windows.
+func (a *DaprRuntime) waitForBindingSchedule(name string) {
+	schedule := a.getBindingSchedule(name)
+	paused := false
+	for now := time.Now(); !schedule.Active(now); now = time.Now() {
+		wait := bindingScheduleRecheckInterval
+		if next := schedule.NextChange(now); !next.IsZero() {
+			wait = next.Sub(now)
+			if !paused {
+				log.Infof("input binding %s is paused by its schedule until %s", name, next.UTC().Format(time.RFC3339))
+			}
+		}
+		paused = true
+		time.Sleep(wait)
+	}
+	if paused {
+		log.Infof("input binding %s resumed by its schedule", name)
+	}
+}
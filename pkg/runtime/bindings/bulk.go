@@ -0,0 +1,98 @@
+package bindings
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/dapr/components-contrib/bindings"
+)
+
+// BatchWriter is implemented by output bindings writing several requests in one call to their service.
+// It returns the error of every request, in the order of the requests, nil for the requests that were written.
+type BatchWriter interface {
+	WriteBatch(reqs []*bindings.WriteRequest) []error
+}
+
+// BulkWriteEntry is a request of a bulk write to an output binding
+type BulkWriteEntry struct {
+	EntryID string
+	Request *bindings.WriteRequest
+}
+
+// BulkWriteRequest writes several requests to an output binding
+type BulkWriteRequest struct {
+	Name    string
+	Entries []BulkWriteEntry
+}
+
+// BulkWriteResult is the result of an entry of a bulk write, with a nil error if the entry was written
+type BulkWriteResult struct {
+	EntryID string
+	Error   error
+}
+
+// BulkWriteResponse has the result of every entry of a bulk write, in the order of the request
+type BulkWriteResponse struct {
+	Results []BulkWriteResult
+}
+
+// ValidateBulkWriteRequest checks that the entries of a bulk write request have unique IDs
+func ValidateBulkWriteRequest(req *BulkWriteRequest) error {
+	ids := make(map[string]struct{}, len(req.Entries))
+	for _, e := range req.Entries {
+		if e.EntryID == "" {
+			return fmt.Errorf("entry ID is missing")
+		}
+		if _, ok := ids[e.EntryID]; ok {
+			return fmt.Errorf("entry ID %s is not unique", e.EntryID)
+		}
+		ids[e.EntryID] = struct{}{}
+		if e.Request == nil {
+			return fmt.Errorf("entry %s has no request", e.EntryID)
+		}
+	}
+	return nil
+}
+
+// BulkWrite writes the entries of a bulk write request in one call if the binding supports batches, and one by one
+// with up to parallelism entries at once otherwise
+func BulkWrite(binding bindings.OutputBinding, req *BulkWriteRequest, parallelism int) BulkWriteResponse {
+	reqs := make([]*bindings.WriteRequest, len(req.Entries))
+	for i, e := range req.Entries {
+		reqs[i] = e.Request
+	}
+
+	var errs []error
+	if bw, ok := binding.(BatchWriter); ok {
+		errs = bw.WriteBatch(reqs)
+		if len(errs) != len(reqs) {
+			err := fmt.Errorf("binding %s returned %v results for %v requests", req.Name, len(errs), len(reqs))
+			errs = make([]error, len(reqs))
+			for i := range errs {
+				errs[i] = err
+			}
+		}
+	} else {
+		errs = make([]error, len(reqs))
+		sem := make(chan struct{}, parallelism)
+		var wg sync.WaitGroup
+		for i, r := range reqs {
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(i int, r *bindings.WriteRequest) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				errs[i] = binding.Write(r)
+			}(i, r)
+		}
+		wg.Wait()
+	}
+
+	resp := BulkWriteResponse{Results: make([]BulkWriteResult, len(req.Entries))}
+	for i, e := range req.Entries {
+		resp.Results[i] = BulkWriteResult{EntryID: e.EntryID, Error: errs[i]}
+	}
+	return resp
+}
@@ -0,0 +1,96 @@
+package bindings
+
+import (
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/stretchr/testify/assert"
+)
+
+type writeFunc func(req *bindings.WriteRequest) error
+
+func (f writeFunc) Init(metadata bindings.Metadata) error  { return nil }
+func (f writeFunc) Write(req *bindings.WriteRequest) error { return f(req) }
+
+type batchBinding struct {
+	writeFunc
+	batches [][]*bindings.WriteRequest
+	errs    []error
+}
+
+func (b *batchBinding) WriteBatch(reqs []*bindings.WriteRequest) []error {
+	b.batches = append(b.batches, reqs)
+	if b.errs != nil {
+		return b.errs
+	}
+	return make([]error, len(reqs))
+}
+
+func bulkWriteRequest(ids ...string) *BulkWriteRequest {
+	req := &BulkWriteRequest{Name: "rows"}
+	for _, id := range ids {
+		req.Entries = append(req.Entries, BulkWriteEntry{EntryID: id, Request: &bindings.WriteRequest{Data: []byte(id)}})
+	}
+	return req
+}
+
+func TestValidateBulkWriteRequest(t *testing.T) {
+	assert.NoError(t, ValidateBulkWriteRequest(bulkWriteRequest("1", "2")))
+	assert.Error(t, ValidateBulkWriteRequest(bulkWriteRequest("1", "1")))
+	assert.Error(t, ValidateBulkWriteRequest(bulkWriteRequest("")))
+	assert.Error(t, ValidateBulkWriteRequest(&BulkWriteRequest{Entries: []BulkWriteEntry{{EntryID: "1"}}}))
+}
+
+func TestBulkWrite(t *testing.T) {
+	t.Run("entry by entry", func(t *testing.T) {
+		var running, maxRunning int32
+		binding := writeFunc(func(req *bindings.WriteRequest) error {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			if id, _ := strconv.Atoi(string(req.Data)); id%2 == 0 {
+				return errors.New("row rejected")
+			}
+			return nil
+		})
+
+		resp := BulkWrite(binding, bulkWriteRequest("1", "2", "3", "4", "5"), 2)
+		assert.LessOrEqual(t, maxRunning, int32(2))
+		assert.Len(t, resp.Results, 5)
+		for i, r := range resp.Results {
+			assert.Equal(t, strconv.Itoa(i+1), r.EntryID)
+			if i%2 == 1 {
+				assert.EqualError(t, r.Error, "row rejected")
+			} else {
+				assert.NoError(t, r.Error)
+			}
+		}
+	})
+
+	t.Run("native batch", func(t *testing.T) {
+		binding := &batchBinding{
+			writeFunc: func(req *bindings.WriteRequest) error { return errors.New("not batched") },
+			errs:      []error{nil, errors.New("row rejected")},
+		}
+		resp := BulkWrite(binding, bulkWriteRequest("1", "2"), 2)
+		assert.Len(t, binding.batches, 1)
+		assert.Len(t, binding.batches[0], 2)
+		assert.NoError(t, resp.Results[0].Error)
+		assert.EqualError(t, resp.Results[1].Error, "row rejected")
+	})
+
+	t.Run("native batch with missing results", func(t *testing.T) {
+		binding := &batchBinding{errs: []error{nil}}
+		resp := BulkWrite(binding, bulkWriteRequest("1", "2"), 2)
+		assert.Error(t, resp.Results[0].Error)
+		assert.Error(t, resp.Results[1].Error)
+	})
+}
@@ -0,0 +1,91 @@
+package bindings
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+)
+
+const (
+	// OrderingKeyMetadataKey is the metadata item of an input binding with the name of the event metadata item carrying
+	// the partition or ordering key of its events, e.g. partitionKey. Events with the same key are delivered to the app
+	// one at a time, in the order the binding hands them to the runtime, even if the binding reads events concurrently.
+	OrderingKeyMetadataKey = "orderingKey"
+	// OrderingLanesMetadataKey is the metadata item of an input binding bounding the keys whose events are delivered
+	// concurrently. Keys are hashed to lanes, so the events of keys sharing a lane wait for each other.
+	OrderingLanesMetadataKey = "orderingLanes"
+
+	// DefaultOrderingLanes is the number of lanes of an input binding declaring an ordering key without lanes
+	DefaultOrderingLanes = 16
+)
+
+// Lanes delivers the events of an input binding with the same ordering key one at a time, in the order they arrive.
+// Events without the ordering key are delivered right away, as are all events of nil Lanes.
+type Lanes struct {
+	key   string
+	lanes []chan laneEvent
+}
+
+type laneEvent struct {
+	deliver func() error
+	result  chan error
+}
+
+// LanesFromMetadata returns the lanes ordering the events of an input binding.
+// It returns false if the binding doesn't declare an ordering key.
+func LanesFromMetadata(properties map[string]string) (*Lanes, bool, error) {
+	key := properties[OrderingKeyMetadataKey]
+	if key == "" {
+		return nil, false, nil
+	}
+	lanes := DefaultOrderingLanes
+	if v, ok := properties[OrderingLanesMetadataKey]; ok {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return nil, true, fmt.Errorf("%s must be a positive number", OrderingLanesMetadataKey)
+		}
+		lanes = n
+	}
+	return NewLanes(key, lanes), true, nil
+}
+
+// NewLanes returns lanes ordering events on the event metadata item key
+func NewLanes(key string, lanes int) *Lanes {
+	l := &Lanes{
+		key:   key,
+		lanes: make([]chan laneEvent, lanes),
+	}
+	for i := range l.lanes {
+		l.lanes[i] = make(chan laneEvent)
+		go l.run(l.lanes[i])
+	}
+	return l
+}
+
+// Deliver runs deliver in the lane of the ordering key of an event, after the events of the lane that arrived before,
+// and returns its error
+func (l *Lanes) Deliver(metadata map[string]string, deliver func() error) error {
+	if l == nil {
+		return deliver()
+	}
+	key, ok := metadata[l.key]
+	if !ok || key == "" {
+		return deliver()
+	}
+	event := laneEvent{deliver: deliver, result: make(chan error, 1)}
+	l.lane(key) <- event
+	return <-event.result
+}
+
+// lane returns the lane of an ordering key
+func (l *Lanes) lane(key string) chan laneEvent {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return l.lanes[h.Sum32()%uint32(len(l.lanes))]
+}
+
+func (l *Lanes) run(lane chan laneEvent) {
+	for event := range lane {
+		event.result <- event.deliver()
+	}
+}
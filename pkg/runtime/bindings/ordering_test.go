@@ -0,0 +1,123 @@
+package bindings
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanesFromMetadata(t *testing.T) {
+	lanes, ok, err := LanesFromMetadata(map[string]string{})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, lanes)
+
+	lanes, ok, err = LanesFromMetadata(map[string]string{OrderingKeyMetadataKey: "partitionKey"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "partitionKey", lanes.key)
+	assert.Len(t, lanes.lanes, DefaultOrderingLanes)
+
+	lanes, _, err = LanesFromMetadata(map[string]string{OrderingKeyMetadataKey: "partitionKey", OrderingLanesMetadataKey: "4"})
+	assert.NoError(t, err)
+	assert.Len(t, lanes.lanes, 4)
+
+	for _, v := range []string{"0", "-1", "many"} {
+		_, _, err := LanesFromMetadata(map[string]string{OrderingKeyMetadataKey: "partitionKey", OrderingLanesMetadataKey: v})
+		assert.Error(t, err, v)
+	}
+}
+
+func TestLanesDeliver(t *testing.T) {
+	t.Run("events of a key are delivered one at a time in order", func(t *testing.T) {
+		lanes := NewLanes("partitionKey", 4)
+		var lock sync.Mutex
+		var delivered []int
+		var running int32
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				lanes.Deliver(map[string]string{"partitionKey": "p1"}, func() error {
+					assert.Equal(t, int32(1), atomic.AddInt32(&running, 1))
+					time.Sleep(time.Millisecond)
+					lock.Lock()
+					delivered = append(delivered, i)
+					lock.Unlock()
+					atomic.AddInt32(&running, -1)
+					return nil
+				})
+			}(i)
+			// the events arrive one after the other, while the lane is busy
+			time.Sleep(100 * time.Microsecond)
+		}
+		wg.Wait()
+		assert.Len(t, delivered, 20)
+		for i := range delivered {
+			assert.Equal(t, i, delivered[i])
+		}
+	})
+
+	t.Run("events of keys in different lanes are delivered concurrently", func(t *testing.T) {
+		lanes := NewLanes("partitionKey", 64)
+		other := "b"
+		for i := 0; lanes.lane(other) == lanes.lane("a"); i++ {
+			other = fmt.Sprintf("b%v", i)
+		}
+		release := make(chan struct{})
+		started := make(chan string, 2)
+		var wg sync.WaitGroup
+		for _, key := range []string{"a", other} {
+			wg.Add(1)
+			go func(key string) {
+				defer wg.Done()
+				lanes.Deliver(map[string]string{"partitionKey": key}, func() error {
+					started <- key
+					<-release
+					return nil
+				})
+			}(key)
+		}
+		<-started
+		<-started
+		close(release)
+		wg.Wait()
+	})
+
+	t.Run("errors are returned to the binding", func(t *testing.T) {
+		lanes := NewLanes("partitionKey", 1)
+		failure := errors.New("app failed")
+		assert.Equal(t, failure, lanes.Deliver(map[string]string{"partitionKey": "p1"}, func() error {
+			return failure
+		}))
+	})
+
+	t.Run("events without a key are delivered right away", func(t *testing.T) {
+		lanes := NewLanes("partitionKey", 1)
+		release := make(chan struct{})
+		go lanes.Deliver(map[string]string{"partitionKey": "p1"}, func() error {
+			<-release
+			return nil
+		})
+		defer close(release)
+		assert.NoError(t, lanes.Deliver(map[string]string{}, func() error {
+			return nil
+		}))
+	})
+
+	t.Run("nil lanes deliver right away", func(t *testing.T) {
+		var lanes *Lanes
+		calls := 0
+		assert.NoError(t, lanes.Deliver(map[string]string{"partitionKey": "p1"}, func() error {
+			calls++
+			return nil
+		}))
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -0,0 +1,273 @@
+package bindings
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ActiveWindowsMetadataKey is the metadata item of an input binding with the weekly windows it delivers events in,
+	// e.g. "Mon-Fri 09:00-17:00, Sat 10:00-12:00". Windows without days apply every day and windows ending before they
+	// start end the next day. Events are delivered at any time when it is not set.
+	ActiveWindowsMetadataKey = "activeWindows"
+	// DisabledWindowsMetadataKey is the metadata item of an input binding with the maintenance windows it doesn't deliver
+	// events in, as RFC 3339 start/end intervals, e.g. "2020-06-01T22:00:00Z/2020-06-02T02:00:00Z"
+	DisabledWindowsMetadataKey = "disabledWindows"
+	// TimeZoneMetadataKey is the metadata item of an input binding with the IANA time zone of its active windows, UTC by default
+	TimeZoneMetadataKey = "scheduleTimeZone"
+
+	minutesPerDay = 24 * 60
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// weeklyWindow is a window starting at the same time on some days of the week
+type weeklyWindow struct {
+	days  [7]bool
+	start int
+	// length is in minutes, up to a day
+	length int
+}
+
+type interval struct {
+	start time.Time
+	end   time.Time
+}
+
+// Schedule tells when an input binding delivers events to the app.
+// A nil Schedule always delivers events.
+type Schedule struct {
+	active   []weeklyWindow
+	disabled []interval
+	location *time.Location
+}
+
+// ScheduleFromMetadata returns the schedule of an input binding.
+// It returns false if the binding declares neither active nor disabled windows.
+func ScheduleFromMetadata(properties map[string]string) (*Schedule, bool, error) {
+	activeWindows := strings.TrimSpace(properties[ActiveWindowsMetadataKey])
+	disabledWindows := strings.TrimSpace(properties[DisabledWindowsMetadataKey])
+	if activeWindows == "" && disabledWindows == "" {
+		return nil, false, nil
+	}
+
+	s := &Schedule{location: time.UTC}
+	if tz := properties[TimeZoneMetadataKey]; tz != "" {
+		location, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, true, fmt.Errorf("invalid %s %s: %s", TimeZoneMetadataKey, tz, err)
+		}
+		s.location = location
+	}
+	if activeWindows != "" {
+		for _, w := range strings.Split(activeWindows, ",") {
+			window, err := parseWeeklyWindow(strings.TrimSpace(w))
+			if err != nil {
+				return nil, true, fmt.Errorf("invalid %s %s: %s", ActiveWindowsMetadataKey, w, err)
+			}
+			s.active = append(s.active, window)
+		}
+	}
+	if disabledWindows != "" {
+		for _, w := range strings.Split(disabledWindows, ",") {
+			i, err := parseInterval(strings.TrimSpace(w))
+			if err != nil {
+				return nil, true, fmt.Errorf("invalid %s %s: %s", DisabledWindowsMetadataKey, w, err)
+			}
+			s.disabled = append(s.disabled, i)
+		}
+	}
+	return s, true, nil
+}
+
+// parseWeeklyWindow parses a window such as "Mon-Fri 09:00-17:00", "Sat 10:00-12:00" or "22:00-06:00"
+func parseWeeklyWindow(s string) (weeklyWindow, error) {
+	w := weeklyWindow{}
+	fields := strings.Fields(s)
+	var times string
+	switch len(fields) {
+	case 1:
+		times = fields[0]
+		for i := range w.days {
+			w.days[i] = true
+		}
+	case 2:
+		days, err := parseDays(fields[0])
+		if err != nil {
+			return w, err
+		}
+		w.days = days
+		times = fields[1]
+	default:
+		return w, fmt.Errorf("expected [days] HH:MM-HH:MM")
+	}
+
+	bounds := strings.Split(times, "-")
+	if len(bounds) != 2 {
+		return w, fmt.Errorf("expected HH:MM-HH:MM")
+	}
+	start, err := parseClock(bounds[0])
+	if err != nil {
+		return w, err
+	}
+	end, err := parseClock(bounds[1])
+	if err != nil {
+		return w, err
+	}
+	if start == minutesPerDay || start == end {
+		return w, fmt.Errorf("the window is empty")
+	}
+	w.start = start
+	w.length = end - start
+	if end < start {
+		w.length += minutesPerDay
+	}
+	return w, nil
+}
+
+// parseDays parses a day, such as "Sat", or a range of days, such as "Mon-Fri" or "Fri-Mon"
+func parseDays(s string) ([7]bool, error) {
+	days := [7]bool{}
+	bounds := strings.Split(strings.ToLower(s), "-")
+	if len(bounds) > 2 {
+		return days, fmt.Errorf("invalid days %s", s)
+	}
+	first, ok := weekdays[bounds[0]]
+	if !ok {
+		return days, fmt.Errorf("invalid day %s", bounds[0])
+	}
+	last := first
+	if len(bounds) == 2 {
+		if last, ok = weekdays[bounds[1]]; !ok {
+			return days, fmt.Errorf("invalid day %s", bounds[1])
+		}
+	}
+	for d := first; ; d = (d + 1) % 7 {
+		days[d] = true
+		if d == last {
+			break
+		}
+	}
+	return days, nil
+}
+
+// parseClock returns the minutes since midnight of a HH:MM time, 24:00 included
+func parseClock(s string) (int, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("invalid time %s", s)
+	}
+	h, err := strconv.Atoi(parts[0])
+	if err != nil || h < 0 || h > 24 {
+		return 0, fmt.Errorf("invalid time %s", s)
+	}
+	m, err := strconv.Atoi(parts[1])
+	if err != nil || m < 0 || m > 59 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("invalid time %s", s)
+	}
+	return h*60 + m, nil
+}
+
+func parseInterval(s string) (interval, error) {
+	bounds := strings.Split(s, "/")
+	if len(bounds) != 2 {
+		return interval{}, fmt.Errorf("expected start/end")
+	}
+	start, err := time.Parse(time.RFC3339, bounds[0])
+	if err != nil {
+		return interval{}, err
+	}
+	end, err := time.Parse(time.RFC3339, bounds[1])
+	if err != nil {
+		return interval{}, err
+	}
+	if !end.After(start) {
+		return interval{}, fmt.Errorf("the end must be after the start")
+	}
+	return interval{start: start, end: end}, nil
+}
+
+// Active returns true if events are delivered at the given time
+func (s *Schedule) Active(t time.Time) bool {
+	if s == nil {
+		return true
+	}
+
+	for _, i := range s.disabled {
+		if !t.Before(i.start) && t.Before(i.end) {
+			return false
+		}
+	}
+	if len(s.active) == 0 {
+		return true
+	}
+	for _, start := range s.windowStarts(t, -1, 0) {
+		if !t.Before(start.at) && t.Before(start.end()) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextChange returns the first time after t when the schedule starts or stops delivering events.
+// It returns the zero time if the schedule never changes.
+func (s *Schedule) NextChange(t time.Time) time.Time {
+	if s == nil {
+		return time.Time{}
+	}
+
+	candidates := []time.Time{}
+	for _, i := range s.disabled {
+		candidates = append(candidates, i.start, i.end)
+	}
+	for _, start := range s.windowStarts(t, -1, 7) {
+		candidates = append(candidates, start.at, start.end())
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Before(candidates[j])
+	})
+
+	active := s.Active(t)
+	for _, c := range candidates {
+		if c.After(t) && s.Active(c) != active {
+			return c
+		}
+	}
+	return time.Time{}
+}
+
+type windowStart struct {
+	at     time.Time
+	window weeklyWindow
+}
+
+func (w windowStart) end() time.Time {
+	return w.at.Add(time.Duration(w.window.length) * time.Minute)
+}
+
+// windowStarts returns the starts of the active windows on the days from..to relative to the day of t
+func (s *Schedule) windowStarts(t time.Time, from, to int) []windowStart {
+	local := t.In(s.location)
+	starts := []windowStart{}
+	for offset := from; offset <= to; offset++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, s.location)
+		for _, w := range s.active {
+			if w.days[day.Weekday()] {
+				at := time.Date(day.Year(), day.Month(), day.Day(), w.start/60, w.start%60, 0, 0, s.location)
+				starts = append(starts, windowStart{at: at, window: w})
+			}
+		}
+	}
+	return starts
+}
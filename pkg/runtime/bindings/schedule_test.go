@@ -0,0 +1,97 @@
+package bindings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParse(t *testing.T, s string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, s)
+	assert.NoError(t, err)
+	return parsed
+}
+
+func TestScheduleFromMetadata(t *testing.T) {
+	t.Run("no schedule", func(t *testing.T) {
+		s, ok, err := ScheduleFromMetadata(map[string]string{})
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.Nil(t, s)
+		assert.True(t, s.Active(time.Now()))
+		assert.True(t, s.NextChange(time.Now()).IsZero())
+	})
+
+	valid := []string{"Mon-Fri 09:00-17:00", "sat 10:00-12:00, Sun 00:00-24:00", "22:00-06:00", "Fri-Mon 08:30-09:00"}
+	for _, v := range valid {
+		_, ok, err := ScheduleFromMetadata(map[string]string{ActiveWindowsMetadataKey: v})
+		assert.NoError(t, err, v)
+		assert.True(t, ok)
+	}
+
+	invalid := []map[string]string{
+		{ActiveWindowsMetadataKey: "Mon-Fri"},
+		{ActiveWindowsMetadataKey: "Someday 09:00-17:00"},
+		{ActiveWindowsMetadataKey: "09:00-09:00"},
+		{ActiveWindowsMetadataKey: "09:00-25:00"},
+		{ActiveWindowsMetadataKey: "09:00-17:00", TimeZoneMetadataKey: "Nowhere/Else"},
+		{DisabledWindowsMetadataKey: "2020-06-02T02:00:00Z/2020-06-01T22:00:00Z"},
+		{DisabledWindowsMetadataKey: "tonight"},
+	}
+	for _, properties := range invalid {
+		_, ok, err := ScheduleFromMetadata(properties)
+		assert.Error(t, err, properties)
+		assert.True(t, ok)
+	}
+}
+
+func TestScheduleActive(t *testing.T) {
+	s, _, err := ScheduleFromMetadata(map[string]string{
+		ActiveWindowsMetadataKey:   "Mon-Fri 09:00-17:00, Sat 22:00-02:00",
+		DisabledWindowsMetadataKey: "2020-06-03T12:00:00Z/2020-06-03T13:00:00Z",
+	})
+	assert.NoError(t, err)
+
+	// 2020-06-01 is a Monday
+	tests := map[string]bool{
+		"2020-06-01T08:59:59Z": false,
+		"2020-06-01T09:00:00Z": true,
+		"2020-06-01T16:59:59Z": true,
+		"2020-06-01T17:00:00Z": false,
+		"2020-06-03T12:30:00Z": false,
+		"2020-06-03T13:00:00Z": true,
+		"2020-06-06T12:00:00Z": false,
+		"2020-06-06T23:00:00Z": true,
+		"2020-06-07T01:59:00Z": true,
+		"2020-06-07T02:00:00Z": false,
+	}
+	for at, active := range tests {
+		assert.Equal(t, active, s.Active(mustParse(t, at)), at)
+	}
+}
+
+func TestScheduleNextChange(t *testing.T) {
+	s, _, err := ScheduleFromMetadata(map[string]string{
+		ActiveWindowsMetadataKey:   "Mon-Fri 09:00-17:00",
+		DisabledWindowsMetadataKey: "2020-06-01T16:00:00Z/2020-06-02T10:00:00Z",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, mustParse(t, "2020-06-01T09:00:00Z"), s.NextChange(mustParse(t, "2020-06-01T08:00:00Z")))
+	assert.Equal(t, mustParse(t, "2020-06-01T16:00:00Z"), s.NextChange(mustParse(t, "2020-06-01T09:00:00Z")))
+	assert.Equal(t, mustParse(t, "2020-06-02T10:00:00Z"), s.NextChange(mustParse(t, "2020-06-01T16:00:00Z")), "the maintenance window overlaps the next active window")
+	assert.Equal(t, mustParse(t, "2020-06-08T09:00:00Z"), s.NextChange(mustParse(t, "2020-06-05T17:00:00Z")), "the weekend is skipped")
+}
+
+func TestScheduleTimeZone(t *testing.T) {
+	s, _, err := ScheduleFromMetadata(map[string]string{
+		ActiveWindowsMetadataKey: "09:00-17:00",
+		TimeZoneMetadataKey:      "America/New_York",
+	})
+	assert.NoError(t, err)
+
+	assert.False(t, s.Active(mustParse(t, "2020-06-01T09:00:00Z")))
+	assert.True(t, s.Active(mustParse(t, "2020-06-01T13:00:00Z")))
+	assert.True(t, mustParse(t, "2020-06-01T21:00:00Z").Equal(s.NextChange(mustParse(t, "2020-06-01T13:00:00Z"))))
+}
@@ -0,0 +1,82 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dapr/components-contrib/pubsub"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+const (
+	// bulkPublishParallelism is the number of entries of a bulk publish request published at once
+	bulkPublishParallelism = 10
+	bulkPublishOperation   = "BulkPublishEventAlpha1"
+)
+
+// BulkPublish publishes the entries of a bulk publish request. Transactional requests are published atomically by the
+// pubsub component. Other requests are published entry by entry and the entries that failed are returned.
+// Requests asking for the entries that succeeded get them with the broker message IDs, which transactional publishes
+// don't report.
+func (a *DaprRuntime) BulkPublish(req *runtime_pubsub.BulkPublishRequest) (runtime_pubsub.BulkPublishResponse, error) {
+	if allowed := a.isPubSubOperationAllowed(req.Topic, a.scopedPublishings); !allowed {
+		return runtime_pubsub.BulkPublishResponse{}, fmt.Errorf("topic %s is not allowed for app id %s", req.Topic, a.runtimeConfig.ID)
+	}
+	if err := runtime_pubsub.ValidateBulkPublishRequest(req); err != nil {
+		return runtime_pubsub.BulkPublishResponse{}, err
+	}
+	if req.Transactional {
+		if err := a.bulkPublishTransactional(req); err != nil {
+			return runtime_pubsub.BulkPublishResponse{}, err
+		}
+		resp := runtime_pubsub.BulkPublishResponse{}
+		if req.ReturnSucceeded {
+			publishedAt := time.Now()
+			for _, e := range req.Entries {
+				resp.SucceededEntries = append(resp.SucceededEntries, runtime_pubsub.BulkPublishSucceededEntry{EntryID: e.EntryID, PublishedAt: publishedAt})
+			}
+		}
+		return resp, nil
+	}
+
+	parallelism := a.memoryThrottle.Parallelism(bulkPublishOperation, bulkPublishParallelism)
+	return runtime_pubsub.BulkPublish(req, parallelism, func(e runtime_pubsub.BulkPublishEntry) (string, error) {
+		return a.Publish(e.Request, e.Metadata)
+	}), nil
+}
+
+// bulkPublishTransactional publishes all the entries of a bulk publish request or none of them
+func (a *DaprRuntime) bulkPublishTransactional(req *runtime_pubsub.BulkPublishRequest) error {
+	if err := runtime_pubsub.RequireFeature(a.pubSubName, a.pubSub, runtime_pubsub.FeatureBulkPublishTransactional); err != nil {
+		return err
+	}
+
+	reqs := make([]*pubsub.PublishRequest, 0, len(req.Entries))
+	for _, e := range req.Entries {
+		if err := a.authorizePublish(req.Topic, e.Metadata); err != nil {
+			return err
+		}
+		if err := a.cloudEventSchema.ValidateTopic(req.Topic, e.Request.Data); err != nil {
+			return err
+		}
+		data, err := a.eventData(e.Request.Data, e.Metadata)
+		if err != nil {
+			return err
+		}
+		reqs = append(reqs, &pubsub.PublishRequest{Topic: a.topicNamespace.BrokerTopic(e.Request.Topic), Data: data})
+	}
+
+	if err := a.rateLimiters[a.pubSubName].Allow(); err != nil {
+		return err
+	}
+
+	inFlight := a.getInFlight("pubsub", a.pubSubName)
+	inFlight.Start()
+	defer inFlight.Done()
+	return a.pubSub.(runtime_pubsub.TransactionalBulkPublisher).PublishTransactional(reqs)
+}
@@ -0,0 +1,151 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/modes"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockTransactionalPubSub struct {
+	mockPublishPubSub
+	published [][]*pubsub.PublishRequest
+	err       error
+}
+
+func (m *mockTransactionalPubSub) PublishTransactional(reqs []*pubsub.PublishRequest) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.published = append(m.published, reqs)
+	return nil
+}
+
+func newBulkPublishRequest(transactional bool, metadata map[string]string) *runtime_pubsub.BulkPublishRequest {
+	return &runtime_pubsub.BulkPublishRequest{
+		Topic: "orders",
+		Entries: []runtime_pubsub.BulkPublishEntry{
+			{EntryID: "1", Request: &pubsub.PublishRequest{Topic: "orders"}, Metadata: metadata},
+			{EntryID: "2", Request: &pubsub.PublishRequest{Topic: "orders"}, Metadata: metadata},
+		},
+		Transactional: transactional,
+	}
+}
+
+func TestBulkPublish(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.pubSubName = "messagebus"
+
+	t.Run("publishes entry by entry", func(t *testing.T) {
+		rt.pubSub = &mockPublishPubSub{}
+		resp, err := rt.BulkPublish(newBulkPublishRequest(false, nil))
+		assert.NoError(t, err)
+		assert.Empty(t, resp.FailedEntries)
+	})
+
+	t.Run("transactional", func(t *testing.T) {
+		ps := &mockTransactionalPubSub{}
+		rt.pubSub = ps
+		_, err := rt.BulkPublish(newBulkPublishRequest(true, nil))
+		assert.NoError(t, err)
+		assert.Len(t, ps.published, 1)
+		assert.Len(t, ps.published[0], 2)
+	})
+
+	t.Run("succeeded entries", func(t *testing.T) {
+		rt.pubSub = &mockPublishPubSub{}
+		req := newBulkPublishRequest(false, nil)
+		req.ReturnSucceeded = true
+		resp, err := rt.BulkPublish(req)
+		assert.NoError(t, err)
+		assert.Empty(t, resp.FailedEntries)
+		assert.Len(t, resp.SucceededEntries, 2)
+		assert.Equal(t, req.Entries[0].EntryID, resp.SucceededEntries[0].EntryID)
+
+		rt.pubSub = &mockTransactionalPubSub{}
+		req = newBulkPublishRequest(true, nil)
+		req.ReturnSucceeded = true
+		resp, err = rt.BulkPublish(req)
+		assert.NoError(t, err)
+		assert.Len(t, resp.SucceededEntries, 2)
+		assert.Empty(t, resp.SucceededEntries[1].MessageID)
+		assert.False(t, resp.SucceededEntries[1].PublishedAt.IsZero())
+	})
+
+	t.Run("transactional rollback", func(t *testing.T) {
+		rt.pubSub = &mockTransactionalPubSub{err: errors.New("transaction aborted")}
+		_, err := rt.BulkPublish(newBulkPublishRequest(true, nil))
+		assert.EqualError(t, err, "transaction aborted")
+	})
+
+	t.Run("transactional without component support", func(t *testing.T) {
+		rt.pubSub = &mockPublishPubSub{}
+		_, err := rt.BulkPublish(newBulkPublishRequest(true, nil))
+		assert.IsType(t, &runtime_pubsub.FeatureError{}, err)
+	})
+
+	t.Run("topic access rules", func(t *testing.T) {
+		acl, _, err := runtime_pubsub.TopicACLFromMetadata(map[string]string{
+			runtime_pubsub.TopicACLsMetadataKey: `[{"topic": "orders", "identities": ["spiffe://public/ns/default/checkout"]}]`,
+		})
+		assert.NoError(t, err)
+		rt.topicACL = acl
+		defer func() { rt.topicACL = nil }()
+
+		ps := &mockTransactionalPubSub{}
+		rt.pubSub = ps
+		rt.appIdentity = runtime_pubsub.SPIFFEID("", "", "shipping")
+		resp, err := rt.BulkPublish(newBulkPublishRequest(false, nil))
+		assert.NoError(t, err)
+		assert.Len(t, resp.FailedEntries, 2)
+		assert.IsType(t, &runtime_pubsub.AuthorizationError{}, resp.FailedEntries[0].Error)
+		_, err = rt.BulkPublish(newBulkPublishRequest(true, nil))
+		assert.IsType(t, &runtime_pubsub.AuthorizationError{}, err)
+		assert.Empty(t, ps.published)
+
+		rt.appIdentity = runtime_pubsub.SPIFFEID("", "", "checkout")
+		_, err = rt.BulkPublish(newBulkPublishRequest(true, nil))
+		assert.NoError(t, err)
+		assert.Len(t, ps.published, 1)
+	})
+
+	t.Run("compression", func(t *testing.T) {
+		compressor, _, err := runtime_pubsub.CompressionFromMetadata(map[string]string{
+			runtime_pubsub.CompressionMetadataKey:          runtime_pubsub.CompressionGzip,
+			runtime_pubsub.CompressionThresholdMetadataKey: "0",
+		})
+		assert.NoError(t, err)
+		rt.publishCompression = compressor
+		defer func() { rt.publishCompression = nil }()
+
+		ps := &mockTransactionalPubSub{}
+		rt.pubSub = ps
+		event := []byte(`{"id":"1","data":"` + strings.Repeat("a", 1000) + `"}`)
+		req := newBulkPublishRequest(true, nil)
+		for _, e := range req.Entries {
+			e.Request.Data = event
+		}
+		_, err = rt.BulkPublish(req)
+		assert.NoError(t, err)
+		published := ps.published[0][0].Data
+		assert.Contains(t, string(published), `"datacontentencoding":"gzip"`)
+		assert.Equal(t, event, req.Entries[0].Request.Data, "the request of the app is left as is")
+
+		var delivered []byte
+		deliver := rt.decompressMessages(func(msg *pubsub.NewMessage) error {
+			delivered = msg.Data
+			return nil
+		})
+		assert.NoError(t, deliver(&pubsub.NewMessage{Topic: "orders", Data: published}))
+		assert.JSONEq(t, string(event), string(delivered))
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	global_config "github.com/dapr/dapr/pkg/config"
 	"github.com/dapr/dapr/pkg/diagnostics"
@@ -18,7 +19,9 @@ import (
 	"github.com/dapr/dapr/pkg/metrics"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/operator/client"
+	"github.com/dapr/dapr/pkg/placement"
 	"github.com/dapr/dapr/pkg/runtime/security"
+	"github.com/dapr/dapr/pkg/throttle"
 	"github.com/dapr/dapr/pkg/version"
 )
 
@@ -42,6 +45,21 @@ func FromFlags() (*DaprRuntime, error) {
 	runtimeVersion := flag.Bool("version", false, "Prints the runtime version")
 	maxConcurrency := flag.Int("max-concurrency", -1, "Controls the concurrency level when forwarding requests to user code")
 	enableMTLS := flag.Bool("enable-mtls", false, "Enables automatic mTLS for daprd to daprd communication channels")
+	componentInitTimeout := flag.String("component-init-timeout", "", "Maximum duration to wait for each component to initialize, e.g. 10s. Components can override it with spec.initTimeout")
+	componentShutdownTimeout := flag.String("component-shutdown-timeout", DefaultComponentShutdownTimeout.String(), "Maximum duration components have to finish in-flight operations, flush and close on shutdown, e.g. 10s")
+	grpcMetadataSizeLimit := flag.Int("grpc-metadata-size-limit", grpc.DefaultMetadataSizeLimit, "Size in bytes of the header metadata of gRPC API responses above which they are rejected, e.g. the maximum header list size of the gRPC clients of the app. 0 disables the limit")
+	grpcMetadataToTrailers := flag.Bool("grpc-metadata-to-trailers", false, "Sends the header metadata of gRPC API responses above grpc-metadata-size-limit as trailers instead of rejecting the responses")
+	pubsubSlowHandlerThreshold := flag.String("pubsub-slow-handler-threshold", DefaultPubSubSlowHandlerThreshold.String(), "Time the app may take to process a pub/sub message before a warning is logged, e.g. 5s. 0 disables the warnings")
+	memoryThrottleRatio := flag.Float64("memory-throttle-ratio", throttle.DefaultMemoryRatio, "Ratio of the memory limit of the sidecar above which the parallelism of bulk operations is reduced. 0 disables throttling")
+	tenantsPath := flag.String("tenants-path", "", "Path of a directory with one sub directory per app served by this process, holding its tenant.yaml settings and components. Standalone mode only")
+	validateOnly := flag.Bool("validate-only", false, "Validates the configuration and component manifests, prints a JSON report and exits without starting the runtime")
+	recordFile := flag.String("record-file", "", "Path of a file to record sanitized Dapr HTTP API calls to. gRPC API calls are not recorded")
+	recordBinding := flag.String("record-binding", "", "Name of an output binding to record sanitized Dapr HTTP API calls to. gRPC API calls are not recorded")
+	recordBodies := flag.Bool("record-bodies", false, "Records the request and response bodies of Dapr API calls, which may contain state values, messages and binding payloads. Calls recorded without their request body are skipped on replay")
+	replayFile := flag.String("replay-file", "", "Path of a recorded file whose Dapr HTTP API calls are replayed once the runtime is ready")
+	placementWeight := flag.Int64("placement-weight", placement.DefaultHostWeight, fmt.Sprintf("Capacity of this host relative to the other actor hosts, e.g. derived from its CPU limit. The placement service assigns proportionally more actors to hosts with a higher weight, up to %v", placement.MaxHostWeight))
+	placementLabels := flag.String("placement-labels", "", "Labels of this host for the actor calls and reminders requiring an affinity, written as comma separated name=value pairs, e.g. pool=gpu")
+	replaySpeed := flag.Float64("replay-speed", 1, "Speed factor applied to the recorded delays between replayed calls. 0 replays the calls without delay")
 
 	loggerOptions := logger.DefaultOptions()
 	loggerOptions.AttachCmdFlags(flag.StringVar, flag.BoolVar)
@@ -56,6 +74,11 @@ func FromFlags() (*DaprRuntime, error) {
 		os.Exit(0)
 	}
 
+	if *validateOnly {
+		// Keep stdout reserved for the validation report
+		loggerOptions.OutputLevel = "fatal"
+	}
+
 	// Apply options to all loggers
 	loggerOptions.SetAppID(*appID)
 	if err := logger.ApplyOptionsToLoggers(&loggerOptions); err != nil {
@@ -111,8 +134,74 @@ func FromFlags() (*DaprRuntime, error) {
 		}
 	}
 
+	var initTimeout time.Duration
+	if *componentInitTimeout != "" {
+		initTimeout, err = time.ParseDuration(*componentInitTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing component-init-timeout: %s", err)
+		}
+	}
+
+	shutdownTimeout, err := time.ParseDuration(*componentShutdownTimeout)
+	if err != nil || shutdownTimeout <= 0 {
+		return nil, fmt.Errorf("error parsing component-shutdown-timeout: must be a positive duration")
+	}
+
+	slowHandlerThreshold, err := time.ParseDuration(*pubsubSlowHandlerThreshold)
+	if err != nil || slowHandlerThreshold < 0 {
+		return nil, fmt.Errorf("error parsing pubsub-slow-handler-threshold: must be 0 or a positive duration")
+	}
+
 	runtimeConfig := NewRuntimeConfig(*appID, *placementServiceAddress, *controlPlaneAddress, *allowedOrigins, *config, *componentsPath,
 		*appProtocol, *mode, daprHTTP, daprInternalGRPC, daprAPIGRPC, applicationPort, profPort, *enableProfiling, *maxConcurrency, *enableMTLS, *sentryAddress)
+	runtimeConfig.ComponentInitTimeout = initTimeout
+	runtimeConfig.ComponentShutdownTimeout = shutdownTimeout
+	runtimeConfig.ValidateOnly = *validateOnly
+	runtimeConfig.AdminAPIToken = os.Getenv(AdminAPITokenEnvVar)
+	if *grpcMetadataSizeLimit < 0 {
+		return nil, fmt.Errorf("grpc-metadata-size-limit must not be negative")
+	}
+	runtimeConfig.GRPCMetadataSizeLimit = *grpcMetadataSizeLimit
+	runtimeConfig.GRPCMetadataToTrailers = *grpcMetadataToTrailers
+	runtimeConfig.PubSubSlowHandlerThreshold = slowHandlerThreshold
+	if *memoryThrottleRatio < 0 || *memoryThrottleRatio >= 1 {
+		return nil, fmt.Errorf("memory-throttle-ratio must be 0 or more and less than 1")
+	}
+	runtimeConfig.MemoryThrottleRatio = *memoryThrottleRatio
+	if *placementWeight < 1 || *placementWeight > placement.MaxHostWeight {
+		return nil, fmt.Errorf("placement-weight must be between 1 and %v", placement.MaxHostWeight)
+	}
+	runtimeConfig.PlacementWeight = *placementWeight
+	labels, err := placement.ParseLabels(*placementLabels)
+	if err != nil {
+		return nil, fmt.Errorf("placement-labels: %s", err)
+	}
+	runtimeConfig.PlacementLabels = labels
+
+	if *recordFile != "" && *recordBinding != "" {
+		return nil, fmt.Errorf("record-file and record-binding can't be used together")
+	}
+	if *recordBodies && *recordFile == "" && *recordBinding == "" {
+		return nil, fmt.Errorf("record-bodies requires record-file or record-binding")
+	}
+	if *replaySpeed < 0 {
+		return nil, fmt.Errorf("replay-speed must be 0 or more")
+	}
+	runtimeConfig.RecordFile = *recordFile
+	runtimeConfig.RecordBinding = *recordBinding
+	runtimeConfig.RecordBodies = *recordBodies
+	runtimeConfig.ReplayFile = *replayFile
+	runtimeConfig.ReplaySpeed = *replaySpeed
+
+	if *tenantsPath != "" {
+		if modes.DaprMode(*mode) != modes.StandaloneMode {
+			return nil, fmt.Errorf("tenants-path is only supported in standalone mode")
+		}
+		if *validateOnly || *recordFile != "" || *recordBinding != "" || *replayFile != "" {
+			return nil, fmt.Errorf("tenants-path can't be used with validate-only, record-file, record-binding or replay-file")
+		}
+		runtimeConfig.TenantsPath = *tenantsPath
+	}
 
 	var globalConfig *global_config.Configuration
 	var configErr error
@@ -146,5 +235,8 @@ func FromFlags() (*DaprRuntime, error) {
 		log.Info("loading default configuration")
 		globalConfig = global_config.LoadDefaultConfiguration()
 	}
-	return NewDaprRuntime(runtimeConfig, globalConfig), nil
+
+	rt := NewDaprRuntime(runtimeConfig, globalConfig)
+	rt.globalConfigErr = configErr
+	return rt, nil
 }
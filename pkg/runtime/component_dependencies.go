@@ -0,0 +1,164 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"fmt"
+	"strings"
+
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+)
+
+// componentInitStages are the component categories in the order the runtime initializes them
+var componentInitStages = []string{"secretstores.", "state.", "pubsub.", "exporters.", "bindings.", "middleware.http."}
+
+// componentInitStage returns the position of the category of a component type in the init order, -1 if it's unknown
+func componentInitStage(componentType string) int {
+	for i, prefix := range componentInitStages {
+		if strings.HasPrefix(componentType, prefix) {
+			return i
+		}
+	}
+	return -1
+}
+
+// componentDependencies returns the names of the components a component depends on: the components of its dependsOn
+// and the secret store of its auth, unless it's the Kubernetes secret store preloaded by the runtime
+func componentDependencies(c components_v1alpha1.Component) []string {
+	dependencies := append([]string{}, c.Spec.DependsOn...)
+	if c.Auth.SecretStore != "" && c.Auth.SecretStore != "kubernetes" {
+		dependencies = append(dependencies, c.Auth.SecretStore)
+	}
+	return dependencies
+}
+
+// checkComponentDependencies returns the components whose dependencies are initialized before them, in their original
+// order, and why each of the other components can't be initialized: it depends on an unknown component, on a
+// component of a category initialized after its own, on itself through a cycle, or on a component that can't be
+// initialized either. Secret stores of the auth of a component that don't exist aren't dependencies.
+func checkComponentDependencies(comps []components_v1alpha1.Component) ([]components_v1alpha1.Component, map[string]error) {
+	byName := map[string]components_v1alpha1.Component{}
+	for _, c := range comps {
+		if _, ok := byName[c.ObjectMeta.Name]; !ok {
+			byName[c.ObjectMeta.Name] = c
+		}
+	}
+
+	errs := map[string]error{}
+	graph := map[string][]string{}
+	for _, c := range comps {
+		name := c.ObjectMeta.Name
+		declared := map[string]bool{}
+		for _, d := range c.Spec.DependsOn {
+			declared[d] = true
+		}
+		for _, d := range componentDependencies(c) {
+			dependency, ok := byName[d]
+			if !ok {
+				if declared[d] {
+					errs[name] = fmt.Errorf("depends on unknown component %s", d)
+				}
+				continue
+			}
+			if componentInitStage(dependency.Spec.Type) > componentInitStage(c.Spec.Type) {
+				errs[name] = fmt.Errorf("depends on component %s (%s) which is initialized after it", d, dependency.Spec.Type)
+				continue
+			}
+			graph[name] = append(graph[name], d)
+		}
+	}
+
+	for name, err := range findDependencyCycles(comps, graph) {
+		errs[name] = err
+	}
+
+	// components depending on components that can't be initialized can't be initialized either
+	for changed := true; changed; {
+		changed = false
+		for _, c := range comps {
+			name := c.ObjectMeta.Name
+			if _, failed := errs[name]; failed {
+				continue
+			}
+			for _, d := range graph[name] {
+				if _, failed := errs[d]; failed {
+					errs[name] = fmt.Errorf("depends on component %s which can't be initialized", d)
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	resolved := []components_v1alpha1.Component{}
+	for _, c := range comps {
+		if _, failed := errs[c.ObjectMeta.Name]; !failed {
+			resolved = append(resolved, c)
+		}
+	}
+	return resolved, errs
+}
+
+// findDependencyCycles returns an error for every component on a dependency cycle, with the path of the cycle
+func findDependencyCycles(comps []components_v1alpha1.Component, graph map[string][]string) map[string]error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	errs := map[string]error{}
+	path := []string{}
+
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = visiting
+		path = append(path, name)
+		for _, d := range graph[name] {
+			switch state[d] {
+			case unvisited:
+				visit(d)
+			case visiting:
+				start := 0
+				for i, n := range path {
+					if n == d {
+						start = i
+						break
+					}
+				}
+				cycle := append(append([]string{}, path[start:]...), d)
+				for _, n := range path[start:] {
+					if _, ok := errs[n]; !ok {
+						errs[n] = fmt.Errorf("dependency cycle: %s", strings.Join(cycle, " -> "))
+					}
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+	}
+
+	for _, c := range comps {
+		if state[c.ObjectMeta.Name] == unvisited {
+			visit(c.ObjectMeta.Name)
+		}
+	}
+	return errs
+}
+
+// resolveComponentDependencies returns the components whose dependencies are initialized before them.
+// The other components are reported as failed and never initialized.
+func (a *DaprRuntime) resolveComponentDependencies(comps []components_v1alpha1.Component) []components_v1alpha1.Component {
+	resolved, errs := checkComponentDependencies(comps)
+	for _, c := range comps {
+		if err, failed := errs[c.ObjectMeta.Name]; failed {
+			log.Errorf("component %s (%s) can't be initialized: %s", c.ObjectMeta.Name, c.Spec.Type, err)
+			diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "dependencies")
+		}
+	}
+	return resolved
+}
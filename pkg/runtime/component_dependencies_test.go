@@ -0,0 +1,97 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/stretchr/testify/assert"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newDependentComponent(name, componentType string, dependsOn ...string) components_v1alpha1.Component {
+	return components_v1alpha1.Component{
+		ObjectMeta: meta_v1.ObjectMeta{Name: name},
+		Spec:       components_v1alpha1.ComponentSpec{Type: componentType, DependsOn: dependsOn},
+	}
+}
+
+func componentNames(comps []components_v1alpha1.Component) []string {
+	names := []string{}
+	for _, c := range comps {
+		names = append(names, c.ObjectMeta.Name)
+	}
+	return names
+}
+
+func TestCheckComponentDependencies(t *testing.T) {
+	t.Run("valid dependencies", func(t *testing.T) {
+		vault := newDependentComponent("vault", "secretstores.hashicorp.vault", "keyvault")
+		store := newDependentComponent("store", "state.redis")
+		store.Auth.SecretStore = "vault"
+		comps := []components_v1alpha1.Component{
+			vault,
+			newDependentComponent("keyvault", "secretstores.azure.keyvault"),
+			store,
+			newDependentComponent("queue", "bindings.kafka", "store", "vault"),
+		}
+		resolved, errs := checkComponentDependencies(comps)
+		assert.Empty(t, errs)
+		assert.Equal(t, []string{"vault", "keyvault", "store", "queue"}, componentNames(resolved))
+	})
+
+	t.Run("invalid dependencies", func(t *testing.T) {
+		orphan := newDependentComponent("orphan", "state.redis")
+		orphan.Auth.SecretStore = "missing"
+		comps := []components_v1alpha1.Component{
+			newDependentComponent("a", "secretstores.local.file", "b"),
+			newDependentComponent("b", "secretstores.local.file", "c"),
+			newDependentComponent("c", "secretstores.local.file", "a"),
+			newDependentComponent("self", "state.redis", "self"),
+			newDependentComponent("unknown", "state.redis", "missing"),
+			newDependentComponent("early", "state.redis", "queue"),
+			newDependentComponent("queue", "bindings.kafka"),
+			newDependentComponent("transitive", "pubsub.redis", "unknown"),
+			orphan,
+		}
+		resolved, errs := checkComponentDependencies(comps)
+		assert.Equal(t, []string{"queue", "orphan"}, componentNames(resolved), "missing auth secret stores aren't dependencies")
+		assert.Len(t, errs, 7)
+		assert.EqualError(t, errs["a"], "dependency cycle: a -> b -> c -> a")
+		assert.EqualError(t, errs["c"], "dependency cycle: a -> b -> c -> a")
+		assert.EqualError(t, errs["self"], "dependency cycle: self -> self")
+		assert.EqualError(t, errs["unknown"], "depends on unknown component missing")
+		assert.EqualError(t, errs["early"], "depends on component queue (bindings.kafka) which is initialized after it")
+		assert.EqualError(t, errs["transitive"], "depends on component unknown which can't be initialized")
+	})
+}
+
+func TestInitComponentsInDependencyOrder(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	comps := []components_v1alpha1.Component{
+		newDependentComponent("app-secrets", "secretstores.local.file", "vault"),
+		newDependentComponent("vault", "secretstores.hashicorp.vault", "keyvault"),
+		newDependentComponent("keyvault", "secretstores.azure.keyvault"),
+		newDependentComponent("other", "secretstores.local.env"),
+	}
+
+	var lock sync.Mutex
+	order := []string{}
+	rt.initComponentsInParallel(comps, func(c components_v1alpha1.Component) {
+		if c.ObjectMeta.Name == "keyvault" {
+			time.Sleep(50 * time.Millisecond)
+		}
+		lock.Lock()
+		order = append(order, c.ObjectMeta.Name)
+		lock.Unlock()
+	})
+	assert.Equal(t, "other", order[0], "components without dependencies aren't held up")
+	assert.Equal(t, []string{"keyvault", "vault", "app-secrets"}, order[1:])
+}
@@ -0,0 +1,156 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+)
+
+// slowComponentInitThreshold is the init duration above which a component is listed in the startup report
+const slowComponentInitThreshold = time.Second
+
+// componentInitTiming records how long a single component took to initialize
+type componentInitTiming struct {
+	Name     string
+	Type     string
+	Elapsed  time.Duration
+	TimedOut bool
+}
+
+// getComponentInitTimeout returns the init timeout of a component.
+// The component spec value takes precedence over the runtime wide default. Zero means no timeout.
+func (a *DaprRuntime) getComponentInitTimeout(component components_v1alpha1.Component) time.Duration {
+	if component.Spec.InitTimeout != "" {
+		timeout, err := time.ParseDuration(component.Spec.InitTimeout)
+		if err == nil {
+			return timeout
+		}
+		log.Warnf("invalid init timeout %s for component %s: %s", component.Spec.InitTimeout, component.ObjectMeta.Name, err)
+	}
+	return a.runtimeConfig.ComponentInitTimeout
+}
+
+// initComponent runs initFn and stops waiting for it once the init timeout of the component elapses.
+// A timed out component keeps initializing in the background but is never registered with the runtime.
+func (a *DaprRuntime) initComponent(component components_v1alpha1.Component, initFn func() error) error {
+	start := time.Now()
+	timeout := a.getComponentInitTimeout(component)
+
+	var err error
+	timedOut := false
+	if timeout <= 0 {
+		err = initFn()
+	} else {
+		done := make(chan error, 1)
+		go func() {
+			done <- initFn()
+		}()
+
+		select {
+		case err = <-done:
+		case <-time.After(timeout):
+			timedOut = true
+			err = fmt.Errorf("init timed out after %s", timeout)
+		}
+	}
+
+	elapsed := time.Since(start)
+	diag.DefaultMonitoring.ComponentInitDuration(component.Spec.Type, component.ObjectMeta.Name, elapsed)
+
+	a.componentsLock.Lock()
+	a.componentInitTimings = append(a.componentInitTimings, componentInitTiming{
+		Name:     component.ObjectMeta.Name,
+		Type:     component.Spec.Type,
+		Elapsed:  elapsed,
+		TimedOut: timedOut,
+	})
+	a.componentsLock.Unlock()
+	return err
+}
+
+// getComponentsByCategory returns the loaded components whose type starts with the given category, e.g. "state"
+func (a *DaprRuntime) getComponentsByCategory(category string) []components_v1alpha1.Component {
+	components := []components_v1alpha1.Component{}
+	for _, c := range a.components {
+		if strings.Index(c.Spec.Type, category) == 0 {
+			components = append(components, c)
+		}
+	}
+	return components
+}
+
+// initComponentsInParallel calls initFn for every component concurrently and waits for all of them to return.
+// A component waits for the components of the same category it depends on, so a slow component only holds up its
+// dependents. The dependencies have no cycles, see resolveComponentDependencies.
+func (a *DaprRuntime) initComponentsInParallel(components []components_v1alpha1.Component, initFn func(c components_v1alpha1.Component)) {
+	var wg sync.WaitGroup
+	wg.Add(len(components))
+
+	initialized := map[string][]chan struct{}{}
+	done := make([]chan struct{}, len(components))
+	for i, c := range components {
+		done[i] = make(chan struct{})
+		initialized[c.ObjectMeta.Name] = append(initialized[c.ObjectMeta.Name], done[i])
+	}
+
+	for i, c := range components {
+		go func(component components_v1alpha1.Component, done chan struct{}) {
+			defer wg.Done()
+			defer close(done)
+			for _, d := range componentDependencies(component) {
+				for _, ch := range initialized[d] {
+					if ch != done {
+						<-ch
+					}
+				}
+			}
+			initFn(component)
+		}(c, done[i])
+	}
+	wg.Wait()
+}
+
+// getSlowComponents returns the components that timed out or exceeded the slow init threshold, slowest first
+func (a *DaprRuntime) getSlowComponents() []componentInitTiming {
+	a.componentsLock.Lock()
+	defer a.componentsLock.Unlock()
+
+	slow := []componentInitTiming{}
+	for _, t := range a.componentInitTimings {
+		if t.TimedOut || t.Elapsed >= slowComponentInitThreshold {
+			slow = append(slow, t)
+		}
+	}
+	sort.Slice(slow, func(i, j int) bool {
+		return slow[i].Elapsed > slow[j].Elapsed
+	})
+	return slow
+}
+
+// logComponentInitReport logs the components that slowed down the runtime startup
+func (a *DaprRuntime) logComponentInitReport() {
+	slow := a.getSlowComponents()
+	if len(slow) == 0 {
+		log.Debugf("all components initialized within %s", slowComponentInitThreshold)
+		return
+	}
+
+	log.Warnf("%d component(s) took longer than %s to initialize", len(slow), slowComponentInitThreshold)
+	for _, t := range slow {
+		if t.TimedOut {
+			log.Warnf("component %s (%s) timed out after %vms", t.Name, t.Type, t.Elapsed.Milliseconds())
+		} else {
+			log.Warnf("component %s (%s) initialized in %vms", t.Name, t.Type, t.Elapsed.Milliseconds())
+		}
+	}
+}
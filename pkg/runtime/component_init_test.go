@@ -0,0 +1,239 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	state_loader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/failover"
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/ratelimit"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/stretchr/testify/assert"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type mockSlowStateStore struct {
+	initDelay time.Duration
+}
+
+func (m *mockSlowStateStore) Init(metadata state.Metadata) error {
+	time.Sleep(m.initDelay)
+	return nil
+}
+
+func (m *mockSlowStateStore) Delete(req *state.DeleteRequest) error {
+	return nil
+}
+
+func (m *mockSlowStateStore) BulkDelete(req []state.DeleteRequest) error {
+	return nil
+}
+
+func (m *mockSlowStateStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	return &state.GetResponse{}, nil
+}
+
+func (m *mockSlowStateStore) Set(req *state.SetRequest) error {
+	return nil
+}
+
+func (m *mockSlowStateStore) BulkSet(req []state.SetRequest) error {
+	return nil
+}
+
+func newStateStoreComponent(name, storeType, initTimeout string) components_v1alpha1.Component {
+	return components_v1alpha1.Component{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: name,
+		},
+		Spec: components_v1alpha1.ComponentSpec{
+			Type:        storeType,
+			InitTimeout: initTimeout,
+		},
+	}
+}
+
+func TestGetComponentInitTimeout(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.runtimeConfig.ComponentInitTimeout = time.Second * 5
+
+	t.Run("runtime default", func(t *testing.T) {
+		c := newStateStoreComponent("store", "state.mock", "")
+		assert.Equal(t, time.Second*5, rt.getComponentInitTimeout(c))
+	})
+
+	t.Run("component override", func(t *testing.T) {
+		c := newStateStoreComponent("store", "state.mock", "30s")
+		assert.Equal(t, time.Second*30, rt.getComponentInitTimeout(c))
+	})
+
+	t.Run("invalid component value falls back to default", func(t *testing.T) {
+		c := newStateStoreComponent("store", "state.mock", "soon")
+		assert.Equal(t, time.Second*5, rt.getComponentInitTimeout(c))
+	})
+}
+
+func TestInitStateWithTimeout(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.stateStoreRegistry.Register(
+		state_loader.New("fast", func() state.Store {
+			return &mockSlowStateStore{}
+		}),
+		state_loader.New("slow", func() state.Store {
+			return &mockSlowStateStore{initDelay: time.Millisecond * 500}
+		}),
+	)
+	rt.components = []components_v1alpha1.Component{
+		newStateStoreComponent("fastStore", "state.fast", "100ms"),
+		newStateStoreComponent("slowStore", "state.slow", "100ms"),
+	}
+
+	start := time.Now()
+	err := rt.initState(rt.stateStoreRegistry)
+	elapsed := time.Since(start)
+
+	assert.NoError(t, err)
+	assert.Less(t, int64(elapsed), int64(time.Millisecond*500), "init must not wait for the timed out store")
+	assert.NotNil(t, rt.stateStores["fastStore"])
+	assert.Nil(t, rt.stateStores["slowStore"])
+	assert.Len(t, rt.componentInitTimings, 2)
+
+	for _, timing := range rt.componentInitTimings {
+		assert.Equal(t, timing.Name == "slowStore", timing.TimedOut)
+	}
+}
+
+func TestGetSlowComponents(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.componentInitTimings = []componentInitTiming{
+		{Name: "fast", Type: "state.redis", Elapsed: time.Millisecond * 10},
+		{Name: "slower", Type: "bindings.kafka", Elapsed: slowComponentInitThreshold * 3},
+		{Name: "slow", Type: "secretstores.azure.keyvault", Elapsed: slowComponentInitThreshold},
+		{Name: "timedout", Type: "state.cosmosdb", Elapsed: time.Millisecond * 100, TimedOut: true},
+	}
+
+	slow := rt.getSlowComponents()
+	assert.Len(t, slow, 3)
+	assert.Equal(t, "slower", slow[0].Name)
+	assert.Equal(t, "slow", slow[1].Name)
+	assert.Equal(t, "timedout", slow[2].Name)
+}
+
+func TestOnComponentUpdatedRefreshesStateDefaults(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.stateStoreRegistry.Register(
+		state_loader.New("mock", func() state.Store {
+			return &mockSlowStateStore{}
+		}),
+	)
+	store := newStateStoreComponent("store", "state.mock", "")
+	store.Spec.Metadata = []components_v1alpha1.MetadataItem{{Name: runtime_state.DefaultConsistencyMetadataKey, Value: state.Eventual}}
+	rt.components = []components_v1alpha1.Component{store}
+	assert.NoError(t, rt.initState(rt.stateStoreRegistry))
+	assert.Equal(t, state.Eventual, rt.stateStoreDefaults["store"].Consistency)
+
+	updated := newStateStoreComponent("store", "state.mock", "")
+	updated.Spec.Metadata = []components_v1alpha1.MetadataItem{{Name: runtime_state.DefaultConsistencyMetadataKey, Value: state.Strong}}
+	rt.onComponentUpdated(updated)
+	assert.Equal(t, state.Strong, rt.stateStoreDefaults["store"].Consistency)
+}
+
+func TestOnComponentUpdatedKeepsStateStoreWrappers(t *testing.T) {
+	newStore := func(keyPrefix string) components_v1alpha1.Component {
+		store := newStateStoreComponent("store", "state.mock", "")
+		store.Spec.Metadata = []components_v1alpha1.MetadataItem{
+			{Name: failover.ComponentMetadataKey, Value: "secondary"},
+			{Name: ratelimit.RateMetadataKey, Value: "10"},
+			{Name: ratelimit.StateStoreMetadataKey, Value: "limits"},
+			{Name: runtime_state.CompressionMetadataKey, Value: "zstd"},
+			{Name: runtime_state.ReadCacheTTLMetadataKey, Value: "5s"},
+			{Name: runtime_state.KeyPrefixMetadataKey, Value: keyPrefix},
+		}
+		return store
+	}
+	newRuntime := func() *DaprRuntime {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		rt.stateStoreRegistry.Register(
+			state_loader.New("mock", func() state.Store {
+				return &mockSlowStateStore{}
+			}),
+		)
+		rt.components = []components_v1alpha1.Component{
+			newStore("{appid}"),
+			newStateStoreComponent("secondary", "state.mock", ""),
+			newStateStoreComponent("limits", "state.mock", ""),
+		}
+		assert.NoError(t, rt.initState(rt.stateStoreRegistry))
+		return rt
+	}
+	assertWrapped := func(t *testing.T, store state.Store) {
+		store = unwrapStateStore(store)
+		for _, wrapper := range []interface{}{&runtime_state.CachedStore{}, &runtime_state.CompressedStore{}, &runtime_state.RateLimitedStore{}} {
+			assert.IsType(t, wrapper, store)
+			store = unwrapStateStore(store)
+		}
+		assert.IsType(t, &runtime_state.FailoverStore{}, store)
+	}
+
+	t.Run("updated store", func(t *testing.T) {
+		rt := newRuntime()
+		assertWrapped(t, rt.stateStores["store"])
+
+		rt.onComponentUpdated(newStore("orders"))
+		assertWrapped(t, rt.stateStores["store"])
+		assert.Equal(t, "orders||", rt.stateKeyPrefixes["store"])
+		assert.NotContains(t, rt.stateStores, "secondary")
+	})
+
+	t.Run("updated secondary store", func(t *testing.T) {
+		rt := newRuntime()
+		previous := rt.stateStores["store"]
+
+		updated := newStateStoreComponent("secondary", "state.mock", "")
+		updated.Spec.Metadata = []components_v1alpha1.MetadataItem{{Name: runtime_state.DefaultConsistencyMetadataKey, Value: state.Strong}}
+		rt.onComponentUpdated(updated)
+		assertWrapped(t, rt.stateStores["store"])
+		assert.False(t, previous == rt.stateStores["store"])
+		assert.NotContains(t, rt.stateStores, "secondary")
+		assert.NotContains(t, rt.stateStoreDefaults, "secondary")
+	})
+}
+
+func TestStateStoreSettingsReadDuringUpdate(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.stateStoreRegistry.Register(
+		state_loader.New("mock", func() state.Store {
+			return &mockSlowStateStore{}
+		}),
+	)
+	rt.components = []components_v1alpha1.Component{newStateStoreComponent("store", "state.mock", "")}
+	assert.NoError(t, rt.initState(rt.stateStoreRegistry))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			rt.getStateStoreDefaults("store")
+			rt.getStateKeyPrefix("store")
+		}
+	}()
+	for i := 0; i < 10; i++ {
+		updated := newStateStoreComponent("store", "state.mock", "")
+		updated.Spec.Metadata = []components_v1alpha1.MetadataItem{{Name: runtime_state.KeyPrefixMetadataKey, Value: fmt.Sprintf("orders-%d", i)}}
+		rt.onComponentUpdated(updated)
+	}
+	<-done
+
+	prefix, ok := rt.getStateKeyPrefix("store")
+	assert.True(t, ok)
+	assert.Equal(t, "orders-9||", prefix)
+}
@@ -0,0 +1,27 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"github.com/dapr/components-contrib/state"
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+)
+
+// compressStateStore wraps a state store whose component enables the compression of the values saved.
+// Stores are compressed before the read cache, so the cache holds decompressed values.
+func (a *DaprRuntime) compressStateStore(c components_v1alpha1.Component, store state.Store) state.Store {
+	config, enabled, err := runtime_state.CompressionFromMetadata(a.convertMetadataItemsToProperties(c.Spec.Metadata))
+	if err != nil {
+		log.Warnf("compression of state store %s is disabled: %s", c.ObjectMeta.Name, err)
+		return store
+	}
+	if !enabled {
+		return store
+	}
+	log.Infof("state store %s compresses values of %v bytes or more with %s", c.ObjectMeta.Name, config.Threshold, config.Algorithm)
+	return runtime_state.NewCompressedStore(store, config)
+}
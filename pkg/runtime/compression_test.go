@@ -0,0 +1,46 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	state_loader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/modes"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressStateStores(t *testing.T) {
+	newRuntime := func(metadata ...components_v1alpha1.MetadataItem) *DaprRuntime {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		rt.stateStoreRegistry.Register(
+			state_loader.New("mock", func() state.Store {
+				return &mockSlowStateStore{}
+			}),
+		)
+		store := newStateStoreComponent("store", "state.mock", "")
+		store.Spec.Metadata = metadata
+		rt.components = []components_v1alpha1.Component{store}
+		return rt
+	}
+
+	t.Run("compression enabled", func(t *testing.T) {
+		rt := newRuntime(components_v1alpha1.MetadataItem{Name: runtime_state.CompressionMetadataKey, Value: "zstd"})
+		assert.NoError(t, rt.initState(rt.stateStoreRegistry))
+		_, ok := unwrapStateStore(rt.stateStores["store"]).(*runtime_state.CompressedStore)
+		assert.True(t, ok)
+	})
+
+	t.Run("invalid compression disables it", func(t *testing.T) {
+		rt := newRuntime(components_v1alpha1.MetadataItem{Name: runtime_state.CompressionMetadataKey, Value: "lz4"})
+		assert.NoError(t, rt.initState(rt.stateStoreRegistry))
+		_, ok := unwrapStateStore(rt.stateStores["store"]).(*mockSlowStateStore)
+		assert.True(t, ok)
+	})
+}
@@ -6,6 +6,8 @@
 package runtime
 
 import (
+	"time"
+
 	config "github.com/dapr/dapr/pkg/config/modes"
 	"github.com/dapr/dapr/pkg/credentials"
 	"github.com/dapr/dapr/pkg/modes"
@@ -31,6 +33,8 @@ const (
 	DefaultComponentsPath = "./components"
 	// DefaultAllowedOrigins is the default origins allowed for the Dapr HTTP servers
 	DefaultAllowedOrigins = "*"
+	// AdminAPITokenEnvVar is the environment variable holding the token callers of the admin API must send
+	AdminAPITokenEnvVar = "DAPR_ADMIN_API_TOKEN"
 )
 
 // Config holds the Dapr Runtime configuration
@@ -53,6 +57,37 @@ type Config struct {
 	mtlsEnabled             bool
 	SentryServiceAddress    string
 	CertChain               *credentials.CertChain
+	ComponentInitTimeout    time.Duration
+	// ComponentShutdownTimeout is the time components have to flush and close on shutdown. Zero uses DefaultComponentShutdownTimeout.
+	ComponentShutdownTimeout time.Duration
+	ValidateOnly             bool
+	RecordFile               string
+	RecordBinding            string
+	ReplayFile               string
+	ReplaySpeed              float64
+	// RecordBodies records the request and response bodies of the recorded Dapr API calls
+	RecordBodies bool
+	// TenantsPath is the directory of the apps served by this process in self-hosted multi-tenant mode
+	TenantsPath string
+	// APIToken is required from the app when calling the Dapr API if set
+	APIToken string
+	// AdminAPIToken is required from the callers of the admin API, which is only served when it's set
+	AdminAPIToken string
+	// GRPCMetadataSizeLimit is the size of the header metadata of the gRPC API responses above which they are rejected
+	GRPCMetadataSizeLimit int
+	// GRPCMetadataToTrailers sends the header metadata of the gRPC API responses above the limit as trailers
+	GRPCMetadataToTrailers bool
+	// PubSubSlowHandlerThreshold is the time the app may take to process a pub/sub message before a warning is logged.
+	// Zero disables the warnings.
+	PubSubSlowHandlerThreshold time.Duration
+	// MemoryThrottleRatio is the ratio of the memory limit above which bulk operations are throttled. Zero disables throttling.
+	MemoryThrottleRatio float64
+	// PlacementWeight is the capacity of this host relative to the other hosts of its actor types.
+	// The placement service assigns proportionally more actors to hosts with a higher weight.
+	PlacementWeight int64
+	// PlacementLabels describe this host to the placement service. Actors called or reminded with an affinity are
+	// placed on the hosts with its labels.
+	PlacementLabels map[string]string
 }
 
 // NewRuntimeConfig returns a new runtime config
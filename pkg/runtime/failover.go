@@ -0,0 +1,165 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/failover"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+)
+
+// getFailoverSecondaries returns the names of the components of a category declared as the secondary component of another one
+func (a *DaprRuntime) getFailoverSecondaries(category string) map[string]bool {
+	secondaries := map[string]bool{}
+	for _, c := range a.components {
+		if strings.Index(c.Spec.Type, category) != 0 {
+			continue
+		}
+		for _, m := range c.Spec.Metadata {
+			if m.Name == failover.ComponentMetadataKey && m.Value != "" {
+				secondaries[m.Value] = true
+			}
+		}
+	}
+	return secondaries
+}
+
+// checkFailoverComponents returns why the failover configuration of each state store and pub sub component is invalid:
+// it declares itself as its secondary component, or a secondary component that declares a secondary component of its
+// own, which includes two components declaring each other. Secondary components are only initialized with their
+// primary component, so these components would never be initialized.
+func checkFailoverComponents(comps []components_v1alpha1.Component) map[string]error {
+	errs := map[string]error{}
+	for _, category := range []string{"state", "pubsub"} {
+		secondaries := map[string]string{}
+		for _, c := range comps {
+			if strings.Index(c.Spec.Type, category) != 0 {
+				continue
+			}
+			for _, m := range c.Spec.Metadata {
+				if m.Name == failover.ComponentMetadataKey && m.Value != "" {
+					secondaries[c.ObjectMeta.Name] = m.Value
+				}
+			}
+		}
+
+		for name, secondary := range secondaries {
+			if secondary == name {
+				errs[name] = fmt.Errorf("%s can't be its own secondary component", name)
+			} else if next, ok := secondaries[secondary]; ok {
+				errs[name] = fmt.Errorf("secondary component %s of %s can't declare a secondary component %s itself", secondary, name, next)
+			}
+		}
+	}
+	return errs
+}
+
+// getComponentByName returns the component of a category with the given name
+func (a *DaprRuntime) getComponentByName(category, name string) *components_v1alpha1.Component {
+	for i, c := range a.components {
+		if strings.Index(c.Spec.Type, category) == 0 && c.ObjectMeta.Name == name {
+			return &a.components[i]
+		}
+	}
+	return nil
+}
+
+// pairPubSub initializes the secondary component of a primary pubsub component and returns the failover pair.
+// The primary component is used alone when the secondary component can't be initialized.
+func (a *DaprRuntime) pairPubSub(c components_v1alpha1.Component, primary pubsub.PubSub, properties map[string]string, config failover.Config) pubsub.PubSub {
+	sc := a.getComponentByName("pubsub", config.Secondary)
+	if sc == nil {
+		log.Warnf("couldn't find secondary pub sub %s of %s, failover is disabled", config.Secondary, c.ObjectMeta.Name)
+		return primary
+	}
+	secondary, _, err := a.createPubSub(*sc)
+	if err != nil {
+		log.Warnf("failover to pub sub %s is disabled", config.Secondary)
+		return primary
+	}
+
+	secondarySubscriptions := runtime_pubsub.SecondarySubscriptionsFromMetadata(properties)
+	log.Infof("pub sub %s fails over to %s after %v consecutive publish failures", c.ObjectMeta.Name, config.Secondary, config.Threshold)
+	if secondarySubscriptions == runtime_pubsub.SubscribeStandby {
+		log.Infof("subscriptions of pub sub %s are kept on standby on %s", c.ObjectMeta.Name, config.Secondary)
+	}
+	return runtime_pubsub.NewFailoverPubSub(primary, secondary, config, secondarySubscriptions,
+		func(from, to failover.Target) {
+			name := c.ObjectMeta.Name
+			if to == failover.Secondary {
+				name = config.Secondary
+			}
+			log.Warnf("pub sub %s switched from its %s to its %s component %s", c.ObjectMeta.Name, from, to, name)
+			diag.DefaultMonitoring.ComponentFailover(c.Spec.Type, c.ObjectMeta.Name, string(to))
+		},
+		func(topic string, err error) {
+			log.Warnf("failed to subscribe to topic %s on secondary pub sub %s: %s", topic, config.Secondary, err)
+		})
+}
+
+// getFailoverPrimary returns the component of a category declaring the component with the given name as its secondary
+// component
+func (a *DaprRuntime) getFailoverPrimary(category, name string) *components_v1alpha1.Component {
+	for i, c := range a.components {
+		if strings.Index(c.Spec.Type, category) != 0 {
+			continue
+		}
+		for _, m := range c.Spec.Metadata {
+			if m.Name == failover.ComponentMetadataKey && m.Value == name {
+				return &a.components[i]
+			}
+		}
+	}
+	return nil
+}
+
+// pairStateStore returns the failover pair of an initialized state store declaring a secondary component, with the
+// name of the secondary component. The store is returned alone when failover is disabled.
+func (a *DaprRuntime) pairStateStore(c components_v1alpha1.Component, primary state.Store) (state.Store, string) {
+	properties := a.convertMetadataItemsToProperties(c.Spec.Metadata)
+	config, ok, err := failover.ConfigFromMetadata(properties)
+	if err == nil {
+		err = runtime_state.ValidateReadPreference(properties[runtime_state.ReadPreferenceMetadataKey])
+	}
+	if err != nil {
+		log.Warnf("failover of state store %s is disabled: %s", c.ObjectMeta.Name, err)
+		return primary, ""
+	}
+	if !ok {
+		return primary, ""
+	}
+	secondary, ok := a.initializedStateStores[config.Secondary]
+	if !ok {
+		log.Warnf("couldn't find initialized secondary state store %s of %s, failover is disabled", config.Secondary, c.ObjectMeta.Name)
+		return primary, ""
+	}
+
+	log.Infof("state store %s fails over to %s after %v consecutive failures", c.ObjectMeta.Name, config.Secondary, config.Threshold)
+	return a.newFailoverStore(c, primary, secondary, config, properties[runtime_state.ReadPreferenceMetadataKey]), config.Secondary
+}
+
+func (a *DaprRuntime) newFailoverStore(c components_v1alpha1.Component, primary, secondary state.Store, config failover.Config, readPreference string) state.Store {
+	return runtime_state.NewFailoverStore(primary, secondary, config, readPreference,
+		func(from, to failover.Target) {
+			if to == failover.Secondary {
+				log.Warnf("state store %s switched to its secondary component %s, writes won't reach the primary component until it recovers", c.ObjectMeta.Name, config.Secondary)
+			} else {
+				log.Warnf("state store %s switched back to its primary component", c.ObjectMeta.Name)
+			}
+			diag.DefaultMonitoring.ComponentFailover(c.Spec.Type, c.ObjectMeta.Name, string(to))
+		},
+		func(d runtime_state.Divergence) {
+			log.Warnf("state store %s diverged: its %s component missed %v writes made during the failover, including keys %v",
+				c.ObjectMeta.Name, d.Target, d.Writes, d.Keys)
+		})
+}
@@ -0,0 +1,105 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	state_loader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/failover"
+	"github.com/dapr/dapr/pkg/modes"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPairStateStores(t *testing.T) {
+	newRuntime := func(metadata ...components_v1alpha1.MetadataItem) *DaprRuntime {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		rt.stateStoreRegistry.Register(
+			state_loader.New("mock", func() state.Store {
+				return &mockSlowStateStore{}
+			}),
+		)
+		primary := newStateStoreComponent("primary", "state.mock", "")
+		primary.Spec.Metadata = metadata
+		rt.components = []components_v1alpha1.Component{primary, newStateStoreComponent("secondary", "state.mock", "")}
+		return rt
+	}
+
+	t.Run("pairs primary with secondary", func(t *testing.T) {
+		rt := newRuntime(components_v1alpha1.MetadataItem{Name: failover.ComponentMetadataKey, Value: "secondary"})
+		assert.NoError(t, rt.initState(rt.stateStoreRegistry))
+		_, ok := unwrapStateStore(rt.stateStores["primary"]).(*runtime_state.FailoverStore)
+		assert.True(t, ok)
+		assert.NotContains(t, rt.stateStores, "secondary")
+	})
+
+	t.Run("invalid read preference disables failover", func(t *testing.T) {
+		rt := newRuntime(
+			components_v1alpha1.MetadataItem{Name: failover.ComponentMetadataKey, Value: "secondary"},
+			components_v1alpha1.MetadataItem{Name: runtime_state.ReadPreferenceMetadataKey, Value: "nearest"},
+		)
+		assert.NoError(t, rt.initState(rt.stateStoreRegistry))
+		_, ok := unwrapStateStore(rt.stateStores["primary"]).(*mockSlowStateStore)
+		assert.True(t, ok)
+		assert.Contains(t, rt.stateStores, "secondary")
+	})
+
+	t.Run("missing secondary disables failover", func(t *testing.T) {
+		rt := newRuntime(components_v1alpha1.MetadataItem{Name: failover.ComponentMetadataKey, Value: "unknown"})
+		assert.NoError(t, rt.initState(rt.stateStoreRegistry))
+		_, ok := unwrapStateStore(rt.stateStores["primary"]).(*mockSlowStateStore)
+		assert.True(t, ok)
+	})
+}
+
+func TestCheckFailoverComponents(t *testing.T) {
+	withSecondary := func(name, componentType, secondary string) components_v1alpha1.Component {
+		c := newStateStoreComponent(name, componentType, "")
+		c.Spec.Metadata = []components_v1alpha1.MetadataItem{{Name: failover.ComponentMetadataKey, Value: secondary}}
+		return c
+	}
+
+	t.Run("valid pair", func(t *testing.T) {
+		errs := checkFailoverComponents([]components_v1alpha1.Component{
+			withSecondary("primary", "state.mock", "secondary"),
+			newStateStoreComponent("secondary", "state.mock", ""),
+		})
+		assert.Empty(t, errs)
+	})
+
+	t.Run("own secondary", func(t *testing.T) {
+		errs := checkFailoverComponents([]components_v1alpha1.Component{
+			withSecondary("pubsub", "pubsub.mock", "pubsub"),
+		})
+		assert.Contains(t, errs, "pubsub")
+	})
+
+	t.Run("components declaring each other", func(t *testing.T) {
+		errs := checkFailoverComponents([]components_v1alpha1.Component{
+			withSecondary("a", "pubsub.mock", "b"),
+			withSecondary("b", "pubsub.mock", "a"),
+		})
+		assert.Len(t, errs, 2)
+	})
+
+	t.Run("categories are checked separately", func(t *testing.T) {
+		errs := checkFailoverComponents([]components_v1alpha1.Component{
+			withSecondary("a", "state.mock", "b"),
+			withSecondary("b", "pubsub.mock", "c"),
+		})
+		assert.Empty(t, errs)
+	})
+}
+
+func unwrapStateStore(store state.Store) state.Store {
+	if t, ok := store.(interface{ Unwrap() state.Store }); ok {
+		return t.Unwrap()
+	}
+	return store
+}
@@ -0,0 +1,128 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dapr/dapr/pkg/http"
+	runtime_bindings "github.com/dapr/dapr/pkg/runtime/bindings"
+)
+
+// recordSubscription stores the state of a topic subscription for the metadata API
+func (a *DaprRuntime) recordSubscription(topic, route, status string) {
+	a.inventoryLock.Lock()
+	defer a.inventoryLock.Unlock()
+
+	sub := http.SubscriptionMetadata{
+		PubsubName: a.pubSubName,
+		Topic:      topic,
+		Route:      route,
+		Type:       http.SubscriptionTypeProgrammatic,
+		Status:     status,
+	}
+	for i, s := range a.subscriptions {
+		if s.Topic == topic {
+			a.subscriptions[i] = sub
+			return
+		}
+	}
+	a.subscriptions = append(a.subscriptions, sub)
+}
+
+// recordBindingEvent stores the time the input binding last received an event
+func (a *DaprRuntime) recordBindingEvent(name string) {
+	a.inventoryLock.Lock()
+	a.bindingEventTimes[name] = time.Now().UTC()
+	a.inventoryLock.Unlock()
+}
+
+// getSubscriptionsMetadata returns the topic subscriptions of the runtime, sorted by topic
+func (a *DaprRuntime) getSubscriptionsMetadata() []http.SubscriptionMetadata {
+	a.inventoryLock.RLock()
+	subscriptions := make([]http.SubscriptionMetadata, len(a.subscriptions))
+	copy(subscriptions, a.subscriptions)
+	a.inventoryLock.RUnlock()
+
+	for i, s := range subscriptions {
+		stats := a.deliveryTracker.Stats(s.Topic)
+		subscriptions[i].InFlight = stats.InFlight
+		subscriptions[i].Retries = stats.Retries
+		if !stats.LastDeliveryTime.IsZero() {
+			subscriptions[i].LastDeliveryTime = stats.LastDeliveryTime.UTC().Format(time.RFC3339)
+		}
+	}
+
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].Topic < subscriptions[j].Topic
+	})
+	return subscriptions
+}
+
+// getInputBindingsMetadata returns the input bindings the runtime reads from, sorted by name.
+// Events of an input binding are delivered to the app route named after the binding.
+func (a *DaprRuntime) getInputBindingsMetadata() []http.InputBindingMetadata {
+	a.componentsLock.Lock()
+	names := make([]string, 0, len(a.inputBindings))
+	for name := range a.inputBindings {
+		names = append(names, name)
+	}
+	schedules := make(map[string]*runtime_bindings.Schedule, len(a.bindingSchedules))
+	for name, s := range a.bindingSchedules {
+		schedules[name] = s
+	}
+	a.componentsLock.Unlock()
+	sort.Strings(names)
+
+	a.inventoryLock.RLock()
+	defer a.inventoryLock.RUnlock()
+
+	now := time.Now()
+	inputBindings := make([]http.InputBindingMetadata, 0, len(names))
+	for _, name := range names {
+		b := http.InputBindingMetadata{
+			Name:   name,
+			Route:  name,
+			Status: http.InputBindingStatusActive,
+		}
+		if !schedules[name].Active(now) {
+			b.Status = http.InputBindingStatusPaused
+		}
+		if next := schedules[name].NextChange(now); !next.IsZero() {
+			b.NextStatusChange = next.UTC().Format(time.RFC3339)
+		}
+		for _, c := range a.components {
+			if c.ObjectMeta.Name == name && strings.Index(c.Spec.Type, "bindings") == 0 {
+				b.Type = c.Spec.Type
+				break
+			}
+		}
+		if t, ok := a.bindingEventTimes[name]; ok {
+			b.LastEventTime = t.Format(time.RFC3339)
+		}
+		inputBindings = append(inputBindings, b)
+	}
+	return inputBindings
+}
+
+// getComponentsMetadata returns the components of the runtime, sorted by name. Metadata items aren't exported, as
+// they may hold secrets.
+func (a *DaprRuntime) getComponentsMetadata() []http.ComponentMetadata {
+	components := make([]http.ComponentMetadata, 0, len(a.components))
+	for _, c := range a.components {
+		components = append(components, http.ComponentMetadata{
+			Name:        c.ObjectMeta.Name,
+			Type:        c.Spec.Type,
+			Initialized: a.isComponentReady(c.ObjectMeta.Name),
+		})
+	}
+	sort.Slice(components, func(i, j int) bool {
+		return components[i].Name < components[j].Name
+	})
+	return components
+}
@@ -0,0 +1,108 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/bindings"
+	"github.com/dapr/components-contrib/pubsub"
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/http"
+	"github.com/dapr/dapr/pkg/modes"
+	runtime_bindings "github.com/dapr/dapr/pkg/runtime/bindings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptionsMetadata(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.pubSubName = "messagebus"
+
+	rt.recordSubscription("orders", "orders", http.SubscriptionStatusActive)
+	rt.recordSubscription("audit", "custom/audit", http.SubscriptionStatusDenied)
+	rt.recordSubscription("orders", "orders", http.SubscriptionStatusFailed)
+
+	subscriptions := rt.getSubscriptionsMetadata()
+	assert.Equal(t, []http.SubscriptionMetadata{
+		{PubsubName: "messagebus", Topic: "audit", Route: "custom/audit", Type: http.SubscriptionTypeProgrammatic, Status: http.SubscriptionStatusDenied},
+		{PubsubName: "messagebus", Topic: "orders", Route: "orders", Type: http.SubscriptionTypeProgrammatic, Status: http.SubscriptionStatusFailed},
+	}, subscriptions)
+}
+
+func TestSubscriptionDeliveryMetadata(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.pubSubName = "messagebus"
+	rt.subscribedTopics = []string{"orders.*"}
+	rt.recordSubscription("orders.*", "orders", http.SubscriptionStatusActive)
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	deliver := rt.trackDeliveries(func(msg *pubsub.NewMessage) error {
+		if msg.Topic == "orders.slow" {
+			close(started)
+			<-release
+			return nil
+		}
+		return errors.New("app failure")
+	})
+	event := []byte(`{"id":"1","data":"created"}`)
+	assert.Error(t, deliver(&pubsub.NewMessage{Topic: "orders.created", Data: event}))
+	assert.Error(t, deliver(&pubsub.NewMessage{Topic: "orders.created", Data: event}))
+	go deliver(&pubsub.NewMessage{Topic: "orders.slow", Data: []byte(`{"id":"2"}`)})
+	<-started
+
+	subscriptions := rt.getSubscriptionsMetadata()
+	assert.Equal(t, int64(1), subscriptions[0].InFlight)
+	assert.Equal(t, int64(1), subscriptions[0].Retries)
+	assert.NotEmpty(t, subscriptions[0].LastDeliveryTime)
+	close(release)
+}
+
+func TestInputBindingsMetadata(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.components = []components_v1alpha1.Component{
+		newStateStoreComponent("queue", "bindings.kafka", ""),
+		newStateStoreComponent("cron", "bindings.cron", ""),
+	}
+	rt.inputBindings = map[string]bindings.InputBinding{
+		"queue": &mockBinding{},
+		"cron":  &mockBinding{},
+	}
+	rt.recordBindingEvent("queue")
+	schedule, _, err := runtime_bindings.ScheduleFromMetadata(map[string]string{
+		runtime_bindings.DisabledWindowsMetadataKey: fmt.Sprintf("%s/%s", time.Now().Add(-time.Hour).UTC().Format(time.RFC3339), "2100-01-01T00:00:00Z"),
+	})
+	assert.NoError(t, err)
+	rt.bindingSchedules = map[string]*runtime_bindings.Schedule{"queue": schedule}
+
+	inputBindings := rt.getInputBindingsMetadata()
+	assert.Len(t, inputBindings, 2)
+	assert.Equal(t, http.InputBindingMetadata{Name: "cron", Type: "bindings.cron", Route: "cron", Status: http.InputBindingStatusActive}, inputBindings[0])
+	assert.Equal(t, "queue", inputBindings[1].Name)
+	assert.Equal(t, "bindings.kafka", inputBindings[1].Type)
+	assert.NotEmpty(t, inputBindings[1].LastEventTime)
+	assert.Equal(t, http.InputBindingStatusPaused, inputBindings[1].Status)
+	assert.Equal(t, "2100-01-01T00:00:00Z", inputBindings[1].NextStatusChange)
+}
+
+func TestComponentsMetadata(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	store := newStateStoreComponent("store", "state.redis", "")
+	store.Spec.Metadata = []components_v1alpha1.MetadataItem{{Name: "redisPassword", Value: "secret"}}
+	rt.components = []components_v1alpha1.Component{
+		newStateStoreComponent("queue", "bindings.kafka", ""),
+		store,
+	}
+	rt.inputBindings = map[string]bindings.InputBinding{"queue": &mockBinding{}}
+
+	assert.Equal(t, []http.ComponentMetadata{
+		{Name: "queue", Type: "bindings.kafka", Initialized: true},
+		{Name: "store", Type: "state.redis", Initialized: false},
+	}, rt.getComponentsMetadata())
+}
@@ -0,0 +1,279 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/dapr/dapr/pkg/apptoken"
+)
+
+const (
+	// TopicACLsMetadataKey is the metadata item of a pubsub component with the JSON list of its topic access rules
+	TopicACLsMetadataKey = "topicACLs"
+	// TopicACLJWKSURLMetadataKey is the metadata item of a pubsub component with the JWKS URL of the keys signing the
+	// tokens whose claims are matched by its topic access rules
+	TopicACLJWKSURLMetadataKey = "topicACLJwksUrl"
+	// TopicACLIssuerMetadataKey is the metadata item of a pubsub component with the issuer of the caller tokens
+	TopicACLIssuerMetadataKey = "topicACLIssuer"
+	// TopicACLAudienceMetadataKey is the metadata item of a pubsub component with the audience of the caller tokens, if any
+	TopicACLAudienceMetadataKey = "topicACLAudience"
+	// TopicACLTrustDomainMetadataKey is the metadata item of a pubsub component with the trust domain of the SPIFFE IDs
+	// of the apps, public by default
+	TopicACLTrustDomainMetadataKey = "topicACLTrustDomain"
+
+	// CallerTokenMetadataKey is the publish metadata item the APIs set to the bearer token of the caller
+	CallerTokenMetadataKey = "callerToken"
+
+	// OperationPublish is publishing to a topic
+	OperationPublish = "publish"
+	// OperationSubscribe is subscribing to a topic
+	OperationSubscribe = "subscribe"
+
+	defaultTrustDomain = "public"
+	// topicACLDecisionTTL is how long an access decision is reused for the same caller, operation and topic
+	topicACLDecisionTTL = time.Minute
+	// maxTopicACLDecisions bounds the cached decisions, the cache is emptied when it is full
+	maxTopicACLDecisions = 10000
+)
+
+// TopicACLRule grants the callers matching its identities and claims the operations on the topics matching its pattern
+type TopicACLRule struct {
+	// Topic is a topic or a glob pattern of topics
+	Topic string `json:"topic"`
+	// Operations are publish and subscribe, both when empty
+	Operations []string `json:"operations,omitempty"`
+	// Identities are SPIFFE IDs or glob patterns of SPIFFE IDs of the callers, any identity when empty
+	Identities []string `json:"identities,omitempty"`
+	// Claims are the values required in the claims of the token of the caller. A list claim matches if it contains the value.
+	Claims map[string]string `json:"claims,omitempty"`
+}
+
+// Caller identifies the app or client performing a pubsub operation
+type Caller struct {
+	// Identity is the SPIFFE ID of the app
+	Identity string
+	// Token is the bearer token presented by the caller, if any
+	Token string
+}
+
+// AuthorizationError is returned when a caller isn't granted an operation on a topic by the topic access rules
+type AuthorizationError struct {
+	Operation string
+	Topic     string
+	Reason    string
+}
+
+func (e *AuthorizationError) Error() string {
+	return fmt.Sprintf("%s on topic %s is not allowed: %s", e.Operation, e.Topic, e.Reason)
+}
+
+type topicACLDecision struct {
+	err       error
+	expiresAt time.Time
+}
+
+// TopicACL authorizes the pubsub operations of callers with topic access rules. Topics no rule applies to are not
+// restricted. A nil TopicACL allows every operation.
+type TopicACL struct {
+	rules    []TopicACLRule
+	keys     apptoken.KeySet
+	issuer   string
+	audience string
+	now      func() time.Time
+
+	lock      sync.Mutex
+	decisions map[string]topicACLDecision
+}
+
+// SPIFFEID returns the SPIFFE ID of an app
+func SPIFFEID(trustDomain, namespace, appID string) string {
+	if trustDomain == "" {
+		trustDomain = defaultTrustDomain
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+	return fmt.Sprintf("spiffe://%s/ns/%s/%s", trustDomain, namespace, appID)
+}
+
+// TopicACLFromMetadata returns the topic access rules of a pubsub component.
+// It returns false if the component doesn't declare any.
+func TopicACLFromMetadata(properties map[string]string) (*TopicACL, bool, error) {
+	raw := properties[TopicACLsMetadataKey]
+	if raw == "" {
+		return nil, false, nil
+	}
+
+	var rules []TopicACLRule
+	if err := json.Unmarshal([]byte(raw), &rules); err != nil {
+		return nil, true, fmt.Errorf("invalid %s: %s", TopicACLsMetadataKey, err)
+	}
+	var keys apptoken.KeySet
+	if url := properties[TopicACLJWKSURLMetadataKey]; url != "" {
+		keys = apptoken.NewRemoteKeySet(url)
+	}
+	return newTopicACL(rules, keys, properties[TopicACLIssuerMetadataKey], properties[TopicACLAudienceMetadataKey])
+}
+
+func newTopicACL(rules []TopicACLRule, keys apptoken.KeySet, issuer, audience string) (*TopicACL, bool, error) {
+	for _, r := range rules {
+		if r.Topic == "" {
+			return nil, true, errors.New("topic access rules require a topic")
+		}
+		patterns := append([]string{r.Topic}, r.Identities...)
+		for _, p := range patterns {
+			if _, err := path.Match(p, ""); err != nil {
+				return nil, true, fmt.Errorf("invalid pattern %s: %s", p, err)
+			}
+		}
+		for _, o := range r.Operations {
+			if o != OperationPublish && o != OperationSubscribe {
+				return nil, true, fmt.Errorf("invalid operation %s of topic %s", o, r.Topic)
+			}
+		}
+		if len(r.Identities) == 0 && len(r.Claims) == 0 {
+			return nil, true, fmt.Errorf("the rule of topic %s requires identities or claims", r.Topic)
+		}
+		if len(r.Claims) > 0 && (keys == nil || issuer == "") {
+			return nil, true, fmt.Errorf("rules with claims require %s and %s", TopicACLJWKSURLMetadataKey, TopicACLIssuerMetadataKey)
+		}
+	}
+	return &TopicACL{
+		rules:     rules,
+		keys:      keys,
+		issuer:    issuer,
+		audience:  audience,
+		now:       time.Now,
+		decisions: map[string]topicACLDecision{},
+	}, true, nil
+}
+
+// Authorize returns an AuthorizationError if the rules applying to the topic and operation don't grant it to the caller.
+// Decisions are cached for a minute, and no longer than the token of the caller is valid.
+func (a *TopicACL) Authorize(operation, topic string, caller Caller) error {
+	if a == nil {
+		return nil
+	}
+
+	key := operation + "\x00" + topic + "\x00" + caller.Identity + "\x00" + caller.Token
+	now := a.now()
+	a.lock.Lock()
+	d, ok := a.decisions[key]
+	a.lock.Unlock()
+	if ok && now.Before(d.expiresAt) {
+		return d.err
+	}
+
+	expiresAt, err := a.authorize(operation, topic, caller, now)
+	a.lock.Lock()
+	if len(a.decisions) >= maxTopicACLDecisions {
+		a.decisions = map[string]topicACLDecision{}
+	}
+	a.decisions[key] = topicACLDecision{err: err, expiresAt: expiresAt}
+	a.lock.Unlock()
+	return err
+}
+
+func (a *TopicACL) authorize(operation, topic string, caller Caller, now time.Time) (time.Time, error) {
+	expiresAt := now.Add(topicACLDecisionTTL)
+	rules := a.applicableRules(operation, topic)
+	if len(rules) == 0 {
+		return expiresAt, nil
+	}
+
+	needsClaims := false
+	for _, r := range rules {
+		needsClaims = needsClaims || len(r.Claims) > 0
+	}
+	var claims map[string]interface{}
+	var tokenErr error
+	if needsClaims {
+		if caller.Token == "" {
+			tokenErr = errors.New("the caller presented no token")
+		} else {
+			var expiry time.Time
+			claims, expiry, tokenErr = apptoken.VerifyToken(a.keys, caller.Token, a.issuer, a.audience, now)
+			if tokenErr == nil && expiry.Before(expiresAt) {
+				expiresAt = expiry
+			}
+		}
+	}
+
+	for _, r := range rules {
+		if matchesIdentity(r, caller.Identity) && (len(r.Claims) == 0 || (tokenErr == nil && matchesClaims(r, claims))) {
+			return expiresAt, nil
+		}
+	}
+
+	reason := fmt.Sprintf("no rule grants it to %s", caller.Identity)
+	if tokenErr != nil {
+		reason = fmt.Sprintf("%s, the caller token is invalid: %s", reason, tokenErr)
+	}
+	return expiresAt, &AuthorizationError{Operation: operation, Topic: topic, Reason: reason}
+}
+
+// applicableRules returns the rules of the operation whose pattern matches the topic
+func (a *TopicACL) applicableRules(operation, topic string) []TopicACLRule {
+	rules := []TopicACLRule{}
+	for _, r := range a.rules {
+		if ok, _ := path.Match(r.Topic, topic); !ok {
+			continue
+		}
+		if len(r.Operations) == 0 {
+			rules = append(rules, r)
+			continue
+		}
+		for _, o := range r.Operations {
+			if o == operation {
+				rules = append(rules, r)
+				break
+			}
+		}
+	}
+	return rules
+}
+
+func matchesIdentity(r TopicACLRule, identity string) bool {
+	if len(r.Identities) == 0 {
+		return true
+	}
+	for _, i := range r.Identities {
+		if ok, _ := path.Match(i, identity); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesClaims(r TopicACLRule, claims map[string]interface{}) bool {
+	for name, expected := range r.Claims {
+		switch v := claims[name].(type) {
+		case string:
+			if v != expected {
+				return false
+			}
+		case []interface{}:
+			found := false
+			for _, item := range v {
+				if fmt.Sprint(item) == expected {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		case nil:
+			return false
+		default:
+			if fmt.Sprint(v) != expected {
+				return false
+			}
+		}
+	}
+	return true
+}
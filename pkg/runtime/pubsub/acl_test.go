@@ -0,0 +1,151 @@
+package pubsub
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const testACLIssuer = "https://issuer.example.com"
+
+type staticKeySet struct {
+	keys jose.JSONWebKeySet
+}
+
+func (s *staticKeySet) Keys(keyID string) ([]jose.JSONWebKey, error) {
+	return s.keys.Key(keyID), nil
+}
+
+func newTestToken(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "key1"))
+	assert.NoError(t, err)
+	token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+	assert.NoError(t, err)
+	return token
+}
+
+func newTestKeys(t *testing.T) (*rsa.PrivateKey, *staticKeySet) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	jwk := jose.JSONWebKey{Key: &key.PublicKey, KeyID: "key1", Algorithm: string(jose.RS256), Use: "sig"}
+	return key, &staticKeySet{keys: jose.JSONWebKeySet{Keys: []jose.JSONWebKey{jwk}}}
+}
+
+func TestSPIFFEID(t *testing.T) {
+	assert.Equal(t, "spiffe://public/ns/default/app1", SPIFFEID("", "", "app1"))
+	assert.Equal(t, "spiffe://example.org/ns/prod/app1", SPIFFEID("example.org", "prod", "app1"))
+}
+
+func TestTopicACLFromMetadata(t *testing.T) {
+	acl, ok, err := TopicACLFromMetadata(map[string]string{})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, acl)
+	assert.NoError(t, acl.Authorize(OperationPublish, "orders", Caller{}))
+
+	acl, ok, err = TopicACLFromMetadata(map[string]string{
+		TopicACLsMetadataKey:       `[{"topic": "orders", "identities": ["spiffe://public/ns/*/checkout"]}, {"topic": "audit.*", "claims": {"role": "auditor"}}]`,
+		TopicACLJWKSURLMetadataKey: "https://issuer.example.com/keys",
+		TopicACLIssuerMetadataKey:  testACLIssuer,
+	})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.NotNil(t, acl)
+
+	invalid := []map[string]string{
+		{TopicACLsMetadataKey: `{"topic": "orders"}`},
+		{TopicACLsMetadataKey: `[{"identities": ["spiffe://public/ns/default/app1"]}]`},
+		{TopicACLsMetadataKey: `[{"topic": "orders[", "identities": ["spiffe://public/ns/default/app1"]}]`},
+		{TopicACLsMetadataKey: `[{"topic": "orders", "operations": ["delete"], "identities": ["spiffe://public/ns/default/app1"]}]`},
+		{TopicACLsMetadataKey: `[{"topic": "orders"}]`},
+		{TopicACLsMetadataKey: `[{"topic": "orders", "claims": {"role": "admin"}}]`},
+	}
+	for _, properties := range invalid {
+		_, ok, err := TopicACLFromMetadata(properties)
+		assert.Error(t, err, properties)
+		assert.True(t, ok)
+	}
+}
+
+func TestAuthorizeIdentities(t *testing.T) {
+	acl, _, err := newTopicACL([]TopicACLRule{
+		{Topic: "orders", Operations: []string{OperationPublish}, Identities: []string{"spiffe://public/ns/*/checkout"}},
+		{Topic: "orders", Operations: []string{OperationSubscribe}, Identities: []string{"spiffe://public/ns/default/shipping"}},
+	}, nil, "", "")
+	assert.NoError(t, err)
+
+	checkout := Caller{Identity: SPIFFEID("", "prod", "checkout")}
+	shipping := Caller{Identity: SPIFFEID("", "", "shipping")}
+	assert.NoError(t, acl.Authorize(OperationPublish, "orders", checkout))
+	assert.Error(t, acl.Authorize(OperationSubscribe, "orders", checkout))
+	assert.NoError(t, acl.Authorize(OperationSubscribe, "orders", shipping))
+
+	err = acl.Authorize(OperationPublish, "orders", shipping)
+	assert.IsType(t, &AuthorizationError{}, err)
+	assert.Contains(t, err.Error(), "spiffe://public/ns/default/shipping")
+
+	assert.NoError(t, acl.Authorize(OperationPublish, "payments", shipping), "topics without rules are not restricted")
+}
+
+func TestAuthorizeClaims(t *testing.T) {
+	key, keys := newTestKeys(t)
+	acl, _, err := newTopicACL([]TopicACLRule{
+		{Topic: "audit.*", Operations: []string{OperationPublish}, Claims: map[string]string{"roles": "auditor"}},
+	}, keys, testACLIssuer, "")
+	assert.NoError(t, err)
+
+	caller := Caller{Identity: SPIFFEID("", "", "app1")}
+	expiry := time.Now().Add(time.Hour).Unix()
+
+	err = acl.Authorize(OperationPublish, "audit.logins", caller)
+	assert.IsType(t, &AuthorizationError{}, err)
+	assert.Contains(t, err.Error(), "no token")
+
+	caller.Token = newTestToken(t, key, map[string]interface{}{"iss": testACLIssuer, "exp": expiry, "roles": []string{"reader", "auditor"}})
+	assert.NoError(t, acl.Authorize(OperationPublish, "audit.logins", caller))
+
+	caller.Token = newTestToken(t, key, map[string]interface{}{"iss": testACLIssuer, "exp": expiry, "roles": []string{"reader"}})
+	assert.Error(t, acl.Authorize(OperationPublish, "audit.logins", caller))
+
+	caller.Token = newTestToken(t, key, map[string]interface{}{"iss": "https://other.example.com", "exp": expiry, "roles": "auditor"})
+	err = acl.Authorize(OperationPublish, "audit.logins", caller)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "token is invalid")
+
+	other, _ := newTestKeys(t)
+	caller.Token = newTestToken(t, other, map[string]interface{}{"iss": testACLIssuer, "exp": expiry, "roles": "auditor"})
+	assert.Error(t, acl.Authorize(OperationPublish, "audit.logins", caller))
+}
+
+func TestAuthorizeCachesDecisions(t *testing.T) {
+	key, keys := newTestKeys(t)
+	acl, _, err := newTopicACL([]TopicACLRule{
+		{Topic: "audit", Claims: map[string]string{"role": "auditor"}},
+	}, keys, testACLIssuer, "")
+	assert.NoError(t, err)
+
+	now := time.Now()
+	acl.now = func() time.Time { return now }
+	caller := Caller{
+		Identity: SPIFFEID("", "", "app1"),
+		Token:    newTestToken(t, key, map[string]interface{}{"iss": testACLIssuer, "exp": now.Add(30 * time.Second).Unix(), "role": "auditor"}),
+	}
+	assert.NoError(t, acl.Authorize(OperationPublish, "audit", caller))
+	assert.Len(t, acl.decisions, 1)
+
+	// a cached decision is reused even if the keys are no longer available
+	keys.keys.Keys = nil
+	now = now.Add(20 * time.Second)
+	assert.NoError(t, acl.Authorize(OperationPublish, "audit", caller))
+
+	// the decision expires with the token
+	now = now.Add(20 * time.Second)
+	assert.Error(t, acl.Authorize(OperationPublish, "audit", caller))
+}
@@ -0,0 +1,102 @@
+package pubsub
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dapr/components-contrib/pubsub"
+)
+
+// BulkPublishEntry is a message of a bulk publish request
+type BulkPublishEntry struct {
+	EntryID  string
+	Request  *pubsub.PublishRequest
+	Metadata map[string]string
+}
+
+// BulkPublishRequest publishes several messages to a topic
+type BulkPublishRequest struct {
+	Topic   string
+	Entries []BulkPublishEntry
+	// Transactional publishes either all the entries or none of them
+	Transactional bool
+	// ReturnSucceeded lists the entries that were published in the response too
+	ReturnSucceeded bool
+}
+
+// BulkPublishFailedEntry is an entry of a bulk publish request that failed to be published
+type BulkPublishFailedEntry struct {
+	EntryID string
+	Error   error
+}
+
+// BulkPublishSucceededEntry is an entry of a bulk publish request that was published
+type BulkPublishSucceededEntry struct {
+	EntryID string
+	// MessageID is the ID the broker assigned to the message, empty if the pubsub component doesn't report it
+	MessageID   string
+	PublishedAt time.Time
+}
+
+// BulkPublishResponse lists the entries of a bulk publish request that failed to be published, and the entries that
+// were published if the request asks for them
+type BulkPublishResponse struct {
+	FailedEntries    []BulkPublishFailedEntry
+	SucceededEntries []BulkPublishSucceededEntry
+}
+
+// ValidateBulkPublishRequest checks that the entries of a bulk publish request have unique IDs and target its topic
+func ValidateBulkPublishRequest(req *BulkPublishRequest) error {
+	ids := make(map[string]struct{}, len(req.Entries))
+	for _, e := range req.Entries {
+		if e.EntryID == "" {
+			return fmt.Errorf("entry ID is missing")
+		}
+		if _, ok := ids[e.EntryID]; ok {
+			return fmt.Errorf("entry ID %s is not unique", e.EntryID)
+		}
+		ids[e.EntryID] = struct{}{}
+		if e.Request == nil || e.Request.Topic != req.Topic {
+			return fmt.Errorf("entry %s doesn't target topic %s", e.EntryID, req.Topic)
+		}
+	}
+	return nil
+}
+
+// BulkPublish publishes the entries of a bulk publish request one by one with up to parallelism entries at once.
+// publish returns the ID the broker assigned to the message, if reported.
+// Failed entries, and succeeded entries if the request asks for them, are returned in the order of the request.
+func BulkPublish(req *BulkPublishRequest, parallelism int, publish func(entry BulkPublishEntry) (string, error)) BulkPublishResponse {
+	errs := make([]error, len(req.Entries))
+	succeeded := make([]BulkPublishSucceededEntry, len(req.Entries))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, e := range req.Entries {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, e BulkPublishEntry) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			messageID, err := publish(e)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			succeeded[i] = BulkPublishSucceededEntry{EntryID: e.EntryID, MessageID: messageID, PublishedAt: time.Now()}
+		}(i, e)
+	}
+	wg.Wait()
+
+	resp := BulkPublishResponse{}
+	for i, err := range errs {
+		if err != nil {
+			resp.FailedEntries = append(resp.FailedEntries, BulkPublishFailedEntry{EntryID: req.Entries[i].EntryID, Error: err})
+		} else if req.ReturnSucceeded {
+			resp.SucceededEntries = append(resp.SucceededEntries, succeeded[i])
+		}
+	}
+	return resp
+}
@@ -0,0 +1,73 @@
+package pubsub
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/stretchr/testify/assert"
+)
+
+func bulkRequest(ids ...string) *BulkPublishRequest {
+	req := &BulkPublishRequest{Topic: "orders"}
+	for _, id := range ids {
+		req.Entries = append(req.Entries, BulkPublishEntry{EntryID: id, Request: &pubsub.PublishRequest{Topic: "orders"}})
+	}
+	return req
+}
+
+func TestValidateBulkPublishRequest(t *testing.T) {
+	assert.NoError(t, ValidateBulkPublishRequest(bulkRequest("1", "2")))
+	assert.Error(t, ValidateBulkPublishRequest(bulkRequest("1", "1")))
+	assert.Error(t, ValidateBulkPublishRequest(bulkRequest("")))
+
+	req := bulkRequest("1")
+	req.Entries[0].Request.Topic = "payments"
+	assert.Error(t, ValidateBulkPublishRequest(req))
+}
+
+func TestBulkPublish(t *testing.T) {
+	var running, maxRunning int32
+	resp := BulkPublish(bulkRequest("1", "2", "3", "4", "5"), 2, func(e BulkPublishEntry) (string, error) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		if e.EntryID == "2" || e.EntryID == "4" {
+			return "", errors.New("broker error")
+		}
+		return "", nil
+	})
+
+	assert.LessOrEqual(t, maxRunning, int32(2))
+	assert.Len(t, resp.FailedEntries, 2)
+	assert.Equal(t, "2", resp.FailedEntries[0].EntryID)
+	assert.Equal(t, "4", resp.FailedEntries[1].EntryID)
+	assert.Empty(t, resp.SucceededEntries)
+
+	t.Run("succeeded entries", func(t *testing.T) {
+		req := bulkRequest("1", "2", "3")
+		req.ReturnSucceeded = true
+		before := time.Now()
+		resp := BulkPublish(req, 2, func(e BulkPublishEntry) (string, error) {
+			if e.EntryID == "2" {
+				return "", errors.New("broker error")
+			}
+			return "msg-" + e.EntryID, nil
+		})
+
+		assert.Len(t, resp.FailedEntries, 1)
+		assert.Len(t, resp.SucceededEntries, 2)
+		assert.Equal(t, "1", resp.SucceededEntries[0].EntryID)
+		assert.Equal(t, "msg-1", resp.SucceededEntries[0].MessageID)
+		assert.Equal(t, "3", resp.SucceededEntries[1].EntryID)
+		assert.Equal(t, "msg-3", resp.SucceededEntries[1].MessageID)
+		assert.False(t, resp.SucceededEntries[1].PublishedAt.Before(before))
+	})
+}
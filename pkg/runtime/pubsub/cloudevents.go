@@ -0,0 +1,81 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+)
+
+const (
+	// PreserveCloudEventMetadataKey is the publish metadata flag that marks the payload as a complete CloudEvent.
+	// The event is validated and published as-is instead of being wrapped in a new envelope.
+	PreserveCloudEventMetadataKey = "preserveCloudEvent"
+
+	cloudEventTimeField        = "time"
+	cloudEventTraceParentField = "traceparent"
+)
+
+// requiredCloudEventFields are the context attributes a structured CloudEvent must carry
+var requiredCloudEventFields = []string{"id", "source", "type", "specversion"}
+
+// IsPreservedCloudEvent returns true if the publish metadata asks to keep the CloudEvent envelope of the payload
+func IsPreservedCloudEvent(metadata map[string]string) bool {
+	preserve, err := strconv.ParseBool(metadata[PreserveCloudEventMetadataKey])
+	return err == nil && preserve
+}
+
+// ValidateCloudEvent checks that data is a structured CloudEvent with the required attributes
+// and that the optional time and traceparent attributes are well formed
+func ValidateCloudEvent(data []byte) error {
+	var event map[string]interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("event is not a structured cloud event: %s", err)
+	}
+	if event == nil {
+		return errors.New("event is not a structured cloud event")
+	}
+
+	for _, field := range requiredCloudEventFields {
+		value, ok := event[field].(string)
+		if !ok || value == "" {
+			return fmt.Errorf("cloud event attribute %s is missing or not a string", field)
+		}
+	}
+
+	if value, ok := event[cloudEventTimeField]; ok {
+		t, isString := value.(string)
+		if !isString {
+			return fmt.Errorf("cloud event attribute %s is not a string", cloudEventTimeField)
+		}
+		if _, err := time.Parse(time.RFC3339, t); err != nil {
+			return fmt.Errorf("cloud event attribute %s is not a RFC3339 timestamp: %s", cloudEventTimeField, err)
+		}
+	}
+
+	if value, ok := event[cloudEventTraceParentField]; ok {
+		traceParent, isString := value.(string)
+		if !isString {
+			return fmt.Errorf("cloud event attribute %s is not a string", cloudEventTraceParentField)
+		}
+		if _, valid := diag.SpanContextFromString(traceParent); !valid {
+			return fmt.Errorf("cloud event attribute %s is not a valid trace context", cloudEventTraceParentField)
+		}
+	}
+	return nil
+}
+
+// GetCloudEventTime returns the time attribute of a structured CloudEvent, if it carries a valid one
+func GetCloudEventTime(data []byte) (time.Time, bool) {
+	var event struct {
+		Time string `json:"time"`
+	}
+	if err := json.Unmarshal(data, &event); err != nil || event.Time == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, event.Time)
+	return t, err == nil
+}
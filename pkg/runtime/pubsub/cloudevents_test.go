@@ -0,0 +1,56 @@
+package pubsub
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPreservedCloudEvent(t *testing.T) {
+	assert.True(t, IsPreservedCloudEvent(map[string]string{PreserveCloudEventMetadataKey: "true"}))
+	assert.False(t, IsPreservedCloudEvent(map[string]string{PreserveCloudEventMetadataKey: "false"}))
+	assert.False(t, IsPreservedCloudEvent(map[string]string{PreserveCloudEventMetadataKey: "yes please"}))
+	assert.False(t, IsPreservedCloudEvent(nil))
+}
+
+func TestValidateCloudEvent(t *testing.T) {
+	t.Run("valid event", func(t *testing.T) {
+		event := `{"id":"a1","source":"relay","type":"order.created","specversion":"1.0","time":"2020-05-01T10:00:00Z",` +
+			`"traceparent":"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01","data":{"orderId":1}}`
+		assert.NoError(t, ValidateCloudEvent([]byte(event)))
+	})
+
+	t.Run("not an object", func(t *testing.T) {
+		assert.Error(t, ValidateCloudEvent([]byte(`"text"`)))
+		assert.Error(t, ValidateCloudEvent([]byte(`null`)))
+	})
+
+	t.Run("missing id", func(t *testing.T) {
+		event := `{"source":"relay","type":"order.created","specversion":"1.0"}`
+		assert.Error(t, ValidateCloudEvent([]byte(event)))
+	})
+
+	t.Run("invalid time", func(t *testing.T) {
+		event := `{"id":"a1","source":"relay","type":"order.created","specversion":"1.0","time":"yesterday"}`
+		assert.Error(t, ValidateCloudEvent([]byte(event)))
+	})
+
+	t.Run("invalid traceparent", func(t *testing.T) {
+		event := `{"id":"a1","source":"relay","type":"order.created","specversion":"1.0","traceparent":"00-0-0-01"}`
+		assert.Error(t, ValidateCloudEvent([]byte(event)))
+	})
+}
+
+func TestGetCloudEventTime(t *testing.T) {
+	published, ok := GetCloudEventTime([]byte(`{"id":"a1","time":"2020-05-01T10:00:00Z"}`))
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC), published)
+
+	_, ok = GetCloudEventTime([]byte(`{"id":"a1"}`))
+	assert.False(t, ok)
+	_, ok = GetCloudEventTime([]byte(`{"id":"a1","time":"yesterday"}`))
+	assert.False(t, ok)
+	_, ok = GetCloudEventTime([]byte(`order created`))
+	assert.False(t, ok)
+}
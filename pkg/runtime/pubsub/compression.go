@@ -0,0 +1,196 @@
+package pubsub
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CompressionMetadataKey is the metadata item of a pubsub component with the algorithm compressing the data of the
+	// published cloud events, gzip or zstd
+	CompressionMetadataKey = "compression"
+	// CompressionThresholdMetadataKey is the metadata item of a pubsub component with the size in bytes from which
+	// published events are compressed
+	CompressionThresholdMetadataKey = "compressionThreshold"
+
+	// CompressionGzip compresses the data of events with gzip
+	CompressionGzip = "gzip"
+	// CompressionZstd compresses the data of events with zstd
+	CompressionZstd = "zstd"
+
+	// DefaultCompressionThreshold is the size in bytes from which events are compressed when no threshold is set
+	DefaultCompressionThreshold = 1024
+
+	// maxDecompressedDataSize bounds the size of the data of a compressed event once decompressed
+	maxDecompressedDataSize = 64 << 20
+
+	cloudEventDataField         = "data"
+	cloudEventDataEncodingField = "datacontentencoding"
+)
+
+// Compressor compresses the data of the cloud events published above a size threshold. The compressed data is base64
+// encoded in the data attribute and the algorithm is set in the datacontentencoding attribute.
+// A nil Compressor publishes events as they are.
+type Compressor struct {
+	algorithm string
+	threshold int
+}
+
+// CompressionFromMetadata returns the compression of the events published to a pubsub component.
+// It returns false if the component doesn't compress events.
+func CompressionFromMetadata(properties map[string]string) (*Compressor, bool, error) {
+	algorithm := properties[CompressionMetadataKey]
+	if algorithm == "" {
+		return nil, false, nil
+	}
+	if algorithm != CompressionGzip && algorithm != CompressionZstd {
+		return nil, true, fmt.Errorf("invalid %s %s: expected %s or %s", CompressionMetadataKey, algorithm, CompressionGzip, CompressionZstd)
+	}
+
+	threshold := DefaultCompressionThreshold
+	if v := properties[CompressionThresholdMetadataKey]; v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return nil, true, fmt.Errorf("invalid %s %s: expected a number of bytes", CompressionThresholdMetadataKey, v)
+		}
+		threshold = n
+	}
+	return &Compressor{algorithm: algorithm, threshold: threshold}, true, nil
+}
+
+// Compress returns the cloud event with its data compressed if the event is at least as large as the threshold.
+// Events without data, already encoded events and events the compression doesn't shrink are returned unchanged.
+func (c *Compressor) Compress(event []byte) ([]byte, error) {
+	if c == nil || len(event) < c.threshold {
+		return event, nil
+	}
+
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(event, &attributes); err != nil {
+		return nil, fmt.Errorf("event is not a structured cloud event: %s", err)
+	}
+	data, ok := attributes[cloudEventDataField]
+	if !ok || string(data) == "null" {
+		return event, nil
+	}
+	if _, ok := attributes[cloudEventDataEncodingField]; ok {
+		return event, nil
+	}
+
+	compressed, err := compress(c.algorithm, data)
+	if err != nil {
+		return nil, fmt.Errorf("error compressing the event data: %s", err)
+	}
+	attributes[cloudEventDataField], _ = json.Marshal(base64.StdEncoding.EncodeToString(compressed))
+	attributes[cloudEventDataEncodingField], _ = json.Marshal(c.algorithm)
+	b, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) >= len(event) {
+		return event, nil
+	}
+	return b, nil
+}
+
+// DecompressCloudEvent returns the cloud event with the data a publisher compressed restored, and without the
+// datacontentencoding attribute. Other events are returned unchanged.
+func DecompressCloudEvent(event []byte) ([]byte, error) {
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(event, &attributes); err != nil {
+		// not a structured cloud event, delivered as is
+		return event, nil
+	}
+	var algorithm string
+	if raw, ok := attributes[cloudEventDataEncodingField]; !ok || json.Unmarshal(raw, &algorithm) != nil {
+		return event, nil
+	}
+	if algorithm != CompressionGzip && algorithm != CompressionZstd {
+		return event, nil
+	}
+
+	var encoded string
+	if err := json.Unmarshal(attributes[cloudEventDataField], &encoded); err != nil {
+		return nil, fmt.Errorf("the data of a %s encoded event is not a string: %s", algorithm, err)
+	}
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("the data of a %s encoded event is not base64: %s", algorithm, err)
+	}
+	data, err := decompress(algorithm, compressed)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing the event data: %s", err)
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("the decompressed event data is not valid JSON")
+	}
+
+	attributes[cloudEventDataField] = data
+	delete(attributes, cloudEventDataEncodingField)
+	return json.Marshal(attributes)
+}
+
+func compress(algorithm string, data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	switch algorithm {
+	case CompressionGzip:
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	case CompressionZstd:
+		w, err := zstd.NewWriter(&buf)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func decompress(algorithm string, data []byte) ([]byte, error) {
+	switch algorithm {
+	case CompressionGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return readDecompressed(r)
+	case CompressionZstd:
+		r, err := zstd.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return readDecompressed(r)
+	}
+	return data, nil
+}
+
+func readDecompressed(r io.Reader) ([]byte, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r, maxDecompressedDataSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxDecompressedDataSize {
+		return nil, fmt.Errorf("the decompressed data exceeds %v bytes", maxDecompressedDataSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,107 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newLargeEvent(t *testing.T) []byte {
+	event, err := json.Marshal(map[string]interface{}{
+		"id":              "1",
+		"source":          "app1",
+		"type":            "com.dapr.event.sent",
+		"specversion":     "0.3",
+		"datacontenttype": "application/json",
+		"data":            map[string]string{"message": strings.Repeat("hello world ", 200)},
+	})
+	assert.NoError(t, err)
+	return event
+}
+
+func TestCompressionFromMetadata(t *testing.T) {
+	c, ok, err := CompressionFromMetadata(map[string]string{})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, c)
+
+	c, ok, err = CompressionFromMetadata(map[string]string{CompressionMetadataKey: CompressionZstd})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, DefaultCompressionThreshold, c.threshold)
+
+	invalid := []map[string]string{
+		{CompressionMetadataKey: "brotli"},
+		{CompressionMetadataKey: CompressionGzip, CompressionThresholdMetadataKey: "-1"},
+		{CompressionMetadataKey: CompressionGzip, CompressionThresholdMetadataKey: "1kb"},
+	}
+	for _, properties := range invalid {
+		_, ok, err := CompressionFromMetadata(properties)
+		assert.Error(t, err, properties)
+		assert.True(t, ok)
+	}
+}
+
+func TestCompressRoundTrip(t *testing.T) {
+	for _, algorithm := range []string{CompressionGzip, CompressionZstd} {
+		t.Run(algorithm, func(t *testing.T) {
+			c, _, err := CompressionFromMetadata(map[string]string{CompressionMetadataKey: algorithm})
+			assert.NoError(t, err)
+
+			event := newLargeEvent(t)
+			compressed, err := c.Compress(event)
+			assert.NoError(t, err)
+			assert.Less(t, len(compressed), len(event))
+
+			var attributes map[string]interface{}
+			assert.NoError(t, json.Unmarshal(compressed, &attributes))
+			assert.Equal(t, algorithm, attributes["datacontentencoding"])
+			assert.IsType(t, "", attributes["data"])
+			assert.Equal(t, "application/json", attributes["datacontenttype"])
+
+			decompressed, err := DecompressCloudEvent(compressed)
+			assert.NoError(t, err)
+			assert.JSONEq(t, string(event), string(decompressed))
+		})
+	}
+}
+
+func TestCompressBelowThreshold(t *testing.T) {
+	var c *Compressor
+	event := newLargeEvent(t)
+	b, err := c.Compress(event)
+	assert.NoError(t, err)
+	assert.Equal(t, event, b)
+
+	c, _, err = CompressionFromMetadata(map[string]string{CompressionMetadataKey: CompressionGzip, CompressionThresholdMetadataKey: "100000"})
+	assert.NoError(t, err)
+	b, err = c.Compress(event)
+	assert.NoError(t, err)
+	assert.Equal(t, event, b)
+
+	c, _, err = CompressionFromMetadata(map[string]string{CompressionMetadataKey: CompressionGzip, CompressionThresholdMetadataKey: "0"})
+	assert.NoError(t, err)
+	small := []byte(`{"id":"1","data":"a"}`)
+	b, err = c.Compress(small)
+	assert.NoError(t, err)
+	assert.Equal(t, small, b, "events the compression doesn't shrink are published as they are")
+}
+
+func TestDecompressCloudEvent(t *testing.T) {
+	plain := []byte(`{"id":"1","data":{"a":1}}`)
+	b, err := DecompressCloudEvent(plain)
+	assert.NoError(t, err)
+	assert.Equal(t, plain, b)
+
+	raw := []byte("not a cloud event")
+	b, err = DecompressCloudEvent(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, raw, b)
+
+	_, err = DecompressCloudEvent([]byte(`{"id":"1","datacontentencoding":"gzip","data":"bm90IGd6aXA="}`))
+	assert.Error(t, err)
+	_, err = DecompressCloudEvent([]byte(`{"id":"1","datacontentencoding":"zstd","data":{"a":1}}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,137 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DeliveryAttemptHeader passes the delivery attempt of a message to the app, 1 for the first delivery
+	DeliveryAttemptHeader = "dapr-delivery-attempt"
+	// FirstDeliveryTimeHeader passes the RFC3339 time a message was first delivered to the app
+	FirstDeliveryTimeHeader = "dapr-first-delivery-time"
+)
+
+// maxTrackedMessages bounds the IDs of the messages not processed yet kept to count their deliveries.
+// The IDs are forgotten when it's reached.
+const maxTrackedMessages = 10000
+
+// DeliveryStats are the delivery counters of a subscription
+type DeliveryStats struct {
+	// InFlight is the number of messages being delivered to the app, waiting for a handler included
+	InFlight int64
+	// LastDeliveryTime is when a message was last delivered to the app, zero if none was
+	LastDeliveryTime time.Time
+	// Retries is the number of redeliveries of messages the app failed to process
+	Retries int64
+}
+
+// Delivery is a delivery of a message to the app
+type Delivery struct {
+	// Attempt counts the deliveries of the message to the app, 1 for the first one
+	Attempt int
+	// FirstDeliveryTime is when the message was first delivered to the app
+	FirstDeliveryTime time.Time
+}
+
+// Headers returns the headers passing the delivery to the app, none for the zero Delivery
+func (d Delivery) Headers() map[string]string {
+	if d.Attempt == 0 {
+		return nil
+	}
+	return map[string]string{
+		DeliveryAttemptHeader:   strconv.Itoa(d.Attempt),
+		FirstDeliveryTimeHeader: d.FirstDeliveryTime.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+// DeliveryTracker tracks the deliveries of the messages of subscriptions to the app.
+// A redelivery is a message delivered again with the cloud event ID of a message the app didn't process yet.
+type DeliveryTracker struct {
+	lock  sync.Mutex
+	stats map[string]*DeliveryStats
+	// deliveries holds the deliveries of the messages not processed yet by subscription and cloud event ID
+	deliveries map[string]map[string]*Delivery
+	now        func() time.Time
+}
+
+// NewDeliveryTracker returns a tracker without deliveries
+func NewDeliveryTracker() *DeliveryTracker {
+	return &DeliveryTracker{
+		stats:      map[string]*DeliveryStats{},
+		deliveries: map[string]map[string]*Delivery{},
+		now:        time.Now,
+	}
+}
+
+// Start records the start of the delivery of a message of a subscription and returns the function recording its end
+// with the error of the app, if any
+func (t *DeliveryTracker) Start(subscription string, data []byte) func(err error) {
+	id := cloudEventID(data)
+
+	t.lock.Lock()
+	s, ok := t.stats[subscription]
+	if !ok {
+		s = &DeliveryStats{}
+		t.stats[subscription] = s
+	}
+	s.InFlight++
+	s.LastDeliveryTime = t.now()
+	if id != "" {
+		deliveries := t.deliveries[subscription]
+		if d, retry := deliveries[id]; retry {
+			d.Attempt++
+			s.Retries++
+		} else {
+			if deliveries == nil || len(deliveries) >= maxTrackedMessages {
+				deliveries = map[string]*Delivery{}
+				t.deliveries[subscription] = deliveries
+			}
+			deliveries[id] = &Delivery{Attempt: 1, FirstDeliveryTime: s.LastDeliveryTime}
+		}
+	}
+	t.lock.Unlock()
+
+	return func(err error) {
+		t.lock.Lock()
+		defer t.lock.Unlock()
+		s.InFlight--
+		if err == nil && id != "" {
+			delete(t.deliveries[subscription], id)
+		}
+	}
+}
+
+// Delivery returns the delivery of the message of a subscription with a cloud event ID being delivered, or the zero
+// Delivery if the message isn't tracked
+func (t *DeliveryTracker) Delivery(subscription, id string) Delivery {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if d, ok := t.deliveries[subscription][id]; ok && id != "" {
+		return *d
+	}
+	return Delivery{}
+}
+
+// Stats returns the delivery counters of a subscription
+func (t *DeliveryTracker) Stats(subscription string) DeliveryStats {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if s, ok := t.stats[subscription]; ok {
+		return *s
+	}
+	return DeliveryStats{}
+}
+
+// cloudEventID returns the ID of a cloud event, or an empty string if the data isn't one
+func cloudEventID(data []byte) string {
+	var event struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return ""
+	}
+	return event.ID
+}
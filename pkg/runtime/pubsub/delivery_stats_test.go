@@ -0,0 +1,66 @@
+package pubsub
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeliveryTracker(t *testing.T) {
+	tracker := NewDeliveryTracker()
+	now := time.Date(2020, 6, 1, 9, 0, 0, 0, time.UTC)
+	tracker.now = func() time.Time { return now }
+
+	assert.Equal(t, DeliveryStats{}, tracker.Stats("orders"))
+
+	done := tracker.Start("orders", []byte(`{"id":"1"}`))
+	assert.Equal(t, DeliveryStats{InFlight: 1, LastDeliveryTime: now}, tracker.Stats("orders"))
+	done(errors.New("app failure"))
+	assert.Equal(t, int64(0), tracker.Stats("orders").InFlight)
+
+	now = now.Add(time.Minute)
+	done = tracker.Start("orders", []byte(`{"id":"1"}`))
+	done(nil)
+	stats := tracker.Stats("orders")
+	assert.Equal(t, int64(1), stats.Retries)
+	assert.Equal(t, now, stats.LastDeliveryTime)
+
+	// a message processed after its redelivery isn't a retry when delivered again
+	tracker.Start("orders", []byte(`{"id":"1"}`))(nil)
+	assert.Equal(t, int64(1), tracker.Stats("orders").Retries)
+
+	// messages without a cloud event ID can't be told apart
+	tracker.Start("orders", []byte("raw"))(errors.New("app failure"))
+	tracker.Start("orders", []byte("raw"))(nil)
+	assert.Equal(t, int64(1), tracker.Stats("orders").Retries)
+	assert.Equal(t, DeliveryStats{}, tracker.Stats("payments"))
+}
+
+func TestDeliveryAttempts(t *testing.T) {
+	tracker := NewDeliveryTracker()
+	first := time.Date(2020, 6, 1, 9, 0, 0, 0, time.UTC)
+	now := first
+	tracker.now = func() time.Time { return now }
+
+	done := tracker.Start("orders", []byte(`{"id":"1"}`))
+	assert.Equal(t, Delivery{Attempt: 1, FirstDeliveryTime: first}, tracker.Delivery("orders", "1"))
+	done(errors.New("app failure"))
+
+	now = now.Add(time.Minute)
+	done = tracker.Start("orders", []byte(`{"id":"1"}`))
+	d := tracker.Delivery("orders", "1")
+	assert.Equal(t, Delivery{Attempt: 2, FirstDeliveryTime: first}, d)
+	assert.Equal(t, map[string]string{
+		DeliveryAttemptHeader:   "2",
+		FirstDeliveryTimeHeader: "2020-06-01T09:00:00Z",
+	}, d.Headers())
+	done(nil)
+
+	// processed messages are forgotten
+	assert.Equal(t, Delivery{}, tracker.Delivery("orders", "1"))
+	assert.Nil(t, Delivery{}.Headers())
+	assert.Equal(t, Delivery{}, tracker.Delivery("orders", ""))
+	assert.Equal(t, Delivery{}, tracker.Delivery("payments", "1"))
+}
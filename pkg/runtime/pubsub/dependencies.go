@@ -0,0 +1,86 @@
+package pubsub
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// StartAfterMetadataKey is the subscription metadata item with the comma separated dependencies to wait for before consuming messages
+	StartAfterMetadataKey = "startAfter"
+	// StartTimeoutMetadataKey is the subscription metadata item with the maximum time to wait for the dependencies.
+	// The subscription is made once it expires, even if some dependencies aren't ready.
+	StartTimeoutMetadataKey = "startTimeout"
+
+	// AppHealthyDependency waits for the app health endpoint to report healthy
+	AppHealthyDependency = "app:healthy"
+	// ComponentDependencyPrefix waits for the named component to be initialized, e.g. component:statestore
+	ComponentDependencyPrefix = "component:"
+)
+
+// Dependencies are the conditions a subscription waits for before consuming messages
+type Dependencies struct {
+	AppHealthy bool
+	Components []string
+	// Timeout is zero when the subscription waits for its dependencies indefinitely
+	Timeout time.Duration
+}
+
+// Empty returns true if the subscription doesn't wait for anything
+func (d Dependencies) Empty() bool {
+	return !d.AppHealthy && len(d.Components) == 0
+}
+
+// GetDependencies reads the dependencies declared in the metadata of a subscription
+func GetDependencies(s Subscription) (Dependencies, error) {
+	d := Dependencies{}
+	for _, dep := range strings.Split(s.Metadata[StartAfterMetadataKey], ",") {
+		dep = strings.TrimSpace(dep)
+		switch {
+		case dep == "":
+		case dep == AppHealthyDependency:
+			d.AppHealthy = true
+		case strings.HasPrefix(dep, ComponentDependencyPrefix) && len(dep) > len(ComponentDependencyPrefix):
+			d.Components = append(d.Components, strings.TrimPrefix(dep, ComponentDependencyPrefix))
+		default:
+			return d, fmt.Errorf("unknown dependency %s in %s of topic %s, use %s or %s<name>", dep, StartAfterMetadataKey, s.Topic, AppHealthyDependency, ComponentDependencyPrefix)
+		}
+	}
+
+	if v := s.Metadata[StartTimeoutMetadataKey]; v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout <= 0 {
+			return d, fmt.Errorf("%s of topic %s must be a positive duration", StartTimeoutMetadataKey, s.Topic)
+		}
+		d.Timeout = timeout
+	}
+	return d, nil
+}
+
+// WaitForDependencies calls unmet every interval until it returns no dependency.
+// It returns the dependencies still unmet when the timeout of d expires or stop is closed.
+func WaitForDependencies(d Dependencies, unmet func(d Dependencies) []string, interval time.Duration, stop <-chan struct{}) []string {
+	var timeout <-chan time.Time
+	if d.Timeout > 0 {
+		timer := time.NewTimer(d.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		missing := unmet(d)
+		if len(missing) == 0 {
+			return nil
+		}
+		select {
+		case <-ticker.C:
+		case <-timeout:
+			return missing
+		case <-stop:
+			return missing
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package pubsub
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDependencies(t *testing.T) {
+	t.Run("no dependencies", func(t *testing.T) {
+		d, err := GetDependencies(Subscription{Topic: "orders"})
+		assert.NoError(t, err)
+		assert.True(t, d.Empty())
+	})
+
+	t.Run("app and components", func(t *testing.T) {
+		d, err := GetDependencies(Subscription{Topic: "orders", Metadata: map[string]string{
+			StartAfterMetadataKey:   "app:healthy, component:statestore,component:cache",
+			StartTimeoutMetadataKey: "2m",
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, Dependencies{AppHealthy: true, Components: []string{"statestore", "cache"}, Timeout: 2 * time.Minute}, d)
+	})
+
+	t.Run("unknown dependency", func(t *testing.T) {
+		_, err := GetDependencies(Subscription{Topic: "orders", Metadata: map[string]string{StartAfterMetadataKey: "db:warm"}})
+		assert.Error(t, err)
+		_, err = GetDependencies(Subscription{Topic: "orders", Metadata: map[string]string{StartAfterMetadataKey: "component:"}})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid timeout", func(t *testing.T) {
+		_, err := GetDependencies(Subscription{Topic: "orders", Metadata: map[string]string{StartTimeoutMetadataKey: "later"}})
+		assert.Error(t, err)
+	})
+}
+
+func TestWaitForDependencies(t *testing.T) {
+	t.Run("waits until ready", func(t *testing.T) {
+		var lock sync.Mutex
+		checks := 0
+		missing := WaitForDependencies(Dependencies{AppHealthy: true}, func(d Dependencies) []string {
+			lock.Lock()
+			defer lock.Unlock()
+			checks++
+			if checks < 3 {
+				return []string{AppHealthyDependency}
+			}
+			return nil
+		}, time.Millisecond, nil)
+		assert.Empty(t, missing)
+		assert.Equal(t, 3, checks)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		missing := WaitForDependencies(Dependencies{Components: []string{"db"}, Timeout: 10 * time.Millisecond}, func(d Dependencies) []string {
+			return []string{"component:db"}
+		}, time.Millisecond, nil)
+		assert.Equal(t, []string{"component:db"}, missing)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		stop := make(chan struct{})
+		close(stop)
+		missing := WaitForDependencies(Dependencies{Components: []string{"db"}}, func(d Dependencies) []string {
+			return []string{"component:db"}
+		}, time.Hour, stop)
+		assert.Equal(t, []string{"component:db"}, missing)
+	})
+}
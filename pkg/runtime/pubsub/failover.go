@@ -0,0 +1,268 @@
+package pubsub
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/components/lifecycle"
+	"github.com/dapr/dapr/pkg/failover"
+)
+
+const (
+	// ResubscribeMetadataKey is the metadata item of a primary pubsub component enabling subscriptions on its secondary component after a failover
+	ResubscribeMetadataKey = "failoverResubscribe"
+	// StandbyMetadataKey is the metadata item of a primary pubsub component enabling warm standby subscriptions on its secondary
+	// component: they are made up front and paused until a failover
+	StandbyMetadataKey = "failoverStandby"
+)
+
+// SecondarySubscriptions selects when the subscriptions of a failover pair are made on its secondary component
+type SecondarySubscriptions string
+
+const (
+	// SubscribeNever only subscribes on the primary component
+	SubscribeNever SecondarySubscriptions = ""
+	// SubscribeOnFailover subscribes on the secondary component after the first failover
+	SubscribeOnFailover SecondarySubscriptions = "onFailover"
+	// SubscribeStandby subscribes on the secondary component up front and only delivers its messages while the pair is
+	// failed over, so the failover doesn't wait for the secondary subscriptions to connect
+	SubscribeStandby SecondarySubscriptions = "standby"
+)
+
+// errStandbyClosed is returned for the messages of paused standby subscriptions when the pair is closed, so they are redelivered
+var errStandbyClosed = errors.New("standby subscription closed before it was resumed")
+
+// SecondarySubscriptionsFromMetadata returns when the subscriptions of a primary pubsub component are made on its secondary component
+func SecondarySubscriptionsFromMetadata(properties map[string]string) SecondarySubscriptions {
+	switch {
+	case strings.EqualFold(properties[StandbyMetadataKey], "true"):
+		return SubscribeStandby
+	case strings.EqualFold(properties[ResubscribeMetadataKey], "true"):
+		return SubscribeOnFailover
+	default:
+		return SubscribeNever
+	}
+}
+
+type subscription struct {
+	req     pubsub.SubscribeRequest
+	handler func(msg *pubsub.NewMessage) error
+}
+
+// FailoverPubSub publishes to a primary pubsub component and fails over to a secondary component when the primary keeps failing
+type FailoverPubSub struct {
+	primary                pubsub.PubSub
+	secondary              pubsub.PubSub
+	breaker                *failover.Breaker
+	secondarySubscriptions SecondarySubscriptions
+
+	lock                sync.Mutex
+	subscriptions       []subscription
+	secondarySubscribed map[string]bool
+	onSubscribeError    func(topic string, err error)
+	// resumed is closed while the standby subscriptions deliver their messages
+	resumed chan struct{}
+	closed  chan struct{}
+}
+
+// NewFailoverPubSub returns a pubsub switching between two initialized components.
+// onSwitch is called every time the component publishes are sent to changes.
+// secondarySubscriptions selects when the subscriptions are also made on the secondary component.
+func NewFailoverPubSub(primary, secondary pubsub.PubSub, config failover.Config, secondarySubscriptions SecondarySubscriptions, onSwitch func(from, to failover.Target), onSubscribeError func(topic string, err error)) *FailoverPubSub {
+	f := &FailoverPubSub{
+		primary:                primary,
+		secondary:              secondary,
+		secondarySubscriptions: secondarySubscriptions,
+		secondarySubscribed:    map[string]bool{},
+		onSubscribeError:       onSubscribeError,
+		resumed:                make(chan struct{}),
+		closed:                 make(chan struct{}),
+	}
+	f.breaker = failover.NewBreaker(config, func(from, to failover.Target) {
+		if onSwitch != nil {
+			onSwitch(from, to)
+		}
+		switch f.secondarySubscriptions {
+		case SubscribeOnFailover:
+			if to == failover.Secondary {
+				f.subscribeSecondary()
+			}
+		case SubscribeStandby:
+			f.setStandbyResumed(to == failover.Secondary)
+		}
+	})
+	return f
+}
+
+// Init is a no-op: both components are initialized before they are paired
+func (f *FailoverPubSub) Init(metadata pubsub.Metadata) error {
+	return nil
+}
+
+// Active returns the component publishes are currently sent to
+func (f *FailoverPubSub) Active() failover.Target {
+	return f.breaker.Active()
+}
+
+// Publish sends a message to the active component.
+// A failed publish to the primary component is retried on the secondary component once the pair failed over.
+func (f *FailoverPubSub) Publish(req *pubsub.PublishRequest) error {
+	_, err := f.PublishWithMessageID(req)
+	return err
+}
+
+// PublishWithMessageID publishes like Publish and returns the message ID reported by the component the message was sent to
+func (f *FailoverPubSub) PublishWithMessageID(req *pubsub.PublishRequest) (string, error) {
+	target := f.breaker.Next()
+	id, err := Publish(f.get(target), req)
+	if err == nil {
+		f.breaker.Success(target)
+		return id, nil
+	}
+
+	f.breaker.Failure(target)
+	if target == failover.Primary && f.breaker.Active() == failover.Secondary {
+		return Publish(f.secondary, req)
+	}
+	return "", err
+}
+
+// Subscribe subscribes to a topic on the primary component, and on the secondary component as selected by secondarySubscriptions.
+// With standby subscriptions a failure to subscribe on the primary component fails the pair over right away.
+func (f *FailoverPubSub) Subscribe(req pubsub.SubscribeRequest, handler func(msg *pubsub.NewMessage) error) error {
+	f.lock.Lock()
+	f.subscriptions = append(f.subscriptions, subscription{req: req, handler: handler})
+	f.lock.Unlock()
+
+	switch f.secondarySubscriptions {
+	case SubscribeOnFailover:
+		err := f.primary.Subscribe(req, handler)
+		if f.breaker.Active() == failover.Secondary {
+			f.subscribeSecondary()
+		}
+		return err
+	case SubscribeStandby:
+		f.subscribeSecondary()
+		if err := f.primary.Subscribe(req, handler); err != nil {
+			f.breaker.Trip()
+			if f.isSecondarySubscribed(req.Topic) {
+				return nil
+			}
+			return err
+		}
+		return nil
+	default:
+		return f.primary.Subscribe(req, handler)
+	}
+}
+
+// subscribeSecondary makes the subscriptions not yet made on the secondary component.
+// Subscriptions on the primary component are kept so its backlog keeps being consumed.
+func (f *FailoverPubSub) subscribeSecondary() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for _, s := range f.subscriptions {
+		if f.secondarySubscribed[s.req.Topic] {
+			continue
+		}
+		handler := s.handler
+		if f.secondarySubscriptions == SubscribeStandby {
+			handler = f.standbyHandler(s.handler)
+		}
+		if err := f.secondary.Subscribe(s.req, handler); err != nil {
+			if f.onSubscribeError != nil {
+				f.onSubscribeError(s.req.Topic, err)
+			}
+			continue
+		}
+		f.secondarySubscribed[s.req.Topic] = true
+	}
+}
+
+func (f *FailoverPubSub) isSecondarySubscribed(topic string) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.secondarySubscribed[topic]
+}
+
+// standbyHandler holds the messages of a standby subscription until the pair fails over.
+// Messages are delivered while the pair is failed over and held again once it fails back.
+func (f *FailoverPubSub) standbyHandler(handler func(msg *pubsub.NewMessage) error) func(msg *pubsub.NewMessage) error {
+	return func(msg *pubsub.NewMessage) error {
+		f.lock.Lock()
+		resumed := f.resumed
+		f.lock.Unlock()
+
+		select {
+		case <-resumed:
+			return handler(msg)
+		case <-f.closed:
+			return errStandbyClosed
+		}
+	}
+}
+
+// setStandbyResumed resumes or pauses the delivery of the messages of the standby subscriptions
+func (f *FailoverPubSub) setStandbyResumed(resumed bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	select {
+	case <-f.resumed:
+		if !resumed {
+			f.resumed = make(chan struct{})
+		}
+	default:
+		if resumed {
+			close(f.resumed)
+		}
+	}
+}
+
+func (f *FailoverPubSub) get(target failover.Target) pubsub.PubSub {
+	if target == failover.Secondary {
+		return f.secondary
+	}
+	return f.primary
+}
+
+// Flush flushes both components of the pair
+func (f *FailoverPubSub) Flush() error {
+	return f.each(func(p pubsub.PubSub) error {
+		if fl, ok := p.(lifecycle.Flusher); ok {
+			return fl.Flush()
+		}
+		return nil
+	})
+}
+
+// Close releases the messages held by the standby subscriptions and closes both components of the pair
+func (f *FailoverPubSub) Close() error {
+	f.lock.Lock()
+	select {
+	case <-f.closed:
+	default:
+		close(f.closed)
+	}
+	f.lock.Unlock()
+
+	return f.each(func(p pubsub.PubSub) error {
+		if c, ok := p.(io.Closer); ok {
+			return c.Close()
+		}
+		return nil
+	})
+}
+
+// each runs fn on both components and returns the first error
+func (f *FailoverPubSub) each(fn func(p pubsub.PubSub) error) error {
+	err := fn(f.primary)
+	if serr := fn(f.secondary); err == nil {
+		err = serr
+	}
+	return err
+}
@@ -0,0 +1,214 @@
+package pubsub
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/failover"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakePubSub struct {
+	fail       bool
+	published  []string
+	subscribed []string
+}
+
+func (f *fakePubSub) Init(metadata pubsub.Metadata) error {
+	return nil
+}
+
+func (f *fakePubSub) Publish(req *pubsub.PublishRequest) error {
+	if f.fail {
+		return errors.New("broker unavailable")
+	}
+	f.published = append(f.published, req.Topic)
+	return nil
+}
+
+func (f *fakePubSub) Subscribe(req pubsub.SubscribeRequest, handler func(msg *pubsub.NewMessage) error) error {
+	f.subscribed = append(f.subscribed, req.Topic)
+	return nil
+}
+
+func TestFailoverPubSub(t *testing.T) {
+	config := failover.Config{Secondary: "dr", Threshold: 2, Cooldown: time.Hour}
+
+	t.Run("publishes to primary", func(t *testing.T) {
+		primary, secondary := &fakePubSub{}, &fakePubSub{}
+		f := NewFailoverPubSub(primary, secondary, config, SubscribeNever, nil, nil)
+		assert.NoError(t, f.Publish(&pubsub.PublishRequest{Topic: "orders"}))
+		assert.Equal(t, []string{"orders"}, primary.published)
+		assert.Empty(t, secondary.published)
+	})
+
+	t.Run("fails over after threshold", func(t *testing.T) {
+		primary, secondary := &fakePubSub{fail: true}, &fakePubSub{}
+		var switches []failover.Target
+		f := NewFailoverPubSub(primary, secondary, config, SubscribeOnFailover, func(from, to failover.Target) {
+			switches = append(switches, to)
+		}, nil)
+		assert.NoError(t, f.Subscribe(pubsub.SubscribeRequest{Topic: "orders"}, nil))
+		assert.Empty(t, secondary.subscribed)
+
+		assert.Error(t, f.Publish(&pubsub.PublishRequest{Topic: "orders"}))
+		assert.NoError(t, f.Publish(&pubsub.PublishRequest{Topic: "orders"}), "the publish tripping the failover is retried on the secondary")
+		assert.NoError(t, f.Publish(&pubsub.PublishRequest{Topic: "orders"}))
+
+		assert.Equal(t, failover.Secondary, f.Active())
+		assert.Equal(t, []string{"orders", "orders"}, secondary.published)
+		assert.Equal(t, []failover.Target{failover.Secondary}, switches)
+		assert.Equal(t, []string{"orders"}, secondary.subscribed)
+
+		assert.NoError(t, f.Subscribe(pubsub.SubscribeRequest{Topic: "payments"}, nil))
+		assert.Equal(t, []string{"orders", "payments"}, secondary.subscribed)
+	})
+
+	t.Run("failed probe falls back to secondary", func(t *testing.T) {
+		primary, secondary := &fakePubSub{fail: true}, &fakePubSub{}
+		f := NewFailoverPubSub(primary, secondary, failover.Config{Secondary: "dr", Threshold: 1, Cooldown: time.Millisecond}, SubscribeNever, nil, nil)
+		assert.NoError(t, f.Publish(&pubsub.PublishRequest{Topic: "orders"}))
+		time.Sleep(5 * time.Millisecond)
+		assert.NoError(t, f.Publish(&pubsub.PublishRequest{Topic: "orders"}))
+		assert.Equal(t, []string{"orders", "orders"}, secondary.published)
+	})
+
+	t.Run("no resubscribe", func(t *testing.T) {
+		primary, secondary := &fakePubSub{fail: true}, &fakePubSub{}
+		f := NewFailoverPubSub(primary, secondary, config, SubscribeNever, nil, nil)
+		assert.NoError(t, f.Subscribe(pubsub.SubscribeRequest{Topic: "orders"}, nil))
+		f.Publish(&pubsub.PublishRequest{Topic: "orders"})
+		f.Publish(&pubsub.PublishRequest{Topic: "orders"})
+		assert.Equal(t, failover.Secondary, f.Active())
+		assert.Empty(t, secondary.subscribed)
+	})
+}
+
+type handlerPubSub struct {
+	fakePubSub
+	subscribeErr error
+	handlers     map[string]func(msg *pubsub.NewMessage) error
+}
+
+func (h *handlerPubSub) Subscribe(req pubsub.SubscribeRequest, handler func(msg *pubsub.NewMessage) error) error {
+	if h.subscribeErr != nil {
+		return h.subscribeErr
+	}
+	if h.handlers == nil {
+		h.handlers = map[string]func(msg *pubsub.NewMessage) error{}
+	}
+	h.handlers[req.Topic] = handler
+	return nil
+}
+
+func TestStandbySubscriptions(t *testing.T) {
+	config := failover.Config{Secondary: "dr", Threshold: 1, Cooldown: time.Millisecond}
+	delivered := make(chan string, 10)
+	handler := func(msg *pubsub.NewMessage) error {
+		delivered <- msg.Topic
+		return nil
+	}
+	deliver := func(p *handlerPubSub, topic string) chan error {
+		result := make(chan error, 1)
+		go func() {
+			result <- p.handlers[topic](&pubsub.NewMessage{Topic: topic})
+		}()
+		return result
+	}
+
+	t.Run("messages are held until failover", func(t *testing.T) {
+		primary, secondary := &handlerPubSub{fakePubSub: fakePubSub{fail: true}}, &handlerPubSub{}
+		f := NewFailoverPubSub(primary, secondary, config, SubscribeStandby, nil, nil)
+		assert.NoError(t, f.Subscribe(pubsub.SubscribeRequest{Topic: "orders"}, handler))
+		assert.Contains(t, secondary.handlers, "orders")
+
+		result := deliver(secondary, "orders")
+		select {
+		case <-delivered:
+			assert.Fail(t, "standby message delivered before failover")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		assert.NoError(t, f.Publish(&pubsub.PublishRequest{Topic: "orders"}))
+		assert.Equal(t, failover.Secondary, f.Active())
+		assert.Equal(t, "orders", <-delivered)
+		assert.NoError(t, <-result)
+
+		// a successful probe of the primary fails back and pauses the standby subscriptions again
+		primary.fail = false
+		time.Sleep(5 * time.Millisecond)
+		assert.NoError(t, f.Publish(&pubsub.PublishRequest{Topic: "orders"}))
+		assert.Equal(t, failover.Primary, f.Active())
+		result = deliver(secondary, "orders")
+		assert.NoError(t, f.Close())
+		assert.Equal(t, errStandbyClosed, <-result)
+		assert.Empty(t, delivered)
+	})
+
+	t.Run("primary subscription failure fails over", func(t *testing.T) {
+		primary, secondary := &handlerPubSub{subscribeErr: errors.New("broker unavailable")}, &handlerPubSub{}
+		var switches []failover.Target
+		f := NewFailoverPubSub(primary, secondary, failover.Config{Secondary: "dr", Threshold: 5, Cooldown: time.Hour}, SubscribeStandby, func(from, to failover.Target) {
+			switches = append(switches, to)
+		}, nil)
+		assert.NoError(t, f.Subscribe(pubsub.SubscribeRequest{Topic: "orders"}, handler))
+		assert.Equal(t, []failover.Target{failover.Secondary}, switches)
+
+		assert.NoError(t, <-deliver(secondary, "orders"))
+		assert.Equal(t, "orders", <-delivered)
+	})
+
+	t.Run("both subscriptions failing", func(t *testing.T) {
+		err := errors.New("broker unavailable")
+		primary, secondary := &handlerPubSub{subscribeErr: err}, &handlerPubSub{subscribeErr: err}
+		var subscribeErrors []string
+		f := NewFailoverPubSub(primary, secondary, config, SubscribeStandby, nil, func(topic string, err error) {
+			subscribeErrors = append(subscribeErrors, topic)
+		})
+		assert.Error(t, f.Subscribe(pubsub.SubscribeRequest{Topic: "orders"}, handler))
+		assert.Equal(t, []string{"orders"}, subscribeErrors)
+	})
+}
+
+func TestSecondarySubscriptionsFromMetadata(t *testing.T) {
+	assert.Equal(t, SubscribeNever, SecondarySubscriptionsFromMetadata(map[string]string{}))
+	assert.Equal(t, SubscribeOnFailover, SecondarySubscriptionsFromMetadata(map[string]string{ResubscribeMetadataKey: "true"}))
+	assert.Equal(t, SubscribeStandby, SecondarySubscriptionsFromMetadata(map[string]string{ResubscribeMetadataKey: "true", StandbyMetadataKey: "True"}))
+}
+
+type fakeMessageIDPubSub struct {
+	fakePubSub
+}
+
+func (f *fakeMessageIDPubSub) PublishWithMessageID(req *pubsub.PublishRequest) (string, error) {
+	if err := f.Publish(req); err != nil {
+		return "", err
+	}
+	return "id-" + req.Topic, nil
+}
+
+func TestPublishMessageID(t *testing.T) {
+	t.Run("component without message IDs", func(t *testing.T) {
+		id, err := Publish(&fakePubSub{}, &pubsub.PublishRequest{Topic: "orders"})
+		assert.NoError(t, err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("component reporting message IDs", func(t *testing.T) {
+		id, err := Publish(&fakeMessageIDPubSub{}, &pubsub.PublishRequest{Topic: "orders"})
+		assert.NoError(t, err)
+		assert.Equal(t, "id-orders", id)
+	})
+
+	t.Run("failover pair reports the ID of the component published to", func(t *testing.T) {
+		config := failover.Config{Secondary: "dr", Threshold: 1, Cooldown: time.Hour}
+		primary, secondary := &fakeMessageIDPubSub{fakePubSub{fail: true}}, &fakeMessageIDPubSub{}
+		f := NewFailoverPubSub(primary, secondary, config, SubscribeNever, nil, nil)
+		id, err := Publish(f, &pubsub.PublishRequest{Topic: "orders"})
+		assert.NoError(t, err)
+		assert.Equal(t, "id-orders", id)
+		assert.Equal(t, []string{"orders"}, secondary.published)
+	})
+}
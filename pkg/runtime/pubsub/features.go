@@ -0,0 +1,80 @@
+package pubsub
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dapr/components-contrib/pubsub"
+)
+
+// Feature is an optional capability of a pubsub component
+type Feature string
+
+const (
+	// FeatureMessageID is the support for reporting the ID the broker assigned to a published message
+	FeatureMessageID Feature = "MESSAGE_ID"
+	// FeatureWildcardTopics is the support for subscribing to topic patterns
+	FeatureWildcardTopics Feature = "WILDCARD_TOPICS"
+	// FeatureBulkPublishTransactional is the support for publishing several messages atomically
+	FeatureBulkPublishTransactional Feature = "BULK_PUBLISH_TRANSACTIONAL"
+	// FeaturePriority is the support for delivering messages of higher priority first
+	FeaturePriority Feature = "PRIORITY"
+)
+
+// TransactionalBulkPublisher is implemented by pubsub components publishing several messages atomically:
+// either all the messages are visible to subscribers or none of them
+type TransactionalBulkPublisher interface {
+	PublishTransactional(reqs []*pubsub.PublishRequest) error
+}
+
+// Features returns the features supported by a pubsub component.
+// The features are derived from the interfaces the component implements.
+func Features(p pubsub.PubSub) []Feature {
+	features := []Feature{}
+	if p == nil {
+		return features
+	}
+	if _, ok := p.(MessageIDPublisher); ok {
+		features = append(features, FeatureMessageID)
+	}
+	if ws, ok := p.(WildcardSubscriber); ok && ws.SupportsWildcardTopics() {
+		features = append(features, FeatureWildcardTopics)
+	}
+	if _, ok := p.(TransactionalBulkPublisher); ok {
+		features = append(features, FeatureBulkPublishTransactional)
+	}
+	if _, ok := p.(PriorityPublisher); ok {
+		features = append(features, FeaturePriority)
+	}
+	return features
+}
+
+// FeatureError is returned when an operation requires a feature the pubsub component doesn't support
+type FeatureError struct {
+	PubSubName   string    `json:"pubsubName"`
+	Feature      Feature   `json:"feature"`
+	Capabilities []Feature `json:"capabilities"`
+}
+
+func (e *FeatureError) Error() string {
+	capabilities := make([]string, 0, len(e.Capabilities))
+	for _, c := range e.Capabilities {
+		capabilities = append(capabilities, string(c))
+	}
+	return fmt.Sprintf("pubsub %s does not support %s - supported features: [%s]", e.PubSubName, e.Feature, strings.Join(capabilities, ", "))
+}
+
+// RequireFeature returns a FeatureError if a pubsub component doesn't support a feature
+func RequireFeature(pubsubName string, p pubsub.PubSub, feature Feature) error {
+	capabilities := Features(p)
+	for _, f := range capabilities {
+		if f == feature {
+			return nil
+		}
+	}
+	return &FeatureError{
+		PubSubName:   pubsubName,
+		Feature:      feature,
+		Capabilities: capabilities,
+	}
+}
@@ -0,0 +1,28 @@
+package pubsub
+
+import (
+	"testing"
+
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/stretchr/testify/assert"
+)
+
+type transactionalPubSub struct {
+	nopPubSub
+}
+
+func (p *transactionalPubSub) PublishTransactional(reqs []*pubsub.PublishRequest) error { return nil }
+
+func TestFeatures(t *testing.T) {
+	assert.Empty(t, Features(nil))
+	assert.Empty(t, Features(&nopPubSub{}))
+	assert.Equal(t, []Feature{FeatureWildcardTopics}, Features(&wildcardPubSub{}))
+	assert.Equal(t, []Feature{FeatureBulkPublishTransactional}, Features(&transactionalPubSub{}))
+}
+
+func TestRequireFeature(t *testing.T) {
+	assert.NoError(t, RequireFeature("messagebus", &transactionalPubSub{}, FeatureBulkPublishTransactional))
+
+	err := RequireFeature("messagebus", &wildcardPubSub{}, FeatureBulkPublishTransactional)
+	assert.EqualError(t, err, "pubsub messagebus does not support BULK_PUBLISH_TRANSACTIONAL - supported features: [WILDCARD_TOPICS]")
+}
@@ -0,0 +1,67 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+)
+
+// filterEventVariable is the name the cloud event is bound to in filter expressions
+const filterEventVariable = "event"
+
+// Filter is a compiled CEL expression selecting the messages of a subscription that are delivered to the app.
+// The expression sees the cloud event as a map named event, e.g. `event.type == "order.created"`.
+// JSON numbers are doubles, so they are compared with double literals: `event.data.amount > 100.0`.
+type Filter struct {
+	expression string
+	program    cel.Program
+}
+
+// NewFilter compiles a filter expression. The expression must evaluate to a bool.
+func NewFilter(expression string) (*Filter, error) {
+	env, err := cel.NewEnv(cel.Declarations(
+		decls.NewIdent(filterEventVariable, decls.NewMapType(decls.String, decls.Dyn), nil)))
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("invalid filter %q: %s", expression, issues.Err())
+	}
+	if !proto.Equal(ast.ResultType(), decls.Bool) {
+		return nil, fmt.Errorf("invalid filter %q: the expression must evaluate to a bool", expression)
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter %q: %s", expression, err)
+	}
+	return &Filter{expression: expression, program: program}, nil
+}
+
+// String returns the expression of the filter
+func (f *Filter) String() string {
+	return f.expression
+}
+
+// Match evaluates the filter against a message holding a structured cloud event
+func (f *Filter) Match(data []byte) (bool, error) {
+	var event map[string]interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return false, fmt.Errorf("message is not a structured cloud event: %s", err)
+	}
+
+	out, _, err := f.program.Eval(map[string]interface{}{filterEventVariable: event})
+	if err != nil {
+		return false, err
+	}
+	match, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("filter %q didn't evaluate to a bool", f.expression)
+	}
+	return match, nil
+}
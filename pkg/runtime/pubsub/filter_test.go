@@ -0,0 +1,56 @@
+package pubsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	event := []byte(`{"id":"1","source":"orders","type":"order.created","specversion":"1.0","data":{"amount":120,"region":"eu"}}`)
+
+	t.Run("matches the cloud event", func(t *testing.T) {
+		f, err := NewFilter(`event.type == "order.created" && event.data.amount > 100.0`)
+		assert.NoError(t, err)
+		match, err := f.Match(event)
+		assert.NoError(t, err)
+		assert.True(t, match)
+	})
+
+	t.Run("doesn't match the cloud event", func(t *testing.T) {
+		f, err := NewFilter(`event.data.region == "us"`)
+		assert.NoError(t, err)
+		match, err := f.Match(event)
+		assert.NoError(t, err)
+		assert.False(t, match)
+	})
+
+	t.Run("missing attributes", func(t *testing.T) {
+		f, err := NewFilter(`has(event.subject) && event.subject == "a"`)
+		assert.NoError(t, err)
+		match, err := f.Match(event)
+		assert.NoError(t, err)
+		assert.False(t, match)
+
+		f, err = NewFilter(`event.subject == "a"`)
+		assert.NoError(t, err)
+		_, err = f.Match(event)
+		assert.Error(t, err)
+	})
+
+	t.Run("message isn't a cloud event", func(t *testing.T) {
+		f, err := NewFilter(`event.type == "order.created"`)
+		assert.NoError(t, err)
+		_, err = f.Match([]byte("order created"))
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid expressions", func(t *testing.T) {
+		_, err := NewFilter(`event.type ==`)
+		assert.Error(t, err)
+		_, err = NewFilter(`event.type`)
+		assert.Error(t, err)
+		_, err = NewFilter(`order.type == "a"`)
+		assert.Error(t, err)
+	})
+}
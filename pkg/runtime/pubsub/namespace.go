@@ -0,0 +1,58 @@
+package pubsub
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NamespaceTopicsMetadataKey is the metadata item of a pubsub component that prefixes the topics of the broker with the
+// namespace of the app, so apps of several namespaces share a broker without their topics colliding
+const NamespaceTopicsMetadataKey = "namespaceTopics"
+
+// TopicNamespace maps the topics of the app to the topics of the broker prefixed with its namespace, e.g. orders to
+// prod.orders. The prefix is a segment of its own, so orders.* matches prod.orders.created on the broker.
+// A nil TopicNamespace leaves topics as they are.
+type TopicNamespace struct {
+	prefix string
+}
+
+// TopicNamespaceFromMetadata returns the topic namespace of a pubsub component for apps of the namespace, default when
+// the app doesn't run in one. It returns false if the component doesn't prefix topics.
+func TopicNamespaceFromMetadata(properties map[string]string, namespace string) (*TopicNamespace, bool, error) {
+	v := properties[NamespaceTopicsMetadataKey]
+	if v == "" {
+		return nil, false, nil
+	}
+	enabled, err := strconv.ParseBool(v)
+	if err != nil {
+		return nil, true, fmt.Errorf("invalid %s %s: %s", NamespaceTopicsMetadataKey, v, err)
+	}
+	if !enabled {
+		return nil, true, nil
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+	return &TopicNamespace{prefix: namespace + topicSegmentSeparator}, true, nil
+}
+
+// BrokerTopic returns the topic or topic pattern of the broker for a topic of the app
+func (n *TopicNamespace) BrokerTopic(topic string) string {
+	if n == nil {
+		return topic
+	}
+	return n.prefix + topic
+}
+
+// AppTopic returns the topic of the app for a topic of the broker.
+// It returns false if the topic of the broker isn't in the namespace.
+func (n *TopicNamespace) AppTopic(topic string) (string, bool) {
+	if n == nil {
+		return topic, true
+	}
+	if !strings.HasPrefix(topic, n.prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(topic, n.prefix), true
+}
@@ -0,0 +1,41 @@
+package pubsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopicNamespaceFromMetadata(t *testing.T) {
+	for _, v := range []string{"", "false"} {
+		n, _, err := TopicNamespaceFromMetadata(map[string]string{NamespaceTopicsMetadataKey: v}, "prod")
+		assert.NoError(t, err)
+		assert.Nil(t, n)
+		assert.Equal(t, "orders", n.BrokerTopic("orders"))
+		topic, ok := n.AppTopic("prod.orders")
+		assert.True(t, ok)
+		assert.Equal(t, "prod.orders", topic)
+	}
+
+	_, ok, err := TopicNamespaceFromMetadata(map[string]string{NamespaceTopicsMetadataKey: "yes please"}, "prod")
+	assert.Error(t, err)
+	assert.True(t, ok)
+
+	n, _, err := TopicNamespaceFromMetadata(map[string]string{NamespaceTopicsMetadataKey: "true"}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "default.orders", n.BrokerTopic("orders"))
+}
+
+func TestTopicNamespace(t *testing.T) {
+	n, _, err := TopicNamespaceFromMetadata(map[string]string{NamespaceTopicsMetadataKey: "true"}, "prod")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "prod.orders", n.BrokerTopic("orders"))
+	assert.True(t, MatchTopic(n.BrokerTopic("orders.*"), "prod.orders.created"))
+
+	topic, ok := n.AppTopic("prod.orders.created")
+	assert.True(t, ok)
+	assert.Equal(t, "orders.created", topic)
+	_, ok = n.AppTopic("production.orders")
+	assert.False(t, ok)
+}
@@ -0,0 +1,58 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/dapr/components-contrib/pubsub"
+)
+
+const (
+	// PriorityMetadataKey is the publish metadata item with the priority of a message, from 0 to 9. Higher priorities
+	// are delivered first by brokers supporting priorities.
+	PriorityMetadataKey = "priority"
+	// MaxPriority is the highest priority of a message
+	MaxPriority = 9
+
+	// cloudEventPriorityField is the cloud event extension attribute carrying the priority of a message
+	cloudEventPriorityField = "priority"
+)
+
+// PriorityPublisher is implemented by pubsub components mapping the priority of a message to the priority of their broker
+type PriorityPublisher interface {
+	PublishWithPriority(req *pubsub.PublishRequest, priority int) (string, error)
+}
+
+// GetPriority returns the priority requested in the publish metadata.
+// It returns false when the metadata doesn't set one.
+func GetPriority(metadata map[string]string) (int, bool, error) {
+	v, ok := metadata[PriorityMetadataKey]
+	if !ok || v == "" {
+		return 0, false, nil
+	}
+	priority, err := strconv.Atoi(v)
+	if err != nil || priority < 0 || priority > MaxPriority {
+		return 0, true, fmt.Errorf("%s must be a number between 0 and %v", PriorityMetadataKey, MaxPriority)
+	}
+	return priority, true, nil
+}
+
+// SetCloudEventPriority returns the cloud event with its priority extension attribute set
+func SetCloudEventPriority(event []byte, priority int) ([]byte, error) {
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(event, &attributes); err != nil {
+		return nil, fmt.Errorf("event is not a structured cloud event: %s", err)
+	}
+	attributes[cloudEventPriorityField] = json.RawMessage(strconv.Itoa(priority))
+	return json.Marshal(attributes)
+}
+
+// PublishWithPriority publishes a message with the given priority if the pubsub component supports priorities, and
+// as any other message otherwise. It returns the ID the broker assigned to the message, if reported.
+func PublishWithPriority(p pubsub.PubSub, req *pubsub.PublishRequest, priority int) (string, error) {
+	if pp, ok := p.(PriorityPublisher); ok {
+		return pp.PublishWithPriority(req, priority)
+	}
+	return Publish(p, req)
+}
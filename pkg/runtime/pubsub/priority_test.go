@@ -0,0 +1,61 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/stretchr/testify/assert"
+)
+
+type priorityPubSub struct {
+	nopPubSub
+	priority int
+}
+
+func (m *priorityPubSub) PublishWithPriority(req *pubsub.PublishRequest, priority int) (string, error) {
+	m.priority = priority
+	return "broker-1", nil
+}
+
+func TestGetPriority(t *testing.T) {
+	_, ok, err := GetPriority(map[string]string{})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	priority, ok, err := GetPriority(map[string]string{PriorityMetadataKey: "7"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 7, priority)
+
+	for _, v := range []string{"-1", "10", "high"} {
+		_, ok, err := GetPriority(map[string]string{PriorityMetadataKey: v})
+		assert.Error(t, err, v)
+		assert.True(t, ok)
+	}
+}
+
+func TestSetCloudEventPriority(t *testing.T) {
+	b, err := SetCloudEventPriority([]byte(`{"id":"1","data":{"a":1}}`), 5)
+	assert.NoError(t, err)
+	var event map[string]interface{}
+	assert.NoError(t, json.Unmarshal(b, &event))
+	assert.Equal(t, float64(5), event["priority"])
+	assert.Equal(t, "1", event["id"])
+
+	_, err = SetCloudEventPriority([]byte("raw"), 5)
+	assert.Error(t, err)
+}
+
+func TestPublishWithPriority(t *testing.T) {
+	p := &priorityPubSub{}
+	id, err := PublishWithPriority(p, &pubsub.PublishRequest{Topic: "orders"}, 8)
+	assert.NoError(t, err)
+	assert.Equal(t, "broker-1", id)
+	assert.Equal(t, 8, p.priority)
+	assert.Contains(t, Features(p), FeaturePriority)
+
+	_, err = PublishWithPriority(&nopPubSub{}, &pubsub.PublishRequest{Topic: "orders"}, 8)
+	assert.NoError(t, err)
+	assert.NotContains(t, Features(&nopPubSub{}), FeaturePriority)
+}
@@ -0,0 +1,19 @@
+package pubsub
+
+import (
+	"github.com/dapr/components-contrib/pubsub"
+)
+
+// MessageIDPublisher is implemented by pubsub components reporting the ID the broker assigned to a published message
+type MessageIDPublisher interface {
+	PublishWithMessageID(req *pubsub.PublishRequest) (string, error)
+}
+
+// Publish publishes a message with a pubsub component and returns the ID the broker assigned to it.
+// The ID is empty when the component doesn't report it.
+func Publish(p pubsub.PubSub, req *pubsub.PublishRequest) (string, error) {
+	if mp, ok := p.(MessageIDPublisher); ok {
+		return mp.PublishWithMessageID(req)
+	}
+	return "", p.Publish(req)
+}
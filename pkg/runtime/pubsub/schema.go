@@ -0,0 +1,104 @@
+package pubsub
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const (
+	// CloudEventSchemaMetadataKey is the metadata item of a pubsub component with the JSON Schema published cloud events
+	// are validated against
+	CloudEventSchemaMetadataKey = "cloudEventSchema"
+	// CloudEventSchemaURLMetadataKey is the metadata item of a pubsub component with the URL of the JSON Schema published
+	// cloud events are validated against, e.g. file:///schemas/orders.json
+	CloudEventSchemaURLMetadataKey = "cloudEventSchemaURL"
+)
+
+// SchemaError is returned when a cloud event doesn't match the schema of its pubsub component
+type SchemaError struct {
+	Violations []string
+}
+
+func (e *SchemaError) Error() string {
+	return fmt.Sprintf("cloud event doesn't match the schema: %s", strings.Join(e.Violations, "; "))
+}
+
+// SchemaValidator validates published cloud events, envelope and extension attributes included, against a JSON Schema,
+// and against the schema of their topic in a schema registry. A nil SchemaValidator accepts every event.
+type SchemaValidator struct {
+	schema   *gojsonschema.Schema
+	registry *SchemaRegistry
+}
+
+// SchemaValidatorFromMetadata returns the validator of the schema and the schema registry declared by a pubsub
+// component. It returns false if the component declares neither.
+func SchemaValidatorFromMetadata(properties map[string]string) (*SchemaValidator, bool, error) {
+	registry, hasRegistry, err := SchemaRegistryFromMetadata(properties)
+	if err != nil {
+		return nil, true, err
+	}
+
+	inline, url := properties[CloudEventSchemaMetadataKey], properties[CloudEventSchemaURLMetadataKey]
+	var loader gojsonschema.JSONLoader
+	switch {
+	case inline != "" && url != "":
+		return nil, true, fmt.Errorf("%s and %s are mutually exclusive", CloudEventSchemaMetadataKey, CloudEventSchemaURLMetadataKey)
+	case inline != "":
+		loader = gojsonschema.NewStringLoader(inline)
+	case url != "":
+		loader = gojsonschema.NewReferenceLoader(url)
+	case hasRegistry:
+		return &SchemaValidator{registry: registry}, true, nil
+	default:
+		return nil, false, nil
+	}
+
+	schema, err := gojsonschema.NewSchema(loader)
+	if err != nil {
+		return nil, true, fmt.Errorf("invalid cloud event schema: %s", err)
+	}
+	return &SchemaValidator{schema: schema, registry: registry}, true, nil
+}
+
+// Validate returns a SchemaError if the cloud event doesn't match the schema
+func (v *SchemaValidator) Validate(data []byte) error {
+	if v == nil {
+		return nil
+	}
+	return validateSchema(v.schema, data)
+}
+
+// ValidateTopic returns a SchemaError if the cloud event doesn't match the schema or the schema of its topic in the
+// schema registry
+func (v *SchemaValidator) ValidateTopic(topic string, data []byte) error {
+	if err := v.Validate(data); err != nil || v == nil || v.registry == nil {
+		return err
+	}
+	schema, err := v.registry.Schema(topic)
+	if err != nil {
+		return err
+	}
+	return validateSchema(schema, data)
+}
+
+// validateSchema returns a SchemaError if the cloud event doesn't match a schema, nil for a nil schema
+func validateSchema(schema *gojsonschema.Schema, data []byte) error {
+	if schema == nil {
+		return nil
+	}
+
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return &SchemaError{Violations: []string{fmt.Sprintf("event is not valid JSON: %s", err)}}
+	}
+	if result.Valid() {
+		return nil
+	}
+	violations := make([]string, 0, len(result.Errors()))
+	for _, e := range result.Errors() {
+		violations = append(violations, e.String())
+	}
+	return &SchemaError{Violations: violations}
+}
@@ -0,0 +1,195 @@
+package pubsub
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const (
+	// SchemaRegistryURLMetadataKey is the metadata item of a pubsub component with the URL of the schema of each topic in
+	// a schema registry, where {topic} stands for the topic, e.g. https://registry.contoso.com/schemas/{topic}.json.
+	// Topics without a schema in the registry are not validated.
+	SchemaRegistryURLMetadataKey = "schemaRegistryURL"
+	// SchemaCacheTTLMetadataKey is the metadata item of a pubsub component with how long the schemas fetched from the
+	// registry are cached, e.g. 10m
+	SchemaCacheTTLMetadataKey = "schemaCacheTTL"
+	// SchemaBundlePathMetadataKey is the metadata item of a pubsub component with a directory of bundled schema files,
+	// named after their topic, e.g. orders.json. They are used when the registry can't be reached.
+	SchemaBundlePathMetadataKey = "schemaBundlePath"
+	// SchemaRegistryOfflineMetadataKey is the metadata item of a pubsub component validating events against the bundled
+	// schema files only, without reaching the registry
+	SchemaRegistryOfflineMetadataKey = "schemaRegistryOffline"
+
+	// DefaultSchemaCacheTTL is how long the schemas fetched from the registry are cached by default
+	DefaultSchemaCacheTTL = time.Minute * 5
+
+	// schemaFetchTimeout bounds the fetch of a schema from the registry
+	schemaFetchTimeout = time.Second * 5
+)
+
+// SchemaRegistry returns the schema of the events of a topic, from a schema registry or bundled schema files.
+// The schemas of the registry are cached, so validating events doesn't reach the registry for every event.
+type SchemaRegistry struct {
+	url     string
+	ttl     time.Duration
+	offline bool
+	bundle  map[string]*gojsonschema.Schema
+	client  *http.Client
+
+	lock  sync.Mutex
+	cache map[string]cachedSchema
+}
+
+// cachedSchema is a schema fetched from the registry, nil for a topic without a schema
+type cachedSchema struct {
+	schema  *gojsonschema.Schema
+	expires time.Time
+}
+
+// SchemaRegistryFromMetadata returns the schema registry declared by a pubsub component.
+// It returns false if the component declares neither a registry nor bundled schema files.
+func SchemaRegistryFromMetadata(properties map[string]string) (*SchemaRegistry, bool, error) {
+	url, bundlePath := properties[SchemaRegistryURLMetadataKey], properties[SchemaBundlePathMetadataKey]
+	if url == "" && bundlePath == "" {
+		return nil, false, nil
+	}
+
+	r := &SchemaRegistry{
+		url:    url,
+		ttl:    DefaultSchemaCacheTTL,
+		client: &http.Client{Timeout: schemaFetchTimeout},
+		cache:  map[string]cachedSchema{},
+	}
+	if v, ok := properties[SchemaRegistryOfflineMetadataKey]; ok {
+		offline, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, true, fmt.Errorf("invalid %s: %s", SchemaRegistryOfflineMetadataKey, err)
+		}
+		r.offline = offline
+	}
+	if url == "" {
+		r.offline = true
+	} else if !strings.Contains(url, "{topic}") {
+		return nil, true, fmt.Errorf("%s must contain {topic}", SchemaRegistryURLMetadataKey)
+	}
+	if r.offline && bundlePath == "" {
+		return nil, true, fmt.Errorf("%s is required to validate events offline", SchemaBundlePathMetadataKey)
+	}
+	if v, ok := properties[SchemaCacheTTLMetadataKey]; ok {
+		ttl, err := time.ParseDuration(v)
+		if err != nil || ttl < 0 {
+			return nil, true, fmt.Errorf("invalid %s %q", SchemaCacheTTLMetadataKey, v)
+		}
+		r.ttl = ttl
+	}
+	if bundlePath != "" {
+		bundle, err := loadSchemaBundle(bundlePath)
+		if err != nil {
+			return nil, true, err
+		}
+		r.bundle = bundle
+	}
+	return r, true, nil
+}
+
+// loadSchemaBundle compiles the schema files of a directory by topic
+func loadSchemaBundle(dir string) (map[string]*gojsonschema.Schema, error) {
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("%s %s is not a directory", SchemaBundlePathMetadataKey, dir)
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	bundle := make(map[string]*gojsonschema.Schema, len(files))
+	for _, f := range files {
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("error reading bundled schema %s: %s", f, err)
+		}
+		schema, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(b))
+		if err != nil {
+			return nil, fmt.Errorf("invalid bundled schema %s: %s", f, err)
+		}
+		bundle[strings.TrimSuffix(filepath.Base(f), ".json")] = schema
+	}
+	return bundle, nil
+}
+
+// Schema returns the schema of the events of a topic, or nil if the topic has no schema.
+// Offline, the schema is the bundled schema file of the topic. Otherwise the schema of the registry is used, cached for
+// the cache TTL. When the registry can't be reached, the expired schema or the bundled schema file is used if any.
+func (r *SchemaRegistry) Schema(topic string) (*gojsonschema.Schema, error) {
+	if r.offline {
+		return r.bundle[topic], nil
+	}
+
+	r.lock.Lock()
+	cached, ok := r.cache[topic]
+	r.lock.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.schema, nil
+	}
+
+	schema, err := r.fetch(topic)
+	if err != nil {
+		if ok {
+			return cached.schema, nil
+		}
+		if bundled, found := r.bundle[topic]; found {
+			return bundled, nil
+		}
+		return nil, err
+	}
+
+	r.lock.Lock()
+	r.cache[topic] = cachedSchema{schema: schema, expires: time.Now().Add(r.ttl)}
+	r.lock.Unlock()
+	return schema, nil
+}
+
+// fetch returns the schema of a topic from the registry, nil if the registry has no schema for the topic
+func (r *SchemaRegistry) fetch(topic string) (*gojsonschema.Schema, error) {
+	url := strings.Replace(r.url, "{topic}", topic, -1)
+
+	var b []byte
+	if strings.HasPrefix(url, "file://") {
+		var err error
+		b, err = ioutil.ReadFile(strings.TrimPrefix(url, "file://"))
+		if os.IsNotExist(err) {
+			return nil, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("error reading the schema of topic %s: %s", topic, err)
+		}
+	} else {
+		resp, err := r.client.Get(url)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching the schema of topic %s: %s", topic, err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("error fetching the schema of topic %s: status code %d", topic, resp.StatusCode)
+		}
+		if b, err = ioutil.ReadAll(resp.Body); err != nil {
+			return nil, fmt.Errorf("error fetching the schema of topic %s: %s", topic, err)
+		}
+	}
+
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(b))
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema of topic %s: %s", topic, err)
+	}
+	return schema, nil
+}
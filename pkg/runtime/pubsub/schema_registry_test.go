@@ -0,0 +1,182 @@
+package pubsub
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const paymentEventSchema = `{
+	"type": "object",
+	"required": ["data"],
+	"properties": {
+		"data": {"type": "object", "required": ["amount"]}
+	}
+}`
+
+// schemaRegistryServer serves the schema of the orders topic, counting the fetches, and fails while down is set
+func schemaRegistryServer(fetches *int32, down *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(fetches, 1)
+		if atomic.LoadInt32(down) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.URL.Path != "/schemas/orders.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(orderEventSchema))
+	}))
+}
+
+func schemaBundle(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "schemas")
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "payments.json"), []byte(paymentEventSchema), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "orders.json"), []byte(orderEventSchema), 0600))
+	return dir
+}
+
+func TestSchemaRegistryFromMetadata(t *testing.T) {
+	bundle := schemaBundle(t)
+	defer os.RemoveAll(bundle)
+
+	r, ok, err := SchemaRegistryFromMetadata(map[string]string{})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, r)
+
+	r, ok, err = SchemaRegistryFromMetadata(map[string]string{
+		SchemaRegistryURLMetadataKey: "https://registry/schemas/{topic}.json",
+		SchemaCacheTTLMetadataKey:    "1m",
+	})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, r.ttl)
+	assert.False(t, r.offline)
+
+	r, _, err = SchemaRegistryFromMetadata(map[string]string{SchemaBundlePathMetadataKey: bundle})
+	assert.NoError(t, err)
+	assert.True(t, r.offline)
+	assert.Len(t, r.bundle, 2)
+
+	invalid := []map[string]string{
+		{SchemaRegistryURLMetadataKey: "https://registry/schemas/orders.json"},
+		{SchemaRegistryURLMetadataKey: "https://registry/schemas/{topic}.json", SchemaCacheTTLMetadataKey: "often"},
+		{SchemaRegistryURLMetadataKey: "https://registry/schemas/{topic}.json", SchemaRegistryOfflineMetadataKey: "true"},
+		{SchemaRegistryURLMetadataKey: "https://registry/schemas/{topic}.json", SchemaRegistryOfflineMetadataKey: "maybe"},
+		{SchemaBundlePathMetadataKey: filepath.Join(bundle, "missing"), SchemaRegistryURLMetadataKey: "https://registry/{topic}"},
+	}
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(bundle, "invalid.json"), []byte(`{"type": 1}`), 0600))
+	invalid = append(invalid, map[string]string{SchemaBundlePathMetadataKey: bundle})
+	for _, properties := range invalid {
+		_, ok, err := SchemaRegistryFromMetadata(properties)
+		assert.True(t, ok)
+		assert.Error(t, err, properties)
+	}
+}
+
+func TestSchemaRegistryCache(t *testing.T) {
+	var fetches, down int32
+	server := schemaRegistryServer(&fetches, &down)
+	defer server.Close()
+
+	v, ok, err := SchemaValidatorFromMetadata(map[string]string{
+		SchemaRegistryURLMetadataKey: server.URL + "/schemas/{topic}.json",
+		SchemaCacheTTLMetadataKey:    "50ms",
+	})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	valid := []byte(`{"id":"1","type":"order.created","data":{"orderId":1}}`)
+	invalid := []byte(`{"id":"1","type":"order.deleted","data":{}}`)
+
+	t.Run("schemas are fetched once per cache TTL", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			assert.NoError(t, v.ValidateTopic("orders", valid))
+		}
+		assert.IsType(t, &SchemaError{}, v.ValidateTopic("orders", invalid))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+
+		time.Sleep(60 * time.Millisecond)
+		assert.NoError(t, v.ValidateTopic("orders", valid))
+		assert.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+	})
+
+	t.Run("topics without a schema are not validated", func(t *testing.T) {
+		assert.NoError(t, v.ValidateTopic("audit", invalid))
+		assert.NoError(t, v.ValidateTopic("audit", invalid))
+		assert.Equal(t, int32(3), atomic.LoadInt32(&fetches))
+	})
+
+	t.Run("expired schemas are used while the registry is down", func(t *testing.T) {
+		atomic.StoreInt32(&down, 1)
+		time.Sleep(60 * time.Millisecond)
+		assert.IsType(t, &SchemaError{}, v.ValidateTopic("orders", invalid))
+
+		err := v.ValidateTopic("payments", valid)
+		assert.Error(t, err)
+		assert.NotContains(t, err.Error(), "doesn't match")
+	})
+}
+
+func TestSchemaRegistryBundle(t *testing.T) {
+	bundle := schemaBundle(t)
+	defer os.RemoveAll(bundle)
+
+	payment := []byte(`{"id":"1","type":"payment.received","data":{"amount":10}}`)
+	invalidPayment := []byte(`{"id":"1","type":"payment.received","data":{}}`)
+
+	t.Run("offline", func(t *testing.T) {
+		var fetches, down int32
+		server := schemaRegistryServer(&fetches, &down)
+		defer server.Close()
+
+		v, _, err := SchemaValidatorFromMetadata(map[string]string{
+			SchemaRegistryURLMetadataKey:     server.URL + "/schemas/{topic}.json",
+			SchemaRegistryOfflineMetadataKey: "true",
+			SchemaBundlePathMetadataKey:      bundle,
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, v.ValidateTopic("payments", payment))
+		err = v.ValidateTopic("payments", invalidPayment)
+		assert.IsType(t, &SchemaError{}, err)
+		assert.True(t, strings.Contains(err.Error(), "amount"))
+		assert.NoError(t, v.ValidateTopic("audit", invalidPayment))
+		assert.Equal(t, int32(0), atomic.LoadInt32(&fetches))
+	})
+
+	t.Run("fallback when the registry is down", func(t *testing.T) {
+		var fetches int32
+		down := int32(1)
+		server := schemaRegistryServer(&fetches, &down)
+		defer server.Close()
+
+		v, _, err := SchemaValidatorFromMetadata(map[string]string{
+			SchemaRegistryURLMetadataKey: server.URL + "/schemas/{topic}.json",
+			SchemaBundlePathMetadataKey:  bundle,
+		})
+		assert.NoError(t, err)
+		assert.IsType(t, &SchemaError{}, v.ValidateTopic("payments", invalidPayment))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+	})
+
+	t.Run("component schema applies too", func(t *testing.T) {
+		v, _, err := SchemaValidatorFromMetadata(map[string]string{
+			CloudEventSchemaMetadataKey: `{"type": "object", "required": ["id"]}`,
+			SchemaBundlePathMetadataKey: bundle,
+		})
+		assert.NoError(t, err)
+		assert.IsType(t, &SchemaError{}, v.ValidateTopic("audit", []byte(`{"data":{}}`)))
+		assert.NoError(t, v.ValidateTopic("payments", payment))
+	})
+}
@@ -0,0 +1,64 @@
+package pubsub
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const orderEventSchema = `{
+	"type": "object",
+	"required": ["id", "type", "data"],
+	"properties": {
+		"type": {"enum": ["order.created"]},
+		"tenant": {"type": "string"},
+		"data": {"type": "object", "required": ["orderId"]}
+	}
+}`
+
+func TestSchemaValidatorFromMetadata(t *testing.T) {
+	v, ok, err := SchemaValidatorFromMetadata(map[string]string{})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, v)
+	assert.NoError(t, v.Validate([]byte("not json")))
+
+	_, ok, err = SchemaValidatorFromMetadata(map[string]string{CloudEventSchemaMetadataKey: `{"type": 1}`})
+	assert.True(t, ok)
+	assert.Error(t, err)
+
+	_, _, err = SchemaValidatorFromMetadata(map[string]string{
+		CloudEventSchemaMetadataKey:    orderEventSchema,
+		CloudEventSchemaURLMetadataKey: "file:///schemas/orders.json",
+	})
+	assert.Error(t, err)
+
+	dir, err := ioutil.TempDir("", "schema")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "orders.json")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(orderEventSchema), 0600))
+
+	v, ok, err = SchemaValidatorFromMetadata(map[string]string{CloudEventSchemaURLMetadataKey: "file://" + path})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.NoError(t, v.Validate([]byte(`{"id":"1","type":"order.created","data":{"orderId":1}}`)))
+}
+
+func TestSchemaValidatorValidate(t *testing.T) {
+	v, _, err := SchemaValidatorFromMetadata(map[string]string{CloudEventSchemaMetadataKey: orderEventSchema})
+	assert.NoError(t, err)
+
+	assert.NoError(t, v.Validate([]byte(`{"id":"1","type":"order.created","tenant":"contoso","data":{"orderId":1}}`)))
+
+	err = v.Validate([]byte(`{"id":"1","type":"order.deleted","tenant":42,"data":{}}`))
+	schemaErr, ok := err.(*SchemaError)
+	assert.True(t, ok)
+	assert.Len(t, schemaErr.Violations, 3)
+
+	err = v.Validate([]byte(`order created`))
+	assert.IsType(t, &SchemaError{}, err)
+}
@@ -4,4 +4,11 @@ type Subscription struct {
 	Topic    string            `json:"topic"`
 	Route    string            `json:"route"`
 	Metadata map[string]string `json:"metadata"`
+	// Filter is a CEL expression selecting the messages delivered to the app, see Filter
+	Filter string `json:"filter,omitempty"`
+	// MaxConcurrentHandlers is the maximum number of messages of the topic delivered to the app at the same time.
+	// Zero doesn't limit the concurrency.
+	MaxConcurrentHandlers int `json:"maxConcurrentHandlers,omitempty"`
+	// Transform shapes the messages of the topic before they are delivered to the app, see Transform
+	Transform *Transform `json:"transform,omitempty"`
 }
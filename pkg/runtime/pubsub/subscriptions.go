@@ -67,10 +67,16 @@ func GetSubscriptionsGRPC(channel daprclientv1pb.DaprClientClient, log logger.Lo
 			log.Debug(noSubscriptionsError)
 		} else {
 			for _, s := range resp.Subscriptions {
-				subscriptions = append(subscriptions, Subscription{
-					Topic:    s.GetTopic(),
-					Metadata: s.GetMetadata(),
-				})
+				subscription := Subscription{
+					Topic:                 s.GetTopic(),
+					Metadata:              s.GetMetadata(),
+					Filter:                s.GetFilter(),
+					MaxConcurrentHandlers: int(s.GetMaxConcurrentHandlers()),
+				}
+				if len(s.GetDataFields()) > 0 {
+					subscription.Transform = &Transform{Fields: s.GetDataFields()}
+				}
+				subscriptions = append(subscriptions, subscription)
 			}
 		}
 	}
@@ -0,0 +1,157 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/dapr/components-contrib/pubsub"
+)
+
+// cloudEventHeaderPrefix prefixes the attributes of a cloud event set as HTTP headers, as in the binary content mode of
+// the cloud events HTTP binding
+const cloudEventHeaderPrefix = "ce-"
+
+// Transform shapes the cloud events of a subscription into the messages the app expects.
+// A nil Transform delivers the cloud events as they are.
+type Transform struct {
+	// DataOnly delivers the data of the event instead of the whole event
+	DataOnly bool `json:"dataOnly,omitempty"`
+	// Fields projects the data of the event on these fields, with dots separating nested fields, e.g. customer.id.
+	// Fields missing from the data are left out.
+	Fields []string `json:"fields,omitempty"`
+	// AttributesAsHeaders delivers the attributes of the event as ce- headers, e.g. ce-type
+	AttributesAsHeaders bool `json:"attributesAsHeaders,omitempty"`
+}
+
+// TransformedMessage is the message delivered to the app for a cloud event
+type TransformedMessage struct {
+	Data        []byte
+	ContentType string
+	Headers     map[string]string
+}
+
+// Validate checks that the fields of the transform are valid
+func (t *Transform) Validate() error {
+	if t == nil {
+		return nil
+	}
+	for _, f := range t.Fields {
+		for _, segment := range strings.Split(f, ".") {
+			if segment == "" {
+				return fmt.Errorf("invalid transform field %q", f)
+			}
+		}
+	}
+	return nil
+}
+
+// Project returns the structured cloud event with its data projected on the fields of the transform
+func (t *Transform) Project(event []byte) ([]byte, error) {
+	if t == nil || len(t.Fields) == 0 {
+		return event, nil
+	}
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(event, &attributes); err != nil {
+		return nil, fmt.Errorf("message is not a structured cloud event: %s", err)
+	}
+	data, err := t.project(attributes["data"])
+	if err != nil {
+		return nil, err
+	}
+	attributes["data"] = data
+	return json.Marshal(attributes)
+}
+
+// Apply returns the message delivered to the app for a structured cloud event
+func (t *Transform) Apply(event []byte) (TransformedMessage, error) {
+	if t == nil {
+		return TransformedMessage{Data: event, ContentType: pubsub.ContentType}, nil
+	}
+	event, err := t.Project(event)
+	if err != nil {
+		return TransformedMessage{}, err
+	}
+	if !t.DataOnly && !t.AttributesAsHeaders {
+		return TransformedMessage{Data: event, ContentType: pubsub.ContentType}, nil
+	}
+
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(event, &attributes); err != nil {
+		return TransformedMessage{}, fmt.Errorf("message is not a structured cloud event: %s", err)
+	}
+	msg := TransformedMessage{Data: event, ContentType: pubsub.ContentType}
+	if t.AttributesAsHeaders {
+		msg.Headers = map[string]string{}
+		for name, value := range attributes {
+			if name == "data" || name == "data_base64" {
+				continue
+			}
+			msg.Headers[cloudEventHeaderPrefix+name] = attributeString(value)
+		}
+	}
+	if t.DataOnly {
+		contentType := attributeString(attributes["datacontenttype"])
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		msg.ContentType = contentType
+		msg.Data = attributes["data"]
+		if !strings.Contains(contentType, "json") {
+			// text data is delivered without its JSON quotes
+			msg.Data = []byte(attributeString(attributes["data"]))
+		}
+	}
+	return msg, nil
+}
+
+// project returns the data projected on the fields of the transform
+func (t *Transform) project(data json.RawMessage) (json.RawMessage, error) {
+	var object map[string]interface{}
+	if err := json.Unmarshal(data, &object); err != nil || object == nil {
+		return nil, fmt.Errorf("data of the event is not a JSON object")
+	}
+	projected := map[string]interface{}{}
+	for _, f := range t.Fields {
+		path := strings.Split(f, ".")
+		value, ok := lookupField(object, path)
+		if !ok {
+			continue
+		}
+		parent := projected
+		for _, segment := range path[:len(path)-1] {
+			child, ok := parent[segment].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				parent[segment] = child
+			}
+			parent = child
+		}
+		parent[path[len(path)-1]] = value
+	}
+	return json.Marshal(projected)
+}
+
+// lookupField returns the value of a nested field of a JSON object
+func lookupField(object map[string]interface{}, path []string) (interface{}, bool) {
+	var value interface{} = object
+	for _, segment := range path {
+		o, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = o[segment]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// attributeString returns a JSON string attribute without its quotes, and other attributes as JSON
+func attributeString(value json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		return s
+	}
+	return string(value)
+}
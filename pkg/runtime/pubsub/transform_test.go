@@ -0,0 +1,69 @@
+package pubsub
+
+import (
+	"testing"
+
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/stretchr/testify/assert"
+)
+
+const transformEvent = `{"id":"e1","type":"order.created","specversion":"0.3","datacontenttype":"application/json",` +
+	`"data":{"id":"o1","customer":{"id":"c1","name":"Ann"},"total":10}}`
+
+func TestTransformValidate(t *testing.T) {
+	var nilTransform *Transform
+	assert.NoError(t, nilTransform.Validate())
+	assert.NoError(t, (&Transform{Fields: []string{"id", "customer.id"}}).Validate())
+	assert.Error(t, (&Transform{Fields: []string{""}}).Validate())
+	assert.Error(t, (&Transform{Fields: []string{"customer..id"}}).Validate())
+}
+
+func TestTransformApply(t *testing.T) {
+	t.Run("nil transform", func(t *testing.T) {
+		var transform *Transform
+		msg, err := transform.Apply([]byte("not a cloud event"))
+		assert.NoError(t, err)
+		assert.Equal(t, "not a cloud event", string(msg.Data))
+		assert.Equal(t, pubsub.ContentType, msg.ContentType)
+	})
+
+	t.Run("projected fields", func(t *testing.T) {
+		transform := &Transform{Fields: []string{"id", "customer.id", "missing"}}
+		msg, err := transform.Apply([]byte(transformEvent))
+		assert.NoError(t, err)
+		assert.Equal(t, pubsub.ContentType, msg.ContentType)
+		assert.JSONEq(t, `{"id":"e1","type":"order.created","specversion":"0.3","datacontenttype":"application/json",`+
+			`"data":{"id":"o1","customer":{"id":"c1"}}}`, string(msg.Data))
+		assert.Empty(t, msg.Headers)
+	})
+
+	t.Run("data only with headers", func(t *testing.T) {
+		transform := &Transform{DataOnly: true, AttributesAsHeaders: true}
+		msg, err := transform.Apply([]byte(transformEvent))
+		assert.NoError(t, err)
+		assert.Equal(t, "application/json", msg.ContentType)
+		assert.JSONEq(t, `{"id":"o1","customer":{"id":"c1","name":"Ann"},"total":10}`, string(msg.Data))
+		assert.Equal(t, map[string]string{
+			"ce-id":              "e1",
+			"ce-type":            "order.created",
+			"ce-specversion":     "0.3",
+			"ce-datacontenttype": "application/json",
+		}, msg.Headers)
+	})
+
+	t.Run("text data only", func(t *testing.T) {
+		transform := &Transform{DataOnly: true}
+		msg, err := transform.Apply([]byte(`{"id":"e1","datacontenttype":"text/plain","data":"hello"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "text/plain", msg.ContentType)
+		assert.Equal(t, "hello", string(msg.Data))
+	})
+
+	t.Run("data that is not an object", func(t *testing.T) {
+		transform := &Transform{Fields: []string{"id"}}
+		_, err := transform.Apply([]byte(`{"id":"e1","data":"hello"}`))
+		assert.Error(t, err)
+		_, err = transform.Apply([]byte("not a cloud event"))
+		assert.Error(t, err)
+	})
+}
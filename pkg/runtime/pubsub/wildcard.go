@@ -0,0 +1,128 @@
+package pubsub
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/dapr/components-contrib/pubsub"
+)
+
+const (
+	// topicWildcard matches any single segment of a topic name in a topic pattern
+	topicWildcard = "*"
+	// topicSegmentSeparator separates the segments of a topic name, e.g. orders.eu.created
+	topicSegmentSeparator = "."
+)
+
+// ErrWildcardNotSupported is returned when a pubsub component can neither subscribe to nor list topics for a topic pattern
+var ErrWildcardNotSupported = errors.New("the pubsub component doesn't support wildcard topics")
+
+// WildcardSubscriber is implemented by pubsub components subscribing to topic patterns natively.
+// Messages are delivered with the name of the topic they were published to.
+type WildcardSubscriber interface {
+	SupportsWildcardTopics() bool
+}
+
+// TopicLister is implemented by pubsub components that list the topics of their broker.
+// The runtime expands topic patterns into the listed topics for components that don't subscribe to them natively.
+type TopicLister interface {
+	ListTopics() ([]string, error)
+}
+
+// IsWildcardTopic returns true if the topic is a pattern, like orders.*
+func IsWildcardTopic(topic string) bool {
+	return strings.Contains(topic, topicWildcard)
+}
+
+// MatchTopic returns true if the topic matches the pattern. Patterns are matched segment by segment:
+// a * segment matches any segment and * within a segment matches any characters, so orders.* matches orders.created
+// but not orders.eu.created.
+func MatchTopic(pattern, topic string) bool {
+	patternSegments := strings.Split(pattern, topicSegmentSeparator)
+	topicSegments := strings.Split(topic, topicSegmentSeparator)
+	if len(patternSegments) != len(topicSegments) {
+		return false
+	}
+	for i, p := range patternSegments {
+		if ok, err := path.Match(p, topicSegments[i]); err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateTopicPattern checks that a topic pattern is well formed
+func ValidateTopicPattern(pattern string) error {
+	for _, p := range strings.Split(pattern, topicSegmentSeparator) {
+		if p == "" {
+			return fmt.Errorf("topic pattern %s has an empty segment", pattern)
+		}
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid topic pattern %s: %s", pattern, err)
+		}
+	}
+	return nil
+}
+
+// ExpandTopic returns the topics to subscribe to for a topic pattern. The pattern itself is returned when the
+// component subscribes to patterns natively, otherwise the topics the component lists matching the pattern.
+func ExpandTopic(p pubsub.PubSub, pattern string) ([]string, error) {
+	if ws, ok := p.(WildcardSubscriber); ok && ws.SupportsWildcardTopics() {
+		return []string{pattern}, nil
+	}
+	lister, ok := p.(TopicLister)
+	if !ok {
+		return nil, ErrWildcardNotSupported
+	}
+
+	topics, err := lister.ListTopics()
+	if err != nil {
+		return nil, fmt.Errorf("error listing topics: %s", err)
+	}
+	matches := []string{}
+	for _, t := range topics {
+		if MatchTopic(pattern, t) {
+			matches = append(matches, t)
+		}
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+// MatchSubscription returns the subscribed topic or pattern a message of the topic is delivered for. A subscription to
+// the topic itself wins over patterns, then the most specific pattern.
+// The topic is returned as-is when nothing matches.
+func MatchSubscription(subscribed []string, topic string) string {
+	match := ""
+	for _, s := range subscribed {
+		if s == topic {
+			return s
+		}
+		if !IsWildcardTopic(s) || !MatchTopic(s, topic) {
+			continue
+		}
+		if match == "" || moreSpecific(s, match) {
+			match = s
+		}
+	}
+	if match == "" {
+		return topic
+	}
+	return match
+}
+
+// moreSpecific returns true if pattern a has fewer wildcards than b, or as many and more literal characters.
+// Remaining ties are broken by name for a stable choice.
+func moreSpecific(a, b string) bool {
+	ca, cb := strings.Count(a, topicWildcard), strings.Count(b, topicWildcard)
+	if ca != cb {
+		return ca < cb
+	}
+	if len(a) != len(b) {
+		return len(a) > len(b)
+	}
+	return a < b
+}
@@ -0,0 +1,80 @@
+package pubsub
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/stretchr/testify/assert"
+)
+
+type nopPubSub struct{}
+
+func (p *nopPubSub) Init(metadata pubsub.Metadata) error      { return nil }
+func (p *nopPubSub) Publish(req *pubsub.PublishRequest) error { return nil }
+func (p *nopPubSub) Subscribe(req pubsub.SubscribeRequest, handler func(msg *pubsub.NewMessage) error) error {
+	return nil
+}
+
+type wildcardPubSub struct {
+	nopPubSub
+}
+
+func (p *wildcardPubSub) SupportsWildcardTopics() bool { return true }
+
+type listingPubSub struct {
+	nopPubSub
+	topics []string
+	err    error
+}
+
+func (p *listingPubSub) ListTopics() ([]string, error) { return p.topics, p.err }
+
+func TestMatchTopic(t *testing.T) {
+	assert.True(t, MatchTopic("orders.*", "orders.created"))
+	assert.True(t, MatchTopic("*.created", "orders.created"))
+	assert.True(t, MatchTopic("orders.eu-*", "orders.eu-west"))
+	assert.False(t, MatchTopic("orders.*", "orders.eu.created"))
+	assert.False(t, MatchTopic("orders.*", "orders"))
+	assert.False(t, MatchTopic("orders.*", "payments.created"))
+}
+
+func TestValidateTopicPattern(t *testing.T) {
+	assert.NoError(t, ValidateTopicPattern("orders.*"))
+	assert.Error(t, ValidateTopicPattern("orders..*"))
+	assert.Error(t, ValidateTopicPattern("orders.[*"))
+}
+
+func TestExpandTopic(t *testing.T) {
+	t.Run("delegates to the component", func(t *testing.T) {
+		topics, err := ExpandTopic(&wildcardPubSub{}, "orders.*")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"orders.*"}, topics)
+	})
+
+	t.Run("expands with the listed topics", func(t *testing.T) {
+		p := &listingPubSub{topics: []string{"orders.updated", "payments.created", "orders.created"}}
+		topics, err := ExpandTopic(p, "orders.*")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"orders.created", "orders.updated"}, topics)
+	})
+
+	t.Run("listing fails", func(t *testing.T) {
+		_, err := ExpandTopic(&listingPubSub{err: errors.New("broker down")}, "orders.*")
+		assert.Error(t, err)
+	})
+
+	t.Run("not supported", func(t *testing.T) {
+		_, err := ExpandTopic(&nopPubSub{}, "orders.*")
+		assert.Equal(t, ErrWildcardNotSupported, err)
+	})
+}
+
+func TestMatchSubscription(t *testing.T) {
+	subscribed := []string{"orders.*", "*.*", "orders.created", "orders.eu-*"}
+	assert.Equal(t, "orders.created", MatchSubscription(subscribed, "orders.created"))
+	assert.Equal(t, "orders.eu-*", MatchSubscription(subscribed, "orders.eu-west"))
+	assert.Equal(t, "orders.*", MatchSubscription(subscribed, "orders.updated"))
+	assert.Equal(t, "*.*", MatchSubscription(subscribed, "payments.created"))
+	assert.Equal(t, "payments", MatchSubscription(subscribed, "payments"))
+}
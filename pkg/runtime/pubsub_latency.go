@@ -0,0 +1,35 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/dapr/components-contrib/pubsub"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+// DefaultPubSubSlowHandlerThreshold is the time the app may take to process a pub/sub message before a warning is logged
+const DefaultPubSubSlowHandlerThreshold = 5 * time.Second
+
+// recordAppResponse records the time the app took to process a message delivered at start, separately from the time
+// since the message was published when its cloud event carries a time attribute, so slow apps can be told apart from
+// slow brokers. Messages the app took longer than the slow handler threshold to process are reported.
+func (a *DaprRuntime) recordAppResponse(msg *pubsub.NewMessage, route string, start time.Time, err error) {
+	now := time.Now()
+	elapsed := now.Sub(start)
+	success := err == nil
+	diag.DefaultMonitoring.PubsubAppResponded(a.pubSubName, msg.Topic, route, success, elapsed)
+	if published, ok := runtime_pubsub.GetCloudEventTime(msg.Data); ok {
+		diag.DefaultMonitoring.PubsubMessageProcessed(a.pubSubName, msg.Topic, route, success, now.Sub(published))
+	}
+
+	if threshold := a.runtimeConfig.PubSubSlowHandlerThreshold; threshold > 0 && elapsed > threshold {
+		diag.DefaultMonitoring.PubsubSlowHandler(a.pubSubName, msg.Topic, route)
+		log.Warnf("app took %v to process a message of topic %s on route %s, above the slow handler threshold of %v", elapsed, msg.Topic, route, threshold)
+	}
+}
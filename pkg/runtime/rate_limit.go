@@ -0,0 +1,52 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"fmt"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/ratelimit"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+)
+
+// rateLimitKeyPrefix prefixes the keys of the buckets of the rate limits in the state stores sharing them
+const rateLimitKeyPrefix = "dapr-ratelimit"
+
+// rateLimitComponents creates the limiters of the components declaring a rate limit. The limits are shared through
+// the state stores as initialized, before the runtime wraps them with compression or a read cache, so every sidecar
+// reads the buckets as saved.
+func (a *DaprRuntime) rateLimitComponents() {
+	for _, c := range a.components {
+		name := c.ObjectMeta.Name
+		config, ok, err := ratelimit.ConfigFromMetadata(a.convertMetadataItemsToProperties(c.Spec.Metadata))
+		if err != nil {
+			log.Warnf("rate limit of component %s is disabled: %s", name, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		store, ok := a.initializedStateStores[config.StateStore]
+		if !ok {
+			log.Warnf("couldn't find initialized state store %s sharing the rate limit of component %s, the rate limit is disabled", config.StateStore, name)
+			continue
+		}
+
+		key := fmt.Sprintf("%s||%s||%s", a.runtimeConfig.ID, rateLimitKeyPrefix, name)
+		limiter := ratelimit.NewLimiter(name, key, config, store)
+		a.rateLimiters[name] = limiter
+		log.Infof("component %s is limited to %v calls per second shared through state store %s", name, config.Rate, config.StateStore)
+	}
+}
+
+// rateLimitStateStore wraps a state store whose component declares a rate limit
+func (a *DaprRuntime) rateLimitStateStore(name string, store state.Store) state.Store {
+	if limiter, ok := a.rateLimiters[name]; ok {
+		return runtime_state.NewRateLimitedStore(store, limiter)
+	}
+	return store
+}
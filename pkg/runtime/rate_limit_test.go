@@ -0,0 +1,76 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	state_loader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/dapr/dapr/pkg/ratelimit"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitComponents(t *testing.T) {
+	newRuntime := func(metadata ...components_v1alpha1.MetadataItem) *DaprRuntime {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		rt.stateStoreRegistry.Register(
+			state_loader.New("mock", func() state.Store {
+				return &mockSlowStateStore{}
+			}),
+		)
+		store := newStateStoreComponent("store", "state.mock", "")
+		store.Spec.Metadata = metadata
+		binding := components_v1alpha1.Component{}
+		binding.ObjectMeta.Name = "output"
+		binding.Spec.Type = "bindings.mock"
+		binding.Spec.Metadata = metadata
+		rt.components = []components_v1alpha1.Component{store, newStateStoreComponent("limits", "state.mock", ""), binding}
+		return rt
+	}
+
+	t.Run("rate limit shared through a state store", func(t *testing.T) {
+		rt := newRuntime(
+			components_v1alpha1.MetadataItem{Name: ratelimit.RateMetadataKey, Value: "10"},
+			components_v1alpha1.MetadataItem{Name: ratelimit.StateStoreMetadataKey, Value: "limits"},
+		)
+		assert.NoError(t, rt.initState(rt.stateStoreRegistry))
+
+		_, ok := unwrapStateStore(rt.stateStores["store"]).(*runtime_state.RateLimitedStore)
+		assert.True(t, ok)
+		_, ok = unwrapStateStore(rt.stateStores["limits"]).(*mockSlowStateStore)
+		assert.True(t, ok)
+		assert.Contains(t, rt.rateLimiters, "store")
+		assert.Contains(t, rt.rateLimiters, "output")
+	})
+
+	t.Run("missing state store disables the rate limit", func(t *testing.T) {
+		rt := newRuntime(
+			components_v1alpha1.MetadataItem{Name: ratelimit.RateMetadataKey, Value: "10"},
+			components_v1alpha1.MetadataItem{Name: ratelimit.StateStoreMetadataKey, Value: "missing"},
+		)
+		assert.NoError(t, rt.initState(rt.stateStoreRegistry))
+
+		_, ok := unwrapStateStore(rt.stateStores["store"]).(*mockSlowStateStore)
+		assert.True(t, ok)
+		assert.Empty(t, rt.rateLimiters)
+	})
+
+	t.Run("invalid rate limit disables it", func(t *testing.T) {
+		rt := newRuntime(
+			components_v1alpha1.MetadataItem{Name: ratelimit.RateMetadataKey, Value: "fast"},
+			components_v1alpha1.MetadataItem{Name: ratelimit.StateStoreMetadataKey, Value: "limits"},
+		)
+		assert.NoError(t, rt.initState(rt.stateStoreRegistry))
+
+		_, ok := unwrapStateStore(rt.stateStores["store"]).(*mockSlowStateStore)
+		assert.True(t, ok)
+		assert.Empty(t, rt.rateLimiters)
+	})
+}
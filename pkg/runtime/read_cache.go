@@ -0,0 +1,26 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"github.com/dapr/components-contrib/state"
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+)
+
+// cacheStateStore wraps a state store whose component enables the read cache of the sidecar
+func (a *DaprRuntime) cacheStateStore(c components_v1alpha1.Component, store state.Store) state.Store {
+	config, err := runtime_state.ReadCacheFromMetadata(a.convertMetadataItemsToProperties(c.Spec.Metadata))
+	if err != nil {
+		log.Warnf("read cache of state store %s is disabled: %s", c.ObjectMeta.Name, err)
+		return store
+	}
+	if !config.Enabled() {
+		return store
+	}
+	log.Infof("state store %s caches up to %v values read for %s", c.ObjectMeta.Name, config.MaxEntries, config.TTL)
+	return runtime_state.NewCachedStore(store, config)
+}
@@ -0,0 +1,46 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	state_loader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/modes"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheStateStores(t *testing.T) {
+	newRuntime := func(metadata ...components_v1alpha1.MetadataItem) *DaprRuntime {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		rt.stateStoreRegistry.Register(
+			state_loader.New("mock", func() state.Store {
+				return &mockSlowStateStore{}
+			}),
+		)
+		store := newStateStoreComponent("store", "state.mock", "")
+		store.Spec.Metadata = metadata
+		rt.components = []components_v1alpha1.Component{store}
+		return rt
+	}
+
+	t.Run("read cache enabled", func(t *testing.T) {
+		rt := newRuntime(components_v1alpha1.MetadataItem{Name: runtime_state.ReadCacheTTLMetadataKey, Value: "5s"})
+		assert.NoError(t, rt.initState(rt.stateStoreRegistry))
+		_, ok := unwrapStateStore(rt.stateStores["store"]).(*runtime_state.CachedStore)
+		assert.True(t, ok)
+	})
+
+	t.Run("invalid read cache disables it", func(t *testing.T) {
+		rt := newRuntime(components_v1alpha1.MetadataItem{Name: runtime_state.ReadCacheTTLMetadataKey, Value: "soon"})
+		assert.NoError(t, rt.initState(rt.stateStoreRegistry))
+		_, ok := unwrapStateStore(rt.stateStores["store"]).(*mockSlowStateStore)
+		assert.True(t, ok)
+	})
+}
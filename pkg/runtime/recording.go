@@ -0,0 +1,60 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dapr/dapr/pkg/recorder"
+)
+
+// initRecorder creates the recorder of Dapr API calls when a record file or binding is configured
+func (a *DaprRuntime) initRecorder() error {
+	var sink recorder.Sink
+	switch {
+	case a.runtimeConfig.RecordFile != "":
+		s, err := recorder.NewFileSink(a.runtimeConfig.RecordFile)
+		if err != nil {
+			return err
+		}
+		sink = s
+		log.Infof("recording Dapr API calls to file %s", a.runtimeConfig.RecordFile)
+	case a.runtimeConfig.RecordBinding != "":
+		if _, ok := a.outputBindings[a.runtimeConfig.RecordBinding]; !ok {
+			return fmt.Errorf("couldn't find output binding %s to record Dapr API calls", a.runtimeConfig.RecordBinding)
+		}
+		sink = recorder.NewBindingSink(a.runtimeConfig.RecordBinding, a.sendToOutputBinding)
+		log.Infof("recording Dapr API calls to output binding %s", a.runtimeConfig.RecordBinding)
+	default:
+		return nil
+	}
+
+	if a.runtimeConfig.RecordBodies {
+		log.Warn("recording the bodies of Dapr API calls, which may contain state values, messages and binding payloads")
+	}
+	log.Info("only Dapr HTTP API calls are recorded, gRPC API calls are not")
+	a.recorder = recorder.NewRecorder(sink, a.runtimeConfig.RecordBodies)
+	return nil
+}
+
+// startReplay replays a capture of Dapr API calls against the local HTTP API
+func (a *DaprRuntime) startReplay() {
+	if a.runtimeConfig.ReplayFile == "" {
+		return
+	}
+
+	go func() {
+		log.Infof("replaying Dapr API calls from %s at speed %v", a.runtimeConfig.ReplayFile, a.runtimeConfig.ReplaySpeed)
+		replayer := recorder.NewReplayer(fmt.Sprintf("http://localhost:%v", a.runtimeConfig.HTTPPort), a.runtimeConfig.ReplaySpeed)
+		result, err := replayer.ReplayFile(context.Background(), a.runtimeConfig.ReplayFile)
+		if err != nil {
+			log.Errorf("failed to replay Dapr API calls: %s", err)
+			return
+		}
+		log.Infof("replayed %v Dapr API calls: %v failed, %v returned a different status code, %v skipped without a recorded body", result.Total, result.Failed, result.Mismatched, result.Skipped)
+	}()
+}
@@ -0,0 +1,38 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"fmt"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+)
+
+// withRecovery runs fn and turns its panic into an error carrying the ID of the crash record, so the component
+// delivering a message or an event sees a failure and can redeliver it rather than the sidecar crashing
+func withRecovery(source string, fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			record := recordPanic(source, r)
+			err = fmt.Errorf("internal error, correlation id %s", record.ID)
+		}
+	}()
+	return fn()
+}
+
+// recoverLoop recovers the panic of a background loop, ending the loop with a crash record rather than the sidecar.
+// It must be deferred by the loop.
+func recoverLoop(source string) {
+	if r := recover(); r != nil {
+		recordPanic(source, r)
+	}
+}
+
+func recordPanic(source string, r interface{}) diag.CrashRecord {
+	record := diag.RecordPanic(source, r)
+	log.Errorf("recovered from panic in %s, correlation id %s: %s\n%s", source, record.ID, record.Panic, record.Stack)
+	return record
+}
@@ -0,0 +1,42 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"errors"
+	"testing"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRecovery(t *testing.T) {
+	err := withRecovery("topic orders", func() error {
+		panic("boom")
+	})
+	assert.Error(t, err)
+	crashes := diag.RecentCrashes()
+	record := crashes[len(crashes)-1]
+	assert.Equal(t, "topic orders", record.Source)
+	assert.Contains(t, err.Error(), record.ID)
+
+	failure := errors.New("app failed")
+	assert.Equal(t, failure, withRecovery("topic orders", func() error {
+		return failure
+	}))
+}
+
+func TestRecoverLoop(t *testing.T) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer recoverLoop("binding kafka")
+		panic("boom")
+	}()
+	<-done
+	crashes := diag.RecentCrashes()
+	assert.Equal(t, "binding kafka", crashes[len(crashes)-1].Source)
+}
@@ -28,11 +28,13 @@ import (
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/actors"
 	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/apptoken"
 	"github.com/dapr/dapr/pkg/channel"
 	http_channel "github.com/dapr/dapr/pkg/channel/http"
 	"github.com/dapr/dapr/pkg/components"
 	bindings_loader "github.com/dapr/dapr/pkg/components/bindings"
 	exporter_loader "github.com/dapr/dapr/pkg/components/exporters"
+	"github.com/dapr/dapr/pkg/components/lifecycle"
 	http_middleware_loader "github.com/dapr/dapr/pkg/components/middleware/http"
 	pubsub_loader "github.com/dapr/dapr/pkg/components/pubsub"
 	secretstores_loader "github.com/dapr/dapr/pkg/components/secretstores"
@@ -41,6 +43,7 @@ import (
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/discovery"
+	"github.com/dapr/dapr/pkg/failover"
 	"github.com/dapr/dapr/pkg/grpc"
 	"github.com/dapr/dapr/pkg/http"
 	"github.com/dapr/dapr/pkg/logger"
@@ -51,18 +54,28 @@ import (
 	"github.com/dapr/dapr/pkg/operator/client"
 	daprclientv1pb "github.com/dapr/dapr/pkg/proto/daprclient/v1"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
+	"github.com/dapr/dapr/pkg/ratelimit"
+	"github.com/dapr/dapr/pkg/recorder"
+	runtime_bindings "github.com/dapr/dapr/pkg/runtime/bindings"
 	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 	"github.com/dapr/dapr/pkg/runtime/security"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
 	"github.com/dapr/dapr/pkg/scopes"
+	"github.com/dapr/dapr/pkg/throttle"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/golang/protobuf/ptypes/empty"
 	jsoniter "github.com/json-iterator/go"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
 	appConfigEndpoint   = "dapr/config"
 	parallelConcurrency = "parallel"
 	actorStateStore     = "actorStateStore"
+
+	// bulkWriteParallelism is the number of entries of a bulk binding write written at once by bindings without batches
+	bulkWriteParallelism = 10
+	bulkWriteOperation   = "InvokeBindingBulkAlpha1"
 )
 
 var log = logger.NewLogger("dapr.runtime")
@@ -71,9 +84,11 @@ var log = logger.NewLogger("dapr.runtime")
 type DaprRuntime struct {
 	runtimeConfig            *Config
 	globalConfig             *config.Configuration
+	globalConfigErr          error
 	components               []components_v1alpha1.Component
 	grpc                     *grpc.Manager
 	appChannel               channel.AppChannel
+	appChannelBudgets        *channel.Budgets
 	appConfig                config.ApplicationConfig
 	directMessaging          messaging.DirectMessaging
 	stateStoreRegistry       state_loader.Registry
@@ -81,6 +96,9 @@ type DaprRuntime struct {
 	exporterRegistry         exporter_loader.Registry
 	serviceDiscoveryRegistry servicediscovery_loader.Registry
 	stateStores              map[string]state.Store
+	initializedStateStores   map[string]state.Store
+	stateStoreDefaults       map[string]runtime_state.Defaults
+	stateKeyPrefixes         map[string]string
 	actor                    actors.Actors
 	bindingsRegistry         bindings_loader.Registry
 	inputBindings            map[string]bindings.InputBinding
@@ -88,6 +106,14 @@ type DaprRuntime struct {
 	secretStores             map[string]secretstores.SecretStore
 	pubSubRegistry           pubsub_loader.Registry
 	pubSub                   pubsub.PubSub
+	pubSubName               string
+	cloudEventSchema         *runtime_pubsub.SchemaValidator
+	topicACL                 *runtime_pubsub.TopicACL
+	publishCompression       *runtime_pubsub.Compressor
+	topicNamespace           *runtime_pubsub.TopicNamespace
+	appIdentity              string
+	memoryThrottle           *throttle.MemoryThrottle
+	appTokenValidator        *apptoken.Validator
 	servicediscoveryResolver servicediscovery.Resolver
 	json                     jsoniter.API
 	httpMiddlewareRegistry   http_middleware_loader.Registry
@@ -102,6 +128,24 @@ type DaprRuntime struct {
 	daprHTTPAPI              http.API
 	operatorClient           operatorv1pb.OperatorClient
 	topicRoutes              map[string]string
+	subscribedTopics         []string
+	topicFilters             map[string]*runtime_pubsub.Filter
+	topicHandlerSlots        map[string]chan struct{}
+	topicTransforms          map[string]*runtime_pubsub.Transform
+	deliveryTracker          *runtime_pubsub.DeliveryTracker
+	componentsLock           sync.RWMutex
+	componentInitTimings     []componentInitTiming
+	inventoryLock            sync.RWMutex
+	subscriptions            []http.SubscriptionMetadata
+	bindingEventTimes        map[string]time.Time
+	bindingSchedules         map[string]*runtime_bindings.Schedule
+	bindingLanes             map[string]*runtime_bindings.Lanes
+	rateLimiters             map[string]*ratelimit.Limiter
+	recorder                 *recorder.Recorder
+	inFlight                 map[string]*lifecycle.InFlight
+	inFlightLock             sync.Mutex
+	shutdownC                chan struct{}
+	shutdownOnce             sync.Once
 }
 
 // NewDaprRuntime returns a new runtime with the given runtime config and global config
@@ -115,6 +159,9 @@ func NewDaprRuntime(runtimeConfig *Config, globalConfig *config.Configuration) *
 		outputBindings:           map[string]bindings.OutputBinding{},
 		secretStores:             map[string]secretstores.SecretStore{},
 		stateStores:              map[string]state.Store{},
+		initializedStateStores:   map[string]state.Store{},
+		stateStoreDefaults:       map[string]runtime_state.Defaults{},
+		stateKeyPrefixes:         map[string]string{},
 		stateStoreRegistry:       state_loader.NewRegistry(),
 		bindingsRegistry:         bindings_loader.NewRegistry(),
 		pubSubRegistry:           pubsub_loader.NewRegistry(),
@@ -123,6 +170,16 @@ func NewDaprRuntime(runtimeConfig *Config, globalConfig *config.Configuration) *
 		serviceDiscoveryRegistry: servicediscovery_loader.NewRegistry(),
 		httpMiddlewareRegistry:   http_middleware_loader.NewRegistry(),
 		topicRoutes:              map[string]string{},
+		topicFilters:             map[string]*runtime_pubsub.Filter{},
+		topicHandlerSlots:        map[string]chan struct{}{},
+		topicTransforms:          map[string]*runtime_pubsub.Transform{},
+		deliveryTracker:          runtime_pubsub.NewDeliveryTracker(),
+		bindingEventTimes:        map[string]time.Time{},
+		bindingSchedules:         map[string]*runtime_bindings.Schedule{},
+		bindingLanes:             map[string]*runtime_bindings.Lanes{},
+		rateLimiters:             map[string]*ratelimit.Limiter{},
+		inFlight:                 map[string]*lifecycle.InFlight{},
+		shutdownC:                make(chan struct{}),
 	}
 }
 
@@ -137,6 +194,10 @@ func (a *DaprRuntime) Run(opts ...Option) error {
 		opt(&o)
 	}
 
+	if a.runtimeConfig.ValidateOnly {
+		a.runValidation(&o)
+	}
+
 	err := a.initRuntime(&o)
 	if err != nil {
 		return err
@@ -154,12 +215,14 @@ func (a *DaprRuntime) Run(opts ...Option) error {
 
 	d := time.Since(start).Seconds() * 1000
 	log.Infof("dapr initialized. Status: Running. Init Elapsed %vms", d)
+	a.logComponentInitReport()
 
 	if a.daprHTTPAPI != nil {
 		// gRPC server start failure is logged as Fatal in initRuntime method. Setting the status only when runtime is initialized.
 		a.daprHTTPAPI.MarkStatusAsReady()
 	}
 
+	a.startReplay()
 	return nil
 }
 
@@ -184,6 +247,7 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 		return err
 	}
 	a.namespace = a.getNamespace()
+	a.memoryThrottle = a.newMemoryThrottle()
 	a.operatorClient, err = a.getOperatorClient()
 	if err != nil {
 		return err
@@ -212,6 +276,13 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 
 	a.loadAppConfiguration()
 
+	failoverErrs := checkFailoverComponents(a.components)
+	for _, c := range a.components {
+		if err, ok := failoverErrs[c.ObjectMeta.Name]; ok {
+			return fmt.Errorf("invalid failover configuration of component %s: %s", c.ObjectMeta.Name, err)
+		}
+	}
+
 	// Register and initialize state stores
 	a.stateStoreRegistry.Register(opts.states...)
 	err = a.initState(a.stateStoreRegistry)
@@ -244,7 +315,18 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 	a.bindingsRegistry.RegisterInputBindings(opts.inputBindings...)
 	a.bindingsRegistry.RegisterOutputBindings(opts.outputBindings...)
 	a.initBindings()
-	a.initDirectMessaging(a.servicediscoveryResolver)
+	err = a.initDirectMessaging(a.servicediscoveryResolver)
+	if err != nil {
+		return err
+	}
+	a.appTokenValidator, err = apptoken.NewValidator(a.globalConfig.Spec.AppTokenValidationSpec)
+	if err != nil {
+		return fmt.Errorf("invalid app token validation configuration: %s", err)
+	}
+	err = a.initRecorder()
+	if err != nil {
+		return fmt.Errorf("failed to init recorder: %s", err)
+	}
 
 	err = a.initActors()
 	if err != nil {
@@ -324,6 +406,7 @@ func (a *DaprRuntime) initBindings() {
 func (a *DaprRuntime) beginReadInputBindings() error {
 	for key, b := range a.inputBindings {
 		go func(name string, binding bindings.InputBinding) {
+			defer recoverLoop("binding " + name)
 			err := a.readFromBinding(name, binding)
 			if err != nil {
 				log.Errorf("error reading from input binding %s: %s", name, err)
@@ -344,29 +427,198 @@ func (a *DaprRuntime) beginPubSub() error {
 	}
 
 	if a.pubSub != nil && a.appChannel != nil {
-		a.topicRoutes = a.getTopicRoutes()
-
-		for t := range a.topicRoutes {
-			allowed := a.isPubSubOperationAllowed(t, a.scopedSubscriptions)
-			if !allowed {
+		subscriptions := a.getTopicSubscriptions()
+		a.topicRoutes = map[string]string{}
+		// filters, handler limits and transforms are prepared before subscribing as they apply as soon as the first topic
+		// is subscribed
+		a.topicFilters = map[string]*runtime_pubsub.Filter{}
+		a.topicHandlerSlots = map[string]chan struct{}{}
+		a.topicTransforms = map[string]*runtime_pubsub.Transform{}
+		subscriptionErrors := map[string]error{}
+		a.subscribedTopics = nil
+		for t, s := range subscriptions {
+			a.topicRoutes[t] = s.Route
+			a.subscribedTopics = append(a.subscribedTopics, t)
+			if s.MaxConcurrentHandlers < 0 {
+				subscriptionErrors[t] = fmt.Errorf("maxConcurrentHandlers must not be negative: %v", s.MaxConcurrentHandlers)
+				continue
+			}
+			if s.MaxConcurrentHandlers > 0 {
+				a.topicHandlerSlots[t] = make(chan struct{}, s.MaxConcurrentHandlers)
+			}
+			if s.Filter != "" {
+				filter, err := runtime_pubsub.NewFilter(s.Filter)
+				if err != nil {
+					subscriptionErrors[t] = err
+					continue
+				}
+				a.topicFilters[t] = filter
+			}
+			if s.Transform != nil {
+				if err := s.Transform.Validate(); err != nil {
+					subscriptionErrors[t] = err
+					continue
+				}
+				a.topicTransforms[t] = s.Transform
+			}
+		}
+		publishFunc = a.stripTopicNamespace(a.decompressMessages(a.filterMessages(a.trackDeliveries(a.limitHandlerConcurrency(publishFunc)))))
+
+		for t, s := range subscriptions {
+			route := s.Route
+			if runtime_pubsub.IsWildcardTopic(t) {
+				// the topics a pattern expands to are checked when subscribing
+				if err := runtime_pubsub.ValidateTopicPattern(t); err != nil {
+					log.Warnf("failed to subscribe to topic %s: %s", t, err)
+					a.recordSubscription(t, route, http.SubscriptionStatusFailed)
+					continue
+				}
+			} else if !a.isPubSubOperationAllowed(t, a.scopedSubscriptions) {
 				log.Warnf("subscription to topic %s is not allowed", t)
+				a.recordSubscription(t, route, http.SubscriptionStatusDenied)
+				continue
+			} else if err := a.authorizeSubscription(t); err != nil {
+				log.Warnf("subscription to topic %s is not allowed: %s", t, err)
+				a.recordSubscription(t, route, http.SubscriptionStatusDenied)
 				continue
 			}
 
-			err := a.pubSub.Subscribe(pubsub.SubscribeRequest{
-				Topic: t,
-			}, publishFunc)
+			dependencies, err := runtime_pubsub.GetDependencies(s)
 			if err != nil {
 				log.Warnf("failed to subscribe to topic %s: %s", t, err)
+				a.recordSubscription(t, route, http.SubscriptionStatusFailed)
+				continue
 			}
+			if err, ok := subscriptionErrors[t]; ok {
+				log.Warnf("failed to subscribe to topic %s: %s", t, err)
+				a.recordSubscription(t, route, http.SubscriptionStatusFailed)
+				continue
+			}
+			if !dependencies.Empty() {
+				a.recordSubscription(t, route, http.SubscriptionStatusPending)
+				go a.subscribeWhenReady(t, route, dependencies, publishFunc)
+				continue
+			}
+
+			a.subscribeTopic(t, route, publishFunc)
 		}
 	}
 
 	return nil
 }
 
-func (a *DaprRuntime) initDirectMessaging(resolver servicediscovery.Resolver) {
-	a.directMessaging = messaging.NewDirectMessaging(
+// stripTopicNamespace delivers the messages of the topics of the broker prefixed with the namespace of the app with
+// the topic of the app
+func (a *DaprRuntime) stripTopicNamespace(publishFunc func(msg *pubsub.NewMessage) error) func(msg *pubsub.NewMessage) error {
+	return func(msg *pubsub.NewMessage) error {
+		topic, ok := a.topicNamespace.AppTopic(msg.Topic)
+		if !ok {
+			log.Warnf("dropping a message of topic %s outside of the namespace of the app", msg.Topic)
+			return nil
+		}
+		msg.Topic = topic
+		return publishFunc(msg)
+	}
+}
+
+// decompressMessages restores the data of the messages compressed by their publisher before they are filtered and
+// delivered to the app
+func (a *DaprRuntime) decompressMessages(publishFunc func(msg *pubsub.NewMessage) error) func(msg *pubsub.NewMessage) error {
+	return func(msg *pubsub.NewMessage) error {
+		data, err := runtime_pubsub.DecompressCloudEvent(msg.Data)
+		if err != nil {
+			log.Warnf("error decompressing a message of topic %s: %s", msg.Topic, err)
+			return err
+		}
+		msg.Data = data
+		return publishFunc(msg)
+	}
+}
+
+// filterMessages acknowledges the messages the filter of their subscription doesn't match instead of delivering them.
+// Messages the filter can't be evaluated against are delivered.
+func (a *DaprRuntime) filterMessages(publishFunc func(msg *pubsub.NewMessage) error) func(msg *pubsub.NewMessage) error {
+	return func(msg *pubsub.NewMessage) error {
+		if filter, ok := a.topicFilters[a.subscriptionTopic(msg.Topic)]; ok {
+			match, err := filter.Match(msg.Data)
+			if err != nil {
+				log.Warnf("error evaluating filter %s of topic %s, delivering the message: %s", filter, msg.Topic, err)
+			} else if !match {
+				diag.DefaultMonitoring.PubsubMessageFiltered(a.pubSubName, msg.Topic)
+				return nil
+			}
+		}
+		return publishFunc(msg)
+	}
+}
+
+// trackDeliveries records the messages of every subscription being delivered to the app, when they were last
+// delivered and how often the app was redelivered the messages it failed to process
+func (a *DaprRuntime) trackDeliveries(publishFunc func(msg *pubsub.NewMessage) error) func(msg *pubsub.NewMessage) error {
+	return func(msg *pubsub.NewMessage) error {
+		done := a.deliveryTracker.Start(a.subscriptionTopic(msg.Topic), msg.Data)
+		err := publishFunc(msg)
+		done(err)
+		return err
+	}
+}
+
+// limitHandlerConcurrency holds the messages of a subscription with maxConcurrentHandlers while the app is already
+// handling that many of its messages, so a slow handler only holds back the messages of its own subscription.
+func (a *DaprRuntime) limitHandlerConcurrency(publishFunc func(msg *pubsub.NewMessage) error) func(msg *pubsub.NewMessage) error {
+	return func(msg *pubsub.NewMessage) error {
+		slots, ok := a.topicHandlerSlots[a.subscriptionTopic(msg.Topic)]
+		if !ok {
+			return publishFunc(msg)
+		}
+		slots <- struct{}{}
+		defer func() { <-slots }()
+		return publishFunc(msg)
+	}
+}
+
+// subscribeTopic subscribes to a topic on the pub/sub component and records the result.
+// Topic patterns are subscribed to natively if the component supports it, or expanded into the matching topics
+// of the broker that aren't subscribed to on their own.
+func (a *DaprRuntime) subscribeTopic(topic, route string, publishFunc func(msg *pubsub.NewMessage) error) {
+	topics := []string{topic}
+	if runtime_pubsub.IsWildcardTopic(topic) {
+		var err error
+		topics, err = a.expandTopic(topic)
+		if err != nil {
+			log.Warnf("failed to subscribe to topic %s: %s", topic, err)
+			a.recordSubscription(topic, route, http.SubscriptionStatusFailed)
+			return
+		}
+		if len(topics) == 0 {
+			log.Warnf("topic pattern %s doesn't match any topic the app is allowed to subscribe to", topic)
+			a.recordSubscription(topic, route, http.SubscriptionStatusFailed)
+			return
+		}
+		log.Infof("topic pattern %s subscribes to topics %v", topic, topics)
+	}
+
+	for _, t := range topics {
+		source := "topic " + t
+		err := a.pubSub.Subscribe(pubsub.SubscribeRequest{
+			Topic: a.topicNamespace.BrokerTopic(t),
+		}, func(msg *pubsub.NewMessage) error {
+			return withRecovery(source, func() error {
+				return publishFunc(msg)
+			})
+		})
+		if err != nil {
+			log.Warnf("failed to subscribe to topic %s: %s", t, err)
+			a.recordSubscription(topic, route, http.SubscriptionStatusFailed)
+			return
+		}
+	}
+	a.recordSubscription(topic, route, http.SubscriptionStatusActive)
+}
+
+func (a *DaprRuntime) initDirectMessaging(resolver servicediscovery.Resolver) error {
+	var err error
+	a.directMessaging, err = messaging.NewDirectMessaging(
 		a.runtimeConfig.ID,
 		a.namespace,
 		a.runtimeConfig.InternalGRPCPort,
@@ -374,7 +626,12 @@ func (a *DaprRuntime) initDirectMessaging(resolver servicediscovery.Resolver) {
 		a.appChannel,
 		a.grpc.GetGRPCConnection,
 		resolver,
-		a.globalConfig.Spec.TracingSpec)
+		a.globalConfig.Spec.TracingSpec,
+		a.globalConfig.Spec.CrossNamespaceSpec,
+		a.globalConfig.Spec.HeaderForwardingSpec,
+		a.globalConfig.Spec.InvocationCacheSpec,
+		a.globalConfig.Spec.InvocationRetrySpec)
+	return err
 }
 
 func (a *DaprRuntime) beginComponentsUpdates() error {
@@ -434,13 +691,31 @@ func (a *DaprRuntime) onComponentUpdated(component components_v1alpha1.Component
 			return
 		}
 
-		err = store.Init(state.Metadata{
-			Properties: a.convertMetadataItemsToProperties(component.Spec.Metadata),
+		props := a.convertMetadataItemsToProperties(component.Spec.Metadata)
+		defaults, err := runtime_state.DefaultsFromMetadata(props)
+		if err != nil {
+			log.Errorf("error on init state store: %s", err)
+			return
+		}
+		keyPrefix, err := runtime_state.KeyPrefixFromMetadata(props, runtime_state.KeyPrefixVariables{
+			AppID:     a.runtimeConfig.ID,
+			Namespace: a.namespace,
+			Name:      component.ObjectMeta.Name,
+		})
+		if err != nil {
+			log.Errorf("error on init state store: %s", err)
+			return
+		}
+
+		err = a.initComponent(component, func() error {
+			return store.Init(state.Metadata{
+				Properties: props,
+			})
 		})
 		if err != nil {
 			log.Errorf("error on init state store: %s", err)
 		} else {
-			a.stateStores[component.ObjectMeta.Name] = store
+			a.updateStateStore(component, store, defaults, keyPrefix)
 		}
 	} else if strings.Index(component.Spec.Type, "bindings") == 0 {
 		//TODO: implement update for input bindings too
@@ -450,12 +725,16 @@ func (a *DaprRuntime) onComponentUpdated(component components_v1alpha1.Component
 			return
 		}
 
-		err = binding.Init(bindings.Metadata{
-			Properties: a.convertMetadataItemsToProperties(component.Spec.Metadata),
-			Name:       component.ObjectMeta.Name,
+		err = a.initComponent(component, func() error {
+			return binding.Init(bindings.Metadata{
+				Properties: a.convertMetadataItemsToProperties(component.Spec.Metadata),
+				Name:       component.ObjectMeta.Name,
+			})
 		})
 		if err == nil {
+			a.componentsLock.Lock()
 			a.outputBindings[component.ObjectMeta.Name] = binding
+			a.componentsLock.Unlock()
 		}
 	}
 }
@@ -487,12 +766,39 @@ func (a *DaprRuntime) sendBatchOutputBindingsSequential(to []string, data []byte
 
 func (a *DaprRuntime) sendToOutputBinding(name string, req *bindings.WriteRequest) error {
 	if binding, ok := a.outputBindings[name]; ok {
+		if err := a.rateLimiters[name].Allow(); err != nil {
+			return err
+		}
+		inFlight := a.getInFlight("bindings", name)
+		inFlight.Start()
+		defer inFlight.Done()
 		err := binding.Write(req)
 		return err
 	}
 	return fmt.Errorf("couldn't find output binding %s", name)
 }
 
+// sendToOutputBindingBulk writes the entries of a bulk write request to an output binding, in one call if the binding
+// supports batches and entry by entry otherwise
+func (a *DaprRuntime) sendToOutputBindingBulk(req *runtime_bindings.BulkWriteRequest) (runtime_bindings.BulkWriteResponse, error) {
+	binding, ok := a.outputBindings[req.Name]
+	if !ok {
+		return runtime_bindings.BulkWriteResponse{}, fmt.Errorf("couldn't find output binding %s", req.Name)
+	}
+	if err := runtime_bindings.ValidateBulkWriteRequest(req); err != nil {
+		return runtime_bindings.BulkWriteResponse{}, err
+	}
+	if err := a.rateLimiters[req.Name].Allow(); err != nil {
+		return runtime_bindings.BulkWriteResponse{}, err
+	}
+
+	inFlight := a.getInFlight("bindings", req.Name)
+	inFlight.Start()
+	defer inFlight.Done()
+	parallelism := a.memoryThrottle.Parallelism(bulkWriteOperation, bulkWriteParallelism)
+	return runtime_bindings.BulkWrite(binding, req, parallelism), nil
+}
+
 func (a *DaprRuntime) onAppResponse(response *bindings.AppResponse) error {
 	if len(response.State) > 0 {
 		go func(reqs []state.SetRequest) {
@@ -533,12 +839,16 @@ func (a *DaprRuntime) sendBindingEventToApp(bindingName string, data []byte, met
 		ctx = diag.AppendToOutgoingGRPCContext(ctx, span.SpanContext())
 
 		client := daprclientv1pb.NewDaprClientClient(a.grpc.AppClient)
-		resp, err := client.OnBindingEvent(ctx, &daprclientv1pb.BindingEventEnvelope{
-			Name: bindingName,
-			Data: &any.Any{
-				Value: data,
-			},
-			Metadata: metadata,
+		var resp *daprclientv1pb.BindingResponseEnvelope
+		err := a.callAppGRPC(ctx, channel.BindingsPath, func() (err error) {
+			resp, err = client.OnBindingEvent(ctx, &daprclientv1pb.BindingEventEnvelope{
+				Name: bindingName,
+				Data: &any.Any{
+					Value: data,
+				},
+				Metadata: metadata,
+			})
+			return err
 		})
 
 		diag.UpdateSpanPairStatusesFromError(span, err, spanName)
@@ -581,7 +891,7 @@ func (a *DaprRuntime) sendBindingEventToApp(bindingName string, data []byte, met
 
 		ctx = diag.NewContext(ctx, span.SpanContext())
 
-		resp, err := a.appChannel.InvokeMethod(ctx, req)
+		resp, err := a.appChannel.InvokeMethod(channel.WithPath(ctx, channel.BindingsPath), req)
 		if err != nil {
 			return fmt.Errorf("error invoking app: %s", err)
 		}
@@ -607,24 +917,39 @@ func (a *DaprRuntime) sendBindingEventToApp(bindingName string, data []byte, met
 }
 
 func (a *DaprRuntime) readFromBinding(name string, binding bindings.InputBinding) error {
+	lanes := a.getBindingLanes(name)
 	err := binding.Read(func(resp *bindings.ReadResponse) error {
-		if resp != nil {
-			err := a.sendBindingEventToApp(name, resp.Data, resp.Metadata)
-			if err != nil {
-				log.Debugf("error from app consumer for binding [%s]: %s", name, err)
-				return err
-			}
+		if resp == nil {
+			return nil
 		}
-		return nil
+		return lanes.Deliver(resp.Metadata, func() error {
+			return withRecovery("binding "+name, func() error {
+				a.waitForBindingSchedule(name)
+				a.recordBindingEvent(name)
+				err := a.sendBindingEventToApp(name, resp.Data, resp.Metadata)
+				if err != nil {
+					log.Debugf("error from app consumer for binding [%s]: %s", name, err)
+					return err
+				}
+				return nil
+			})
+		})
 	})
 	return err
 }
 
 func (a *DaprRuntime) startHTTPServer(port, profilePort int, allowedOrigins string, pipeline http_middleware.Pipeline) {
-	a.daprHTTPAPI = http.NewAPI(a.runtimeConfig.ID, a.appChannel, a.directMessaging, a.stateStores, a.secretStores, a.getPublishAdapter(), a.actor, a.sendToOutputBinding, a.globalConfig.Spec.TracingSpec)
+	// the admin API exports the runtime state and is only served to authenticated callers
+	var getSnapshot func() http.Snapshot
+	if a.runtimeConfig.AdminAPIToken != "" {
+		getSnapshot = a.getSnapshot
+	}
+	a.daprHTTPAPI = http.NewAPI(a.runtimeConfig.ID, a.appChannel, a.directMessaging, a.stateStores, a.getStateStoreDefaults, a.getStateKeyPrefix, a.secretStores, a.getPublishAdapter(), a.actor, a.sendToOutputBinding, a.globalConfig.Spec.TracingSpec, a.getSubscriptionsMetadata, a.getInputBindingsMetadata, a.getComponentsMetadata, getSnapshot, a.getMigrateStateFn())
 	serverConf := http.NewServerConfig(a.runtimeConfig.ID, a.hostAddress, port, profilePort, allowedOrigins, a.runtimeConfig.EnableProfiling)
+	serverConf.APIToken = a.runtimeConfig.APIToken
+	serverConf.AdminAPIToken = a.runtimeConfig.AdminAPIToken
 
-	server := http.NewServer(a.daprHTTPAPI, serverConf, a.globalConfig.Spec.TracingSpec, pipeline)
+	server := http.NewServer(a.daprHTTPAPI, serverConf, a.globalConfig.Spec.TracingSpec, pipeline, a.recorder)
 	server.StartNonBlocking()
 }
 
@@ -637,22 +962,41 @@ func (a *DaprRuntime) startGRPCInternalServer(api grpc.API, port int) error {
 
 func (a *DaprRuntime) startGRPCAPIServer(api grpc.API, port int) error {
 	serverConf := grpc.NewServerConfig(a.runtimeConfig.ID, a.hostAddress, port)
+	serverConf.APIToken = a.runtimeConfig.APIToken
+	serverConf.AdminAPIToken = a.runtimeConfig.AdminAPIToken
+	serverConf.MetadataSizeLimit = a.runtimeConfig.GRPCMetadataSizeLimit
+	serverConf.MetadataToTrailers = a.runtimeConfig.GRPCMetadataToTrailers
 	server := grpc.NewAPIServer(api, serverConf, a.globalConfig.Spec.TracingSpec)
 	err := server.StartNonBlocking()
 	return err
 }
 
 func (a *DaprRuntime) getGRPCAPI() grpc.API {
-	return grpc.NewAPI(a.runtimeConfig.ID, a.appChannel, a.stateStores, a.secretStores, a.getPublishAdapter(), a.directMessaging, a.actor, a.sendToOutputBinding, a.globalConfig.Spec.TracingSpec)
+	return grpc.NewAPI(a.runtimeConfig.ID, a.appChannel, a.stateStores, a.getStateStoreDefaults, a.getStateKeyPrefix, a.secretStores, a.getPublishAdapter(), a.getBulkPublishAdapter(), a.directMessaging, a.actor, a.sendToOutputBinding, a.sendToOutputBindingBulk, a.globalConfig.Spec.TracingSpec, a.memoryThrottle, a.appTokenValidator, a.getMigrateStateFn())
 }
 
-func (a *DaprRuntime) getPublishAdapter() func(*pubsub.PublishRequest) error {
+// newMemoryThrottle returns the throttle of bulk operations, nil when throttling is disabled
+func (a *DaprRuntime) newMemoryThrottle() *throttle.MemoryThrottle {
+	if a.runtimeConfig.MemoryThrottleRatio <= 0 {
+		return nil
+	}
+	return throttle.NewMemoryThrottle(throttle.NewCgroupMemoryReader(throttle.DefaultCgroupRoot), a.runtimeConfig.MemoryThrottleRatio)
+}
+
+func (a *DaprRuntime) getPublishAdapter() func(*pubsub.PublishRequest, map[string]string) (string, error) {
 	if a.pubSub == nil {
 		return nil
 	}
 	return a.Publish
 }
 
+func (a *DaprRuntime) getBulkPublishAdapter() func(*runtime_pubsub.BulkPublishRequest) (runtime_pubsub.BulkPublishResponse, error) {
+	if a.pubSub == nil {
+		return nil
+	}
+	return a.BulkPublish
+}
+
 func (a *DaprRuntime) getSubscribedBindingsGRPC() []string {
 	client := daprclientv1pb.NewDaprClientClient(a.grpc.AppClient)
 	resp, err := client.GetBindingsSubscriptions(context.Background(), &empty.Empty{})
@@ -696,97 +1040,165 @@ func (a *DaprRuntime) initInputBindings(registry bindings_loader.Registry) error
 		bindingsList = a.getSubscribedBindingsGRPC()
 	}
 
-	for _, c := range a.components {
-		if strings.Index(c.Spec.Type, "bindings") == 0 {
-			subscribed := a.isAppSubscribedToBinding(c.ObjectMeta.Name, bindingsList)
-			if !subscribed {
-				continue
-			}
+	a.initComponentsInParallel(a.getComponentsByCategory("bindings"), func(c components_v1alpha1.Component) {
+		subscribed := a.isAppSubscribedToBinding(c.ObjectMeta.Name, bindingsList)
+		if !subscribed {
+			return
+		}
 
-			binding, err := registry.CreateInputBinding(c.Spec.Type)
-			if err != nil {
-				log.Errorf("failed to create input binding %s (%s): %s", c.ObjectMeta.Name, c.Spec.Type, err)
-				diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
-				continue
-			}
-			err = binding.Init(bindings.Metadata{
-				Properties: a.convertMetadataItemsToProperties(c.Spec.Metadata),
+		properties := a.convertMetadataItemsToProperties(c.Spec.Metadata)
+		schedule, _, err := runtime_bindings.ScheduleFromMetadata(properties)
+		if err != nil {
+			log.Warnf("failed to init input binding %s (%s): %s", c.ObjectMeta.Name, c.Spec.Type, err)
+			diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
+			return
+		}
+		lanes, _, err := runtime_bindings.LanesFromMetadata(properties)
+		if err != nil {
+			log.Warnf("failed to init input binding %s (%s): %s", c.ObjectMeta.Name, c.Spec.Type, err)
+			diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
+			return
+		}
+
+		binding, err := registry.CreateInputBinding(c.Spec.Type)
+		if err != nil {
+			log.Errorf("failed to create input binding %s (%s): %s", c.ObjectMeta.Name, c.Spec.Type, err)
+			diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
+			return
+		}
+		err = a.initComponent(c, func() error {
+			return binding.Init(bindings.Metadata{
+				Properties: properties,
 				Name:       c.ObjectMeta.Name,
 			})
-			if err != nil {
-				log.Errorf("failed to init input binding %s (%s): %s", c.ObjectMeta.Name, c.Spec.Type, err)
-				diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
-				continue
-			}
+		})
+		if err != nil {
+			log.Errorf("failed to init input binding %s (%s): %s", c.ObjectMeta.Name, c.Spec.Type, err)
+			diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
+			return
+		}
 
-			log.Infof("successful init for input binding %s (%s)", c.ObjectMeta.Name, c.Spec.Type)
-			a.inputBindings[c.ObjectMeta.Name] = binding
-			diag.DefaultMonitoring.ComponentInitialized(c.Spec.Type)
+		log.Infof("successful init for input binding %s (%s)", c.ObjectMeta.Name, c.Spec.Type)
+		a.componentsLock.Lock()
+		a.inputBindings[c.ObjectMeta.Name] = binding
+		if schedule != nil {
+			a.bindingSchedules[c.ObjectMeta.Name] = schedule
 		}
-	}
+		if lanes != nil {
+			a.bindingLanes[c.ObjectMeta.Name] = lanes
+		}
+		a.componentsLock.Unlock()
+		diag.DefaultMonitoring.ComponentInitialized(c.Spec.Type)
+	})
 	return nil
 }
 
 func (a *DaprRuntime) initOutputBindings(registry bindings_loader.Registry) error {
-	for _, c := range a.components {
-		if strings.Index(c.Spec.Type, "bindings") == 0 {
-			binding, err := registry.CreateOutputBinding(c.Spec.Type)
-			if err != nil {
-				log.Errorf("failed to create output binding %s (%s): %s", c.ObjectMeta.Name, c.Spec.Type, err)
-				diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
-				continue
-			}
+	a.initComponentsInParallel(a.getComponentsByCategory("bindings"), func(c components_v1alpha1.Component) {
+		binding, err := registry.CreateOutputBinding(c.Spec.Type)
+		if err != nil {
+			log.Errorf("failed to create output binding %s (%s): %s", c.ObjectMeta.Name, c.Spec.Type, err)
+			diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
+			return
+		}
 
-			if binding != nil {
-				err := binding.Init(bindings.Metadata{
+		if binding != nil {
+			err := a.initComponent(c, func() error {
+				return binding.Init(bindings.Metadata{
 					Properties: a.convertMetadataItemsToProperties(c.Spec.Metadata),
 					Name:       c.ObjectMeta.Name,
 				})
-				if err != nil {
-					log.Errorf("failed to init output binding %s (%s): %s", c.ObjectMeta.Name, c.Spec.Type, err)
-					diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
-					continue
-				}
-				log.Infof("successful init for output binding %s (%s)", c.ObjectMeta.Name, c.Spec.Type)
-				a.outputBindings[c.ObjectMeta.Name] = binding
-				diag.DefaultMonitoring.ComponentInitialized(c.Spec.Type)
+			})
+			if err != nil {
+				log.Errorf("failed to init output binding %s (%s): %s", c.ObjectMeta.Name, c.Spec.Type, err)
+				diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
+				return
 			}
+			log.Infof("successful init for output binding %s (%s)", c.ObjectMeta.Name, c.Spec.Type)
+			a.componentsLock.Lock()
+			a.outputBindings[c.ObjectMeta.Name] = binding
+			a.componentsLock.Unlock()
+			diag.DefaultMonitoring.ComponentInitialized(c.Spec.Type)
 		}
-	}
+	})
 	return nil
 }
 
 // Refer for state store api decision  https://github.com/dapr/dapr/blob/master/docs/decision_records/api/API-008-multi-state-store-api-design.md
 func (a *DaprRuntime) initState(registry state_loader.Registry) error {
-	for _, s := range a.components {
-		if strings.Index(s.Spec.Type, "state") == 0 {
-			store, err := registry.CreateStateStore(s.Spec.Type)
+	a.initComponentsInParallel(a.getComponentsByCategory("state"), func(s components_v1alpha1.Component) {
+		store, err := registry.CreateStateStore(s.Spec.Type)
+		if err != nil {
+			log.Warnf("error creating state store %s: %s", s.Spec.Type, err)
+			diag.DefaultMonitoring.ComponentInitFailed(s.Spec.Type, "creation")
+			return
+		}
+		if store != nil {
+			props := a.convertMetadataItemsToProperties(s.Spec.Metadata)
+			defaults, err := runtime_state.DefaultsFromMetadata(props)
 			if err != nil {
-				log.Warnf("error creating state store %s: %s", s.Spec.Type, err)
-				diag.DefaultMonitoring.ComponentInitFailed(s.Spec.Type, "creation")
-				continue
+				diag.DefaultMonitoring.ComponentInitFailed(s.Spec.Type, "init")
+				log.Warnf("error initializing state store %s: %s", s.Spec.Type, err)
+				return
 			}
-			if store != nil {
-				props := a.convertMetadataItemsToProperties(s.Spec.Metadata)
-				err := store.Init(state.Metadata{
+			keyPrefix, err := runtime_state.KeyPrefixFromMetadata(props, runtime_state.KeyPrefixVariables{
+				AppID:     a.runtimeConfig.ID,
+				Namespace: a.namespace,
+				Name:      s.ObjectMeta.Name,
+			})
+			if err != nil {
+				diag.DefaultMonitoring.ComponentInitFailed(s.Spec.Type, "init")
+				log.Warnf("error initializing state store %s: %s", s.Spec.Type, err)
+				return
+			}
+
+			err = a.initComponent(s, func() error {
+				return store.Init(state.Metadata{
 					Properties: props,
 				})
-				if err != nil {
-					diag.DefaultMonitoring.ComponentInitFailed(s.Spec.Type, "init")
-					log.Warnf("error initializing state store %s: %s", s.Spec.Type, err)
-					continue
-				}
+			})
+			if err != nil {
+				diag.DefaultMonitoring.ComponentInitFailed(s.Spec.Type, "init")
+				log.Warnf("error initializing state store %s: %s", s.Spec.Type, err)
+				return
+			}
 
-				a.stateStores[s.ObjectMeta.Name] = store
+			a.componentsLock.Lock()
+			a.initializedStateStores[s.ObjectMeta.Name] = store
+			a.stateStoreDefaults[s.ObjectMeta.Name] = defaults
+			a.stateKeyPrefixes[s.ObjectMeta.Name] = keyPrefix
+			a.componentsLock.Unlock()
+			diag.DefaultMonitoring.ComponentInitialized(s.Spec.Type)
+		}
+	})
+	a.rateLimitComponents()
+	secondaries := []string{}
+	for _, s := range a.getComponentsByCategory("state") {
+		if _, ok := a.initializedStateStores[s.ObjectMeta.Name]; !ok {
+			continue
+		}
+		store, secondary := a.wrapStateStore(s)
+		a.stateStores[s.ObjectMeta.Name] = store
+		if secondary != "" {
+			secondaries = append(secondaries, secondary)
+		}
+	}
+	for _, name := range secondaries {
+		delete(a.stateStores, name)
+		delete(a.stateStoreDefaults, name)
+		delete(a.stateKeyPrefixes, name)
+	}
 
-				// set specified actor store if "actorStateStore" is true in the spec.
-				actorStoreSpecified := props[actorStateStore]
-				if actorStoreSpecified == "true" {
-					if a.actorStateStoreCount++; a.actorStateStoreCount == 1 {
-						a.actorStateStoreName = s.ObjectMeta.Name
-					}
-				}
-				diag.DefaultMonitoring.ComponentInitialized(s.Spec.Type)
+	// set specified actor store if "actorStateStore" is true in the spec.
+	// evaluated in component order so the selection doesn't depend on which store finished init first.
+	for _, s := range a.getComponentsByCategory("state") {
+		if _, ok := a.stateStores[s.ObjectMeta.Name]; !ok {
+			continue
+		}
+		actorStoreSpecified := a.convertMetadataItemsToProperties(s.Spec.Metadata)[actorStateStore]
+		if actorStoreSpecified == "true" {
+			if a.actorStateStoreCount++; a.actorStateStoreCount == 1 {
+				a.actorStateStoreName = s.ObjectMeta.Name
 			}
 		}
 	}
@@ -798,10 +1210,70 @@ func (a *DaprRuntime) initState(registry state_loader.Registry) error {
 	return nil
 }
 
-func (a *DaprRuntime) getTopicRoutes() map[string]string {
-	topicRoutes := map[string]string{}
+// wrapStateStore wraps the initialized state store of a component as declared by its metadata: paired with its
+// secondary component, rate limited, compressed, cached and tracked for shutdown, in that order. It returns the name
+// of the secondary component paired with the store, which is only reachable through the store.
+func (a *DaprRuntime) wrapStateStore(c components_v1alpha1.Component) (state.Store, string) {
+	name := c.ObjectMeta.Name
+	store, secondary := a.pairStateStore(c, a.initializedStateStores[name])
+	store = a.rateLimitStateStore(name, store)
+	store = a.compressStateStore(c, store)
+	store = a.cacheStateStore(c, store)
+	return runtime_state.NewTrackedStore(store, a.getInFlight("state", name)), secondary
+}
+
+// updateStateStore replaces the state store of an updated component, wrapped as on init.
+// The update of a secondary component replaces the failover pair of its primary component.
+func (a *DaprRuntime) updateStateStore(c components_v1alpha1.Component, store state.Store, defaults runtime_state.Defaults, keyPrefix string) {
+	a.componentsLock.Lock()
+	defer a.componentsLock.Unlock()
+
+	name := c.ObjectMeta.Name
+	a.initializedStateStores[name] = store
+	a.stateStoreDefaults[name] = defaults
+	a.stateKeyPrefixes[name] = keyPrefix
+	if primary := a.getFailoverPrimary("state", name); primary != nil && a.stateStores[primary.ObjectMeta.Name] != nil {
+		if a.setWrappedStateStore(*primary) == name {
+			return
+		}
+	}
+	a.setWrappedStateStore(c)
+}
+
+// getStateStoreDefaults returns the defaults of a state store. Component updates replace them while the APIs read them.
+func (a *DaprRuntime) getStateStoreDefaults(name string) runtime_state.Defaults {
+	a.componentsLock.RLock()
+	defer a.componentsLock.RUnlock()
+	return a.stateStoreDefaults[name]
+}
+
+// getStateKeyPrefix returns the resolved key prefix of a state store, if any. Component updates replace it while the
+// APIs read it.
+func (a *DaprRuntime) getStateKeyPrefix(name string) (string, bool) {
+	a.componentsLock.RLock()
+	defer a.componentsLock.RUnlock()
+	prefix, ok := a.stateKeyPrefixes[name]
+	return prefix, ok
+}
+
+// setWrappedStateStore sets the wrapped state store of a component and removes the secondary component it's paired
+// with, returning the name of the secondary component
+func (a *DaprRuntime) setWrappedStateStore(c components_v1alpha1.Component) string {
+	store, secondary := a.wrapStateStore(c)
+	a.stateStores[c.ObjectMeta.Name] = store
+	if secondary != "" {
+		delete(a.stateStores, secondary)
+		delete(a.stateStoreDefaults, secondary)
+		delete(a.stateKeyPrefixes, secondary)
+	}
+	return secondary
+}
+
+// getTopicSubscriptions returns the subscriptions of the app by topic
+func (a *DaprRuntime) getTopicSubscriptions() map[string]runtime_pubsub.Subscription {
+	topicSubscriptions := map[string]runtime_pubsub.Subscription{}
 	if a.appChannel == nil {
-		return topicRoutes
+		return topicSubscriptions
 	}
 
 	var subscriptions []runtime_pubsub.Subscription
@@ -813,61 +1285,87 @@ func (a *DaprRuntime) getTopicRoutes() map[string]string {
 	}
 
 	for _, s := range subscriptions {
-		topicRoutes[s.Topic] = s.Route
+		topicSubscriptions[s.Topic] = s
 	}
 
-	if len(topicRoutes) > 0 {
+	if len(topicSubscriptions) > 0 {
 		topics := []string{}
-		for t := range topicRoutes {
+		for t := range topicSubscriptions {
 			topics = append(topics, t)
 		}
 		log.Infof("app is subscribed to the following topics: %v", topics)
 	}
-	return topicRoutes
+	return topicSubscriptions
 }
 
 func (a *DaprRuntime) initExporters() error {
-	for _, c := range a.components {
-		if strings.Index(c.Spec.Type, "exporter") == 0 {
-			exporter, err := a.exporterRegistry.Create(c.Spec.Type)
-			if err != nil {
-				log.Warnf("error creating exporter %s: %s", c.Spec.Type, err)
-				diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
-				continue
-			}
+	a.initComponentsInParallel(a.getComponentsByCategory("exporter"), func(c components_v1alpha1.Component) {
+		exporter, err := a.exporterRegistry.Create(c.Spec.Type)
+		if err != nil {
+			log.Warnf("error creating exporter %s: %s", c.Spec.Type, err)
+			diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
+			return
+		}
 
-			properties := a.convertMetadataItemsToProperties(c.Spec.Metadata)
+		properties := a.convertMetadataItemsToProperties(c.Spec.Metadata)
 
-			err = exporter.Init(a.runtimeConfig.ID, a.hostAddress, exporters.Metadata{
+		err = a.initComponent(c, func() error {
+			return exporter.Init(a.runtimeConfig.ID, a.hostAddress, exporters.Metadata{
 				Properties: properties,
 			})
-			if err != nil {
-				log.Warnf("error initializing exporter %s: %s", c.Spec.Type, err)
-				diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
-				continue
-			}
-			diag.DefaultMonitoring.ComponentInitialized(c.Spec.Type)
+		})
+		if err != nil {
+			log.Warnf("error initializing exporter %s: %s", c.Spec.Type, err)
+			diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
+			return
 		}
-	}
+		diag.DefaultMonitoring.ComponentInitialized(c.Spec.Type)
+	})
 	return nil
 }
 
 func (a *DaprRuntime) initPubSub() error {
+	secondaries := a.getFailoverSecondaries("pubsub")
 	for _, c := range a.components {
 		if strings.Index(c.Spec.Type, "pubsub") == 0 {
-			pubSub, err := a.pubSubRegistry.Create(c.Spec.Type)
-			if err != nil {
-				log.Warnf("error creating pub sub %s: %s", c.Spec.Type, err)
-				diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
+			if secondaries[c.ObjectMeta.Name] {
+				// Secondary components are initialized with their primary component
 				continue
 			}
 
-			properties := a.convertMetadataItemsToProperties(c.Spec.Metadata)
-			properties["consumerID"] = a.runtimeConfig.ID
+			pubSub, properties, err := a.createPubSub(c)
+			if err != nil {
+				continue
+			}
 
-			err = pubSub.Init(pubsub.Metadata{
-				Properties: properties,
-			})
+			failoverConfig, ok, err := failover.ConfigFromMetadata(properties)
+			if err != nil {
+				log.Warnf("error initializing pub sub %s: %s", c.Spec.Type, err)
+				diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
+				continue
+			}
+			if ok {
+				pubSub = a.pairPubSub(c, pubSub, properties, failoverConfig)
+			}
+			schemaValidator, _, err := runtime_pubsub.SchemaValidatorFromMetadata(properties)
+			if err != nil {
+				log.Warnf("error initializing pub sub %s: %s", c.Spec.Type, err)
+				diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
+				continue
+			}
+			topicACL, _, err := runtime_pubsub.TopicACLFromMetadata(properties)
+			if err != nil {
+				log.Warnf("error initializing pub sub %s: %s", c.Spec.Type, err)
+				diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
+				continue
+			}
+			compressor, _, err := runtime_pubsub.CompressionFromMetadata(properties)
+			if err != nil {
+				log.Warnf("error initializing pub sub %s: %s", c.Spec.Type, err)
+				diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
+				continue
+			}
+			topicNamespace, _, err := runtime_pubsub.TopicNamespaceFromMetadata(properties, a.namespace)
 			if err != nil {
 				log.Warnf("error initializing pub sub %s: %s", c.Spec.Type, err)
 				diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
@@ -879,6 +1377,12 @@ func (a *DaprRuntime) initPubSub() error {
 			a.allowedTopics = scopes.GetAllowedTopics(properties)
 
 			a.pubSub = pubSub
+			a.pubSubName = c.ObjectMeta.Name
+			a.cloudEventSchema = schemaValidator
+			a.topicACL = topicACL
+			a.publishCompression = compressor
+			a.topicNamespace = topicNamespace
+			a.appIdentity = runtime_pubsub.SPIFFEID(properties[runtime_pubsub.TopicACLTrustDomainMetadataKey], a.namespace, a.runtimeConfig.ID)
 			diag.DefaultMonitoring.ComponentInitialized(c.Spec.Type)
 			break
 		}
@@ -887,14 +1391,88 @@ func (a *DaprRuntime) initPubSub() error {
 	return nil
 }
 
+// createPubSub creates and initializes a pubsub component
+func (a *DaprRuntime) createPubSub(c components_v1alpha1.Component) (pubsub.PubSub, map[string]string, error) {
+	pubSub, err := a.pubSubRegistry.Create(c.Spec.Type)
+	if err != nil {
+		log.Warnf("error creating pub sub %s: %s", c.Spec.Type, err)
+		diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
+		return nil, nil, err
+	}
+
+	properties := a.convertMetadataItemsToProperties(c.Spec.Metadata)
+	properties["consumerID"] = a.runtimeConfig.ID
+
+	err = a.initComponent(c, func() error {
+		return pubSub.Init(pubsub.Metadata{
+			Properties: properties,
+		})
+	})
+	if err != nil {
+		log.Warnf("error initializing pub sub %s: %s", c.Spec.Type, err)
+		diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
+		return nil, nil, err
+	}
+	return pubSub, properties, nil
+}
+
 // Publish is an adapter method for the runtime to pre-validate publish requests
-// And then forward them to the Pub/Sub component.
+// And then forward them to the Pub/Sub component. It returns the ID the broker assigned to the message, if reported.
 // This method is used by the HTTP and gRPC APIs.
-func (a *DaprRuntime) Publish(req *pubsub.PublishRequest) error {
+func (a *DaprRuntime) Publish(req *pubsub.PublishRequest, metadata map[string]string) (string, error) {
 	if allowed := a.isPubSubOperationAllowed(req.Topic, a.scopedPublishings); !allowed {
-		return fmt.Errorf("topic %s is not allowed for app id %s", req.Topic, a.runtimeConfig.ID)
+		return "", fmt.Errorf("topic %s is not allowed for app id %s", req.Topic, a.runtimeConfig.ID)
+	}
+	if err := a.authorizePublish(req.Topic, metadata); err != nil {
+		return "", err
 	}
-	return a.pubSub.Publish(req)
+	if err := a.cloudEventSchema.ValidateTopic(req.Topic, req.Data); err != nil {
+		return "", err
+	}
+	if err := a.rateLimiters[a.pubSubName].Allow(); err != nil {
+		return "", err
+	}
+	data, err := a.eventData(req.Data, metadata)
+	if err != nil {
+		return "", err
+	}
+	req = &pubsub.PublishRequest{Topic: a.topicNamespace.BrokerTopic(req.Topic), Data: data}
+
+	inFlight := a.getInFlight("pubsub", a.pubSubName)
+	inFlight.Start()
+	defer inFlight.Done()
+	if priority, ok, _ := runtime_pubsub.GetPriority(metadata); ok {
+		return runtime_pubsub.PublishWithPriority(a.pubSub, req, priority)
+	}
+	return runtime_pubsub.Publish(a.pubSub, req)
+}
+
+// eventData returns the data published for an event: the event with its priority attribute if the metadata sets one,
+// compressed if the pubsub component compresses events that large
+func (a *DaprRuntime) eventData(event []byte, metadata map[string]string) ([]byte, error) {
+	priority, ok, err := runtime_pubsub.GetPriority(metadata)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if event, err = runtime_pubsub.SetCloudEventPriority(event, priority); err != nil {
+			return nil, err
+		}
+	}
+	return a.publishCompression.Compress(event)
+}
+
+// authorizePublish checks the topic access rules grant publishing to the app, with the token of the caller if any
+func (a *DaprRuntime) authorizePublish(topic string, metadata map[string]string) error {
+	return a.topicACL.Authorize(runtime_pubsub.OperationPublish, topic, runtime_pubsub.Caller{
+		Identity: a.appIdentity,
+		Token:    metadata[runtime_pubsub.CallerTokenMetadataKey],
+	})
+}
+
+// authorizeSubscription checks the topic access rules grant subscribing to the app
+func (a *DaprRuntime) authorizeSubscription(topic string) error {
+	return a.topicACL.Authorize(runtime_pubsub.OperationSubscribe, topic, runtime_pubsub.Caller{Identity: a.appIdentity})
 }
 
 func (a *DaprRuntime) isPubSubOperationAllowed(topic string, scopedTopics []string) bool {
@@ -959,10 +1537,27 @@ func (a *DaprRuntime) publishMessageHTTP(msg *pubsub.NewMessage) error {
 		subject = cloudEvent.Subject
 	}
 
-	route := a.topicRoutes[msg.Topic]
+	topic := a.subscriptionTopic(msg.Topic)
+	route := a.topicRoutes[topic]
 	req := invokev1.NewInvokeMethodRequest(route)
 	req.WithHTTPExtension(nethttp.MethodPost, "")
-	req.WithRawData(msg.Data, pubsub.ContentType)
+	transformed, err := a.topicTransforms[topic].Apply(msg.Data)
+	if err != nil {
+		log.Warnf("error transforming message of topic %s, delivering it as is: %s", msg.Topic, err)
+		transformed = runtime_pubsub.TransformedMessage{Data: msg.Data, ContentType: pubsub.ContentType}
+	}
+	req.WithRawData(transformed.Data, transformed.ContentType)
+	headers := map[string][]string{}
+	for k, v := range transformed.Headers {
+		headers[k] = []string{v}
+	}
+	// the app can give up on a message after some attempts
+	for k, v := range a.deliveryTracker.Delivery(topic, cloudEvent.ID).Headers() {
+		headers[k] = []string{v}
+	}
+	if len(headers) > 0 {
+		req.WithMetadata(headers)
+	}
 
 	// subject contains the correlationID which is passed span context
 	sc, _ := diag.SpanContextFromString(subject)
@@ -972,8 +1567,10 @@ func (a *DaprRuntime) publishMessageHTTP(msg *pubsub.NewMessage) error {
 	defer span.End()
 
 	ctx = diag.NewContext(ctx, span.SpanContext())
-	resp, err := a.appChannel.InvokeMethod(ctx, req)
+	start := time.Now()
+	resp, err := a.appChannel.InvokeMethod(channel.WithPath(ctx, channel.PubSubPath), req)
 	if err != nil {
+		a.recordAppResponse(msg, route, start, err)
 		return fmt.Errorf("error from app channel while sending pub/sub event to app: %s", err)
 	}
 
@@ -981,15 +1578,20 @@ func (a *DaprRuntime) publishMessageHTTP(msg *pubsub.NewMessage) error {
 
 	if resp.Status().Code != nethttp.StatusOK {
 		_, errorMsg := resp.RawData()
-		return fmt.Errorf("error returned from app while processing pub/sub event: %s. status code returned: %v", errorMsg, resp.Status().Code)
+		err = fmt.Errorf("error returned from app while processing pub/sub event: %s. status code returned: %v", errorMsg, resp.Status().Code)
 	}
-
-	return nil
+	a.recordAppResponse(msg, route, start, err)
+	return err
 }
 
 func (a *DaprRuntime) publishMessageGRPC(msg *pubsub.NewMessage) error {
+	data, err := a.topicTransforms[a.subscriptionTopic(msg.Topic)].Project(msg.Data)
+	if err != nil {
+		log.Warnf("error transforming message of topic %s, delivering it as is: %s", msg.Topic, err)
+		data = msg.Data
+	}
 	var cloudEvent pubsub.CloudEventsEnvelope
-	err := a.json.Unmarshal(msg.Data, &cloudEvent)
+	err = a.json.Unmarshal(data, &cloudEvent)
 	if err != nil {
 		log.Debugf("error deserializing cloud events proto: %s", err)
 		return err
@@ -1024,9 +1626,17 @@ func (a *DaprRuntime) publishMessageGRPC(msg *pubsub.NewMessage) error {
 	defer span.End()
 
 	ctx = diag.AppendToOutgoingGRPCContext(ctx, span.SpanContext())
+	for k, v := range a.deliveryTracker.Delivery(a.subscriptionTopic(msg.Topic), cloudEvent.ID).Headers() {
+		ctx = metadata.AppendToOutgoingContext(ctx, k, v)
+	}
 
 	clientV1 := daprclientv1pb.NewDaprClientClient(a.grpc.AppClient)
-	_, err = clientV1.OnTopicEvent(ctx, envelope)
+	start := time.Now()
+	err = a.callAppGRPC(ctx, channel.PubSubPath, func() error {
+		_, err := clientV1.OnTopicEvent(ctx, envelope)
+		return err
+	})
+	a.recordAppResponse(msg, "", start, err)
 
 	diag.UpdateSpanPairStatusesFromError(span, err, spanName)
 
@@ -1040,8 +1650,20 @@ func (a *DaprRuntime) publishMessageGRPC(msg *pubsub.NewMessage) error {
 
 func (a *DaprRuntime) initActors() error {
 	actorConfig := actors.NewConfig(a.hostAddress, a.runtimeConfig.ID, a.runtimeConfig.PlacementServiceAddress, a.appConfig.Entities,
-		a.runtimeConfig.InternalGRPCPort, a.appConfig.ActorScanInterval, a.appConfig.ActorIdleTimeout, a.appConfig.DrainOngoingCallTimeout, a.appConfig.DrainRebalancedActors)
-	act := actors.NewActors(a.stateStores[a.actorStateStoreName], a.appChannel, a.grpc.GetGRPCConnection, actorConfig, a.runtimeConfig.CertChain, a.globalConfig.Spec.TracingSpec)
+		a.runtimeConfig.InternalGRPCPort, a.appConfig.ActorScanInterval, a.appConfig.ActorIdleTimeout, a.appConfig.DrainOngoingCallTimeout, a.appConfig.DrainRebalancedActors, a.appConfig.ReadOnlyMethods)
+	actorConfig.ForwardedMetadata = a.appConfig.ActorMetadata
+	actorConfig.CachedMethods = a.appConfig.CachedMethods
+	if a.appConfig.CachedMethodTTL != "" {
+		ttl, err := time.ParseDuration(a.appConfig.CachedMethodTTL)
+		if err != nil || ttl <= 0 {
+			log.Warnf("cachedMethodTTL %q of the app isn't a positive duration, using the default TTL", a.appConfig.CachedMethodTTL)
+		} else {
+			actorConfig.CachedMethodTTL = ttl
+		}
+	}
+	actorConfig.PlacementWeight = a.runtimeConfig.PlacementWeight
+	actorConfig.PlacementLabels = a.runtimeConfig.PlacementLabels
+	act := actors.NewActors(a.stateStores[a.actorStateStoreName], a.actorStateStoreName, a.appChannel, a.grpc.GetGRPCConnection, actorConfig, a.runtimeConfig.CertChain, a.globalConfig.Spec.TracingSpec)
 	err := act.Init()
 	a.actor = act
 	return err
@@ -1088,7 +1710,7 @@ func (a *DaprRuntime) loadComponents(opts *runtimeOpts) error {
 	if err != nil {
 		return err
 	}
-	a.components = a.getAuthorizedComponents(comps)
+	a.components = a.resolveComponentDependencies(a.getAuthorizedComponents(comps))
 
 	// Register and initialize secret stores
 	a.secretStoresRegistry.Register(opts.secretStores...)
@@ -1116,6 +1738,15 @@ func (a *DaprRuntime) loadComponents(opts *runtimeOpts) error {
 // Stop allows for a graceful shutdown of all runtime internal operations or components
 func (a *DaprRuntime) Stop() {
 	log.Info("stop command issued. Shutting down all operations")
+	a.shutdownOnce.Do(func() {
+		close(a.shutdownC)
+	})
+	a.shutdownComponents()
+	if a.recorder != nil {
+		if err := a.recorder.Close(); err != nil {
+			log.Warnf("error closing recorder: %s", err)
+		}
+	}
 }
 
 func (a *DaprRuntime) processComponentSecrets(component components_v1alpha1.Component) components_v1alpha1.Component {
@@ -1171,6 +1802,9 @@ func (a *DaprRuntime) getSecretStore(storeName string) secretstores.SecretStore
 			return nil
 		}
 	}
+
+	a.componentsLock.Lock()
+	defer a.componentsLock.Unlock()
 	return a.secretStores[storeName]
 }
 
@@ -1277,6 +1911,15 @@ func (a *DaprRuntime) createAppChannel() error {
 		if a.runtimeConfig.MaxConcurrency > 0 {
 			log.Infof("app max concurrency set to %v", a.runtimeConfig.MaxConcurrency)
 		}
+		budgets, err := channel.NewBudgets(a.globalConfig.Spec.AppChannelSpec)
+		if err != nil {
+			return err
+		}
+		if budgets.Len() > 0 {
+			log.Infof("app channel budgets set for %v paths", budgets.Len())
+			ch = channel.NewBudgetedChannel(ch, budgets)
+		}
+		a.appChannelBudgets = budgets
 		a.appChannel = ch
 	}
 
@@ -1312,11 +1955,7 @@ func (a *DaprRuntime) initSecretStores() error {
 	}
 
 	// Initialize all secretstore components
-	for _, c := range a.components {
-		if !strings.Contains(c.Spec.Type, "secretstores") {
-			continue
-		}
-
+	a.initComponentsInParallel(a.getComponentsByCategory("secretstores"), func(c components_v1alpha1.Component) {
 		// Look up the secrets to authenticate this secretstore from K8S secret store
 		a.processComponentSecrets(c)
 
@@ -1324,21 +1963,25 @@ func (a *DaprRuntime) initSecretStores() error {
 		if err != nil {
 			log.Warnf("failed creating state store %s: %s", c.Spec.Type, err)
 			diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "creation")
-			continue
+			return
 		}
 
-		err = secretStore.Init(secretstores.Metadata{
-			Properties: a.convertMetadataItemsToProperties(c.Spec.Metadata),
+		err = a.initComponent(c, func() error {
+			return secretStore.Init(secretstores.Metadata{
+				Properties: a.convertMetadataItemsToProperties(c.Spec.Metadata),
+			})
 		})
 		if err != nil {
 			log.Warnf("failed to init state store %s named %s: %s", c.Spec.Type, c.ObjectMeta.Name, err)
 			diag.DefaultMonitoring.ComponentInitFailed(c.Spec.Type, "init")
-			continue
+			return
 		}
 
+		a.componentsLock.Lock()
 		a.secretStores[c.ObjectMeta.Name] = secretStore
+		a.componentsLock.Unlock()
 		diag.DefaultMonitoring.ComponentInitialized(c.Spec.Type)
-	}
+	})
 
 	return nil
 }
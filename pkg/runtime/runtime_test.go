@@ -11,7 +11,9 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/components-contrib/pubsub"
@@ -21,6 +23,7 @@ import (
 	pubsub_loader "github.com/dapr/dapr/pkg/components/pubsub"
 	secretstores_loader "github.com/dapr/dapr/pkg/components/secretstores"
 	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/failover"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 	"github.com/dapr/dapr/pkg/modes"
 	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
@@ -375,9 +378,9 @@ func TestInitPubSub(t *testing.T) {
 		assert.Nil(t, err)
 
 		rt.pubSub = &mockPublishPubSub{}
-		err = rt.Publish(&pubsub.PublishRequest{
+		_, err = rt.Publish(&pubsub.PublishRequest{
 			Topic: "topic0",
-		})
+		}, nil)
 		assert.Nil(t, err)
 	})
 
@@ -402,9 +405,9 @@ func TestInitPubSub(t *testing.T) {
 		assert.Nil(t, err)
 
 		rt.pubSub = &mockPublishPubSub{}
-		err = rt.Publish(&pubsub.PublishRequest{
+		_, err = rt.Publish(&pubsub.PublishRequest{
 			Topic: "topic5",
-		})
+		}, nil)
 		assert.NotNil(t, err)
 	})
 
@@ -732,6 +735,119 @@ func TestOnNewPublishedMessage(t *testing.T) {
 		assert.Equal(t, expectedClientError, err)
 		mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
 	})
+
+	t.Run("transformed message", func(t *testing.T) {
+		rt.topicTransforms = map[string]*runtime_pubsub.Transform{
+			"topic1": {DataOnly: true, AttributesAsHeaders: true, Fields: []string{"id"}},
+		}
+		defer func() { rt.topicTransforms = map[string]*runtime_pubsub.Transform{} }()
+		mockAppChannel := new(channelt.MockAppChannel)
+		rt.appChannel = mockAppChannel
+
+		fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+		var delivered *invokev1.InvokeMethodRequest
+		mockAppChannel.On("InvokeMethod", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*v1.InvokeMethodRequest")).
+			Run(func(args mock.Arguments) { delivered = args.Get(1).(*invokev1.InvokeMethodRequest) }).
+			Return(fakeResp, nil)
+
+		err := rt.publishMessageHTTP(&pubsub.NewMessage{
+			Topic: "topic1",
+			Data:  []byte(`{"id":"e1","type":"order.created","datacontenttype":"application/json","data":{"id":"o1","total":10}}`),
+		})
+		assert.NoError(t, err)
+		contentType, data := delivered.RawData()
+		assert.Equal(t, "application/json", contentType)
+		assert.JSONEq(t, `{"id":"o1"}`, string(data))
+		assert.Equal(t, []string{"order.created"}, delivered.Metadata()["ce-type"].Values)
+	})
+
+	t.Run("delivery attempts", func(t *testing.T) {
+		mockAppChannel := new(channelt.MockAppChannel)
+		rt.appChannel = mockAppChannel
+
+		var delivered []*invokev1.InvokeMethodRequest
+		mockAppChannel.On("InvokeMethod", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*v1.InvokeMethodRequest")).
+			Run(func(args mock.Arguments) { delivered = append(delivered, args.Get(1).(*invokev1.InvokeMethodRequest)) }).
+			Return(invokev1.NewInvokeMethodResponse(500, "Internal Error", nil), nil).Once()
+		mockAppChannel.On("InvokeMethod", mock.AnythingOfType("*context.valueCtx"), mock.AnythingOfType("*v1.InvokeMethodRequest")).
+			Run(func(args mock.Arguments) { delivered = append(delivered, args.Get(1).(*invokev1.InvokeMethodRequest)) }).
+			Return(invokev1.NewInvokeMethodResponse(200, "OK", nil), nil)
+
+		publish := rt.trackDeliveries(rt.publishMessageHTTP)
+		msg := func() *pubsub.NewMessage {
+			return &pubsub.NewMessage{Topic: "topic1", Data: []byte(`{"id":"attempts","data":"order"}`)}
+		}
+		assert.Error(t, publish(msg()))
+		assert.NoError(t, publish(msg()))
+
+		assert.Len(t, delivered, 2)
+		assert.Equal(t, []string{"1"}, delivered[0].Metadata()[runtime_pubsub.DeliveryAttemptHeader].Values)
+		assert.Equal(t, []string{"2"}, delivered[1].Metadata()[runtime_pubsub.DeliveryAttemptHeader].Values)
+		first := delivered[0].Metadata()[runtime_pubsub.FirstDeliveryTimeHeader].Values
+		assert.NotEmpty(t, first)
+		assert.Equal(t, first, delivered[1].Metadata()[runtime_pubsub.FirstDeliveryTimeHeader].Values)
+	})
+}
+
+func TestFilterMessages(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	filter, err := runtime_pubsub.NewFilter(`event.type == "order.created"`)
+	assert.NoError(t, err)
+	rt.topicFilters = map[string]*runtime_pubsub.Filter{"orders": filter}
+
+	var delivered []string
+	publishFunc := rt.filterMessages(func(msg *pubsub.NewMessage) error {
+		delivered = append(delivered, string(msg.Data))
+		return nil
+	})
+
+	messages := []*pubsub.NewMessage{
+		{Topic: "orders", Data: []byte(`{"type":"order.created"}`)},
+		{Topic: "orders", Data: []byte(`{"type":"order.deleted"}`)},
+		{Topic: "orders", Data: []byte(`not a cloud event`)},
+		{Topic: "payments", Data: []byte(`{"type":"payment.received"}`)},
+	}
+	for _, msg := range messages {
+		assert.NoError(t, publishFunc(msg))
+	}
+	assert.Equal(t, []string{`{"type":"order.created"}`, `not a cloud event`, `{"type":"payment.received"}`}, delivered)
+}
+
+func TestLimitHandlerConcurrency(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.topicHandlerSlots = map[string]chan struct{}{"orders": make(chan struct{}, 1)}
+
+	release := make(chan struct{})
+	started := make(chan string, 10)
+	publishFunc := rt.limitHandlerConcurrency(func(msg *pubsub.NewMessage) error {
+		started <- msg.Topic
+		<-release
+		return nil
+	})
+
+	var wg sync.WaitGroup
+	for _, topic := range []string{"orders", "orders", "payments", "payments"} {
+		wg.Add(1)
+		go func(topic string) {
+			defer wg.Done()
+			assert.NoError(t, publishFunc(&pubsub.NewMessage{Topic: topic}))
+		}(topic)
+	}
+
+	received := map[string]int{}
+	for i := 0; i < 3; i++ {
+		received[<-started]++
+	}
+	select {
+	case topic := <-started:
+		assert.Fail(t, "handler limit exceeded", topic)
+	case <-time.After(20 * time.Millisecond):
+	}
+	assert.Equal(t, map[string]int{"orders": 1, "payments": 2}, received, "only orders is limited to a single handler")
+
+	close(release)
+	assert.Equal(t, "orders", <-started)
+	wg.Wait()
 }
 
 func getFakeProperties() map[string]string {
@@ -1035,6 +1151,92 @@ func TestAuthorizedComponents(t *testing.T) {
 	})
 }
 
+func TestInitPubSubFailover(t *testing.T) {
+	newRuntime := func(primaryMetadata []components_v1alpha1.MetadataItem) *DaprRuntime {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		rt.pubSubRegistry.Register(
+			pubsub_loader.New("mockPubSub", func() pubsub.PubSub {
+				return &mockPublishPubSub{}
+			}),
+		)
+		rt.components = []components_v1alpha1.Component{
+			{
+				ObjectMeta: meta_v1.ObjectMeta{Name: "secondary"},
+				Spec:       components_v1alpha1.ComponentSpec{Type: "pubsub.mockPubSub"},
+			},
+			{
+				ObjectMeta: meta_v1.ObjectMeta{Name: "primary"},
+				Spec:       components_v1alpha1.ComponentSpec{Type: "pubsub.mockPubSub", Metadata: primaryMetadata},
+			},
+		}
+		return rt
+	}
+
+	t.Run("pairs primary with secondary", func(t *testing.T) {
+		rt := newRuntime([]components_v1alpha1.MetadataItem{{Name: failover.ComponentMetadataKey, Value: "secondary"}})
+		assert.NoError(t, rt.initPubSub())
+		assert.Equal(t, "primary", rt.pubSubName)
+		_, ok := rt.pubSub.(*runtime_pubsub.FailoverPubSub)
+		assert.True(t, ok)
+	})
+
+	t.Run("missing secondary disables failover", func(t *testing.T) {
+		rt := newRuntime([]components_v1alpha1.MetadataItem{{Name: failover.ComponentMetadataKey, Value: "unknown"}})
+		rt.components = rt.components[1:]
+		assert.NoError(t, rt.initPubSub())
+		assert.Equal(t, "primary", rt.pubSubName)
+		_, ok := rt.pubSub.(*mockPublishPubSub)
+		assert.True(t, ok)
+	})
+
+	t.Run("invalid failover configuration", func(t *testing.T) {
+		rt := newRuntime([]components_v1alpha1.MetadataItem{
+			{Name: failover.ComponentMetadataKey, Value: "secondary"},
+			{Name: failover.ThresholdMetadataKey, Value: "-1"},
+		})
+		assert.NoError(t, rt.initPubSub())
+		assert.Nil(t, rt.pubSub)
+	})
+}
+
+func TestInitPubSubCloudEventSchema(t *testing.T) {
+	newRuntime := func(metadata ...components_v1alpha1.MetadataItem) *DaprRuntime {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		rt.pubSubRegistry.Register(
+			pubsub_loader.New("mockPubSub", func() pubsub.PubSub {
+				return &mockPublishPubSub{}
+			}),
+		)
+		rt.components = []components_v1alpha1.Component{
+			{
+				ObjectMeta: meta_v1.ObjectMeta{Name: "orders"},
+				Spec:       components_v1alpha1.ComponentSpec{Type: "pubsub.mockPubSub", Metadata: metadata},
+			},
+		}
+		return rt
+	}
+
+	t.Run("validates published events", func(t *testing.T) {
+		rt := newRuntime(components_v1alpha1.MetadataItem{
+			Name:  runtime_pubsub.CloudEventSchemaMetadataKey,
+			Value: `{"type":"object","required":["data"],"properties":{"data":{"type":"object","required":["orderId"]}}}`,
+		})
+		assert.NoError(t, rt.initPubSub())
+
+		_, err := rt.Publish(&pubsub.PublishRequest{Topic: "topic0", Data: []byte(`{"id":"1","data":{"orderId":1}}`)}, nil)
+		assert.NoError(t, err)
+
+		_, err = rt.Publish(&pubsub.PublishRequest{Topic: "topic0", Data: []byte(`{"id":"1","data":{}}`)}, nil)
+		assert.IsType(t, &runtime_pubsub.SchemaError{}, err)
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		rt := newRuntime(components_v1alpha1.MetadataItem{Name: runtime_pubsub.CloudEventSchemaMetadataKey, Value: `{"type":`})
+		assert.NoError(t, rt.initPubSub())
+		assert.Nil(t, rt.pubSub)
+	})
+}
+
 type mockPublishPubSub struct {
 }
 
@@ -1052,3 +1254,30 @@ func (m *mockPublishPubSub) Publish(req *pubsub.PublishRequest) error {
 func (m *mockPublishPubSub) Subscribe(req pubsub.SubscribeRequest, handler func(msg *pubsub.NewMessage) error) error {
 	return nil
 }
+
+type mockPriorityPubSub struct {
+	mockPublishPubSub
+	published *pubsub.PublishRequest
+	priority  int
+}
+
+func (m *mockPriorityPubSub) PublishWithPriority(req *pubsub.PublishRequest, priority int) (string, error) {
+	m.published = req
+	m.priority = priority
+	return "", nil
+}
+
+func TestPublishWithPriority(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	ps := &mockPriorityPubSub{}
+	rt.pubSub = ps
+	rt.pubSubName = "messagebus"
+
+	_, err := rt.Publish(&pubsub.PublishRequest{Topic: "orders", Data: []byte(`{"id":"1"}`)}, map[string]string{runtime_pubsub.PriorityMetadataKey: "3"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, ps.priority)
+	assert.JSONEq(t, `{"id":"1","priority":3}`, string(ps.published.Data))
+
+	_, err = rt.Publish(&pubsub.PublishRequest{Topic: "orders", Data: []byte(`{"id":"1"}`)}, map[string]string{runtime_pubsub.PriorityMetadataKey: "urgent"})
+	assert.Error(t, err)
+}
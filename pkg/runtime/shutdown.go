@@ -0,0 +1,99 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dapr/dapr/pkg/components/lifecycle"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+)
+
+// DefaultComponentShutdownTimeout is the time components have to finish their in-flight operations, flush and close on shutdown
+const DefaultComponentShutdownTimeout = 5 * time.Second
+
+type componentShutdown struct {
+	category  string
+	name      string
+	component interface{}
+}
+
+// getInFlight returns the counter of the operations running on a component
+func (a *DaprRuntime) getInFlight(category, name string) *lifecycle.InFlight {
+	a.inFlightLock.Lock()
+	defer a.inFlightLock.Unlock()
+
+	key := category + "/" + name
+	i, ok := a.inFlight[key]
+	if !ok {
+		i = &lifecycle.InFlight{}
+		a.inFlight[key] = i
+	}
+	return i
+}
+
+// shutdownComponents waits for the in-flight operations of the initialized components, then flushes and closes them.
+// Components are shut down in parallel and share the component shutdown timeout.
+func (a *DaprRuntime) shutdownComponents() {
+	timeout := a.runtimeConfig.ComponentShutdownTimeout
+	if timeout <= 0 {
+		timeout = DefaultComponentShutdownTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	log.Infof("waiting up to %s for components to finish in-flight operations, flush and close", timeout)
+
+	var wg sync.WaitGroup
+	for _, c := range a.getComponentsToShutdown() {
+		wg.Add(1)
+		go func(c componentShutdown) {
+			defer wg.Done()
+			a.shutdownComponent(c, deadline)
+		}(c)
+	}
+	wg.Wait()
+}
+
+func (a *DaprRuntime) shutdownComponent(c componentShutdown, deadline time.Time) {
+	componentType := c.category
+	if spec := a.getComponentByName(c.category, c.name); spec != nil {
+		componentType = spec.Spec.Type
+	}
+
+	start := time.Now()
+	err := lifecycle.Shutdown(c.component, a.getInFlight(c.category, c.name), deadline)
+	elapsed := time.Since(start)
+	diag.DefaultMonitoring.ComponentShutdownDuration(componentType, c.name, elapsed)
+	if err != nil {
+		diag.DefaultMonitoring.ComponentShutdownFailed(componentType, c.name, "shutdown")
+		log.Warnf("component %s (%s) didn't shut down cleanly after %v: %s", c.name, componentType, elapsed, err)
+		return
+	}
+	log.Debugf("component %s (%s) shut down in %v", c.name, componentType, elapsed)
+}
+
+func (a *DaprRuntime) getComponentsToShutdown() []componentShutdown {
+	a.componentsLock.Lock()
+	defer a.componentsLock.Unlock()
+
+	var components []componentShutdown
+	for name, b := range a.inputBindings {
+		components = append(components, componentShutdown{category: "bindings", name: name, component: b})
+	}
+	for name, b := range a.outputBindings {
+		components = append(components, componentShutdown{category: "bindings", name: name, component: b})
+	}
+	if a.pubSub != nil {
+		components = append(components, componentShutdown{category: "pubsub", name: a.pubSubName, component: a.pubSub})
+	}
+	for name, s := range a.stateStores {
+		components = append(components, componentShutdown{category: "state", name: name, component: s})
+	}
+	for name, s := range a.secretStores {
+		components = append(components, componentShutdown{category: "secretstores", name: name, component: s})
+	}
+	return components
+}
@@ -0,0 +1,70 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockClosableStateStore struct {
+	mockSlowStateStore
+	flushed bool
+	closed  bool
+}
+
+func (m *mockClosableStateStore) Flush() error {
+	m.flushed = true
+	return nil
+}
+
+func (m *mockClosableStateStore) Close() error {
+	m.closed = true
+	return nil
+}
+
+func TestShutdownComponents(t *testing.T) {
+	t.Run("flushes and closes components", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		store := &mockClosableStateStore{}
+		rt.stateStores["store"] = store
+
+		rt.shutdownComponents()
+		assert.True(t, store.flushed)
+		assert.True(t, store.closed)
+	})
+
+	t.Run("waits for in-flight operations", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		store := &mockClosableStateStore{}
+		rt.stateStores["store"] = store
+		inFlight := rt.getInFlight("state", "store")
+		inFlight.Start()
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			inFlight.Done()
+		}()
+
+		start := time.Now()
+		rt.shutdownComponents()
+		assert.True(t, time.Since(start) >= 50*time.Millisecond)
+		assert.True(t, store.closed)
+	})
+
+	t.Run("doesn't close components with operations running past the timeout", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		rt.runtimeConfig.ComponentShutdownTimeout = 50 * time.Millisecond
+		store := &mockClosableStateStore{}
+		rt.stateStores["store"] = store
+		rt.getInFlight("state", "store").Start()
+
+		rt.shutdownComponents()
+		assert.False(t, store.closed)
+	})
+}
@@ -0,0 +1,100 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/http"
+)
+
+const redactedValue = "<redacted>"
+
+// sensitiveMetadataNames are the fragments of metadata item names whose values are never exported
+var sensitiveMetadataNames = []string{"password", "secret", "token", "key", "connectionstring", "credential", "auth", "cert", "sas"}
+
+// getSnapshot returns the state of the runtime exported by the admin API
+func (a *DaprRuntime) getSnapshot() http.Snapshot {
+	snapshot := http.Snapshot{
+		ID:   a.runtimeConfig.ID,
+		Time: time.Now().UTC().Format(time.RFC3339),
+		Endpoints: http.EndpointsSnapshot{
+			HTTPPort:         a.runtimeConfig.HTTPPort,
+			GRPCPort:         a.runtimeConfig.APIGRPCPort,
+			InternalGRPCPort: a.runtimeConfig.InternalGRPCPort,
+			AppPort:          a.runtimeConfig.ApplicationPort,
+			AppProtocol:      string(a.runtimeConfig.ApplicationProtocol),
+		},
+		Components:    []http.ComponentSnapshot{},
+		Subscriptions: a.getSubscriptionsMetadata(),
+		InputBindings: a.getInputBindingsMetadata(),
+		ActorTypes:    append([]string{}, a.appConfig.Entities...),
+		Crashes:       diag.RecentCrashes(),
+	}
+	sort.Strings(snapshot.ActorTypes)
+
+	secrets := a.getResolvedSecrets()
+	for _, c := range a.components {
+		cs := http.ComponentSnapshot{
+			Name:        c.ObjectMeta.Name,
+			Type:        c.Spec.Type,
+			Initialized: a.isComponentReady(c.ObjectMeta.Name),
+			Metadata:    map[string]string{},
+		}
+		for _, m := range c.Spec.Metadata {
+			cs.Metadata[m.Name] = redactMetadataItem(m, secrets)
+		}
+		snapshot.Components = append(snapshot.Components, cs)
+	}
+	sort.Slice(snapshot.Components, func(i, j int) bool {
+		return snapshot.Components[i].Name < snapshot.Components[j].Name
+	})
+	return snapshot
+}
+
+// getResolvedSecrets returns the secret values the runtime read from secret stores into component metadata
+func (a *DaprRuntime) getResolvedSecrets() []string {
+	var secrets []string
+	for _, c := range a.components {
+		for _, m := range c.Spec.Metadata {
+			if m.SecretKeyRef.Name != "" && m.Value != "" {
+				secrets = append(secrets, m.Value)
+			}
+		}
+	}
+	return secrets
+}
+
+// redactMetadataItem returns the exportable value of a component metadata item.
+// Secret references are shown instead of their values, values of sensitive items are redacted
+// and secrets resolved for any component are scrubbed from the other values.
+func redactMetadataItem(m components_v1alpha1.MetadataItem, secrets []string) string {
+	if m.SecretKeyRef.Name != "" {
+		key := m.SecretKeyRef.Key
+		if key == "" {
+			key = m.SecretKeyRef.Name
+		}
+		return fmt.Sprintf("<secretKeyRef %s/%s>", m.SecretKeyRef.Name, key)
+	}
+
+	name := strings.ToLower(m.Name)
+	for _, s := range sensitiveMetadataNames {
+		if strings.Contains(name, s) {
+			return redactedValue
+		}
+	}
+
+	value := m.Value
+	for _, s := range secrets {
+		value = strings.ReplaceAll(value, s, redactedValue)
+	}
+	return value
+}
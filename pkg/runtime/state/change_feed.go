@@ -0,0 +1,46 @@
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// ChangeOperation is the kind of change of a key
+type ChangeOperation string
+
+const (
+	// ChangeUpsert is a key saved with a new value
+	ChangeUpsert ChangeOperation = "upsert"
+	// ChangeDelete is a deleted key
+	ChangeDelete ChangeOperation = "delete"
+)
+
+// Change is a change of a key of a state store. Value and ETag are empty for deleted keys.
+type Change struct {
+	Key       string
+	Operation ChangeOperation
+	Value     []byte
+	ETag      string
+	// Time is when the store applied the change, zero if it doesn't report it
+	Time time.Time
+}
+
+// ChangeFeedStore is implemented by state stores reporting the changes of their keys, from a change feed or logical
+// decoding for instance
+type ChangeFeedStore interface {
+	// SubscribeChanges calls handler with the changes of the keys starting with keyPrefix, one change at a time, until
+	// ctx is done or handler returns an error
+	SubscribeChanges(ctx context.Context, keyPrefix string, handler func(change Change) error) error
+}
+
+// AsChangeFeedStore returns the change feed of a state store, or of the component it wraps.
+// The values of the changes are decompressed if the state store compresses them.
+func AsChangeFeedStore(store state.Store) (ChangeFeedStore, bool) {
+	cs, ok := componentStore(store).(ChangeFeedStore)
+	if ok && compressesValues(store) {
+		cs = decompressedChangeFeed{cs}
+	}
+	return cs, ok
+}
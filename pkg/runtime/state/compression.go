@@ -0,0 +1,303 @@
+package state
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/components/lifecycle"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CompressionMetadataKey is the state store component metadata item with the algorithm compressing the values saved,
+	// gzip or zstd
+	CompressionMetadataKey = "compression"
+	// CompressionThresholdMetadataKey is the state store component metadata item with the size in bytes from which
+	// values are compressed
+	CompressionThresholdMetadataKey = "compressionThreshold"
+
+	// CompressionGzip compresses values with gzip
+	CompressionGzip = "gzip"
+	// CompressionZstd compresses values with zstd
+	CompressionZstd = "zstd"
+
+	// DefaultCompressionThreshold is the size in bytes from which values are compressed when no threshold is set
+	DefaultCompressionThreshold = 1024
+
+	// maxDecompressedValueSize bounds the size of a compressed value once decompressed
+	maxDecompressedValueSize = 64 << 20
+)
+
+// compressedValuePrefix starts the compressed values, followed by the algorithm and a NUL byte, so values saved before
+// compression was enabled or below the threshold are read as they are
+var compressedValuePrefix = []byte("\x00dapr-compressed:")
+
+// CompressionConfig configures the compression of the values saved to a state store
+type CompressionConfig struct {
+	Algorithm string
+	Threshold int
+}
+
+// CompressionFromMetadata returns the compression of the values saved to a state store component.
+// It returns false if the component doesn't compress values.
+func CompressionFromMetadata(properties map[string]string) (CompressionConfig, bool, error) {
+	c := CompressionConfig{Algorithm: properties[CompressionMetadataKey], Threshold: DefaultCompressionThreshold}
+	if c.Algorithm == "" {
+		return c, false, nil
+	}
+	if c.Algorithm != CompressionGzip && c.Algorithm != CompressionZstd {
+		return c, true, fmt.Errorf("invalid %s %s: expected %s or %s", CompressionMetadataKey, c.Algorithm, CompressionGzip, CompressionZstd)
+	}
+	if v := properties[CompressionThresholdMetadataKey]; v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return c, true, fmt.Errorf("invalid %s %s: expected a number of bytes", CompressionThresholdMetadataKey, v)
+		}
+		c.Threshold = n
+	}
+	return c, true, nil
+}
+
+// CompressedStore compresses the values saved to a state store that are at least as large as the threshold, and
+// decompresses them when they are read. Values are serialized as the state stores do before they are compressed:
+// byte slices as they are and other values as JSON.
+type CompressedStore struct {
+	store  state.Store
+	config CompressionConfig
+}
+
+type transactionalCompressedStore struct {
+	*CompressedStore
+}
+
+// NewCompressedStore returns a state store compressing the values saved to store.
+// The returned store is transactional if store is.
+func NewCompressedStore(store state.Store, config CompressionConfig) state.Store {
+	c := &CompressedStore{
+		store:  store,
+		config: config,
+	}
+	if _, ok := store.(state.TransactionalStore); ok {
+		return transactionalCompressedStore{c}
+	}
+	return c
+}
+
+// Unwrap returns the compressed state store
+func (c *CompressedStore) Unwrap() state.Store {
+	return c.store
+}
+
+// Init initializes the compressed state store
+func (c *CompressedStore) Init(metadata state.Metadata) error {
+	return c.store.Init(metadata)
+}
+
+// Get reads a key and decompresses its value
+func (c *CompressedStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	resp, err := c.store.Get(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	data, err := decompressValue(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing the value of key %s: %s", req.Key, err)
+	}
+	decompressed := *resp
+	decompressed.Data = data
+	return &decompressed, nil
+}
+
+// Set compresses the value of a key and saves it
+func (c *CompressedStore) Set(req *state.SetRequest) error {
+	compressed, err := c.compressSet(*req)
+	if err != nil {
+		return err
+	}
+	return c.store.Set(&compressed)
+}
+
+// BulkSet compresses the values of keys and saves them
+func (c *CompressedStore) BulkSet(req []state.SetRequest) error {
+	compressed := make([]state.SetRequest, 0, len(req))
+	for _, r := range req {
+		r, err := c.compressSet(r)
+		if err != nil {
+			return err
+		}
+		compressed = append(compressed, r)
+	}
+	return c.store.BulkSet(compressed)
+}
+
+// Delete deletes a key
+func (c *CompressedStore) Delete(req *state.DeleteRequest) error {
+	return c.store.Delete(req)
+}
+
+// BulkDelete deletes keys
+func (c *CompressedStore) BulkDelete(req []state.DeleteRequest) error {
+	return c.store.BulkDelete(req)
+}
+
+// Flush flushes the compressed state store if it buffers writes
+func (c *CompressedStore) Flush() error {
+	if f, ok := c.store.(lifecycle.Flusher); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+// Close closes the compressed state store if it holds resources
+func (c *CompressedStore) Close() error {
+	if cl, ok := c.store.(io.Closer); ok {
+		return cl.Close()
+	}
+	return nil
+}
+
+// Multi compresses the values of the upserts of a transaction and runs it
+func (t transactionalCompressedStore) Multi(reqs []state.TransactionalRequest) error {
+	compressed := make([]state.TransactionalRequest, 0, len(reqs))
+	for _, r := range reqs {
+		if req, ok := r.Request.(state.SetRequest); ok {
+			req, err := t.compressSet(req)
+			if err != nil {
+				return err
+			}
+			r.Request = req
+		}
+		compressed = append(compressed, r)
+	}
+	return t.store.(state.TransactionalStore).Multi(compressed)
+}
+
+// decompressedChangeFeed decompresses the values of the changes reported by the component of a compressed state store
+type decompressedChangeFeed struct {
+	feed ChangeFeedStore
+}
+
+// SubscribeChanges calls handler with the changes of the keys starting with keyPrefix, with their values decompressed
+func (d decompressedChangeFeed) SubscribeChanges(ctx context.Context, keyPrefix string, handler func(change Change) error) error {
+	return d.feed.SubscribeChanges(ctx, keyPrefix, func(change Change) error {
+		value, err := decompressValue(change.Value)
+		if err != nil {
+			return fmt.Errorf("error decompressing the value of key %s: %s", change.Key, err)
+		}
+		change.Value = value
+		return handler(change)
+	})
+}
+
+// compressesValues returns true if a state store, or a state store it wraps, compresses the values saved
+func compressesValues(store state.Store) bool {
+	for {
+		switch s := store.(type) {
+		case *CompressedStore, transactionalCompressedStore:
+			return true
+		case wrappedStore:
+			store = s.Unwrap()
+		default:
+			return false
+		}
+	}
+}
+
+// compressSet returns the set request with its value compressed if it reaches the threshold
+func (c *CompressedStore) compressSet(req state.SetRequest) (state.SetRequest, error) {
+	data, ok := req.Value.([]byte)
+	if !ok {
+		b, err := json.Marshal(req.Value)
+		if err != nil {
+			return req, fmt.Errorf("error serializing the value of key %s: %s", req.Key, err)
+		}
+		data = b
+	}
+	if len(data) < c.config.Threshold {
+		return req, nil
+	}
+	compressed, err := compressValue(c.config.Algorithm, data)
+	if err != nil {
+		return req, fmt.Errorf("error compressing the value of key %s: %s", req.Key, err)
+	}
+	if len(compressed) < len(data) {
+		req.Value = compressed
+	}
+	return req, nil
+}
+
+// compressValue returns the compressed data prefixed with the compression header
+func compressValue(algorithm string, data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(compressedValuePrefix)
+	buf.WriteString(algorithm)
+	buf.WriteByte(0)
+	var w io.WriteCloser
+	switch algorithm {
+	case CompressionGzip:
+		w = gzip.NewWriter(&buf)
+	case CompressionZstd:
+		zw, err := zstd.NewWriter(&buf)
+		if err != nil {
+			return nil, err
+		}
+		w = zw
+	default:
+		return nil, fmt.Errorf("unknown compression %s", algorithm)
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decompressValue returns a value without its compression, and values that aren't compressed as they are
+func decompressValue(value []byte) ([]byte, error) {
+	if !bytes.HasPrefix(value, compressedValuePrefix) {
+		return value, nil
+	}
+	rest := value[len(compressedValuePrefix):]
+	end := bytes.IndexByte(rest, 0)
+	if end < 0 {
+		return nil, fmt.Errorf("the compression header is incomplete")
+	}
+	algorithm, compressed := string(rest[:end]), rest[end+1:]
+
+	var r io.Reader
+	switch algorithm {
+	case CompressionGzip:
+		gr, err := gzip.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+		r = gr
+	case CompressionZstd:
+		zr, err := zstd.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		r = zr
+	default:
+		return nil, fmt.Errorf("unknown compression %s", algorithm)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(r, maxDecompressedValueSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxDecompressedValueSize {
+		return nil, fmt.Errorf("the decompressed value exceeds %v bytes", maxDecompressedValueSize)
+	}
+	return data, nil
+}
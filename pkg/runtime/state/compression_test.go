@@ -0,0 +1,158 @@
+package state
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/stretchr/testify/assert"
+)
+
+// replayChangeFeedStore reports a fixed list of changes
+type replayChangeFeedStore struct {
+	fakeStore
+	changes []Change
+}
+
+func (s replayChangeFeedStore) SubscribeChanges(ctx context.Context, keyPrefix string, handler func(change Change) error) error {
+	for _, c := range s.changes {
+		if err := handler(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestCompressionFromMetadata(t *testing.T) {
+	_, enabled, err := CompressionFromMetadata(map[string]string{})
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	c, enabled, err := CompressionFromMetadata(map[string]string{CompressionMetadataKey: "gzip"})
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Equal(t, CompressionConfig{Algorithm: CompressionGzip, Threshold: DefaultCompressionThreshold}, c)
+
+	c, _, err = CompressionFromMetadata(map[string]string{CompressionMetadataKey: "zstd", CompressionThresholdMetadataKey: "0"})
+	assert.NoError(t, err)
+	assert.Equal(t, CompressionConfig{Algorithm: CompressionZstd, Threshold: 0}, c)
+
+	for _, properties := range []map[string]string{
+		{CompressionMetadataKey: "lz4"},
+		{CompressionMetadataKey: "zstd", CompressionThresholdMetadataKey: "1KB"},
+		{CompressionMetadataKey: "zstd", CompressionThresholdMetadataKey: "-1"},
+	} {
+		_, _, err := CompressionFromMetadata(properties)
+		assert.Error(t, err)
+	}
+}
+
+func TestCompressedStore(t *testing.T) {
+	large := bytes.Repeat([]byte("value "), 100)
+
+	for _, algorithm := range []string{CompressionGzip, CompressionZstd} {
+		t.Run(algorithm, func(t *testing.T) {
+			store := &countingStore{data: map[string][]byte{}}
+			compressed := NewCompressedStore(store, CompressionConfig{Algorithm: algorithm, Threshold: 64})
+
+			assert.NoError(t, compressed.Set(&state.SetRequest{Key: "large", Value: large}))
+			assert.True(t, bytes.HasPrefix(store.data["large"], compressedValuePrefix))
+			assert.Less(t, len(store.data["large"]), len(large))
+			resp, err := compressed.Get(&state.GetRequest{Key: "large"})
+			assert.NoError(t, err)
+			assert.Equal(t, large, resp.Data)
+			assert.Equal(t, "1", resp.ETag)
+
+			// values below the threshold are saved as they are
+			assert.NoError(t, compressed.Set(&state.SetRequest{Key: "small", Value: []byte("value")}))
+			assert.Equal(t, []byte("value"), store.data["small"])
+			resp, err = compressed.Get(&state.GetRequest{Key: "small"})
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("value"), resp.Data)
+		})
+	}
+
+	t.Run("values saved before compression are read as they are", func(t *testing.T) {
+		store := &countingStore{data: map[string][]byte{"key": large}}
+		compressed := NewCompressedStore(store, CompressionConfig{Algorithm: CompressionZstd})
+		resp, err := compressed.Get(&state.GetRequest{Key: "key"})
+		assert.NoError(t, err)
+		assert.Equal(t, large, resp.Data)
+	})
+
+	t.Run("values are serialized as JSON before compression", func(t *testing.T) {
+		store := &countingStore{data: map[string][]byte{}}
+		compressed := NewCompressedStore(store, CompressionConfig{Algorithm: CompressionGzip})
+		value := map[string]string{"name": string(large)}
+		assert.NoError(t, compressed.Set(&state.SetRequest{Key: "key", Value: value}))
+		resp, err := compressed.Get(&state.GetRequest{Key: "key"})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name":"`+string(large)+`"}`, string(resp.Data))
+	})
+
+	t.Run("values that don't shrink are saved as they are", func(t *testing.T) {
+		store := &countingStore{data: map[string][]byte{}}
+		compressed := NewCompressedStore(store, CompressionConfig{Algorithm: CompressionGzip})
+		assert.NoError(t, compressed.Set(&state.SetRequest{Key: "key", Value: []byte("x")}))
+		assert.Equal(t, []byte("x"), store.data["key"])
+	})
+
+	t.Run("corrupt values fail", func(t *testing.T) {
+		corrupt := append(append([]byte{}, compressedValuePrefix...), []byte("zstd\x00garbage")...)
+		store := &countingStore{data: map[string][]byte{"key": corrupt}}
+		compressed := NewCompressedStore(store, CompressionConfig{Algorithm: CompressionZstd})
+		_, err := compressed.Get(&state.GetRequest{Key: "key"})
+		assert.Error(t, err)
+	})
+}
+
+func TestCompressedStoreTransaction(t *testing.T) {
+	var reqs []state.TransactionalRequest
+	compressed := NewCompressedStore(recordingTransactionalStore{reqs: &reqs}, CompressionConfig{Algorithm: CompressionZstd})
+	_, ok := compressed.(state.TransactionalStore)
+	assert.True(t, ok)
+
+	large := bytes.Repeat([]byte("value "), 1000)
+	assert.NoError(t, compressed.(state.TransactionalStore).Multi([]state.TransactionalRequest{
+		{Operation: state.Upsert, Request: state.SetRequest{Key: "a", Value: large}},
+		{Operation: state.Delete, Request: state.DeleteRequest{Key: "b"}},
+	}))
+	assert.Len(t, reqs, 2)
+	assert.True(t, bytes.HasPrefix(reqs[0].Request.(state.SetRequest).Value.([]byte), compressedValuePrefix))
+	assert.Equal(t, state.DeleteRequest{Key: "b"}, reqs[1].Request)
+}
+
+func TestCompressedChangeFeed(t *testing.T) {
+	large := bytes.Repeat([]byte("value "), 1000)
+	value, err := compressValue(CompressionGzip, large)
+	assert.NoError(t, err)
+	component := replayChangeFeedStore{changes: []Change{{Key: "key", Operation: ChangeUpsert, Value: value}}}
+
+	// the read cache wraps the compressed store in the runtime
+	store := NewCachedStore(NewCompressedStore(component, CompressionConfig{Algorithm: CompressionGzip}), ReadCacheConfig{TTL: 1, MaxEntries: 1})
+	feed, ok := AsChangeFeedStore(store)
+	assert.True(t, ok)
+	var changes []Change
+	assert.NoError(t, feed.SubscribeChanges(context.Background(), "", func(change Change) error {
+		changes = append(changes, change)
+		return nil
+	}))
+	assert.Equal(t, []Change{{Key: "key", Operation: ChangeUpsert, Value: large}}, changes)
+
+	// the changes of stores without compression are reported as they are
+	feed, _ = AsChangeFeedStore(component)
+	_, decompressed := feed.(decompressedChangeFeed)
+	assert.False(t, decompressed)
+}
+
+// recordingTransactionalStore records the requests of the transactions it runs
+type recordingTransactionalStore struct {
+	state.Store
+	reqs *[]state.TransactionalRequest
+}
+
+func (s recordingTransactionalStore) Multi(reqs []state.TransactionalRequest) error {
+	*s.reqs = append(*s.reqs, reqs...)
+	return nil
+}
@@ -0,0 +1,214 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// CrossStoreStatus is the outcome of a cross-store transaction on one of its state stores
+type CrossStoreStatus string
+
+const (
+	// CrossStoreNotAttempted is the status of the stores left untouched after another store failed
+	CrossStoreNotAttempted CrossStoreStatus = "NOT_ATTEMPTED"
+	// CrossStoreCommitted is the status of the stores the operations were applied to
+	CrossStoreCommitted CrossStoreStatus = "COMMITTED"
+	// CrossStoreFailed is the status of the store whose operations failed, left as it was before the transaction
+	CrossStoreFailed CrossStoreStatus = "FAILED"
+	// CrossStoreCompensated is the status of the stores restored after another store failed
+	CrossStoreCompensated CrossStoreStatus = "COMPENSATED"
+	// CrossStoreCompensationFailed is the status of the stores that couldn't be restored and need to be repaired
+	CrossStoreCompensationFailed CrossStoreStatus = "COMPENSATION_FAILED"
+)
+
+// CrossStoreOperation is an upsert or delete of a cross-store transaction.
+// Request is a state.SetRequest for upserts and a state.DeleteRequest for deletes.
+type CrossStoreOperation struct {
+	StoreName string
+	state.TransactionalRequest
+}
+
+// CrossStoreStoreResult is the outcome of a cross-store transaction on a state store
+type CrossStoreStoreResult struct {
+	StoreName string
+	Status    CrossStoreStatus
+	// Err is the error of the operations or of the compensation of the store
+	Err error
+}
+
+// CrossStoreResult is the outcome of a cross-store transaction, with a result per state store in the order the stores
+// were first referenced
+type CrossStoreResult struct {
+	Committed bool
+	Stores    []CrossStoreStoreResult
+}
+
+// snapshot is the value of a key before a cross-store transaction, to restore it if the transaction fails
+type snapshot struct {
+	key    string
+	data   []byte
+	exists bool
+}
+
+// ExecuteCrossStore applies operations spanning several state stores as a saga. The operations of each store are
+// applied in one transaction if the store is transactional. When the operations of a store fail, the keys of the
+// stores already changed are restored to the values read before the transaction.
+// The transaction isn't isolated: other writers can see its intermediate state, and writes they make to the keys of
+// the transaction before it's compensated are overwritten. An error is returned only for invalid operations, before
+// any store is changed.
+func ExecuteCrossStore(stores map[string]state.Store, operations []CrossStoreOperation) (*CrossStoreResult, error) {
+	var order []string
+	grouped := map[string][]state.TransactionalRequest{}
+	for _, o := range operations {
+		if _, ok := stores[o.StoreName]; !ok {
+			return nil, fmt.Errorf("state store %s not found", o.StoreName)
+		}
+		if _, err := operationKey(o.TransactionalRequest); err != nil {
+			return nil, err
+		}
+		if _, ok := grouped[o.StoreName]; !ok {
+			order = append(order, o.StoreName)
+		}
+		grouped[o.StoreName] = append(grouped[o.StoreName], o.TransactionalRequest)
+	}
+
+	result := &CrossStoreResult{Stores: make([]CrossStoreStoreResult, len(order))}
+	snapshots := make([][]snapshot, len(order))
+	for i, name := range order {
+		result.Stores[i] = CrossStoreStoreResult{StoreName: name, Status: CrossStoreNotAttempted}
+	}
+	for i, name := range order {
+		store := stores[name]
+		snaps, err := takeSnapshots(store, grouped[name])
+		if err == nil {
+			snapshots[i] = snaps
+			var applied int
+			applied, err = applyOperations(store, grouped[name])
+			if err != nil && applied > 0 {
+				// a store without transactions keeps the operations applied before the failing one
+				if cerr := restoreSnapshots(store, appliedSnapshots(snaps, grouped[name][:applied])); cerr != nil {
+					result.Stores[i] = CrossStoreStoreResult{StoreName: name, Status: CrossStoreCompensationFailed,
+						Err: fmt.Errorf("%s, then failed to restore the applied operations: %s", err, cerr)}
+					compensate(stores, order[:i], snapshots[:i], result)
+					return result, nil
+				}
+			}
+		}
+		if err != nil {
+			result.Stores[i] = CrossStoreStoreResult{StoreName: name, Status: CrossStoreFailed, Err: err}
+			compensate(stores, order[:i], snapshots[:i], result)
+			return result, nil
+		}
+		result.Stores[i].Status = CrossStoreCommitted
+	}
+	result.Committed = true
+	return result, nil
+}
+
+// compensate restores the stores changed by a failed cross-store transaction, in reverse order
+func compensate(stores map[string]state.Store, names []string, snapshots [][]snapshot, result *CrossStoreResult) {
+	for i := len(names) - 1; i >= 0; i-- {
+		if err := restoreSnapshots(stores[names[i]], snapshots[i]); err != nil {
+			result.Stores[i].Status = CrossStoreCompensationFailed
+			result.Stores[i].Err = err
+			continue
+		}
+		result.Stores[i].Status = CrossStoreCompensated
+	}
+}
+
+// takeSnapshots reads the keys written by operations
+func takeSnapshots(store state.Store, reqs []state.TransactionalRequest) ([]snapshot, error) {
+	seen := map[string]bool{}
+	var snaps []snapshot
+	for _, r := range reqs {
+		key, _ := operationKey(r)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		resp, err := store.Get(&state.GetRequest{Key: key})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key %s before the transaction: %s", key, err)
+		}
+		s := snapshot{key: key}
+		if resp != nil && resp.Data != nil {
+			s.data = resp.Data
+			s.exists = true
+		}
+		snaps = append(snaps, s)
+	}
+	return snaps, nil
+}
+
+// appliedSnapshots returns the snapshots of the keys written by operations
+func appliedSnapshots(snaps []snapshot, reqs []state.TransactionalRequest) []snapshot {
+	written := map[string]bool{}
+	for _, r := range reqs {
+		key, _ := operationKey(r)
+		written[key] = true
+	}
+	var applied []snapshot
+	for _, s := range snaps {
+		if written[s.key] {
+			applied = append(applied, s)
+		}
+	}
+	return applied
+}
+
+// applyOperations applies operations to a store and returns how many were applied before an error
+func applyOperations(store state.Store, reqs []state.TransactionalRequest) (int, error) {
+	if ts, ok := store.(state.TransactionalStore); ok {
+		if err := ts.Multi(reqs); err != nil {
+			return 0, err
+		}
+		return len(reqs), nil
+	}
+	for i, r := range reqs {
+		var err error
+		switch req := r.Request.(type) {
+		case state.SetRequest:
+			err = store.Set(&req)
+		case state.DeleteRequest:
+			err = store.Delete(&req)
+		}
+		if err != nil {
+			return i, err
+		}
+	}
+	return len(reqs), nil
+}
+
+// restoreSnapshots writes back the keys of a store as they were before a transaction
+func restoreSnapshots(store state.Store, snaps []snapshot) error {
+	reqs := make([]state.TransactionalRequest, 0, len(snaps))
+	for _, s := range snaps {
+		if s.exists {
+			reqs = append(reqs, state.TransactionalRequest{Operation: state.Upsert, Request: state.SetRequest{Key: s.key, Value: s.data}})
+		} else {
+			reqs = append(reqs, state.TransactionalRequest{Operation: state.Delete, Request: state.DeleteRequest{Key: s.key}})
+		}
+	}
+	_, err := applyOperations(store, reqs)
+	return err
+}
+
+// operationKey returns the key of an operation of a cross-store transaction
+func operationKey(r state.TransactionalRequest) (string, error) {
+	switch req := r.Request.(type) {
+	case state.SetRequest:
+		if r.Operation != state.Upsert {
+			return "", fmt.Errorf("operation %s on key %s must be %s", r.Operation, req.Key, state.Upsert)
+		}
+		return req.Key, nil
+	case state.DeleteRequest:
+		if r.Operation != state.Delete {
+			return "", fmt.Errorf("operation %s on key %s must be %s", r.Operation, req.Key, state.Delete)
+		}
+		return req.Key, nil
+	default:
+		return "", fmt.Errorf("operation type %s not supported", r.Operation)
+	}
+}
@@ -0,0 +1,146 @@
+package state
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/stretchr/testify/assert"
+)
+
+// sagaStore is a state store failing the writes of failKey, and all the writes once broken
+type sagaStore struct {
+	state.Store
+	data    map[string][]byte
+	failKey string
+	broken  bool
+	onFail  func()
+}
+
+func (s *sagaStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	return &state.GetResponse{Data: s.data[req.Key]}, nil
+}
+
+func (s *sagaStore) Set(req *state.SetRequest) error {
+	if s.broken || req.Key == s.failKey {
+		return s.fail()
+	}
+	switch v := req.Value.(type) {
+	case []byte:
+		s.data[req.Key] = v
+	case string:
+		s.data[req.Key] = []byte(v)
+	}
+	return nil
+}
+
+func (s *sagaStore) Delete(req *state.DeleteRequest) error {
+	if s.broken || req.Key == s.failKey {
+		return s.fail()
+	}
+	delete(s.data, req.Key)
+	return nil
+}
+
+func (s *sagaStore) fail() error {
+	if s.onFail != nil {
+		s.onFail()
+	}
+	return errors.New("write failed")
+}
+
+type transactionalSagaStore struct {
+	*sagaStore
+}
+
+func (s transactionalSagaStore) Multi(reqs []state.TransactionalRequest) error {
+	for _, r := range reqs {
+		if key, _ := operationKey(r); s.broken || key == s.failKey {
+			return errors.New("transaction failed")
+		}
+	}
+	_, err := applyOperations(s.sagaStore, reqs)
+	return err
+}
+
+func upsert(storeName, key, value string) CrossStoreOperation {
+	return CrossStoreOperation{
+		StoreName:            storeName,
+		TransactionalRequest: state.TransactionalRequest{Operation: state.Upsert, Request: state.SetRequest{Key: key, Value: value}},
+	}
+}
+
+func TestExecuteCrossStore(t *testing.T) {
+	newStores := func() (*sagaStore, *sagaStore, map[string]state.Store) {
+		hot := &sagaStore{data: map[string][]byte{"a": []byte("1")}}
+		cold := &sagaStore{data: map[string][]byte{}}
+		return hot, cold, map[string]state.Store{"hot": transactionalSagaStore{hot}, "cold": cold}
+	}
+
+	t.Run("commits every store", func(t *testing.T) {
+		hot, cold, stores := newStores()
+		result, err := ExecuteCrossStore(stores, []CrossStoreOperation{upsert("hot", "a", "2"), upsert("cold", "b", "3"), {
+			StoreName:            "hot",
+			TransactionalRequest: state.TransactionalRequest{Operation: state.Delete, Request: state.DeleteRequest{Key: "c"}},
+		}})
+		assert.NoError(t, err)
+		assert.True(t, result.Committed)
+		assert.Equal(t, []CrossStoreStoreResult{{StoreName: "hot", Status: CrossStoreCommitted}, {StoreName: "cold", Status: CrossStoreCommitted}}, result.Stores)
+		assert.Equal(t, []byte("2"), hot.data["a"])
+		assert.Equal(t, []byte("3"), cold.data["b"])
+	})
+
+	t.Run("compensates the stores changed before a failure", func(t *testing.T) {
+		hot, cold, stores := newStores()
+		cold.failKey = "c"
+		stores["other"] = &sagaStore{data: map[string][]byte{}}
+		result, err := ExecuteCrossStore(stores, []CrossStoreOperation{
+			upsert("hot", "a", "2"), upsert("hot", "new", "2"), upsert("cold", "b", "3"), upsert("cold", "c", "3"), upsert("other", "d", "4"),
+		})
+		assert.NoError(t, err)
+		assert.False(t, result.Committed)
+		assert.Equal(t, CrossStoreCompensated, result.Stores[0].Status)
+		assert.Equal(t, CrossStoreFailed, result.Stores[1].Status)
+		assert.EqualError(t, result.Stores[1].Err, "write failed")
+		assert.Equal(t, CrossStoreNotAttempted, result.Stores[2].Status)
+		assert.Equal(t, map[string][]byte{"a": []byte("1")}, hot.data)
+		assert.Empty(t, cold.data)
+	})
+
+	t.Run("reports the stores that couldn't be compensated", func(t *testing.T) {
+		hot, cold, stores := newStores()
+		cold.failKey = "b"
+		// the hot store goes down with the cold store
+		cold.onFail = func() { hot.broken = true }
+		result, err := ExecuteCrossStore(stores, []CrossStoreOperation{upsert("hot", "a", "2"), upsert("cold", "b", "3")})
+		assert.NoError(t, err)
+		assert.False(t, result.Committed)
+		assert.Equal(t, CrossStoreCompensationFailed, result.Stores[0].Status)
+		assert.EqualError(t, result.Stores[0].Err, "transaction failed")
+		assert.Equal(t, CrossStoreFailed, result.Stores[1].Status)
+		assert.Equal(t, []byte("2"), hot.data["a"])
+	})
+
+	t.Run("restores the operations applied to a store without transactions", func(t *testing.T) {
+		_, cold, stores := newStores()
+		cold.data["b"] = []byte("1")
+		cold.failKey = "c"
+		result, err := ExecuteCrossStore(stores, []CrossStoreOperation{upsert("cold", "b", "2"), upsert("cold", "c", "2")})
+		assert.NoError(t, err)
+		assert.Equal(t, CrossStoreFailed, result.Stores[0].Status)
+		assert.Equal(t, map[string][]byte{"b": []byte("1")}, cold.data)
+	})
+
+	t.Run("rejects invalid operations before changing any store", func(t *testing.T) {
+		hot, _, stores := newStores()
+		_, err := ExecuteCrossStore(stores, []CrossStoreOperation{upsert("hot", "a", "2"), upsert("unknown", "b", "3")})
+		assert.EqualError(t, err, "state store unknown not found")
+
+		_, err = ExecuteCrossStore(stores, []CrossStoreOperation{upsert("hot", "a", "2"), {
+			StoreName:            "cold",
+			TransactionalRequest: state.TransactionalRequest{Operation: state.Delete, Request: state.SetRequest{Key: "b"}},
+		}})
+		assert.EqualError(t, err, "operation delete on key b must be upsert")
+		assert.Equal(t, []byte("1"), hot.data["a"])
+	})
+}
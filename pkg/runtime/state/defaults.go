@@ -0,0 +1,85 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+const (
+	// DefaultConsistencyMetadataKey is the state store component metadata item with the consistency applied to requests without one
+	DefaultConsistencyMetadataKey = "defaultConsistency"
+	// DefaultConcurrencyMetadataKey is the state store component metadata item with the concurrency applied to requests without one
+	DefaultConcurrencyMetadataKey = "defaultConcurrency"
+
+	// ConsistencyHeader returns the consistency a state request was executed with
+	ConsistencyHeader = "dapr-state-consistency"
+	// ConcurrencyHeader returns the concurrency a state request was executed with
+	ConcurrencyHeader = "dapr-state-concurrency"
+)
+
+// Defaults are the options applied to state requests that don't set them
+type Defaults struct {
+	Consistency string
+	Concurrency string
+}
+
+// DefaultsFromMetadata reads the default options from the metadata of a state store component
+func DefaultsFromMetadata(properties map[string]string) (Defaults, error) {
+	d := Defaults{
+		Consistency: properties[DefaultConsistencyMetadataKey],
+		Concurrency: properties[DefaultConcurrencyMetadataKey],
+	}
+	if d.Consistency != "" && d.Consistency != state.Strong && d.Consistency != state.Eventual {
+		return d, fmt.Errorf("%s must be %s or %s", DefaultConsistencyMetadataKey, state.Strong, state.Eventual)
+	}
+	if d.Concurrency != "" && d.Concurrency != state.FirstWrite && d.Concurrency != state.LastWrite {
+		return d, fmt.Errorf("%s must be %s or %s", DefaultConcurrencyMetadataKey, state.FirstWrite, state.LastWrite)
+	}
+	return d, nil
+}
+
+// ApplyToGet sets the default consistency on a get request without one
+func (d Defaults) ApplyToGet(req *state.GetRequest) {
+	if req.Options.Consistency == "" {
+		req.Options.Consistency = d.Consistency
+	}
+}
+
+// ApplyToSet sets the default consistency and concurrency on a set request without them
+func (d Defaults) ApplyToSet(req *state.SetRequest) {
+	if req.Options.Consistency == "" {
+		req.Options.Consistency = d.Consistency
+	}
+	if req.Options.Concurrency == "" {
+		req.Options.Concurrency = d.Concurrency
+	}
+}
+
+// ApplyToDelete sets the default consistency and concurrency on a delete request without them
+func (d Defaults) ApplyToDelete(req *state.DeleteRequest) {
+	if req.Options.Consistency == "" {
+		req.Options.Consistency = d.Consistency
+	}
+	if req.Options.Concurrency == "" {
+		req.Options.Concurrency = d.Concurrency
+	}
+}
+
+// EffectiveSetOptions returns the consistency and concurrency shared by all the requests.
+// A value is empty if the store default applies or if the requests use different values.
+func EffectiveSetOptions(reqs []state.SetRequest) (string, string) {
+	if len(reqs) == 0 {
+		return "", ""
+	}
+	consistency, concurrency := reqs[0].Options.Consistency, reqs[0].Options.Concurrency
+	for _, r := range reqs[1:] {
+		if r.Options.Consistency != consistency {
+			consistency = ""
+		}
+		if r.Options.Concurrency != concurrency {
+			concurrency = ""
+		}
+	}
+	return consistency, concurrency
+}
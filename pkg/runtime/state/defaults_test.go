@@ -0,0 +1,75 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultsFromMetadata(t *testing.T) {
+	t.Run("no defaults", func(t *testing.T) {
+		d, err := DefaultsFromMetadata(map[string]string{})
+		assert.NoError(t, err)
+		assert.Equal(t, Defaults{}, d)
+	})
+
+	t.Run("valid defaults", func(t *testing.T) {
+		d, err := DefaultsFromMetadata(map[string]string{
+			DefaultConsistencyMetadataKey: state.Strong,
+			DefaultConcurrencyMetadataKey: state.FirstWrite,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, Defaults{Consistency: state.Strong, Concurrency: state.FirstWrite}, d)
+	})
+
+	t.Run("invalid consistency", func(t *testing.T) {
+		_, err := DefaultsFromMetadata(map[string]string{DefaultConsistencyMetadataKey: "linearizable"})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid concurrency", func(t *testing.T) {
+		_, err := DefaultsFromMetadata(map[string]string{DefaultConcurrencyMetadataKey: "optimistic"})
+		assert.Error(t, err)
+	})
+}
+
+func TestApplyDefaults(t *testing.T) {
+	d := Defaults{Consistency: state.Strong, Concurrency: state.FirstWrite}
+
+	t.Run("get without options", func(t *testing.T) {
+		req := state.GetRequest{Key: "key"}
+		d.ApplyToGet(&req)
+		assert.Equal(t, state.Strong, req.Options.Consistency)
+	})
+
+	t.Run("set keeps request options", func(t *testing.T) {
+		req := state.SetRequest{Key: "key", Options: state.SetStateOption{Consistency: state.Eventual}}
+		d.ApplyToSet(&req)
+		assert.Equal(t, state.Eventual, req.Options.Consistency)
+		assert.Equal(t, state.FirstWrite, req.Options.Concurrency)
+	})
+
+	t.Run("delete without options", func(t *testing.T) {
+		req := state.DeleteRequest{Key: "key"}
+		d.ApplyToDelete(&req)
+		assert.Equal(t, state.Strong, req.Options.Consistency)
+		assert.Equal(t, state.FirstWrite, req.Options.Concurrency)
+	})
+
+	t.Run("zero defaults", func(t *testing.T) {
+		req := state.DeleteRequest{Key: "key"}
+		Defaults{}.ApplyToDelete(&req)
+		assert.Empty(t, req.Options.Consistency)
+		assert.Empty(t, req.Options.Concurrency)
+	})
+}
+
+func TestEffectiveSetOptions(t *testing.T) {
+	consistency, concurrency := EffectiveSetOptions([]state.SetRequest{
+		{Options: state.SetStateOption{Consistency: state.Strong, Concurrency: state.FirstWrite}},
+		{Options: state.SetStateOption{Consistency: state.Strong, Concurrency: state.LastWrite}},
+	})
+	assert.Equal(t, state.Strong, consistency)
+	assert.Empty(t, concurrency)
+}
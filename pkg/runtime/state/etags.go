@@ -0,0 +1,114 @@
+package state
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// SetEachParallelism bounds the keys SetEach saves at once
+const SetEachParallelism = 10
+
+// AcceptedETags returns the ETags a conditional write is accepted with: etag followed by the other etags, without
+// duplicates. It returns nil when the write has one ETag at most, which the state store checks itself.
+func AcceptedETags(etag string, etags []string) []string {
+	accepted := []string{}
+	seen := map[string]bool{}
+	for _, e := range append([]string{etag}, etags...) {
+		if e != "" && !seen[e] {
+			seen[e] = true
+			accepted = append(accepted, e)
+		}
+	}
+	if len(accepted) < 2 {
+		return nil
+	}
+	return accepted
+}
+
+// SetWithETags saves a key conditionally on each of etags in turn until the state store accepts one of them.
+// State stores don't tell an ETag mismatch from other failures, so the error of the last ETag is returned if none is
+// accepted.
+func SetWithETags(store state.Store, req state.SetRequest, etags []string) error {
+	return tryETags(req.Key, etags, func(etag string) error {
+		req.ETag = etag
+		return store.Set(&req)
+	})
+}
+
+// DeleteWithETags deletes a key conditionally on each of etags in turn until the state store accepts one of them.
+// The key is deleted with the ETag of the request when etags is nil.
+func DeleteWithETags(store state.Store, req state.DeleteRequest, etags []string) error {
+	if etags == nil {
+		return store.Delete(&req)
+	}
+	return tryETags(req.Key, etags, func(etag string) error {
+		req.ETag = etag
+		return store.Delete(&req)
+	})
+}
+
+// BulkSetWithETags saves keys, in bulk but for the keys accepting several ETags, which are saved one at a time with
+// SetWithETags. etags holds the accepted ETags of the request at the same index, nil for requests with one ETag at most.
+func BulkSetWithETags(store state.Store, reqs []state.SetRequest, etags [][]string) error {
+	bulk := make([]state.SetRequest, 0, len(reqs))
+	for i, req := range reqs {
+		if i >= len(etags) || etags[i] == nil {
+			bulk = append(bulk, req)
+		}
+	}
+	if len(bulk) == len(reqs) {
+		return store.BulkSet(reqs)
+	}
+
+	if len(bulk) > 0 {
+		if err := store.BulkSet(bulk); err != nil {
+			return err
+		}
+	}
+	for i, req := range reqs {
+		if i < len(etags) && etags[i] != nil {
+			if err := SetWithETags(store, req, etags[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// SetEach saves every key on its own, so a failed key doesn't fail the others, and returns the error of every request
+// at its index, nil for the keys saved. At most SetEachParallelism keys are saved at once. etags holds the accepted
+// ETags of the request at the same index as for BulkSetWithETags.
+func SetEach(store state.Store, reqs []state.SetRequest, etags [][]string) []error {
+	errs := make([]error, len(reqs))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, SetEachParallelism)
+	for i := range reqs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if i < len(etags) && etags[i] != nil {
+				errs[i] = SetWithETags(store, reqs[i], etags[i])
+			} else {
+				errs[i] = store.Set(&reqs[i])
+			}
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+func tryETags(key string, etags []string, write func(etag string) error) error {
+	var err error
+	for _, etag := range etags {
+		if err = write(etag); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("the write of key %s failed with each of the etags %v: %s", key, etags, err)
+}
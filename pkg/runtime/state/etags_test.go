@@ -0,0 +1,130 @@
+package state
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/stretchr/testify/assert"
+)
+
+// etagStore is a state store rejecting the writes with an ETag other than the current ETag of their key
+type etagStore struct {
+	state.Store
+	etags  map[string]string
+	writes int
+	bulks  int
+}
+
+func (s *etagStore) check(key, etag string) error {
+	s.writes++
+	if etag != "" && etag != s.etags[key] {
+		return errors.New("possible etag mismatch")
+	}
+	return nil
+}
+
+func (s *etagStore) Set(req *state.SetRequest) error {
+	if err := s.check(req.Key, req.ETag); err != nil {
+		return err
+	}
+	s.etags[req.Key] += "+"
+	return nil
+}
+
+func (s *etagStore) BulkSet(reqs []state.SetRequest) error {
+	s.bulks++
+	for _, req := range reqs {
+		if err := s.Set(&req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *etagStore) Delete(req *state.DeleteRequest) error {
+	if err := s.check(req.Key, req.ETag); err != nil {
+		return err
+	}
+	delete(s.etags, req.Key)
+	return nil
+}
+
+func TestAcceptedETags(t *testing.T) {
+	assert.Nil(t, AcceptedETags("", nil))
+	assert.Nil(t, AcceptedETags("1", nil))
+	assert.Nil(t, AcceptedETags("1", []string{"1", ""}))
+	assert.Equal(t, []string{"1", "2"}, AcceptedETags("1", []string{"2", "1"}))
+	assert.Equal(t, []string{"2", "3"}, AcceptedETags("", []string{"2", "3"}))
+}
+
+func TestSetWithETags(t *testing.T) {
+	store := &etagStore{etags: map[string]string{"key": "2"}}
+	assert.NoError(t, SetWithETags(store, state.SetRequest{Key: "key"}, []string{"1", "2", "3"}))
+	assert.Equal(t, 2, store.writes)
+
+	err := SetWithETags(store, state.SetRequest{Key: "key"}, []string{"1", "2"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "possible etag mismatch")
+}
+
+func TestDeleteWithETags(t *testing.T) {
+	store := &etagStore{etags: map[string]string{"key": "2"}}
+	assert.Error(t, DeleteWithETags(store, state.DeleteRequest{Key: "key", ETag: "2"}, []string{"1", "3"}))
+	assert.NoError(t, DeleteWithETags(store, state.DeleteRequest{Key: "key"}, []string{"1", "2"}))
+	_, ok := store.etags["key"]
+	assert.False(t, ok)
+
+	// without accepted ETags the request is deleted with its own ETag
+	store.etags["key"] = "1"
+	assert.NoError(t, DeleteWithETags(store, state.DeleteRequest{Key: "key", ETag: "1"}, nil))
+}
+
+func TestBulkSetWithETags(t *testing.T) {
+	store := &etagStore{etags: map[string]string{"a": "1", "b": "2"}}
+	reqs := []state.SetRequest{{Key: "a", ETag: "1"}, {Key: "b", ETag: "1"}, {Key: "c"}}
+	assert.NoError(t, BulkSetWithETags(store, reqs, [][]string{nil, {"1", "2"}, nil}))
+	assert.Equal(t, 1, store.bulks)
+	assert.Equal(t, map[string]string{"a": "1+", "b": "2+", "c": "+"}, store.etags)
+
+	// requests without several ETags are saved in one bulk
+	store = &etagStore{etags: map[string]string{}}
+	assert.NoError(t, BulkSetWithETags(store, reqs[2:], [][]string{nil}))
+	assert.Equal(t, 1, store.bulks)
+
+	store = &etagStore{etags: map[string]string{"b": "3"}}
+	assert.Error(t, BulkSetWithETags(store, reqs[1:2], [][]string{{"1", "2"}}))
+}
+
+// lockedStore serializes the calls to a state store that isn't safe for concurrent use
+type lockedStore struct {
+	state.Store
+	lock sync.Mutex
+}
+
+func (s *lockedStore) Set(req *state.SetRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.Store.Set(req)
+}
+
+func TestSetEach(t *testing.T) {
+	store := &etagStore{etags: map[string]string{"a": "1", "b": "1"}}
+	reqs := []state.SetRequest{
+		{Key: "a", ETag: "1"},
+		{Key: "b", ETag: "2"},
+		{Key: "c"},
+		{Key: "b"},
+	}
+	etags := [][]string{nil, nil, nil, {"0", "1"}}
+
+	errs := SetEach(&lockedStore{Store: store}, reqs, etags)
+	assert.Len(t, errs, 4)
+	assert.NoError(t, errs[0])
+	assert.Error(t, errs[1])
+	assert.NoError(t, errs[2])
+	assert.NoError(t, errs[3])
+	assert.Equal(t, "1+", store.etags["a"])
+	assert.Equal(t, "+", store.etags["c"])
+}
@@ -0,0 +1,330 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/components/lifecycle"
+	"github.com/dapr/dapr/pkg/failover"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ReadPreferenceMetadataKey is the metadata item of a primary state store selecting the component reads are sent to
+	ReadPreferenceMetadataKey = "failoverReadPreference"
+
+	// ReadPreferenceActive reads from the component writes are sent to. This is the default.
+	ReadPreferenceActive = "active"
+	// ReadPreferencePrimary reads from the primary component and falls back to the secondary component on errors
+	ReadPreferencePrimary = "primaryPreferred"
+	// ReadPreferenceSecondary reads from the secondary component and falls back to the primary component on errors
+	ReadPreferenceSecondary = "secondaryPreferred"
+
+	// maxDivergentKeys caps the keys written during a failover that are listed in divergence warnings
+	maxDivergentKeys = 10
+)
+
+// unavailableMessages are parts of the messages of transport errors that stores return without wrapping the original error
+var unavailableMessages = []string{
+	"connection refused",
+	"connection reset",
+	"broken pipe",
+	"i/o timeout",
+	"no such host",
+	"network is unreachable",
+}
+
+// ValidateReadPreference returns an error for values not accepted as a read preference
+func ValidateReadPreference(preference string) error {
+	switch preference {
+	case "", ReadPreferenceActive, ReadPreferencePrimary, ReadPreferenceSecondary:
+		return nil
+	default:
+		return fmt.Errorf("%s must be %s, %s or %s", ReadPreferenceMetadataKey, ReadPreferenceActive, ReadPreferencePrimary, ReadPreferenceSecondary)
+	}
+}
+
+// Divergence describes the writes a component missed while the other component of a failover pair was active
+type Divergence struct {
+	// Target is the component that missed the writes
+	Target failover.Target
+	Writes int
+	Keys   []string
+}
+
+// FailoverStore sends requests to a primary state store and fails over to a secondary state store when the primary keeps failing.
+// The components aren't replicated: writes made to one component while the other is active are reported as a divergence.
+type FailoverStore struct {
+	primary        state.Store
+	secondary      state.Store
+	breaker        *failover.Breaker
+	readPreference string
+	onDivergence   func(d Divergence)
+
+	lock       sync.Mutex
+	divergence Divergence
+}
+
+type transactionalFailoverStore struct {
+	*FailoverStore
+}
+
+// NewFailoverStore returns a state store switching between two initialized components.
+// onSwitch is called every time the component writes are sent to changes, and onDivergence
+// when switching back to the primary component after writes were made to the secondary component.
+// The returned store is transactional if both components are.
+func NewFailoverStore(primary, secondary state.Store, config failover.Config, readPreference string, onSwitch func(from, to failover.Target), onDivergence func(d Divergence)) state.Store {
+	f := &FailoverStore{
+		primary:        primary,
+		secondary:      secondary,
+		readPreference: readPreference,
+		onDivergence:   onDivergence,
+	}
+	f.breaker = failover.NewBreaker(config, func(from, to failover.Target) {
+		if onSwitch != nil {
+			onSwitch(from, to)
+		}
+		if to == failover.Primary {
+			f.reportDivergence()
+		}
+	})
+
+	_, primaryTransactional := primary.(state.TransactionalStore)
+	_, secondaryTransactional := secondary.(state.TransactionalStore)
+	if primaryTransactional && secondaryTransactional {
+		return transactionalFailoverStore{f}
+	}
+	return f
+}
+
+// Init is a no-op: both components are initialized before they are paired
+func (f *FailoverStore) Init(metadata state.Metadata) error {
+	return nil
+}
+
+// Active returns the component writes are currently sent to
+func (f *FailoverStore) Active() failover.Target {
+	return f.breaker.Active()
+}
+
+// Get reads a key from the component selected by the read preference
+func (f *FailoverStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	var resp *state.GetResponse
+	switch f.readPreference {
+	case ReadPreferencePrimary, ReadPreferenceSecondary:
+		first, second := failover.Primary, failover.Secondary
+		if f.readPreference == ReadPreferenceSecondary {
+			first, second = second, first
+		}
+		var err error
+		resp, err = f.get(first).Get(req)
+		f.report(first, err)
+		if !IsUnavailableError(err) {
+			return resp, err
+		}
+		resp, err = f.get(second).Get(req)
+		f.report(second, err)
+		return resp, err
+	default:
+		err := f.do(func(store state.Store) error {
+			var err error
+			resp, err = store.Get(req)
+			return err
+		}, nil)
+		return resp, err
+	}
+}
+
+// Set saves a key to the active component
+func (f *FailoverStore) Set(req *state.SetRequest) error {
+	return f.do(func(store state.Store) error {
+		return store.Set(req)
+	}, []string{req.Key})
+}
+
+// BulkSet saves keys to the active component
+func (f *FailoverStore) BulkSet(req []state.SetRequest) error {
+	keys := make([]string, 0, len(req))
+	for _, r := range req {
+		keys = append(keys, r.Key)
+	}
+	return f.do(func(store state.Store) error {
+		return store.BulkSet(req)
+	}, keys)
+}
+
+// Delete deletes a key from the active component
+func (f *FailoverStore) Delete(req *state.DeleteRequest) error {
+	return f.do(func(store state.Store) error {
+		return store.Delete(req)
+	}, []string{req.Key})
+}
+
+// BulkDelete deletes keys from the active component
+func (f *FailoverStore) BulkDelete(req []state.DeleteRequest) error {
+	keys := make([]string, 0, len(req))
+	for _, r := range req {
+		keys = append(keys, r.Key)
+	}
+	return f.do(func(store state.Store) error {
+		return store.BulkDelete(req)
+	}, keys)
+}
+
+// Multi runs a transaction on the active component
+func (t transactionalFailoverStore) Multi(reqs []state.TransactionalRequest) error {
+	keys := make([]string, 0, len(reqs))
+	for _, r := range reqs {
+		switch req := r.Request.(type) {
+		case state.SetRequest:
+			keys = append(keys, req.Key)
+		case state.DeleteRequest:
+			keys = append(keys, req.Key)
+		}
+	}
+	return t.do(func(store state.Store) error {
+		return store.(state.TransactionalStore).Multi(reqs)
+	}, keys)
+}
+
+// do runs an operation on the active component.
+// An operation that failed because the primary component is unavailable is retried on the secondary component once
+// the pair failed over. Other errors, such as an ETag mismatch, are returned as is. written holds the keys the
+// operation modifies.
+func (f *FailoverStore) do(op func(store state.Store) error, written []string) error {
+	target := f.breaker.Next()
+	err := op(f.get(target))
+	if err == nil {
+		f.breaker.Success(target)
+		f.recordWrites(target, written)
+		return nil
+	}
+
+	f.report(target, err)
+	if !IsUnavailableError(err) {
+		return err
+	}
+	if target == failover.Primary && f.breaker.Active() == failover.Secondary {
+		err = op(f.secondary)
+		if err == nil {
+			f.recordWrites(failover.Secondary, written)
+		}
+	}
+	return err
+}
+
+// report counts an operation towards the failover of the pair. Only errors showing the component is unavailable are
+// failures: a component rejecting a request still answered it.
+func (f *FailoverStore) report(target failover.Target, err error) {
+	if IsUnavailableError(err) {
+		f.breaker.Failure(target)
+	} else {
+		f.breaker.Success(target)
+	}
+}
+
+// IsUnavailableError returns true if an error shows a state store couldn't be reached or didn't answer in time,
+// as opposed to errors about the request itself, such as an ETag mismatch or an invalid request
+func IsUnavailableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return true
+		}
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, m := range unavailableMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordWrites tracks the keys written to the secondary component, which the primary component misses
+func (f *FailoverStore) recordWrites(target failover.Target, keys []string) {
+	if target != failover.Secondary || len(keys) == 0 {
+		return
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.divergence.Writes += len(keys)
+	for _, k := range keys {
+		if len(f.divergence.Keys) >= maxDivergentKeys {
+			break
+		}
+		f.divergence.Keys = append(f.divergence.Keys, k)
+	}
+}
+
+// reportDivergence reports the writes the primary component missed during the failover
+func (f *FailoverStore) reportDivergence() {
+	f.lock.Lock()
+	d := f.divergence
+	f.divergence = Divergence{}
+	f.lock.Unlock()
+
+	if d.Writes > 0 && f.onDivergence != nil {
+		d.Target = failover.Primary
+		f.onDivergence(d)
+	}
+}
+
+func (f *FailoverStore) get(target failover.Target) state.Store {
+	if target == failover.Secondary {
+		return f.secondary
+	}
+	return f.primary
+}
+
+// Flush flushes both components of the pair
+func (f *FailoverStore) Flush() error {
+	return f.each(func(store state.Store) error {
+		if fl, ok := store.(lifecycle.Flusher); ok {
+			return fl.Flush()
+		}
+		return nil
+	})
+}
+
+// Close closes both components of the pair
+func (f *FailoverStore) Close() error {
+	return f.each(func(store state.Store) error {
+		if c, ok := store.(io.Closer); ok {
+			return c.Close()
+		}
+		return nil
+	})
+}
+
+// each runs fn on both components and returns the first error
+func (f *FailoverStore) each(fn func(store state.Store) error) error {
+	err := fn(f.primary)
+	if serr := fn(f.secondary); err == nil {
+		err = serr
+	}
+	return err
+}
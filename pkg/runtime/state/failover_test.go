@@ -0,0 +1,198 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/failover"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errStoreUnavailable = fmt.Errorf("dial tcp 10.0.0.1:6379: %w", syscall.ECONNREFUSED)
+
+type fakeFailoverStore struct {
+	fail bool
+	// failWith is returned instead of errStoreUnavailable when set
+	failWith error
+	data     map[string][]byte
+}
+
+func (f *fakeFailoverStore) failure() error {
+	if f.failWith != nil {
+		return f.failWith
+	}
+	return errStoreUnavailable
+}
+
+func newFakeFailoverStore(fail bool) *fakeFailoverStore {
+	return &fakeFailoverStore{fail: fail, data: map[string][]byte{}}
+}
+
+func (f *fakeFailoverStore) Init(metadata state.Metadata) error {
+	return nil
+}
+
+func (f *fakeFailoverStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	if f.fail {
+		return nil, f.failure()
+	}
+	return &state.GetResponse{Data: f.data[req.Key]}, nil
+}
+
+func (f *fakeFailoverStore) Set(req *state.SetRequest) error {
+	if f.fail {
+		return f.failure()
+	}
+	f.data[req.Key] = []byte(req.Value.(string))
+	return nil
+}
+
+func (f *fakeFailoverStore) BulkSet(req []state.SetRequest) error {
+	for i := range req {
+		if err := f.Set(&req[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeFailoverStore) Delete(req *state.DeleteRequest) error {
+	if f.fail {
+		return f.failure()
+	}
+	delete(f.data, req.Key)
+	return nil
+}
+
+func (f *fakeFailoverStore) BulkDelete(req []state.DeleteRequest) error {
+	for i := range req {
+		if err := f.Delete(&req[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type fakeTransactionalFailoverStore struct {
+	*fakeFailoverStore
+}
+
+func (f fakeTransactionalFailoverStore) Multi(reqs []state.TransactionalRequest) error {
+	return nil
+}
+
+func TestFailoverStore(t *testing.T) {
+	config := failover.Config{Secondary: "dr", Threshold: 2, Cooldown: time.Millisecond}
+
+	t.Run("writes fail over and report divergence", func(t *testing.T) {
+		primary, secondary := newFakeFailoverStore(true), newFakeFailoverStore(false)
+		var switches []failover.Target
+		var divergence *Divergence
+		store := NewFailoverStore(primary, secondary, config, "", func(from, to failover.Target) {
+			switches = append(switches, to)
+		}, func(d Divergence) {
+			divergence = &d
+		})
+
+		assert.Error(t, store.Set(&state.SetRequest{Key: "key1", Value: "1"}))
+		assert.NoError(t, store.Set(&state.SetRequest{Key: "key2", Value: "2"}), "the write tripping the failover is retried on the secondary")
+		assert.NoError(t, store.BulkSet([]state.SetRequest{{Key: "key3", Value: "3"}}))
+		assert.Equal(t, failover.Secondary, store.(*FailoverStore).Active())
+
+		resp, err := store.Get(&state.GetRequest{Key: "key2"})
+		assert.NoError(t, err)
+		assert.Equal(t, "2", string(resp.Data))
+
+		primary.fail = false
+		time.Sleep(5 * time.Millisecond)
+		assert.NoError(t, store.Delete(&state.DeleteRequest{Key: "key4"}))
+		assert.Equal(t, failover.Primary, store.(*FailoverStore).Active())
+		assert.Equal(t, []failover.Target{failover.Secondary, failover.Primary}, switches)
+		assert.Equal(t, &Divergence{Target: failover.Primary, Writes: 2, Keys: []string{"key2", "key3"}}, divergence)
+	})
+
+	t.Run("primary preferred reads", func(t *testing.T) {
+		primary, secondary := newFakeFailoverStore(false), newFakeFailoverStore(false)
+		primary.data["key1"] = []byte("primary")
+		secondary.data["key1"] = []byte("secondary")
+		store := NewFailoverStore(primary, secondary, config, ReadPreferencePrimary, nil, nil)
+
+		resp, err := store.Get(&state.GetRequest{Key: "key1"})
+		assert.NoError(t, err)
+		assert.Equal(t, "primary", string(resp.Data))
+
+		primary.fail = true
+		resp, err = store.Get(&state.GetRequest{Key: "key1"})
+		assert.NoError(t, err)
+		assert.Equal(t, "secondary", string(resp.Data))
+	})
+
+	t.Run("secondary preferred reads", func(t *testing.T) {
+		primary, secondary := newFakeFailoverStore(false), newFakeFailoverStore(false)
+		primary.data["key1"] = []byte("primary")
+		secondary.data["key1"] = []byte("secondary")
+		store := NewFailoverStore(primary, secondary, config, ReadPreferenceSecondary, nil, nil)
+
+		resp, err := store.Get(&state.GetRequest{Key: "key1"})
+		assert.NoError(t, err)
+		assert.Equal(t, "secondary", string(resp.Data))
+	})
+
+	t.Run("request errors don't fail over", func(t *testing.T) {
+		primary, secondary := newFakeFailoverStore(true), newFakeFailoverStore(false)
+		primary.failWith = errors.New("possible etag mismatch. error from state store")
+		store := NewFailoverStore(primary, secondary, config, "", nil, nil)
+
+		for i := 0; i < 3; i++ {
+			assert.EqualError(t, store.Set(&state.SetRequest{Key: "key1", Value: "1", ETag: "1"}), primary.failWith.Error())
+		}
+		assert.Equal(t, failover.Primary, store.(*FailoverStore).Active())
+		assert.Empty(t, secondary.data)
+	})
+
+	t.Run("request errors don't fall back on reads", func(t *testing.T) {
+		primary, secondary := newFakeFailoverStore(true), newFakeFailoverStore(false)
+		primary.failWith = errors.New("invalid key")
+		secondary.data["key1"] = []byte("secondary")
+		store := NewFailoverStore(primary, secondary, config, ReadPreferencePrimary, nil, nil)
+
+		_, err := store.Get(&state.GetRequest{Key: "key1"})
+		assert.Error(t, err)
+	})
+
+	t.Run("transactional only if both components are", func(t *testing.T) {
+		store := NewFailoverStore(fakeTransactionalFailoverStore{newFakeFailoverStore(false)}, newFakeFailoverStore(false), config, "", nil, nil)
+		_, ok := store.(state.TransactionalStore)
+		assert.False(t, ok)
+
+		store = NewFailoverStore(fakeTransactionalFailoverStore{newFakeFailoverStore(false)}, fakeTransactionalFailoverStore{newFakeFailoverStore(false)}, config, "", nil, nil)
+		ts, ok := store.(state.TransactionalStore)
+		assert.True(t, ok)
+		assert.NoError(t, ts.Multi(nil))
+	})
+}
+
+func TestIsUnavailableError(t *testing.T) {
+	assert.False(t, IsUnavailableError(nil))
+	assert.True(t, IsUnavailableError(errStoreUnavailable))
+	assert.True(t, IsUnavailableError(&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNRESET}))
+	assert.True(t, IsUnavailableError(fmt.Errorf("get: %w", context.DeadlineExceeded)))
+	assert.True(t, IsUnavailableError(status.Error(codes.Unavailable, "down")))
+	assert.True(t, IsUnavailableError(errors.New("dial tcp: lookup redis: no such host")))
+	assert.False(t, IsUnavailableError(errors.New("possible etag mismatch")))
+	assert.False(t, IsUnavailableError(status.Error(codes.InvalidArgument, "bad key")))
+}
+
+func TestValidateReadPreference(t *testing.T) {
+	assert.NoError(t, ValidateReadPreference(""))
+	assert.NoError(t, ValidateReadPreference(ReadPreferencePrimary))
+	assert.Error(t, ValidateReadPreference("nearest"))
+}
@@ -0,0 +1,139 @@
+package state
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// Feature is an optional capability of a state store
+type Feature string
+
+const (
+	// FeatureCRUD is the support for getting, saving and deleting single keys, which every state store has
+	FeatureCRUD Feature = "CRUD"
+	// FeatureBulk is the support for saving and deleting several keys in one request, which every state store has
+	FeatureBulk Feature = "BULK"
+	// FeatureTransactional is the support for saving and deleting several keys atomically
+	FeatureTransactional Feature = "TRANSACTIONAL"
+	// FeatureQuery is the support for querying state by value
+	FeatureQuery Feature = "QUERY"
+	// FeatureTTL is the support for expiring keys
+	FeatureTTL Feature = "TTL"
+	// FeatureChangeFeed is the support for subscribing to the changes of keys
+	FeatureChangeFeed Feature = "CHANGE_FEED"
+	// FeatureListKeys is the support for listing keys
+	FeatureListKeys Feature = "LIST_KEYS"
+	// FeatureSessionConsistency is the support for reading the writes of a session without strong consistency
+	FeatureSessionConsistency Feature = "SESSION_CONSISTENCY"
+	// FeatureSnapshotRead is the support for reading several keys at a single point in time
+	FeatureSnapshotRead Feature = "SNAPSHOT_READ"
+)
+
+// featureAlternatives holds the closest supported operation to suggest for a missing feature
+var featureAlternatives = map[Feature]struct {
+	feature    Feature
+	suggestion string
+}{
+	FeatureTransactional:      {FeatureBulk, "save or delete the keys in bulk, without atomicity"},
+	FeatureQuery:              {FeatureCRUD, "get the keys individually"},
+	FeatureTTL:                {FeatureCRUD, "delete the keys once they are no longer needed"},
+	FeatureChangeFeed:         {FeatureCRUD, "get the keys again to detect their changes"},
+	FeatureSessionConsistency: {FeatureCRUD, "get the keys with strong consistency"},
+	FeatureSnapshotRead:       {FeatureCRUD, "get the keys individually, without a consistent snapshot"},
+}
+
+// wrappedStore is implemented by the state stores the runtime wraps around state store components
+type wrappedStore interface {
+	Unwrap() state.Store
+}
+
+// componentStore returns the state store component wrapped by the runtime
+func componentStore(store state.Store) state.Store {
+	for {
+		w, ok := store.(wrappedStore)
+		if !ok {
+			return store
+		}
+		store = w.Unwrap()
+	}
+}
+
+// Features returns the features supported by a state store.
+// The features are derived from the interfaces the store implements, or the component it wraps implements.
+func Features(store state.Store) []Feature {
+	if store == nil {
+		return nil
+	}
+	features := []Feature{FeatureCRUD, FeatureBulk}
+	if _, ok := store.(state.TransactionalStore); ok {
+		features = append(features, FeatureTransactional)
+	}
+	if ts, ok := componentStore(store).(TTLStore); ok && ts.SupportsTTL() {
+		features = append(features, FeatureTTL)
+	}
+	if _, ok := AsChangeFeedStore(store); ok {
+		features = append(features, FeatureChangeFeed)
+	}
+	if _, ok := AsKeyListerStore(store); ok {
+		features = append(features, FeatureListKeys)
+	}
+	if _, ok := AsSessionStore(store); ok {
+		features = append(features, FeatureSessionConsistency)
+	}
+	if _, ok := AsSnapshotStore(store); ok {
+		features = append(features, FeatureSnapshotRead)
+	}
+	return features
+}
+
+// HasFeature returns true if a state store supports a feature
+func HasFeature(store state.Store, feature Feature) bool {
+	for _, f := range Features(store) {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// FeatureError is returned when an operation requires a feature the state store doesn't support
+type FeatureError struct {
+	StoreName    string    `json:"storeName"`
+	Feature      Feature   `json:"feature"`
+	Capabilities []Feature `json:"capabilities"`
+	Alternative  string    `json:"alternative,omitempty"`
+}
+
+func (e *FeatureError) Error() string {
+	capabilities := make([]string, 0, len(e.Capabilities))
+	for _, c := range e.Capabilities {
+		capabilities = append(capabilities, string(c))
+	}
+	msg := fmt.Sprintf("state store %s does not support %s - supported features: [%s]", e.StoreName, e.Feature, strings.Join(capabilities, ", "))
+	if e.Alternative != "" {
+		msg = fmt.Sprintf("%s - alternative: %s", msg, e.Alternative)
+	}
+	return msg
+}
+
+// RequireFeature returns a FeatureError if a state store doesn't support a feature
+func RequireFeature(storeName string, store state.Store, feature Feature) error {
+	capabilities := Features(store)
+	for _, f := range capabilities {
+		if f == feature {
+			return nil
+		}
+	}
+
+	e := &FeatureError{
+		StoreName:    storeName,
+		Feature:      feature,
+		Capabilities: capabilities,
+	}
+	if alt, ok := featureAlternatives[feature]; ok && HasFeature(store, alt.feature) {
+		e.Alternative = alt.suggestion
+	}
+	return e
+}
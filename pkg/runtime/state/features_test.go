@@ -0,0 +1,81 @@
+package state
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeStore struct {
+	state.Store
+}
+
+type fakeTransactionalStore struct {
+	fakeStore
+}
+
+func (f fakeTransactionalStore) Multi(reqs []state.TransactionalRequest) error {
+	return nil
+}
+
+type fakeChangeFeedStore struct {
+	fakeStore
+}
+
+func (f fakeChangeFeedStore) SubscribeChanges(ctx context.Context, keyPrefix string, handler func(change Change) error) error {
+	return nil
+}
+
+type fakeKeyListerStore struct {
+	fakeStore
+}
+
+func (f fakeKeyListerStore) ListKeys(req *ListKeysRequest) (*ListKeysResponse, error) {
+	return &ListKeysResponse{}, nil
+}
+
+func TestFeatures(t *testing.T) {
+	assert.Nil(t, Features(nil))
+	assert.Equal(t, []Feature{FeatureCRUD, FeatureBulk}, Features(fakeStore{}))
+	assert.Equal(t, []Feature{FeatureCRUD, FeatureBulk, FeatureTransactional}, Features(fakeTransactionalStore{}))
+	assert.Equal(t, []Feature{FeatureCRUD, FeatureBulk, FeatureTTL}, Features(fakeTTLStore{}))
+	assert.Equal(t, []Feature{FeatureCRUD, FeatureBulk, FeatureChangeFeed}, Features(fakeChangeFeedStore{}))
+	assert.Equal(t, []Feature{FeatureCRUD, FeatureBulk, FeatureListKeys}, Features(fakeKeyListerStore{}))
+	assert.Equal(t, []Feature{FeatureCRUD, FeatureBulk, FeatureSessionConsistency}, Features(fakeSessionStore{}))
+	assert.Equal(t, []Feature{FeatureCRUD, FeatureBulk, FeatureSnapshotRead}, Features(fakeSnapshotStore{}))
+
+	// the features of components wrapped by the runtime
+	cached := NewCachedStore(fakeTTLStore{}, ReadCacheConfig{TTL: time.Second, MaxEntries: 1})
+	assert.Equal(t, []Feature{FeatureCRUD, FeatureBulk, FeatureTTL}, Features(cached))
+	_, ok := AsChangeFeedStore(NewCachedStore(fakeChangeFeedStore{}, ReadCacheConfig{TTL: time.Second, MaxEntries: 1}))
+	assert.True(t, ok)
+	_, ok = AsKeyListerStore(NewTrackedStore(fakeKeyListerStore{}, nil))
+	assert.True(t, ok)
+}
+
+func TestRequireFeature(t *testing.T) {
+	t.Run("supported", func(t *testing.T) {
+		assert.NoError(t, RequireFeature("store1", fakeTransactionalStore{}, FeatureTransactional))
+	})
+
+	t.Run("not supported", func(t *testing.T) {
+		err := RequireFeature("store1", fakeStore{}, FeatureTransactional)
+		fe, ok := err.(*FeatureError)
+		assert.True(t, ok)
+		assert.Equal(t, "store1", fe.StoreName)
+		assert.Equal(t, []Feature{FeatureCRUD, FeatureBulk}, fe.Capabilities)
+		assert.NotEmpty(t, fe.Alternative)
+		assert.Equal(t, "state store store1 does not support TRANSACTIONAL - supported features: [CRUD, BULK] - alternative: save or delete the keys in bulk, without atomicity", err.Error())
+	})
+
+	t.Run("no store", func(t *testing.T) {
+		err := RequireFeature("store1", nil, FeatureTTL)
+		fe, ok := err.(*FeatureError)
+		assert.True(t, ok)
+		assert.Empty(t, fe.Capabilities)
+		assert.Empty(t, fe.Alternative)
+	})
+}
@@ -0,0 +1,62 @@
+package state
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// KeyPrefixMetadataKey is the state store component metadata item with the template of the prefix of the keys of the
+	// app, e.g. "{namespace}-{appid}". The keys are prefixed with the app ID when it isn't set.
+	KeyPrefixMetadataKey = "keyPrefix"
+
+	keySeparator = "||"
+)
+
+var keyPrefixVariable = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// KeyPrefixVariables are the values of the variables of the key prefix templates
+type KeyPrefixVariables struct {
+	AppID     string
+	Namespace string
+	// Name is the name of the state store component
+	Name string
+}
+
+// KeyPrefixFromMetadata resolves the key prefix template of a state store component and returns the prefix of the
+// keys of the app, the resolved template followed by the key separator. Without a template, the prefix is the app ID
+// followed by the separator, or empty without app ID. A template resolving to an empty string leaves keys unprefixed.
+func KeyPrefixFromMetadata(properties map[string]string, vars KeyPrefixVariables) (string, error) {
+	template, ok := properties[KeyPrefixMetadataKey]
+	if !ok || template == "" {
+		template = "{appid}"
+	}
+
+	var err error
+	prefix := keyPrefixVariable.ReplaceAllStringFunc(template, func(v string) string {
+		switch v {
+		case "{appid}":
+			return vars.AppID
+		case "{namespace}":
+			return vars.Namespace
+		case "{name}":
+			return vars.Name
+		case "{actorType}":
+			err = fmt.Errorf("%s doesn't support %s: the keys of the actor state are built by the actor runtime", KeyPrefixMetadataKey, v)
+		default:
+			err = fmt.Errorf("%s has unknown variable %s, expected {appid}, {namespace} or {name}", KeyPrefixMetadataKey, v)
+		}
+		return v
+	})
+	if err != nil {
+		return "", err
+	}
+	if strings.ContainsAny(prefix, "{}") {
+		return "", fmt.Errorf("%s has unbalanced braces: %s", KeyPrefixMetadataKey, template)
+	}
+	if prefix == "" {
+		return "", nil
+	}
+	return prefix + keySeparator, nil
+}
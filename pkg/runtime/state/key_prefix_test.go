@@ -0,0 +1,56 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyPrefixFromMetadata(t *testing.T) {
+	vars := KeyPrefixVariables{AppID: "orders", Namespace: "prod", Name: "statestore"}
+
+	t.Run("defaults to the app ID", func(t *testing.T) {
+		prefix, err := KeyPrefixFromMetadata(map[string]string{}, vars)
+		assert.NoError(t, err)
+		assert.Equal(t, "orders||", prefix)
+	})
+
+	t.Run("no app ID", func(t *testing.T) {
+		prefix, err := KeyPrefixFromMetadata(map[string]string{}, KeyPrefixVariables{})
+		assert.NoError(t, err)
+		assert.Equal(t, "", prefix)
+	})
+
+	t.Run("template", func(t *testing.T) {
+		prefix, err := KeyPrefixFromMetadata(map[string]string{KeyPrefixMetadataKey: "{namespace}-{appid}.{name}"}, vars)
+		assert.NoError(t, err)
+		assert.Equal(t, "prod-orders.statestore||", prefix)
+	})
+
+	t.Run("template without variables", func(t *testing.T) {
+		prefix, err := KeyPrefixFromMetadata(map[string]string{KeyPrefixMetadataKey: "shared"}, vars)
+		assert.NoError(t, err)
+		assert.Equal(t, "shared||", prefix)
+	})
+
+	t.Run("empty resolved template", func(t *testing.T) {
+		prefix, err := KeyPrefixFromMetadata(map[string]string{KeyPrefixMetadataKey: "{namespace}"}, KeyPrefixVariables{AppID: "orders"})
+		assert.NoError(t, err)
+		assert.Equal(t, "", prefix)
+	})
+
+	t.Run("actor type", func(t *testing.T) {
+		_, err := KeyPrefixFromMetadata(map[string]string{KeyPrefixMetadataKey: "{appid}-{actorType}"}, vars)
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown variable", func(t *testing.T) {
+		_, err := KeyPrefixFromMetadata(map[string]string{KeyPrefixMetadataKey: "{tenant}"}, vars)
+		assert.Error(t, err)
+	})
+
+	t.Run("unbalanced braces", func(t *testing.T) {
+		_, err := KeyPrefixFromMetadata(map[string]string{KeyPrefixMetadataKey: "{appid"}, vars)
+		assert.Error(t, err)
+	})
+}
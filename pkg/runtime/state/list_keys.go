@@ -0,0 +1,151 @@
+package state
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+const (
+	// DefaultListKeysPageSize is the number of keys of the pages of a request without a page size
+	DefaultListKeysPageSize = 100
+	// MaxListKeysPageSize bounds the number of keys of a page
+	MaxListKeysPageSize = 1000
+)
+
+// ListKeysRequest selects a page of the keys of a state store
+type ListKeysRequest struct {
+	// Prefix selects the keys starting with it
+	Prefix string
+	// PageToken is the NextPageToken of the previous page, empty for the first page
+	PageToken string
+	// PageSize bounds the number of keys of the page, chosen by the state store when 0
+	PageSize int
+	Metadata map[string]string
+}
+
+// ListKeysResponse is a page of the keys of a state store
+type ListKeysResponse struct {
+	Keys []string
+	// NextPageToken requests the next page, empty on the last page
+	NextPageToken string
+}
+
+// KeyListerStore is implemented by state stores listing their keys without their values
+type KeyListerStore interface {
+	// ListKeys returns a page of the keys of the request, in the order of the store
+	ListKeys(req *ListKeysRequest) (*ListKeysResponse, error)
+}
+
+// AsKeyListerStore returns the key lister of a state store, or of the component it wraps
+func AsKeyListerStore(store state.Store) (KeyListerStore, bool) {
+	ks, ok := componentStore(store).(KeyListerStore)
+	return ks, ok
+}
+
+// PageTokenError is returned for a page token the runtime didn't issue for the store and prefix of the request
+type PageTokenError struct {
+	Reason string
+}
+
+func (e *PageTokenError) Error() string {
+	return fmt.Sprintf("invalid page token: %s", e.Reason)
+}
+
+// keysCursor is the position of a page in the keys of a state store, encoded in the page tokens of the runtime.
+// Offset counts the keys of the page of the state store at Token that were already returned.
+type keysCursor struct {
+	Store  string `json:"s"`
+	Prefix string `json:"p"`
+	Token  string `json:"t,omitempty"`
+	Offset int    `json:"o,omitempty"`
+}
+
+func (c keysCursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeKeysCursor(storeName, prefix, token string) (keysCursor, error) {
+	cursor := keysCursor{Store: storeName, Prefix: prefix}
+	if token == "" {
+		return cursor, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return cursor, &PageTokenError{Reason: "malformed token"}
+	}
+	if err := json.Unmarshal(b, &cursor); err != nil || cursor.Offset < 0 {
+		return cursor, &PageTokenError{Reason: "malformed token"}
+	}
+	if cursor.Store != storeName || cursor.Prefix != prefix {
+		return cursor, &PageTokenError{Reason: "the token was issued for another store or prefix"}
+	}
+	return cursor, nil
+}
+
+// ListKeys returns a page of the keys of a state store starting with prefix, whatever the pagination of the store.
+// Every page holds pageSize keys but the last, DefaultListKeysPageSize when pageSize is 0 and MaxListKeysPageSize at
+// most. The next page token is an opaque token of the runtime, which is rejected with a PageTokenError by the requests
+// for another store or prefix. Keys not starting with prefix are left out even if the store returns them.
+func ListKeys(storeName string, lister KeyListerStore, prefix, pageToken string, pageSize int, metadata map[string]string) (*ListKeysResponse, error) {
+	if pageSize < 0 {
+		return nil, fmt.Errorf("page size must not be negative")
+	}
+	if pageSize == 0 {
+		pageSize = DefaultListKeysPageSize
+	} else if pageSize > MaxListKeysPageSize {
+		pageSize = MaxListKeysPageSize
+	}
+	cursor, err := decodeKeysCursor(storeName, prefix, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, pageSize)
+	for {
+		resp, err := lister.ListKeys(&ListKeysRequest{
+			Prefix:    prefix,
+			PageToken: cursor.Token,
+			PageSize:  pageSize,
+			Metadata:  metadata,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		matching := make([]string, 0, len(resp.Keys))
+		for _, k := range resp.Keys {
+			if strings.HasPrefix(k, prefix) {
+				matching = append(matching, k)
+			}
+		}
+		if cursor.Offset < len(matching) {
+			matching = matching[cursor.Offset:]
+		} else {
+			matching = nil
+		}
+
+		// the page of the store has more keys than the page, the next page resumes after the returned ones
+		if need := pageSize - len(keys); len(matching) > need {
+			keys = append(keys, matching[:need]...)
+			cursor.Offset += need
+			return &ListKeysResponse{Keys: keys, NextPageToken: cursor.encode()}, nil
+		}
+		keys = append(keys, matching...)
+
+		if resp.NextPageToken == "" {
+			return &ListKeysResponse{Keys: keys}, nil
+		}
+		if resp.NextPageToken == cursor.Token {
+			return nil, fmt.Errorf("state store %s returned the same page token twice", storeName)
+		}
+		cursor.Token, cursor.Offset = resp.NextPageToken, 0
+		if len(keys) == pageSize {
+			return &ListKeysResponse{Keys: keys, NextPageToken: cursor.encode()}, nil
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package state
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedStore lists its keys in pages of its own size, whatever the page size of the request, paginated by the index
+// of the next key. It ignores the prefix of the requests.
+type pagedStore struct {
+	keys     []string
+	pageSize int
+	// stuck returns the same next page token for every page
+	stuck bool
+}
+
+func (s *pagedStore) ListKeys(req *ListKeysRequest) (*ListKeysResponse, error) {
+	start, _ := strconv.Atoi(req.PageToken)
+	end := start + s.pageSize
+	if end > len(s.keys) {
+		end = len(s.keys)
+	}
+	resp := &ListKeysResponse{Keys: s.keys[start:end]}
+	if s.stuck {
+		resp.NextPageToken = "0"
+	} else if end < len(s.keys) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+func listAllKeys(t *testing.T, store KeyListerStore, pageSize int) [][]string {
+	pages := [][]string{}
+	token := ""
+	for {
+		resp, err := ListKeys("store", store, "app||", token, pageSize, nil)
+		assert.NoError(t, err)
+		pages = append(pages, resp.Keys)
+		if resp.NextPageToken == "" {
+			return pages
+		}
+		token = resp.NextPageToken
+	}
+}
+
+func TestListKeys(t *testing.T) {
+	keys := []string{}
+	for i := 0; i < 7; i++ {
+		keys = append(keys, fmt.Sprintf("app||%d", i))
+	}
+	expected := [][]string{
+		{"app||0", "app||1", "app||2"},
+		{"app||3", "app||4", "app||5"},
+		{"app||6"},
+	}
+
+	t.Run("store pages larger than the page size", func(t *testing.T) {
+		assert.Equal(t, expected, listAllKeys(t, &pagedStore{keys: keys, pageSize: 5}, 3))
+	})
+
+	t.Run("store pages smaller than the page size", func(t *testing.T) {
+		assert.Equal(t, expected, listAllKeys(t, &pagedStore{keys: keys, pageSize: 2}, 3))
+	})
+
+	t.Run("keys of other prefixes are left out", func(t *testing.T) {
+		mixed := []string{"app||0", "other||0", "app||1", "other||1", "other||2", "app||2"}
+		assert.Equal(t, [][]string{{"app||0", "app||1"}, {"app||2"}}, listAllKeys(t, &pagedStore{keys: mixed, pageSize: 2}, 2))
+	})
+
+	t.Run("page size", func(t *testing.T) {
+		many := make([]string, MaxListKeysPageSize+1)
+		for i := range many {
+			many[i] = fmt.Sprintf("app||%d", i)
+		}
+		store := &pagedStore{keys: many, pageSize: MaxListKeysPageSize + 1}
+
+		resp, err := ListKeys("store", store, "app||", "", 0, nil)
+		assert.NoError(t, err)
+		assert.Len(t, resp.Keys, DefaultListKeysPageSize)
+
+		resp, err = ListKeys("store", store, "app||", "", MaxListKeysPageSize+1, nil)
+		assert.NoError(t, err)
+		assert.Len(t, resp.Keys, MaxListKeysPageSize)
+
+		_, err = ListKeys("store", store, "app||", "", -1, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("page tokens", func(t *testing.T) {
+		store := &pagedStore{keys: keys, pageSize: 5}
+		resp, err := ListKeys("store", store, "app||", "", 3, nil)
+		assert.NoError(t, err)
+
+		_, err = ListKeys("store", store, "app||1", resp.NextPageToken, 3, nil)
+		assert.IsType(t, &PageTokenError{}, err)
+		_, err = ListKeys("other", store, "app||", resp.NextPageToken, 3, nil)
+		assert.IsType(t, &PageTokenError{}, err)
+		_, err = ListKeys("store", store, "app||", "5", 3, nil)
+		assert.IsType(t, &PageTokenError{}, err)
+	})
+
+	t.Run("store not advancing", func(t *testing.T) {
+		_, err := ListKeys("store", &pagedStore{keys: keys[:1], pageSize: 1, stuck: true}, "app||", "", 3, nil)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,89 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// MigrateRequest copies the keys of an app from a state store to another
+type MigrateRequest struct {
+	Source      string
+	Destination string
+	// SourcePrefix selects the keys of the app in the source store
+	SourcePrefix string
+	// DestinationPrefix replaces SourcePrefix in the keys saved to the destination store
+	DestinationPrefix string
+	// PageSize is the number of keys copied between two progress reports, DefaultListKeysPageSize when 0
+	PageSize int
+}
+
+// MigrateFunc copies the keys of the app from the source state store to the destination state store, reporting the
+// progress after every page of pageSize keys. With rekey, the keys are saved with the key prefix of the destination
+// store instead of the prefix of the source store.
+type MigrateFunc func(ctx context.Context, source, destination string, rekey bool, pageSize int, progress func(MigrateProgress) error) (MigrateProgress, error)
+
+// MigrateProgress reports the keys copied so far by a migration
+type MigrateProgress struct {
+	// Copied counts the keys saved to the destination store
+	Copied int
+	// Skipped counts the listed keys deleted from the source store before they were copied
+	Skipped int
+	// LastKey is the last key copied, without the prefix of the app
+	LastKey string
+}
+
+// Migrate copies the keys starting with SourcePrefix from source to destination, one page of keys at a time, and
+// reports the progress after every page. The source store must list its keys. Values are read and saved through the
+// given stores, so the wrappers of the runtime apply to them. The migration stops at the first error, of the stores
+// or of progress, and returns the progress so far: copying again the same keys is safe.
+func Migrate(ctx context.Context, req *MigrateRequest, source, destination state.Store, progress func(MigrateProgress) error) (MigrateProgress, error) {
+	p := MigrateProgress{}
+	if req.Source == req.Destination {
+		return p, fmt.Errorf("the source and destination state stores must differ")
+	}
+	if err := RequireFeature(req.Source, source, FeatureListKeys); err != nil {
+		return p, err
+	}
+	lister, _ := AsKeyListerStore(source)
+
+	token := ""
+	for {
+		page, err := ListKeys(req.Source, lister, req.SourcePrefix, token, req.PageSize, nil)
+		if err != nil {
+			return p, fmt.Errorf("failed listing the keys of state store %s: %s", req.Source, err)
+		}
+		for _, key := range page.Keys {
+			if err := ctx.Err(); err != nil {
+				return p, err
+			}
+			resp, err := source.Get(&state.GetRequest{Key: key})
+			if err != nil {
+				return p, fmt.Errorf("failed getting key %s from state store %s: %s", key, req.Source, err)
+			}
+			if resp == nil || resp.Data == nil {
+				p.Skipped++
+				continue
+			}
+			trimmed := strings.TrimPrefix(key, req.SourcePrefix)
+			err = destination.Set(&state.SetRequest{
+				Key:   req.DestinationPrefix + trimmed,
+				Value: resp.Data,
+			})
+			if err != nil {
+				return p, fmt.Errorf("failed saving key %s to state store %s: %s", key, req.Destination, err)
+			}
+			p.Copied++
+			p.LastKey = trimmed
+		}
+		if err := progress(p); err != nil {
+			return p, err
+		}
+		if page.NextPageToken == "" {
+			return p, nil
+		}
+		token = page.NextPageToken
+	}
+}
@@ -0,0 +1,99 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// listingSagaStore is a sagaStore listing its keys in order, in pages of the page size of the requests
+type listingSagaStore struct {
+	*sagaStore
+}
+
+func (s listingSagaStore) ListKeys(req *ListKeysRequest) (*ListKeysResponse, error) {
+	keys := []string{}
+	for k := range s.data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	start, _ := strconv.Atoi(req.PageToken)
+	end := start + req.PageSize
+	if end >= len(keys) {
+		return &ListKeysResponse{Keys: keys[start:]}, nil
+	}
+	return &ListKeysResponse{Keys: keys[start:end], NextPageToken: strconv.Itoa(end)}, nil
+}
+
+func TestMigrate(t *testing.T) {
+	newSource := func() listingSagaStore {
+		return listingSagaStore{&sagaStore{data: map[string][]byte{
+			"app||a":   []byte("1"),
+			"app||b":   []byte("2"),
+			"app||c":   []byte("3"),
+			"other||a": []byte("4"),
+		}}}
+	}
+
+	t.Run("copies the keys of the app", func(t *testing.T) {
+		dst := &sagaStore{data: map[string][]byte{}}
+		reports := []MigrateProgress{}
+		req := &MigrateRequest{Source: "src", Destination: "dst", SourcePrefix: "app||", DestinationPrefix: "app||", PageSize: 2}
+		p, err := Migrate(context.Background(), req, newSource(), dst, func(p MigrateProgress) error {
+			reports = append(reports, p)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, MigrateProgress{Copied: 3, LastKey: "c"}, p)
+		assert.Equal(t, []MigrateProgress{{Copied: 2, LastKey: "b"}, {Copied: 3, LastKey: "c"}}, reports)
+		assert.Equal(t, map[string][]byte{"app||a": []byte("1"), "app||b": []byte("2"), "app||c": []byte("3")}, dst.data)
+	})
+
+	t.Run("rekeys with the destination prefix", func(t *testing.T) {
+		dst := &sagaStore{data: map[string][]byte{}}
+		req := &MigrateRequest{Source: "src", Destination: "dst", SourcePrefix: "app||", DestinationPrefix: "new||"}
+		_, err := Migrate(context.Background(), req, newSource(), dst, func(MigrateProgress) error { return nil })
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string][]byte{"new||a": []byte("1"), "new||b": []byte("2"), "new||c": []byte("3")}, dst.data)
+	})
+
+	t.Run("stops at the first failure", func(t *testing.T) {
+		dst := &sagaStore{data: map[string][]byte{}, failKey: "app||b"}
+		req := &MigrateRequest{Source: "src", Destination: "dst", SourcePrefix: "app||", DestinationPrefix: "app||"}
+		p, err := Migrate(context.Background(), req, newSource(), dst, func(MigrateProgress) error { return nil })
+
+		assert.Error(t, err)
+		assert.Equal(t, MigrateProgress{Copied: 1, LastKey: "a"}, p)
+	})
+
+	t.Run("stops when progress fails", func(t *testing.T) {
+		dst := &sagaStore{data: map[string][]byte{}}
+		req := &MigrateRequest{Source: "src", Destination: "dst", SourcePrefix: "app||", DestinationPrefix: "app||", PageSize: 1}
+		p, err := Migrate(context.Background(), req, newSource(), dst, func(MigrateProgress) error { return errors.New("client gone") })
+
+		assert.EqualError(t, err, "client gone")
+		assert.Equal(t, 1, p.Copied)
+	})
+
+	t.Run("source must list keys", func(t *testing.T) {
+		src := &sagaStore{data: map[string][]byte{}}
+		req := &MigrateRequest{Source: "src", Destination: "dst"}
+		_, err := Migrate(context.Background(), req, src, &sagaStore{}, func(MigrateProgress) error { return nil })
+
+		assert.IsType(t, &FeatureError{}, err)
+	})
+
+	t.Run("stores must differ", func(t *testing.T) {
+		src := newSource()
+		req := &MigrateRequest{Source: "src", Destination: "src"}
+		_, err := Migrate(context.Background(), req, src, src, func(MigrateProgress) error { return nil })
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,160 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ProjectionMetadataKey is the metadata item of a get request with the JSONPath expressions of the fields returned
+const ProjectionMetadataKey = "projection"
+
+// Projection selects fields of JSON documents with a comma-separated list of JSONPath expressions, e.g.
+// "$.customer.id, $['order date']". Only member paths are supported, without wildcards, filters or array indexes.
+// The projected document keeps the nesting of the selected fields and leaves out the missing ones.
+type Projection struct {
+	paths [][]string
+}
+
+// ProjectionFromMetadata returns the projection of the metadata of a get request and the metadata left for the state
+// store, or a nil projection if the request has none
+func ProjectionFromMetadata(metadata map[string]string) (*Projection, map[string]string, error) {
+	expr, ok := metadata[ProjectionMetadataKey]
+	if !ok {
+		return nil, metadata, nil
+	}
+	p, err := ParseProjection(expr)
+	if err != nil {
+		return nil, nil, err
+	}
+	rest := make(map[string]string, len(metadata)-1)
+	for k, v := range metadata {
+		if k != ProjectionMetadataKey {
+			rest[k] = v
+		}
+	}
+	return p, rest, nil
+}
+
+// ParseProjection parses a comma-separated list of JSONPath expressions
+func ParseProjection(expr string) (*Projection, error) {
+	p := &Projection{}
+	for _, e := range splitPaths(expr) {
+		path, err := parseJSONPath(strings.TrimSpace(e))
+		if err != nil {
+			return nil, fmt.Errorf("invalid projection %q: %s", expr, err)
+		}
+		p.paths = append(p.paths, path)
+	}
+	if len(p.paths) == 0 {
+		return nil, fmt.Errorf("invalid projection %q: no path", expr)
+	}
+	return p, nil
+}
+
+// Apply returns the fields of a JSON object selected by the projection
+func (p *Projection) Apply(data []byte) ([]byte, error) {
+	if p == nil {
+		return data, nil
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal(data, &object); err != nil || object == nil {
+		return nil, fmt.Errorf("projection requires a JSON object")
+	}
+	projected := map[string]interface{}{}
+	for _, path := range p.paths {
+		if len(path) == 0 {
+			// $ selects the whole document
+			return data, nil
+		}
+		value, ok := lookupPath(object, path)
+		if !ok {
+			continue
+		}
+		parent := projected
+		for _, name := range path[:len(path)-1] {
+			child, ok := parent[name].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				parent[name] = child
+			}
+			parent = child
+		}
+		parent[path[len(path)-1]] = value
+	}
+	return json.Marshal(projected)
+}
+
+// lookupPath returns the value of a nested member of a JSON object
+func lookupPath(object map[string]interface{}, path []string) (interface{}, bool) {
+	var value interface{} = object
+	for _, name := range path {
+		o, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = o[name]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// splitPaths splits a list of JSONPath expressions on the commas outside of quoted member names
+func splitPaths(expr string) []string {
+	var paths []string
+	var quote rune
+	start := 0
+	for i, r := range expr {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == ',':
+			paths = append(paths, expr[start:i])
+			start = i + 1
+		}
+	}
+	if strings.TrimSpace(expr[start:]) != "" || len(paths) > 0 {
+		paths = append(paths, expr[start:])
+	}
+	return paths
+}
+
+// parseJSONPath returns the member names of a JSONPath expression like $.a.b or $['a']['b']
+func parseJSONPath(expr string) ([]string, error) {
+	if !strings.HasPrefix(expr, "$") {
+		return nil, fmt.Errorf("path %q must start with $", expr)
+	}
+	var path []string
+	rest := expr[1:]
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, "."):
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			name := rest[1 : end+1]
+			if name == "" || name == "*" || strings.HasPrefix(name, ".") {
+				return nil, fmt.Errorf("path %q has an unsupported member", expr)
+			}
+			path = append(path, name)
+			rest = rest[end+1:]
+		case strings.HasPrefix(rest, "['") || strings.HasPrefix(rest, "[\""):
+			quote := rest[1:2]
+			end := strings.Index(rest[2:], quote+"]")
+			if end < 0 {
+				return nil, fmt.Errorf("path %q has an unterminated member", expr)
+			}
+			path = append(path, rest[2:end+2])
+			rest = rest[end+4:]
+		default:
+			return nil, fmt.Errorf("path %q is not supported: only member names can be selected", expr)
+		}
+	}
+	return path, nil
+}
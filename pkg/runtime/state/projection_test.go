@@ -0,0 +1,55 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProjection(t *testing.T) {
+	p, err := ParseProjection("$.customer.id, $['order date'], $[\"a,b\"].c")
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"customer", "id"}, {"order date"}, {"a,b", "c"}}, p.paths)
+
+	for _, expr := range []string{"", "customer.id", "$..id", "$.items[0]", "$.*", "$['id'", "$.id,"} {
+		_, err := ParseProjection(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestProjectionApply(t *testing.T) {
+	doc := []byte(`{"id":"1","customer":{"id":"c1","name":"Ann"},"items":[1,2],"order date":"today"}`)
+
+	p, _ := ParseProjection("$.customer.id,$.items,$.missing,$.id.nested")
+	data, err := p.Apply(doc)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"customer":{"id":"c1"},"items":[1,2]}`, string(data))
+
+	p, _ = ParseProjection("$")
+	data, err = p.Apply(doc)
+	assert.NoError(t, err)
+	assert.Equal(t, doc, data)
+
+	_, err = p.Apply([]byte("plain text"))
+	assert.Error(t, err)
+
+	var none *Projection
+	data, err = none.Apply([]byte("plain text"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("plain text"), data)
+}
+
+func TestProjectionFromMetadata(t *testing.T) {
+	p, rest, err := ProjectionFromMetadata(map[string]string{"partitionKey": "p1"})
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+	assert.Equal(t, map[string]string{"partitionKey": "p1"}, rest)
+
+	p, rest, err = ProjectionFromMetadata(map[string]string{"partitionKey": "p1", ProjectionMetadataKey: "$.id"})
+	assert.NoError(t, err)
+	assert.NotNil(t, p)
+	assert.Equal(t, map[string]string{"partitionKey": "p1"}, rest)
+
+	_, _, err = ProjectionFromMetadata(map[string]string{ProjectionMetadataKey: "id"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package state
+
+import (
+	"io"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/components/lifecycle"
+	"github.com/dapr/dapr/pkg/ratelimit"
+)
+
+// RateLimitedStore is a state store whose calls are limited by a rate limit shared by the sidecars of the app.
+// Bulk operations and transactions count as one call.
+type RateLimitedStore struct {
+	store   state.Store
+	limiter *ratelimit.Limiter
+}
+
+type transactionalRateLimitedStore struct {
+	*RateLimitedStore
+}
+
+// NewRateLimitedStore returns a state store limiting the calls to store with limiter.
+// The returned store is transactional if store is.
+func NewRateLimitedStore(store state.Store, limiter *ratelimit.Limiter) state.Store {
+	r := &RateLimitedStore{
+		store:   store,
+		limiter: limiter,
+	}
+	if _, ok := store.(state.TransactionalStore); ok {
+		return transactionalRateLimitedStore{r}
+	}
+	return r
+}
+
+// Unwrap returns the rate limited state store
+func (r *RateLimitedStore) Unwrap() state.Store {
+	return r.store
+}
+
+// Init initializes the rate limited state store
+func (r *RateLimitedStore) Init(metadata state.Metadata) error {
+	return r.store.Init(metadata)
+}
+
+// Get reads a key within the rate limit
+func (r *RateLimitedStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	if err := r.limiter.Allow(); err != nil {
+		return nil, err
+	}
+	return r.store.Get(req)
+}
+
+// Set saves a key within the rate limit
+func (r *RateLimitedStore) Set(req *state.SetRequest) error {
+	if err := r.limiter.Allow(); err != nil {
+		return err
+	}
+	return r.store.Set(req)
+}
+
+// BulkSet saves keys within the rate limit
+func (r *RateLimitedStore) BulkSet(req []state.SetRequest) error {
+	if err := r.limiter.Allow(); err != nil {
+		return err
+	}
+	return r.store.BulkSet(req)
+}
+
+// Delete deletes a key within the rate limit
+func (r *RateLimitedStore) Delete(req *state.DeleteRequest) error {
+	if err := r.limiter.Allow(); err != nil {
+		return err
+	}
+	return r.store.Delete(req)
+}
+
+// BulkDelete deletes keys within the rate limit
+func (r *RateLimitedStore) BulkDelete(req []state.DeleteRequest) error {
+	if err := r.limiter.Allow(); err != nil {
+		return err
+	}
+	return r.store.BulkDelete(req)
+}
+
+// Flush flushes the rate limited state store if it buffers writes
+func (r *RateLimitedStore) Flush() error {
+	if f, ok := r.store.(lifecycle.Flusher); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+// Close closes the rate limited state store if it holds resources
+func (r *RateLimitedStore) Close() error {
+	if c, ok := r.store.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// Multi runs a transaction within the rate limit
+func (t transactionalRateLimitedStore) Multi(reqs []state.TransactionalRequest) error {
+	if err := t.limiter.Allow(); err != nil {
+		return err
+	}
+	return t.store.(state.TransactionalStore).Multi(reqs)
+}
@@ -0,0 +1,235 @@
+package state
+
+import (
+	"container/list"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/components/lifecycle"
+)
+
+const (
+	// ReadCacheTTLMetadataKey is the state store component metadata item enabling the read cache of the sidecar, with
+	// the duration a value is served from the cache, e.g. 5s
+	ReadCacheTTLMetadataKey = "readCacheTTL"
+	// ReadCacheMaxEntriesMetadataKey is the state store component metadata item bounding the keys in the read cache
+	ReadCacheMaxEntriesMetadataKey = "readCacheMaxEntries"
+
+	defaultReadCacheMaxEntries = 1000
+)
+
+// ReadCacheConfig configures the read cache of a state store. The cache is disabled when TTL is zero.
+type ReadCacheConfig struct {
+	TTL        time.Duration
+	MaxEntries int
+}
+
+// ReadCacheFromMetadata reads the read cache configuration from the metadata of a state store component
+func ReadCacheFromMetadata(properties map[string]string) (ReadCacheConfig, error) {
+	c := ReadCacheConfig{MaxEntries: defaultReadCacheMaxEntries}
+	if v, ok := properties[ReadCacheTTLMetadataKey]; ok {
+		ttl, err := time.ParseDuration(v)
+		if err != nil || ttl <= 0 {
+			return c, fmt.Errorf("%s must be a positive duration", ReadCacheTTLMetadataKey)
+		}
+		c.TTL = ttl
+	}
+	if v, ok := properties[ReadCacheMaxEntriesMetadataKey]; ok {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return c, fmt.Errorf("%s must be a positive number", ReadCacheMaxEntriesMetadataKey)
+		}
+		c.MaxEntries = n
+	}
+	return c, nil
+}
+
+// Enabled returns true if the configuration enables the read cache
+func (c ReadCacheConfig) Enabled() bool {
+	return c.TTL > 0
+}
+
+type readCacheEntry struct {
+	key     string
+	resp    state.GetResponse
+	expires time.Time
+}
+
+// CachedStore serves the values read recently from a state store from a bounded LRU cache, and drops the values of
+// the keys it writes. Writes made by other apps or directly to the store aren't seen, so a value read from the cache
+// can be stale for up to the TTL of the cache. Reads with strong consistency or metadata always go to the store.
+type CachedStore struct {
+	store      state.Store
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	lock    sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	// generation counts the writes, so a value read before a write isn't cached after it
+	generation uint64
+}
+
+type transactionalCachedStore struct {
+	*CachedStore
+}
+
+// NewCachedStore returns a state store caching the values read from store.
+// The returned store is transactional if store is.
+func NewCachedStore(store state.Store, config ReadCacheConfig) state.Store {
+	c := &CachedStore{
+		store:      store,
+		ttl:        config.TTL,
+		maxEntries: config.MaxEntries,
+		now:        time.Now,
+		entries:    map[string]*list.Element{},
+		lru:        list.New(),
+	}
+	if _, ok := store.(state.TransactionalStore); ok {
+		return transactionalCachedStore{c}
+	}
+	return c
+}
+
+// Unwrap returns the cached state store
+func (c *CachedStore) Unwrap() state.Store {
+	return c.store
+}
+
+// Init initializes the cached state store
+func (c *CachedStore) Init(metadata state.Metadata) error {
+	return c.store.Init(metadata)
+}
+
+// Get reads a key from the cache, or from the store if the cache doesn't hold it
+func (c *CachedStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	if req.Options.Consistency == state.Strong || len(req.Metadata) > 0 {
+		return c.store.Get(req)
+	}
+
+	c.lock.Lock()
+	if e, ok := c.entries[req.Key]; ok {
+		entry := e.Value.(*readCacheEntry)
+		if c.now().Before(entry.expires) {
+			c.lru.MoveToFront(e)
+			resp := entry.resp
+			c.lock.Unlock()
+			return &resp, nil
+		}
+		c.remove(e)
+	}
+	generation := c.generation
+	c.lock.Unlock()
+
+	resp, err := c.store.Get(req)
+	if err != nil || resp == nil || resp.Data == nil {
+		return resp, err
+	}
+	c.put(req.Key, *resp, generation)
+	return resp, nil
+}
+
+// Set saves a key and drops it from the cache
+func (c *CachedStore) Set(req *state.SetRequest) error {
+	defer c.invalidate(req.Key)
+	return c.store.Set(req)
+}
+
+// BulkSet saves keys and drops them from the cache
+func (c *CachedStore) BulkSet(req []state.SetRequest) error {
+	keys := make([]string, 0, len(req))
+	for _, r := range req {
+		keys = append(keys, r.Key)
+	}
+	defer c.invalidate(keys...)
+	return c.store.BulkSet(req)
+}
+
+// Delete deletes a key and drops it from the cache
+func (c *CachedStore) Delete(req *state.DeleteRequest) error {
+	defer c.invalidate(req.Key)
+	return c.store.Delete(req)
+}
+
+// BulkDelete deletes keys and drops them from the cache
+func (c *CachedStore) BulkDelete(req []state.DeleteRequest) error {
+	keys := make([]string, 0, len(req))
+	for _, r := range req {
+		keys = append(keys, r.Key)
+	}
+	defer c.invalidate(keys...)
+	return c.store.BulkDelete(req)
+}
+
+// Flush flushes the cached state store if it buffers writes
+func (c *CachedStore) Flush() error {
+	if f, ok := c.store.(lifecycle.Flusher); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+// Close closes the cached state store if it holds resources
+func (c *CachedStore) Close() error {
+	if cl, ok := c.store.(io.Closer); ok {
+		return cl.Close()
+	}
+	return nil
+}
+
+// Multi runs a transaction and drops its keys from the cache
+func (t transactionalCachedStore) Multi(reqs []state.TransactionalRequest) error {
+	keys := make([]string, 0, len(reqs))
+	for _, r := range reqs {
+		switch req := r.Request.(type) {
+		case state.SetRequest:
+			keys = append(keys, req.Key)
+		case state.DeleteRequest:
+			keys = append(keys, req.Key)
+		}
+	}
+	defer t.invalidate(keys...)
+	return t.store.(state.TransactionalStore).Multi(reqs)
+}
+
+// put caches the value of a key unless a key was written since generation, evicting the least recently used key if
+// the cache is full
+func (c *CachedStore) put(key string, resp state.GetResponse, generation uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.generation != generation {
+		return
+	}
+	entry := &readCacheEntry{key: key, resp: resp, expires: c.now().Add(c.ttl)}
+	if e, ok := c.entries[key]; ok {
+		e.Value = entry
+		c.lru.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	if c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// invalidate drops keys from the cache
+func (c *CachedStore) invalidate(keys ...string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.generation++
+	for _, key := range keys {
+		if e, ok := c.entries[key]; ok {
+			c.remove(e)
+		}
+	}
+}
+
+func (c *CachedStore) remove(e *list.Element) {
+	c.lru.Remove(e)
+	delete(c.entries, e.Value.(*readCacheEntry).key)
+}
@@ -0,0 +1,144 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingStore is a state store holding its values in a map and counting its reads
+type countingStore struct {
+	state.Store
+	data  map[string][]byte
+	reads int
+	// onGet runs before a read returns
+	onGet func()
+}
+
+func (s *countingStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	s.reads++
+	resp := &state.GetResponse{Data: s.data[req.Key], ETag: "1"}
+	if s.onGet != nil {
+		s.onGet()
+	}
+	return resp, nil
+}
+
+func (s *countingStore) Set(req *state.SetRequest) error {
+	s.data[req.Key] = req.Value.([]byte)
+	return nil
+}
+
+func (s *countingStore) Delete(req *state.DeleteRequest) error {
+	delete(s.data, req.Key)
+	return nil
+}
+
+func TestReadCacheFromMetadata(t *testing.T) {
+	c, err := ReadCacheFromMetadata(map[string]string{})
+	assert.NoError(t, err)
+	assert.False(t, c.Enabled())
+
+	c, err = ReadCacheFromMetadata(map[string]string{ReadCacheTTLMetadataKey: "5s", ReadCacheMaxEntriesMetadataKey: "10"})
+	assert.NoError(t, err)
+	assert.Equal(t, ReadCacheConfig{TTL: 5 * time.Second, MaxEntries: 10}, c)
+	assert.True(t, c.Enabled())
+
+	for _, properties := range []map[string]string{
+		{ReadCacheTTLMetadataKey: "soon"},
+		{ReadCacheTTLMetadataKey: "-1s"},
+		{ReadCacheTTLMetadataKey: "5s", ReadCacheMaxEntriesMetadataKey: "0"},
+	} {
+		_, err := ReadCacheFromMetadata(properties)
+		assert.Error(t, err)
+	}
+}
+
+func TestCachedStore(t *testing.T) {
+	newStore := func(maxEntries int) (*countingStore, *CachedStore) {
+		store := &countingStore{data: map[string][]byte{"a": []byte("1"), "b": []byte("2")}}
+		return store, NewCachedStore(store, ReadCacheConfig{TTL: time.Second, MaxEntries: maxEntries}).(*CachedStore)
+	}
+
+	t.Run("reads are served from the cache until they expire", func(t *testing.T) {
+		store, cached := newStore(10)
+		now := time.Now()
+		cached.now = func() time.Time { return now }
+
+		for i := 0; i < 3; i++ {
+			resp, err := cached.Get(&state.GetRequest{Key: "a"})
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("1"), resp.Data)
+			assert.Equal(t, "1", resp.ETag)
+		}
+		assert.Equal(t, 1, store.reads)
+
+		now = now.Add(time.Second)
+		cached.Get(&state.GetRequest{Key: "a"})
+		assert.Equal(t, 2, store.reads)
+	})
+
+	t.Run("writes drop the keys", func(t *testing.T) {
+		store, cached := newStore(10)
+		cached.Get(&state.GetRequest{Key: "a"})
+		assert.NoError(t, cached.Set(&state.SetRequest{Key: "a", Value: []byte("3")}))
+		resp, _ := cached.Get(&state.GetRequest{Key: "a"})
+		assert.Equal(t, []byte("3"), resp.Data)
+
+		assert.NoError(t, cached.Delete(&state.DeleteRequest{Key: "a"}))
+		resp, _ = cached.Get(&state.GetRequest{Key: "a"})
+		assert.Nil(t, resp.Data)
+		assert.Equal(t, 3, store.reads)
+	})
+
+	t.Run("values read before a write aren't cached", func(t *testing.T) {
+		store, cached := newStore(10)
+		store.onGet = func() {
+			store.onGet = nil
+			cached.Set(&state.SetRequest{Key: "a", Value: []byte("3")})
+		}
+		resp, _ := cached.Get(&state.GetRequest{Key: "a"})
+		assert.Equal(t, []byte("1"), resp.Data)
+		resp, _ = cached.Get(&state.GetRequest{Key: "a"})
+		assert.Equal(t, []byte("3"), resp.Data)
+	})
+
+	t.Run("the least recently used key is evicted", func(t *testing.T) {
+		store, cached := newStore(1)
+		cached.Get(&state.GetRequest{Key: "a"})
+		cached.Get(&state.GetRequest{Key: "b"})
+		cached.Get(&state.GetRequest{Key: "a"})
+		assert.Equal(t, 3, store.reads)
+		assert.Equal(t, 1, cached.lru.Len())
+	})
+
+	t.Run("strong reads and reads with metadata skip the cache", func(t *testing.T) {
+		store, cached := newStore(10)
+		cached.Get(&state.GetRequest{Key: "a"})
+		cached.Get(&state.GetRequest{Key: "a", Options: state.GetStateOption{Consistency: state.Strong}})
+		cached.Get(&state.GetRequest{Key: "a", Metadata: map[string]string{"partitionKey": "p1"}})
+		assert.Equal(t, 3, store.reads)
+	})
+
+	t.Run("transactions drop their keys", func(t *testing.T) {
+		store := &countingStore{data: map[string][]byte{"a": []byte("1")}}
+		cached := NewCachedStore(fakeTransactionalCountingStore{store}, ReadCacheConfig{TTL: time.Second, MaxEntries: 10})
+		cached.Get(&state.GetRequest{Key: "a"})
+		err := cached.(state.TransactionalStore).Multi([]state.TransactionalRequest{
+			{Operation: state.Delete, Request: state.DeleteRequest{Key: "a"}},
+		})
+		assert.NoError(t, err)
+		cached.Get(&state.GetRequest{Key: "a"})
+		assert.Equal(t, 2, store.reads)
+	})
+}
+
+type fakeTransactionalCountingStore struct {
+	*countingStore
+}
+
+func (f fakeTransactionalCountingStore) Multi(reqs []state.TransactionalRequest) error {
+	return nil
+}
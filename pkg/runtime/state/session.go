@@ -0,0 +1,52 @@
+package state
+
+import (
+	"github.com/dapr/components-contrib/state"
+)
+
+const (
+	// SessionTokenHeader returns the session token of a state request, and sets the session token a get request reads
+	// at
+	SessionTokenHeader = "dapr-state-session-token"
+	// SessionTokenMetadataKey is the metadata item of a get request with the session token the state store reads at
+	SessionTokenMetadataKey = "sessionToken"
+)
+
+// SessionStore is implemented by state stores with session consistency: a get request with the sessionToken metadata
+// item sees at least the writes of the session, without requiring strong consistency
+type SessionStore interface {
+	// SessionToken returns the session token covering the latest requests of the store to the keys
+	SessionToken(keys ...string) string
+}
+
+// AsSessionStore returns the session consistency of a state store, or of the component it wraps
+func AsSessionStore(store state.Store) (SessionStore, bool) {
+	ss, ok := componentStore(store).(SessionStore)
+	return ss, ok
+}
+
+// ApplySessionToken sets the sessionToken metadata item of a get request to the session token of the request. It
+// returns a FeatureError if the state store doesn't have session consistency.
+func ApplySessionToken(storeName string, store state.Store, req *state.GetRequest, token string) error {
+	if err := RequireFeature(storeName, store, FeatureSessionConsistency); err != nil {
+		return err
+	}
+
+	metadata := make(map[string]string, len(req.Metadata)+1)
+	for k, v := range req.Metadata {
+		metadata[k] = v
+	}
+	metadata[SessionTokenMetadataKey] = token
+	req.Metadata = metadata
+	return nil
+}
+
+// SessionToken returns the session token covering the latest requests of a state store to the keys, empty if the
+// store doesn't have session consistency
+func SessionToken(store state.Store, keys ...string) string {
+	ss, ok := AsSessionStore(store)
+	if !ok {
+		return ""
+	}
+	return ss.SessionToken(keys...)
+}
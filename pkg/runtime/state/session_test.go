@@ -0,0 +1,44 @@
+package state
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSessionStore struct {
+	fakeStore
+}
+
+func (f fakeSessionStore) SessionToken(keys ...string) string {
+	return "session:" + strings.Join(keys, ",")
+}
+
+func TestApplySessionToken(t *testing.T) {
+	t.Run("sets the metadata item", func(t *testing.T) {
+		metadata := map[string]string{"partitionKey": "p1"}
+		req := &state.GetRequest{Key: "k1", Metadata: metadata}
+		assert.NoError(t, ApplySessionToken("store1", fakeSessionStore{}, req, "session:k1"))
+		assert.Equal(t, map[string]string{"partitionKey": "p1", SessionTokenMetadataKey: "session:k1"}, req.Metadata)
+		assert.Len(t, metadata, 1, "the metadata of the caller is left as is")
+	})
+
+	t.Run("store without session consistency", func(t *testing.T) {
+		req := &state.GetRequest{Key: "k1"}
+		err := ApplySessionToken("store1", fakeStore{}, req, "session:k1")
+		fe, ok := err.(*FeatureError)
+		assert.True(t, ok)
+		assert.Equal(t, FeatureSessionConsistency, fe.Feature)
+		assert.NotEmpty(t, fe.Alternative)
+		assert.Nil(t, req.Metadata)
+	})
+}
+
+func TestSessionToken(t *testing.T) {
+	assert.Equal(t, "session:k1,k2", SessionToken(fakeSessionStore{}, "k1", "k2"))
+	assert.Equal(t, "session:k1", SessionToken(NewCachedStore(fakeSessionStore{}, ReadCacheConfig{TTL: time.Second, MaxEntries: 1}), "k1"))
+	assert.Empty(t, SessionToken(fakeStore{}, "k1"))
+}
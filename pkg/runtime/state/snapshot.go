@@ -0,0 +1,39 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// SnapshotStore is implemented by state stores reading several keys in one transaction or snapshot, so that the
+// values are consistent with each other
+type SnapshotStore interface {
+	// GetSnapshot returns the state of the keys of the requests at a single point in time, in the order of the
+	// requests. The state of a missing key has no data.
+	GetSnapshot(reqs []state.GetRequest) ([]state.GetResponse, error)
+}
+
+// AsSnapshotStore returns the snapshot reads of a state store, or of the component it wraps
+func AsSnapshotStore(store state.Store) (SnapshotStore, bool) {
+	ss, ok := componentStore(store).(SnapshotStore)
+	return ss, ok
+}
+
+// GetSnapshot reads the keys of the requests at a single point in time. It returns a FeatureError if the state store
+// doesn't support snapshot reads.
+func GetSnapshot(storeName string, store state.Store, reqs []state.GetRequest) ([]state.GetResponse, error) {
+	if err := RequireFeature(storeName, store, FeatureSnapshotRead); err != nil {
+		return nil, err
+	}
+
+	ss, _ := AsSnapshotStore(store)
+	resps, err := ss.GetSnapshot(reqs)
+	if err != nil {
+		return nil, err
+	}
+	if len(resps) != len(reqs) {
+		return nil, fmt.Errorf("state store %s returned %d states for %d keys", storeName, len(resps), len(reqs))
+	}
+	return resps, nil
+}
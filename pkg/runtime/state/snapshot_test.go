@@ -0,0 +1,58 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSnapshotStore struct {
+	fakeStore
+	values map[string]string
+	// short drops the state of the last key
+	short bool
+}
+
+func (f fakeSnapshotStore) GetSnapshot(reqs []state.GetRequest) ([]state.GetResponse, error) {
+	resps := make([]state.GetResponse, 0, len(reqs))
+	for _, req := range reqs {
+		resp := state.GetResponse{}
+		if v, ok := f.values[req.Key]; ok {
+			resp.Data, resp.ETag = []byte(v), "1"
+		}
+		resps = append(resps, resp)
+	}
+	if f.short {
+		resps = resps[:len(resps)-1]
+	}
+	return resps, nil
+}
+
+func TestGetSnapshot(t *testing.T) {
+	reqs := []state.GetRequest{{Key: "k1"}, {Key: "k2"}, {Key: "k3"}}
+
+	t.Run("reads the keys in order", func(t *testing.T) {
+		store := fakeSnapshotStore{values: map[string]string{"k1": "v1", "k3": "v3"}}
+		resps, err := GetSnapshot("store1", store, reqs)
+		assert.NoError(t, err)
+		assert.Equal(t, []state.GetResponse{
+			{Data: []byte("v1"), ETag: "1"},
+			{},
+			{Data: []byte("v3"), ETag: "1"},
+		}, resps)
+	})
+
+	t.Run("store without snapshot reads", func(t *testing.T) {
+		_, err := GetSnapshot("store1", fakeStore{}, reqs)
+		fe, ok := err.(*FeatureError)
+		assert.True(t, ok)
+		assert.Equal(t, FeatureSnapshotRead, fe.Feature)
+		assert.NotEmpty(t, fe.Alternative)
+	})
+
+	t.Run("missing states", func(t *testing.T) {
+		_, err := GetSnapshot("store1", fakeSnapshotStore{short: true}, reqs)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,99 @@
+package state
+
+import (
+	"io"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/components/lifecycle"
+)
+
+// TrackedStore counts the operations running on a state store so the runtime can wait for them before closing it
+type TrackedStore struct {
+	store    state.Store
+	inFlight *lifecycle.InFlight
+}
+
+type transactionalTrackedStore struct {
+	*TrackedStore
+}
+
+// NewTrackedStore returns a state store recording its running operations in inFlight.
+// The returned store is transactional if store is.
+func NewTrackedStore(store state.Store, inFlight *lifecycle.InFlight) state.Store {
+	t := &TrackedStore{
+		store:    store,
+		inFlight: inFlight,
+	}
+	if _, ok := store.(state.TransactionalStore); ok {
+		return transactionalTrackedStore{t}
+	}
+	return t
+}
+
+// Unwrap returns the tracked state store
+func (t *TrackedStore) Unwrap() state.Store {
+	return t.store
+}
+
+// Init initializes the tracked state store
+func (t *TrackedStore) Init(metadata state.Metadata) error {
+	return t.store.Init(metadata)
+}
+
+// Get reads a key
+func (t *TrackedStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	t.inFlight.Start()
+	defer t.inFlight.Done()
+	return t.store.Get(req)
+}
+
+// Set saves a key
+func (t *TrackedStore) Set(req *state.SetRequest) error {
+	t.inFlight.Start()
+	defer t.inFlight.Done()
+	return t.store.Set(req)
+}
+
+// BulkSet saves keys
+func (t *TrackedStore) BulkSet(req []state.SetRequest) error {
+	t.inFlight.Start()
+	defer t.inFlight.Done()
+	return t.store.BulkSet(req)
+}
+
+// Delete deletes a key
+func (t *TrackedStore) Delete(req *state.DeleteRequest) error {
+	t.inFlight.Start()
+	defer t.inFlight.Done()
+	return t.store.Delete(req)
+}
+
+// BulkDelete deletes keys
+func (t *TrackedStore) BulkDelete(req []state.DeleteRequest) error {
+	t.inFlight.Start()
+	defer t.inFlight.Done()
+	return t.store.BulkDelete(req)
+}
+
+// Flush flushes the tracked state store if it buffers writes
+func (t *TrackedStore) Flush() error {
+	if f, ok := t.store.(lifecycle.Flusher); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+// Close closes the tracked state store if it holds resources
+func (t *TrackedStore) Close() error {
+	if c, ok := t.store.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// Multi runs a transaction
+func (t transactionalTrackedStore) Multi(reqs []state.TransactionalRequest) error {
+	t.inFlight.Start()
+	defer t.inFlight.Done()
+	return t.store.(state.TransactionalStore).Multi(reqs)
+}
@@ -0,0 +1,49 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/components/lifecycle"
+	"github.com/stretchr/testify/assert"
+)
+
+type blockingStore struct {
+	fakeFailoverStore
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingStore) Set(req *state.SetRequest) error {
+	close(b.started)
+	<-b.release
+	return nil
+}
+
+func TestTrackedStore(t *testing.T) {
+	t.Run("counts running operations", func(t *testing.T) {
+		inFlight := &lifecycle.InFlight{}
+		b := &blockingStore{started: make(chan struct{}), release: make(chan struct{})}
+		store := NewTrackedStore(b, inFlight)
+
+		done := make(chan error)
+		go func() {
+			done <- store.Set(&state.SetRequest{Key: "k"})
+		}()
+		<-b.started
+		assert.Equal(t, int64(1), inFlight.Count())
+		close(b.release)
+		assert.NoError(t, <-done)
+		assert.Equal(t, int64(0), inFlight.Count())
+	})
+
+	t.Run("transactional only if the tracked store is", func(t *testing.T) {
+		_, ok := NewTrackedStore(newFakeFailoverStore(false), &lifecycle.InFlight{}).(state.TransactionalStore)
+		assert.False(t, ok)
+
+		store := NewTrackedStore(fakeTransactionalFailoverStore{newFakeFailoverStore(false)}, &lifecycle.InFlight{})
+		tx, ok := store.(state.TransactionalStore)
+		assert.True(t, ok)
+		assert.NoError(t, tx.Multi(nil))
+	})
+}
@@ -0,0 +1,40 @@
+package state
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+// TTLMetadataKey is the metadata item of a set request with the number of seconds the key expires after
+const TTLMetadataKey = "ttlInSeconds"
+
+// TTLStore is implemented by state stores expiring the keys of set requests after their ttlInSeconds metadata item
+type TTLStore interface {
+	SupportsTTL() bool
+}
+
+// ApplyTTL sets the ttlInSeconds metadata item of a set request to the TTL of the request, which must be a whole
+// number of seconds. It returns a FeatureError if the state store doesn't expire keys.
+func ApplyTTL(storeName string, store state.Store, req *state.SetRequest, ttl time.Duration) error {
+	if ttl <= 0 || ttl%time.Second != 0 {
+		return fmt.Errorf("ttl of key %s must be a positive number of seconds", req.Key)
+	}
+	seconds := strconv.FormatInt(int64(ttl/time.Second), 10)
+	if v, ok := req.Metadata[TTLMetadataKey]; ok && v != seconds {
+		return fmt.Errorf("ttl of key %s conflicts with its %s metadata item %s", req.Key, TTLMetadataKey, v)
+	}
+	if err := RequireFeature(storeName, store, FeatureTTL); err != nil {
+		return err
+	}
+
+	metadata := make(map[string]string, len(req.Metadata)+1)
+	for k, v := range req.Metadata {
+		metadata[k] = v
+	}
+	metadata[TTLMetadataKey] = seconds
+	req.Metadata = metadata
+	return nil
+}
@@ -0,0 +1,49 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTTLStore struct {
+	fakeStore
+}
+
+func (f fakeTTLStore) SupportsTTL() bool {
+	return true
+}
+
+func TestApplyTTL(t *testing.T) {
+	t.Run("sets the metadata item", func(t *testing.T) {
+		metadata := map[string]string{"partitionKey": "p1"}
+		req := &state.SetRequest{Key: "k1", Metadata: metadata}
+		assert.NoError(t, ApplyTTL("store1", fakeTTLStore{}, req, time.Minute))
+		assert.Equal(t, map[string]string{"partitionKey": "p1", TTLMetadataKey: "60"}, req.Metadata)
+		assert.Len(t, metadata, 1, "the metadata of the caller is left as is")
+	})
+
+	t.Run("matching metadata item", func(t *testing.T) {
+		req := &state.SetRequest{Key: "k1", Metadata: map[string]string{TTLMetadataKey: "60"}}
+		assert.NoError(t, ApplyTTL("store1", fakeTTLStore{}, req, time.Minute))
+	})
+
+	t.Run("conflicting metadata item", func(t *testing.T) {
+		req := &state.SetRequest{Key: "k1", Metadata: map[string]string{TTLMetadataKey: "10"}}
+		assert.EqualError(t, ApplyTTL("store1", fakeTTLStore{}, req, time.Minute), "ttl of key k1 conflicts with its ttlInSeconds metadata item 10")
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		for _, ttl := range []time.Duration{0, -time.Second, 1500 * time.Millisecond} {
+			assert.Error(t, ApplyTTL("store1", fakeTTLStore{}, &state.SetRequest{Key: "k1"}, ttl))
+		}
+	})
+
+	t.Run("store without ttl", func(t *testing.T) {
+		err := ApplyTTL("store1", fakeStore{}, &state.SetRequest{Key: "k1"}, time.Minute)
+		assert.IsType(t, &FeatureError{}, err)
+		assert.Contains(t, err.Error(), "alternative: delete the keys once they are no longer needed")
+	})
+}
@@ -0,0 +1,65 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+)
+
+// stateKeyPrefix returns the prefix of the keys of the app in a state store, resolved from the key prefix template of
+// the store, or else the app ID
+func (a *DaprRuntime) stateKeyPrefix(storeName string) string {
+	if prefix, ok := a.getStateKeyPrefix(storeName); ok {
+		return prefix
+	}
+	if a.runtimeConfig.ID == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s||", a.runtimeConfig.ID)
+}
+
+// getMigrateStateFn returns the state migration of the admin API, nil unless an admin API token is set
+func (a *DaprRuntime) getMigrateStateFn() runtime_state.MigrateFunc {
+	if a.runtimeConfig.AdminAPIToken == "" {
+		return nil
+	}
+	return a.migrateState
+}
+
+// migrateState is the runtime_state.MigrateFunc of the admin API, copying the keys through the state stores of the
+// runtime
+func (a *DaprRuntime) migrateState(ctx context.Context, source, destination string, rekey bool, pageSize int, progress func(runtime_state.MigrateProgress) error) (runtime_state.MigrateProgress, error) {
+	src, ok := a.stateStores[source]
+	if !ok {
+		return runtime_state.MigrateProgress{}, fmt.Errorf("state store %s not found", source)
+	}
+	dst, ok := a.stateStores[destination]
+	if !ok {
+		return runtime_state.MigrateProgress{}, fmt.Errorf("state store %s not found", destination)
+	}
+
+	req := &runtime_state.MigrateRequest{
+		Source:            source,
+		Destination:       destination,
+		SourcePrefix:      a.stateKeyPrefix(source),
+		DestinationPrefix: a.stateKeyPrefix(source),
+		PageSize:          pageSize,
+	}
+	if rekey {
+		req.DestinationPrefix = a.stateKeyPrefix(destination)
+	}
+	log.Infof("migrating the state of app %s from state store %s to state store %s", a.runtimeConfig.ID, source, destination)
+	p, err := runtime_state.Migrate(ctx, req, src, dst, progress)
+	if err != nil {
+		log.Warnf("migration of the state from state store %s to state store %s failed after %v keys: %s", source, destination, p.Copied, err)
+		return p, err
+	}
+	log.Infof("migrated %v keys from state store %s to state store %s", p.Copied, source, destination)
+	return p, nil
+}
@@ -0,0 +1,40 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dapr/dapr/pkg/modes"
+	runtime_state "github.com/dapr/dapr/pkg/runtime/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrateState(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+
+	t.Run("admin API requires an admin API token", func(t *testing.T) {
+		assert.Nil(t, rt.getMigrateStateFn())
+		rt.runtimeConfig.APIToken = "token"
+		assert.Nil(t, rt.getMigrateStateFn())
+		rt.runtimeConfig.AdminAPIToken = "token"
+		defer func() { rt.runtimeConfig.APIToken, rt.runtimeConfig.AdminAPIToken = "", "" }()
+		assert.NotNil(t, rt.getMigrateStateFn())
+	})
+
+	t.Run("key prefix of the state store", func(t *testing.T) {
+		rt.stateKeyPrefixes["prefixed"] = "prod-orders||"
+		defer delete(rt.stateKeyPrefixes, "prefixed")
+		assert.Equal(t, "prod-orders||", rt.stateKeyPrefix("prefixed"))
+		assert.Equal(t, rt.runtimeConfig.ID+"||", rt.stateKeyPrefix("other"))
+	})
+
+	t.Run("unknown state store", func(t *testing.T) {
+		_, err := rt.migrateState(context.Background(), "missing", "other", false, 0, func(runtime_state.MigrateProgress) error { return nil })
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,92 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"context"
+	nethttp "net/http"
+	"time"
+
+	"github.com/dapr/components-contrib/pubsub"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+const appHealthEndpoint = "healthz"
+
+// dependencyCheckInterval is the interval between checks of the dependencies of pending subscriptions
+var dependencyCheckInterval = time.Second
+
+// subscribeWhenReady subscribes to a topic once its dependencies are ready or their timeout expires
+func (a *DaprRuntime) subscribeWhenReady(topic, route string, dependencies runtime_pubsub.Dependencies, publishFunc func(msg *pubsub.NewMessage) error) {
+	defer recoverLoop("subscription " + topic)
+	log.Infof("subscription to topic %s is waiting for its dependencies", topic)
+	missing := runtime_pubsub.WaitForDependencies(dependencies, a.getUnmetDependencies, dependencyCheckInterval, a.shutdownC)
+	select {
+	case <-a.shutdownC:
+		log.Infof("subscription to topic %s canceled by shutdown", topic)
+		return
+	default:
+	}
+	if len(missing) > 0 {
+		log.Warnf("subscribing to topic %s after %s although dependencies %v aren't ready", topic, dependencies.Timeout, missing)
+	}
+	a.subscribeTopic(topic, route, publishFunc)
+}
+
+// getUnmetDependencies returns the dependencies of a subscription that aren't ready
+func (a *DaprRuntime) getUnmetDependencies(dependencies runtime_pubsub.Dependencies) []string {
+	var missing []string
+	if dependencies.AppHealthy && !a.isAppHealthy() {
+		missing = append(missing, runtime_pubsub.AppHealthyDependency)
+	}
+	for _, c := range dependencies.Components {
+		if !a.isComponentReady(c) {
+			missing = append(missing, runtime_pubsub.ComponentDependencyPrefix+c)
+		}
+	}
+	return missing
+}
+
+// isAppHealthy returns true if the app health endpoint responds with a success status code.
+// gRPC apps have no health endpoint and are healthy once their channel is open.
+func (a *DaprRuntime) isAppHealthy() bool {
+	if a.appChannel == nil {
+		return false
+	}
+	if a.runtimeConfig.ApplicationProtocol != HTTPProtocol {
+		return true
+	}
+
+	req := invokev1.NewInvokeMethodRequest(appHealthEndpoint)
+	req.WithHTTPExtension(nethttp.MethodGet, "")
+	resp, err := a.appChannel.InvokeMethod(context.Background(), req)
+	if err != nil {
+		return false
+	}
+	code := resp.Status().Code
+	return code >= 200 && code < 300
+}
+
+// isComponentReady returns true if a component with the given name is initialized
+func (a *DaprRuntime) isComponentReady(name string) bool {
+	a.componentsLock.Lock()
+	defer a.componentsLock.Unlock()
+
+	if _, ok := a.stateStores[name]; ok {
+		return true
+	}
+	if _, ok := a.secretStores[name]; ok {
+		return true
+	}
+	if _, ok := a.inputBindings[name]; ok {
+		return true
+	}
+	if _, ok := a.outputBindings[name]; ok {
+		return true
+	}
+	return a.pubSub != nil && a.pubSubName == name
+}
@@ -0,0 +1,95 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dapr/components-contrib/pubsub"
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	"github.com/dapr/dapr/pkg/http"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/modes"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetUnmetDependencies(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	mockAppChannel := new(channelt.MockAppChannel)
+	rt.appChannel = mockAppChannel
+	mockAppChannel.On("InvokeMethod", mock.Anything, mock.AnythingOfType("*v1.InvokeMethodRequest")).
+		Return(invokev1.NewInvokeMethodResponse(503, "Service Unavailable", nil), nil).Once()
+	mockAppChannel.On("InvokeMethod", mock.Anything, mock.AnythingOfType("*v1.InvokeMethodRequest")).
+		Return(invokev1.NewInvokeMethodResponse(200, "OK", nil), nil)
+
+	dependencies := runtime_pubsub.Dependencies{AppHealthy: true, Components: []string{"statestore"}}
+	assert.Equal(t, []string{"app:healthy", "component:statestore"}, rt.getUnmetDependencies(dependencies))
+
+	rt.stateStores["statestore"] = &mockSlowStateStore{}
+	assert.Empty(t, rt.getUnmetDependencies(dependencies))
+}
+
+func TestSubscribeWhenReady(t *testing.T) {
+	dependencyCheckInterval = time.Millisecond
+	defer func() {
+		dependencyCheckInterval = time.Second
+	}()
+
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.pubSub = &mockPublishPubSub{}
+	rt.pubSubName = "messagebus"
+	publishFunc := func(msg *pubsub.NewMessage) error { return nil }
+
+	t.Run("waits for component", func(t *testing.T) {
+		rt.recordSubscription("orders", "orders", http.SubscriptionStatusPending)
+		done := make(chan struct{})
+		go func() {
+			rt.subscribeWhenReady("orders", "orders", runtime_pubsub.Dependencies{Components: []string{"statestore"}}, publishFunc)
+			close(done)
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+		assert.Equal(t, http.SubscriptionStatusPending, rt.getSubscriptionsMetadata()[0].Status)
+
+		rt.componentsLock.Lock()
+		rt.stateStores["statestore"] = &mockSlowStateStore{}
+		rt.componentsLock.Unlock()
+		<-done
+		assert.Equal(t, http.SubscriptionStatusActive, rt.getSubscriptionsMetadata()[0].Status)
+	})
+
+	t.Run("subscribes after timeout", func(t *testing.T) {
+		rt.subscribeWhenReady("audit", "audit", runtime_pubsub.Dependencies{Components: []string{"unknown"}, Timeout: 5 * time.Millisecond}, publishFunc)
+		for _, s := range rt.getSubscriptionsMetadata() {
+			if s.Topic == "audit" {
+				assert.Equal(t, http.SubscriptionStatusActive, s.Status)
+			}
+		}
+	})
+
+	t.Run("stops waiting on shutdown", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		rt.pubSub = &mockPublishPubSub{}
+		rt.pubSubName = "messagebus"
+		rt.recordSubscription("billing", "billing", http.SubscriptionStatusPending)
+		done := make(chan struct{})
+		go func() {
+			rt.subscribeWhenReady("billing", "billing", runtime_pubsub.Dependencies{Components: []string{"unknown"}}, publishFunc)
+			close(done)
+		}()
+
+		rt.Stop()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			assert.Fail(t, "subscription still waiting after shutdown")
+		}
+		assert.Equal(t, http.SubscriptionStatusPending, rt.getSubscriptionsMetadata()[0].Status)
+	})
+}
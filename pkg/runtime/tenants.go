@@ -0,0 +1,154 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	global_config "github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/grpc"
+	"github.com/ghodss/yaml"
+)
+
+const (
+	tenantConfigFile    = "tenant.yaml"
+	tenantComponentsDir = "components"
+)
+
+// TenantConfig holds the settings of an app served by a shared daprd, read from the tenant.yaml file of its directory
+type TenantConfig struct {
+	AppPort     int    `json:"appPort"`
+	AppProtocol string `json:"appProtocol"`
+	HTTPPort    int    `json:"httpPort"`
+	GRPCPort    int    `json:"grpcPort"`
+	// Config is the path of the configuration file of the app, relative to its directory
+	Config string `json:"config"`
+	// APIToken is required from the app when calling the Dapr API if set
+	APIToken string `json:"apiToken"`
+}
+
+// Tenant is an app served by a shared daprd
+type Tenant struct {
+	TenantConfig
+	ID             string
+	ComponentsPath string
+}
+
+// LoadTenants reads the tenants of a shared daprd. Every directory of path is named after the ID of an app
+// and holds its tenant.yaml settings file and its components directory.
+func LoadTenants(path string) ([]Tenant, error) {
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	tenants := []Tenant{}
+	ports := map[int]string{}
+	for _, f := range files {
+		if !f.IsDir() {
+			continue
+		}
+		t, err := loadTenant(filepath.Join(path, f.Name()), f.Name())
+		if err != nil {
+			return nil, err
+		}
+		for _, port := range []int{t.HTTPPort, t.GRPCPort} {
+			if other, ok := ports[port]; ok {
+				return nil, fmt.Errorf("port %v of tenant %s is already used by tenant %s", port, t.ID, other)
+			}
+			ports[port] = t.ID
+		}
+		tenants = append(tenants, t)
+	}
+
+	if len(tenants) == 0 {
+		return nil, fmt.Errorf("no tenant directory found in %s", path)
+	}
+	return tenants, nil
+}
+
+func loadTenant(dir, id string) (Tenant, error) {
+	t := Tenant{ID: id, ComponentsPath: filepath.Join(dir, tenantComponentsDir)}
+	b, err := ioutil.ReadFile(filepath.Join(dir, tenantConfigFile))
+	if err != nil {
+		return t, fmt.Errorf("error reading settings of tenant %s: %s", id, err)
+	}
+	if err := yaml.Unmarshal(b, &t.TenantConfig); err != nil {
+		return t, fmt.Errorf("error parsing settings of tenant %s: %s", id, err)
+	}
+
+	if t.HTTPPort <= 0 || t.GRPCPort <= 0 {
+		return t, fmt.Errorf("tenant %s must set httpPort and grpcPort", id)
+	}
+	if t.HTTPPort == t.GRPCPort {
+		return t, fmt.Errorf("httpPort and grpcPort of tenant %s must be different", id)
+	}
+	switch Protocol(t.AppProtocol) {
+	case "":
+		t.AppProtocol = string(HTTPProtocol)
+	case HTTPProtocol, GRPCProtocol:
+	default:
+		return t, fmt.Errorf("appProtocol of tenant %s must be %s or %s", id, HTTPProtocol, GRPCProtocol)
+	}
+	if t.Config != "" && !filepath.IsAbs(t.Config) {
+		t.Config = filepath.Join(dir, t.Config)
+	}
+	if info, err := os.Stat(t.ComponentsPath); err != nil || !info.IsDir() {
+		return t, fmt.Errorf("tenant %s has no %s directory", id, tenantComponentsDir)
+	}
+	return t, nil
+}
+
+// TenantRuntimes returns the runtimes served by this process: one per tenant when a tenants path is set,
+// the runtime itself otherwise. Tenants have their own components, ports and configuration and share the other settings.
+func (a *DaprRuntime) TenantRuntimes() ([]*DaprRuntime, error) {
+	if a.runtimeConfig.TenantsPath == "" {
+		return []*DaprRuntime{a}, nil
+	}
+
+	tenants, err := LoadTenants(a.runtimeConfig.TenantsPath)
+	if err != nil {
+		return nil, fmt.Errorf("error loading tenants: %s", err)
+	}
+
+	runtimes := make([]*DaprRuntime, 0, len(tenants))
+	for i, t := range tenants {
+		runtimeConfig := *a.runtimeConfig
+		runtimeConfig.TenantsPath = ""
+		runtimeConfig.ID = t.ID
+		runtimeConfig.HTTPPort = t.HTTPPort
+		runtimeConfig.APIGRPCPort = t.GRPCPort
+		runtimeConfig.ApplicationPort = t.AppPort
+		runtimeConfig.ApplicationProtocol = Protocol(t.AppProtocol)
+		runtimeConfig.Standalone.ComponentsPath = t.ComponentsPath
+		runtimeConfig.APIToken = t.APIToken
+		// the profiling server is served once for the process
+		runtimeConfig.EnableProfiling = a.runtimeConfig.EnableProfiling && i == 0
+		runtimeConfig.InternalGRPCPort, err = grpc.GetFreePort()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get free port for internal grpc server of tenant %s: %s", t.ID, err)
+		}
+
+		globalConfig := a.globalConfig
+		if t.Config != "" {
+			globalConfig, err = global_config.LoadStandaloneConfiguration(t.Config)
+			if err != nil {
+				return nil, fmt.Errorf("error loading configuration of tenant %s: %s", t.ID, err)
+			}
+		}
+
+		rt := NewDaprRuntime(&runtimeConfig, globalConfig)
+		if t.Config == "" {
+			rt.globalConfigErr = a.globalConfigErr
+		}
+		runtimes = append(runtimes, rt)
+		log.Infof("serving tenant %s on http port %v and grpc port %v", t.ID, t.HTTPPort, t.GRPCPort)
+	}
+	return runtimes, nil
+}
@@ -0,0 +1,104 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTenant(t *testing.T, root, id, settings string) {
+	dir := filepath.Join(root, id)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, tenantComponentsDir), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, tenantConfigFile), []byte(settings), 0600))
+}
+
+func TestLoadTenants(t *testing.T) {
+	t.Run("loads tenants", func(t *testing.T) {
+		root, _ := ioutil.TempDir("", "tenants")
+		defer os.RemoveAll(root)
+		writeTenant(t, root, "app1", "appPort: 3000\nhttpPort: 3500\ngrpcPort: 50001\napiToken: token1\n")
+		writeTenant(t, root, "app2", "appPort: 3001\nappProtocol: grpc\nhttpPort: 3501\ngrpcPort: 50002\nconfig: config.yaml\n")
+
+		tenants, err := LoadTenants(root)
+		assert.NoError(t, err)
+		assert.Len(t, tenants, 2)
+		assert.Equal(t, "app1", tenants[0].ID)
+		assert.Equal(t, string(HTTPProtocol), tenants[0].AppProtocol)
+		assert.Equal(t, "token1", tenants[0].APIToken)
+		assert.Equal(t, filepath.Join(root, "app1", tenantComponentsDir), tenants[0].ComponentsPath)
+		assert.Equal(t, string(GRPCProtocol), tenants[1].AppProtocol)
+		assert.Equal(t, filepath.Join(root, "app2", "config.yaml"), tenants[1].Config)
+	})
+
+	t.Run("port used by two tenants", func(t *testing.T) {
+		root, _ := ioutil.TempDir("", "tenants")
+		defer os.RemoveAll(root)
+		writeTenant(t, root, "app1", "httpPort: 3500\ngrpcPort: 50001\n")
+		writeTenant(t, root, "app2", "httpPort: 3501\ngrpcPort: 3500\n")
+
+		_, err := LoadTenants(root)
+		assert.EqualError(t, err, "port 3500 of tenant app2 is already used by tenant app1")
+	})
+
+	t.Run("missing ports", func(t *testing.T) {
+		root, _ := ioutil.TempDir("", "tenants")
+		defer os.RemoveAll(root)
+		writeTenant(t, root, "app1", "appPort: 3000\n")
+
+		_, err := LoadTenants(root)
+		assert.Error(t, err)
+	})
+
+	t.Run("no tenant", func(t *testing.T) {
+		root, _ := ioutil.TempDir("", "tenants")
+		defer os.RemoveAll(root)
+
+		_, err := LoadTenants(root)
+		assert.Error(t, err)
+	})
+}
+
+func TestTenantRuntimes(t *testing.T) {
+	t.Run("runtime itself without tenants", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		runtimes, err := rt.TenantRuntimes()
+		assert.NoError(t, err)
+		assert.Equal(t, []*DaprRuntime{rt}, runtimes)
+	})
+
+	t.Run("one runtime per tenant", func(t *testing.T) {
+		root, _ := ioutil.TempDir("", "tenants")
+		defer os.RemoveAll(root)
+		writeTenant(t, root, "app1", "appPort: 3000\nhttpPort: 3500\ngrpcPort: 50001\napiToken: token1\n")
+		writeTenant(t, root, "app2", "appPort: 3001\nhttpPort: 3501\ngrpcPort: 50002\n")
+
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		rt.runtimeConfig.TenantsPath = root
+		rt.runtimeConfig.EnableProfiling = true
+		runtimes, err := rt.TenantRuntimes()
+		assert.NoError(t, err)
+		assert.Len(t, runtimes, 2)
+
+		first, second := runtimes[0].runtimeConfig, runtimes[1].runtimeConfig
+		assert.Equal(t, "app1", first.ID)
+		assert.Equal(t, 3500, first.HTTPPort)
+		assert.Equal(t, 50001, first.APIGRPCPort)
+		assert.Equal(t, "token1", first.APIToken)
+		assert.True(t, first.EnableProfiling)
+		assert.Equal(t, "app2", second.ID)
+		assert.Equal(t, filepath.Join(root, "app2", tenantComponentsDir), second.Standalone.ComponentsPath)
+		assert.False(t, second.EnableProfiling)
+		assert.NotEqual(t, first.InternalGRPCPort, second.InternalGRPCPort)
+		assert.Empty(t, first.TenantsPath)
+	})
+}
@@ -0,0 +1,333 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/components"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/messaging"
+	"github.com/dapr/dapr/pkg/modes"
+)
+
+const (
+	validationSeverityError   = "error"
+	validationSeverityWarning = "warning"
+)
+
+// componentCategories are the component type prefixes understood by the runtime
+var componentCategories = []string{"bindings.", "state.", "pubsub.", "secretstores.", "exporters.", "middleware.http."}
+
+// validationReport is the machine readable result of a --validate-only run
+type validationReport struct {
+	Valid         bool              `json:"valid"`
+	AppID         string            `json:"appId"`
+	Mode          string            `json:"mode"`
+	Configuration string            `json:"configuration,omitempty"`
+	Components    int               `json:"components"`
+	Issues        []validationIssue `json:"issues"`
+}
+
+// validationIssue is a single problem found in a configuration or component manifest
+type validationIssue struct {
+	Severity string `json:"severity"`
+	Resource string `json:"resource"`
+	Message  string `json:"message"`
+}
+
+func (r *validationReport) addError(resource, format string, args ...interface{}) {
+	r.Issues = append(r.Issues, validationIssue{Severity: validationSeverityError, Resource: resource, Message: fmt.Sprintf(format, args...)})
+}
+
+func (r *validationReport) addWarning(resource, format string, args ...interface{}) {
+	r.Issues = append(r.Issues, validationIssue{Severity: validationSeverityWarning, Resource: resource, Message: fmt.Sprintf(format, args...)})
+}
+
+// runValidation validates the configuration and component manifests, prints the report to stdout
+// and exits without starting any servers. The exit code is 1 when errors were found.
+func (a *DaprRuntime) runValidation(opts *runtimeOpts) {
+	report := a.validateResources(opts)
+
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		log.Fatalf("failed to marshal validation report: %s", err)
+	}
+	fmt.Println(string(b))
+
+	if !report.Valid {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+// validateResources loads the configuration and component manifests and validates their schema and cross references
+func (a *DaprRuntime) validateResources(opts *runtimeOpts) *validationReport {
+	report := &validationReport{
+		AppID:         a.runtimeConfig.ID,
+		Mode:          string(a.runtimeConfig.Mode),
+		Configuration: a.runtimeConfig.GlobalConfig,
+		Issues:        []validationIssue{},
+	}
+
+	if a.globalConfigErr != nil {
+		report.addError(configurationResource(a.runtimeConfig.GlobalConfig), "failed to load configuration: %s", a.globalConfigErr)
+	}
+
+	comps, err := a.loadComponentsForValidation(report)
+	if err != nil {
+		report.addError("components", "failed to load components: %s", err)
+	}
+	report.Components = len(comps)
+
+	a.validateComponents(report, comps, getRegisteredComponentTypes(opts))
+	a.validateConfiguration(report, comps)
+
+	report.Valid = true
+	for _, issue := range report.Issues {
+		if issue.Severity == validationSeverityError {
+			report.Valid = false
+			break
+		}
+	}
+	return report
+}
+
+func (a *DaprRuntime) loadComponentsForValidation(report *validationReport) ([]components_v1alpha1.Component, error) {
+	switch a.runtimeConfig.Mode {
+	case modes.KubernetesMode:
+		err := a.establishSecurity(a.runtimeConfig.SentryServiceAddress)
+		if err != nil {
+			return nil, err
+		}
+		a.operatorClient, err = a.getOperatorClient()
+		if err != nil {
+			return nil, err
+		}
+		return components.NewKubernetesComponents(a.runtimeConfig.Kubernetes, a.operatorClient).LoadComponents()
+	case modes.StandaloneMode:
+		comps, errs, err := components.NewStandaloneComponents(a.runtimeConfig.Standalone).LoadComponentsWithErrors()
+		for _, e := range errs {
+			report.addError("components", "%s", e)
+		}
+		return comps, err
+	default:
+		return nil, fmt.Errorf("components loader for mode %s not found", a.runtimeConfig.Mode)
+	}
+}
+
+// getRegisteredComponentTypes returns the full type names of the components compiled into the runtime
+func getRegisteredComponentTypes(opts *runtimeOpts) map[string]bool {
+	types := map[string]bool{}
+	for _, s := range opts.secretStores {
+		types["secretstores."+s.Name] = true
+	}
+	for _, s := range opts.states {
+		types["state."+s.Name] = true
+	}
+	for _, p := range opts.pubsubs {
+		types["pubsub."+p.Name] = true
+	}
+	for _, e := range opts.exporters {
+		types["exporters."+e.Name] = true
+	}
+	for _, b := range opts.inputBindings {
+		types["bindings."+b.Name] = true
+	}
+	for _, b := range opts.outputBindings {
+		types["bindings."+b.Name] = true
+	}
+	for _, m := range opts.httpMiddleware {
+		types["middleware.http."+m.Name] = true
+	}
+	return types
+}
+
+func (a *DaprRuntime) validateComponents(report *validationReport, comps []components_v1alpha1.Component, registeredTypes map[string]bool) {
+	secretStores := map[string]bool{}
+	for _, c := range comps {
+		if strings.Index(c.Spec.Type, "secretstores.") == 0 {
+			secretStores[c.ObjectMeta.Name] = true
+		}
+	}
+
+	_, dependencyErrs := checkComponentDependencies(comps)
+	failoverErrs := checkFailoverComponents(comps)
+
+	names := map[string]bool{}
+	for _, c := range comps {
+		resource := componentResource(c)
+		if err, ok := dependencyErrs[c.ObjectMeta.Name]; ok {
+			report.addError(resource, "component can't be initialized: %s", err)
+		}
+		if err, ok := failoverErrs[c.ObjectMeta.Name]; ok {
+			report.addError(resource, "invalid failover configuration: %s", err)
+		}
+
+		if c.ObjectMeta.Name == "" {
+			report.addError(resource, "component name is required")
+		} else {
+			key := c.ObjectMeta.Namespace + "/" + c.ObjectMeta.Name
+			if names[key] {
+				report.addError(resource, "duplicate component name %s", c.ObjectMeta.Name)
+			}
+			names[key] = true
+		}
+
+		if c.Spec.Type == "" {
+			report.addError(resource, "spec.type is required")
+		} else if !isKnownComponentCategory(c.Spec.Type) {
+			report.addError(resource, "unknown component category for type %s", c.Spec.Type)
+		} else if len(registeredTypes) > 0 && !registeredTypes[c.Spec.Type] {
+			report.addError(resource, "component type %s is not registered in this runtime", c.Spec.Type)
+		}
+
+		if c.Spec.InitTimeout != "" {
+			if _, err := time.ParseDuration(c.Spec.InitTimeout); err != nil {
+				report.addError(resource, "invalid spec.initTimeout %s: %s", c.Spec.InitTimeout, err)
+			}
+		}
+
+		hasSecretRefs := false
+		for i, m := range c.Spec.Metadata {
+			if m.Name == "" {
+				report.addError(resource, "spec.metadata[%v].name is required", i)
+			}
+			if m.SecretKeyRef.Name == "" {
+				if m.SecretKeyRef.Key != "" {
+					report.addError(resource, "spec.metadata[%v].secretKeyRef.name is required when a key is set", i)
+				}
+				continue
+			}
+			hasSecretRefs = true
+			if m.Value != "" {
+				report.addWarning(resource, "spec.metadata[%v] has both a value and a secretKeyRef, the secret takes precedence", i)
+			}
+		}
+
+		if c.Auth.SecretStore != "" && !secretStores[c.Auth.SecretStore] {
+			if !(a.runtimeConfig.Mode == modes.KubernetesMode && c.Auth.SecretStore == "kubernetes") {
+				report.addError(resource, "auth.secretStore %s doesn't reference a secret store component", c.Auth.SecretStore)
+			}
+		}
+		if hasSecretRefs && c.Auth.SecretStore == "" && a.runtimeConfig.Mode == modes.StandaloneMode {
+			report.addError(resource, "secretKeyRef requires auth.secretStore in %s mode", modes.StandaloneMode)
+		}
+
+		a.validateComponentScopes(report, c)
+	}
+}
+
+func (a *DaprRuntime) validateComponentScopes(report *validationReport, c components_v1alpha1.Component) {
+	resource := componentResource(c)
+	scopes := map[string]bool{}
+	for i, s := range c.Scopes {
+		if strings.TrimSpace(s) == "" {
+			report.addError(resource, "scopes[%v] is empty", i)
+			continue
+		}
+		if scopes[s] {
+			report.addWarning(resource, "duplicate scope %s", s)
+		}
+		scopes[s] = true
+	}
+
+	if len(scopes) > 0 && a.runtimeConfig.ID != "" && !scopes[a.runtimeConfig.ID] {
+		report.addWarning(resource, "component is not scoped to app %s and will not be loaded", a.runtimeConfig.ID)
+	}
+}
+
+func (a *DaprRuntime) validateConfiguration(report *validationReport, comps []components_v1alpha1.Component) {
+	if a.globalConfig == nil {
+		return
+	}
+	resource := configurationResource(a.runtimeConfig.GlobalConfig)
+	spec := a.globalConfig.Spec
+
+	if spec.TracingSpec.SamplingRate != "" {
+		rate, err := strconv.ParseFloat(spec.TracingSpec.SamplingRate, 64)
+		if err != nil || rate < 0 || rate > 1 {
+			report.addError(resource, "tracing.samplingRate %s must be a number between 0 and 1", spec.TracingSpec.SamplingRate)
+		}
+	}
+
+	if spec.MTLSSpec.WorkloadCertTTL != "" {
+		if _, err := time.ParseDuration(spec.MTLSSpec.WorkloadCertTTL); err != nil {
+			report.addError(resource, "invalid mtls.workloadCertTTL %s: %s", spec.MTLSSpec.WorkloadCertTTL, err)
+		}
+	}
+	if spec.MTLSSpec.AllowedClockSkew != "" {
+		if _, err := time.ParseDuration(spec.MTLSSpec.AllowedClockSkew); err != nil {
+			report.addError(resource, "invalid mtls.allowedClockSkew %s: %s", spec.MTLSSpec.AllowedClockSkew, err)
+		}
+	}
+
+	if !isValidPolicyAction(spec.CrossNamespaceSpec.DefaultAction, true) {
+		report.addError(resource, "crossNamespaceInvocation.defaultAction must be %s or %s", config.AllowAction, config.DenyAction)
+	}
+	for i, r := range spec.CrossNamespaceSpec.Rules {
+		if !isValidPolicyAction(r.Action, false) {
+			report.addError(resource, "crossNamespaceInvocation.rules[%v].action must be %s or %s", i, config.AllowAction, config.DenyAction)
+		}
+	}
+	if !spec.CrossNamespaceSpec.Enabled && (spec.CrossNamespaceSpec.DefaultAction != "" || len(spec.CrossNamespaceSpec.Rules) > 0) {
+		report.addWarning(resource, "crossNamespaceInvocation policy has no effect unless crossNamespaceInvocation.enabled is true")
+	}
+
+	if _, err := messaging.NewHeaderPolicy(spec.HeaderForwardingSpec.Request); err != nil {
+		report.addError(resource, "invalid headerForwarding.request: %s", err)
+	}
+	if _, err := messaging.NewHeaderPolicy(spec.HeaderForwardingSpec.Response); err != nil {
+		report.addError(resource, "invalid headerForwarding.response: %s", err)
+	}
+
+	for i, h := range spec.HTTPPipelineSpec.Handlers {
+		found := false
+		for _, c := range comps {
+			if c.ObjectMeta.Name == h.Name && c.Spec.Type == h.Type {
+				found = true
+				break
+			}
+		}
+		if !found {
+			report.addError(resource, "httpPipeline.handlers[%v] references missing middleware component with name %s and type %s", i, h.Name, h.Type)
+		}
+	}
+}
+
+func isValidPolicyAction(action string, allowEmpty bool) bool {
+	switch strings.ToLower(action) {
+	case config.AllowAction, config.DenyAction:
+		return true
+	case "":
+		return allowEmpty
+	default:
+		return false
+	}
+}
+
+func isKnownComponentCategory(componentType string) bool {
+	for _, category := range componentCategories {
+		if strings.Index(componentType, category) == 0 && len(componentType) > len(category) {
+			return true
+		}
+	}
+	return false
+}
+
+func componentResource(c components_v1alpha1.Component) string {
+	return fmt.Sprintf("component/%s", c.ObjectMeta.Name)
+}
+
+func configurationResource(name string) string {
+	return fmt.Sprintf("configuration/%s", name)
+}
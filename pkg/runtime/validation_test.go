@@ -0,0 +1,175 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dapr/components-contrib/state"
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	state_loader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/config"
+	"github.com/dapr/dapr/pkg/modes"
+	"github.com/stretchr/testify/assert"
+)
+
+func countIssues(report *validationReport, severity string) int {
+	count := 0
+	for _, issue := range report.Issues {
+		if issue.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+func TestValidateComponents(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	registered := map[string]bool{"state.redis": true, "secretstores.local.file": true}
+
+	t.Run("valid components", func(t *testing.T) {
+		store := newStateStoreComponent("store", "state.redis", "10s")
+		store.Auth.SecretStore = "secrets"
+		store.Spec.Metadata = []components_v1alpha1.MetadataItem{
+			{Name: "redisPassword", SecretKeyRef: components_v1alpha1.SecretKeyRef{Name: "redis", Key: "password"}},
+		}
+		store.Scopes = []string{TestRuntimeConfigID}
+		secrets := newStateStoreComponent("secrets", "secretstores.local.file", "")
+
+		report := &validationReport{}
+		rt.validateComponents(report, []components_v1alpha1.Component{store, secrets}, registered)
+		assert.Empty(t, report.Issues)
+	})
+
+	t.Run("schema errors", func(t *testing.T) {
+		report := &validationReport{}
+		rt.validateComponents(report, []components_v1alpha1.Component{
+			newStateStoreComponent("", "state.redis", ""),
+			newStateStoreComponent("store", "", ""),
+			newStateStoreComponent("unknown", "queue.redis", ""),
+			newStateStoreComponent("unregistered", "state.mongodb", ""),
+			newStateStoreComponent("timeout", "state.redis", "soon"),
+		}, registered)
+		assert.Equal(t, 5, countIssues(report, validationSeverityError))
+	})
+
+	t.Run("duplicate names", func(t *testing.T) {
+		report := &validationReport{}
+		rt.validateComponents(report, []components_v1alpha1.Component{
+			newStateStoreComponent("store", "state.redis", ""),
+			newStateStoreComponent("store", "state.redis", ""),
+		}, registered)
+		assert.Equal(t, 1, countIssues(report, validationSeverityError))
+	})
+
+	t.Run("missing secret store reference", func(t *testing.T) {
+		store := newStateStoreComponent("store", "state.redis", "")
+		store.Auth.SecretStore = "vault"
+		report := &validationReport{}
+		rt.validateComponents(report, []components_v1alpha1.Component{store}, registered)
+		assert.Equal(t, 1, countIssues(report, validationSeverityError))
+	})
+
+	t.Run("secretKeyRef without secret store in standalone mode", func(t *testing.T) {
+		store := newStateStoreComponent("store", "state.redis", "")
+		store.Spec.Metadata = []components_v1alpha1.MetadataItem{
+			{Name: "redisPassword", SecretKeyRef: components_v1alpha1.SecretKeyRef{Name: "redis"}},
+		}
+		report := &validationReport{}
+		rt.validateComponents(report, []components_v1alpha1.Component{store}, registered)
+		assert.Equal(t, 1, countIssues(report, validationSeverityError))
+	})
+
+	t.Run("dependencies", func(t *testing.T) {
+		report := &validationReport{}
+		rt.validateComponents(report, []components_v1alpha1.Component{
+			newDependentComponent("store", "state.redis", "secrets"),
+			newDependentComponent("secrets", "secretstores.local.file", "store"),
+		}, registered)
+		assert.Equal(t, 2, countIssues(report, validationSeverityError))
+	})
+
+	t.Run("scopes", func(t *testing.T) {
+		store := newStateStoreComponent("store", "state.redis", "")
+		store.Scopes = []string{"other", "other", ""}
+		report := &validationReport{}
+		rt.validateComponents(report, []components_v1alpha1.Component{store}, registered)
+		assert.Equal(t, 1, countIssues(report, validationSeverityError))
+		assert.Equal(t, 2, countIssues(report, validationSeverityWarning))
+	})
+}
+
+func TestValidateConfiguration(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.globalConfig = &config.Configuration{
+		Spec: config.ConfigurationSpec{
+			TracingSpec: config.TracingSpec{SamplingRate: "2"},
+			MTLSSpec:    config.MTLSSpec{WorkloadCertTTL: "1d"},
+			CrossNamespaceSpec: config.CrossNamespaceSpec{
+				DefaultAction: "deny",
+				Rules:         []config.CrossNamespaceRule{{Namespace: "prod-*", Action: "block"}},
+			},
+			HeaderForwardingSpec: config.HeaderForwardingSpec{
+				Request: config.HeaderRulesSpec{Rename: map[string]string{"x-*": "y"}},
+			},
+			HTTPPipelineSpec: config.PipelineSpec{
+				Handlers: []config.HandlerSpec{
+					{Name: "upper", Type: "middleware.http.uppercase"},
+					{Name: "oauth", Type: "middleware.http.oauth2"},
+				},
+			},
+		},
+	}
+
+	report := &validationReport{}
+	rt.validateConfiguration(report, []components_v1alpha1.Component{
+		newStateStoreComponent("upper", "middleware.http.uppercase", ""),
+	})
+	assert.Equal(t, 5, countIssues(report, validationSeverityError))
+	assert.Equal(t, 1, countIssues(report, validationSeverityWarning))
+}
+
+func TestValidateResources(t *testing.T) {
+	dir, err := ioutil.TempDir("", "components")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	manifests := `apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: statestore
+spec:
+  type: state.mock
+  metadata:
+  - name: host
+    value: localhost
+---
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+  name: messagebus
+spec:
+  type: pubsub.missing
+`
+	err = ioutil.WriteFile(filepath.Join(dir, "components.yaml"), []byte(manifests), 0600)
+	assert.NoError(t, err)
+
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	rt.runtimeConfig.Standalone.ComponentsPath = dir
+	opts := &runtimeOpts{}
+	WithStates(state_loader.New("mock", func() state.Store {
+		return &mockSlowStateStore{}
+	}))(opts)
+
+	report := rt.validateResources(opts)
+	assert.False(t, report.Valid)
+	assert.Equal(t, 2, report.Components)
+	assert.Len(t, report.Issues, 1)
+	assert.Equal(t, "component/messagebus", report.Issues[0].Resource)
+}
@@ -0,0 +1,45 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+// expandTopic returns the topics to subscribe to for a topic pattern that the app is allowed to subscribe to
+func (a *DaprRuntime) expandTopic(pattern string) ([]string, error) {
+	brokerTopics, err := runtime_pubsub.ExpandTopic(a.pubSub, a.topicNamespace.BrokerTopic(pattern))
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := []string{}
+	for _, bt := range brokerTopics {
+		t, ok := a.topicNamespace.AppTopic(bt)
+		if !ok {
+			continue
+		}
+		if t != pattern && runtime_pubsub.MatchSubscription(a.subscribedTopics, t) != pattern {
+			// the topic has its own subscription or a more specific pattern
+			continue
+		}
+		if !a.isPubSubOperationAllowed(t, a.scopedSubscriptions) {
+			log.Debugf("topic %s of pattern %s is not allowed", t, pattern)
+			continue
+		}
+		if err := a.authorizeSubscription(t); err != nil {
+			log.Debugf("topic %s of pattern %s is not allowed: %s", t, pattern, err)
+			continue
+		}
+		allowed = append(allowed, t)
+	}
+	return allowed, nil
+}
+
+// subscriptionTopic returns the subscribed topic or topic pattern messages of the topic are delivered for
+func (a *DaprRuntime) subscriptionTopic(topic string) string {
+	return runtime_pubsub.MatchSubscription(a.subscribedTopics, topic)
+}
@@ -0,0 +1,77 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/dapr/pkg/http"
+	"github.com/dapr/dapr/pkg/modes"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockTopicListingPubSub struct {
+	mockPublishPubSub
+	topics     []string
+	subscribed []string
+}
+
+func (m *mockTopicListingPubSub) ListTopics() ([]string, error) {
+	return m.topics, nil
+}
+
+func (m *mockTopicListingPubSub) Subscribe(req pubsub.SubscribeRequest, handler func(msg *pubsub.NewMessage) error) error {
+	m.subscribed = append(m.subscribed, req.Topic)
+	return nil
+}
+
+func TestSubscribeWildcardTopic(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	ps := &mockTopicListingPubSub{topics: []string{"orders.created", "orders.updated", "orders.eu.created", "payments.created"}}
+	rt.pubSub = ps
+	rt.pubSubName = "messagebus"
+	rt.topicRoutes = map[string]string{"orders.*": "orders", "orders.updated": "updates"}
+	rt.subscribedTopics = []string{"orders.*", "orders.updated"}
+
+	rt.subscribeTopic("orders.*", "orders", func(msg *pubsub.NewMessage) error { return nil })
+
+	assert.Equal(t, []string{"orders.created"}, ps.subscribed)
+	assert.Equal(t, "orders.*", rt.subscriptionTopic("orders.created"))
+	assert.Equal(t, "orders.updated", rt.subscriptionTopic("orders.updated"))
+	assert.Equal(t, http.SubscriptionStatusActive, rt.getSubscriptionsMetadata()[0].Status)
+
+	t.Run("component without wildcard support", func(t *testing.T) {
+		rt.pubSub = &mockPublishPubSub{}
+		rt.subscribeTopic("payments.*", "payments", func(msg *pubsub.NewMessage) error { return nil })
+		assert.Equal(t, http.SubscriptionStatusFailed, rt.getSubscriptionsMetadata()[1].Status)
+	})
+}
+
+func TestSubscribeNamespaceTopics(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	ps := &mockTopicListingPubSub{topics: []string{"prod.orders.created", "staging.orders.created", "orders.created"}}
+	rt.pubSub = ps
+	rt.pubSubName = "messagebus"
+	rt.topicNamespace, _, _ = runtime_pubsub.TopicNamespaceFromMetadata(map[string]string{runtime_pubsub.NamespaceTopicsMetadataKey: "true"}, "prod")
+	rt.topicRoutes = map[string]string{"orders.*": "orders", "payments": "payments"}
+	rt.subscribedTopics = []string{"orders.*", "payments"}
+
+	handler := func(msg *pubsub.NewMessage) error { return nil }
+	rt.subscribeTopic("orders.*", "orders", handler)
+	rt.subscribeTopic("payments", "payments", handler)
+	assert.Equal(t, []string{"prod.orders.created", "prod.payments"}, ps.subscribed)
+
+	var delivered []string
+	deliver := rt.stripTopicNamespace(func(msg *pubsub.NewMessage) error {
+		delivered = append(delivered, msg.Topic)
+		return nil
+	})
+	assert.NoError(t, deliver(&pubsub.NewMessage{Topic: "prod.orders.created"}))
+	assert.NoError(t, deliver(&pubsub.NewMessage{Topic: "staging.orders.created"}))
+	assert.Equal(t, []string{"orders.created"}, delivered)
+}
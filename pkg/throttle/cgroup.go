@@ -0,0 +1,83 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package throttle
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultCgroupRoot is where the cgroup filesystem is mounted
+	DefaultCgroupRoot = "/sys/fs/cgroup"
+
+	// cgroup v2 files
+	cgroupV2Usage = "memory.current"
+	cgroupV2Limit = "memory.max"
+	// cgroup v1 files
+	cgroupV1Usage = "memory/memory.usage_in_bytes"
+	cgroupV1Limit = "memory/memory.limit_in_bytes"
+
+	// cgroupV1Unlimited is the limit above which cgroup v1 reports no limit, it is rounded down to the page size
+	cgroupV1Unlimited = 1 << 62
+)
+
+// MemoryReader reads the memory used by the process and the limit it runs under.
+// The limit is zero when the process has no memory limit.
+type MemoryReader interface {
+	Memory() (usage, limit uint64, err error)
+}
+
+// cgroupMemoryReader reads the memory usage and limit of the cgroup of the process
+type cgroupMemoryReader struct {
+	root string
+}
+
+// NewCgroupMemoryReader returns a reader of the memory of the cgroup mounted at root, either cgroup v2 or v1
+func NewCgroupMemoryReader(root string) MemoryReader {
+	return &cgroupMemoryReader{root: root}
+}
+
+func (r *cgroupMemoryReader) Memory() (uint64, uint64, error) {
+	usageFile, limitFile := cgroupV2Usage, cgroupV2Limit
+	if _, err := os.Stat(filepath.Join(r.root, cgroupV2Usage)); err != nil {
+		usageFile, limitFile = cgroupV1Usage, cgroupV1Limit
+	}
+
+	usage, err := readCgroupValue(filepath.Join(r.root, usageFile))
+	if err != nil {
+		return 0, 0, err
+	}
+	limit, err := readCgroupValue(filepath.Join(r.root, limitFile))
+	if err != nil {
+		return 0, 0, err
+	}
+	if limit >= cgroupV1Unlimited {
+		limit = 0
+	}
+	return usage, limit, nil
+}
+
+// readCgroupValue reads a number of bytes from a cgroup file. "max" means no limit and is read as zero.
+func readCgroupValue(path string) (uint64, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	value := strings.TrimSpace(string(b))
+	if value == "max" {
+		return 0, nil
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing %s: %s", path, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,61 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package throttle
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeCgroupFiles(t *testing.T, files map[string]string) string {
+	root, err := ioutil.TempDir("", "cgroup")
+	assert.NoError(t, err)
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	}
+	return root
+}
+
+func TestCgroupMemoryReader(t *testing.T) {
+	t.Run("cgroup v2", func(t *testing.T) {
+		root := writeCgroupFiles(t, map[string]string{cgroupV2Usage: "100\n", cgroupV2Limit: "400\n"})
+		defer os.RemoveAll(root)
+		usage, limit, err := NewCgroupMemoryReader(root).Memory()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(100), usage)
+		assert.Equal(t, uint64(400), limit)
+	})
+
+	t.Run("cgroup v2 without limit", func(t *testing.T) {
+		root := writeCgroupFiles(t, map[string]string{cgroupV2Usage: "100\n", cgroupV2Limit: "max\n"})
+		defer os.RemoveAll(root)
+		_, limit, err := NewCgroupMemoryReader(root).Memory()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), limit)
+	})
+
+	t.Run("cgroup v1 without limit", func(t *testing.T) {
+		root := writeCgroupFiles(t, map[string]string{cgroupV1Usage: "100\n", cgroupV1Limit: "9223372036854771712\n"})
+		defer os.RemoveAll(root)
+		usage, limit, err := NewCgroupMemoryReader(root).Memory()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(100), usage)
+		assert.Equal(t, uint64(0), limit)
+	})
+
+	t.Run("no cgroup", func(t *testing.T) {
+		root := writeCgroupFiles(t, nil)
+		defer os.RemoveAll(root)
+		_, _, err := NewCgroupMemoryReader(root).Memory()
+		assert.Error(t, err)
+	})
+}
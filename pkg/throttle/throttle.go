@@ -0,0 +1,94 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package throttle
+
+import (
+	"sync"
+	"time"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/logger"
+)
+
+const (
+	// DefaultMemoryRatio is the ratio of the memory limit above which bulk operations are throttled
+	DefaultMemoryRatio = 0.8
+
+	// maxMemoryRatio is the ratio of the memory limit at which bulk operations run one item at a time
+	maxMemoryRatio = 0.95
+	// sampleInterval is how long a memory reading is reused
+	sampleInterval = time.Second
+)
+
+var log = logger.NewLogger("dapr.runtime.throttle")
+
+// MemoryThrottle reduces the parallelism of bulk operations when the sidecar approaches its memory limit.
+// Between the throttling ratio and 95% of the limit the parallelism decreases linearly, down to one.
+// A nil MemoryThrottle never throttles.
+type MemoryThrottle struct {
+	reader MemoryReader
+	ratio  float64
+
+	lock     sync.Mutex
+	sampled  time.Time
+	usage    float64
+	disabled bool
+}
+
+// NewMemoryThrottle returns a throttle starting to reduce parallelism above ratio of the memory limit
+func NewMemoryThrottle(reader MemoryReader, ratio float64) *MemoryThrottle {
+	return &MemoryThrottle{
+		reader: reader,
+		ratio:  ratio,
+	}
+}
+
+// Parallelism returns how many items of the named bulk operation may be processed at once,
+// out of the requested parallelism
+func (t *MemoryThrottle) Parallelism(operation string, requested int) int {
+	if t == nil || requested <= 1 {
+		return requested
+	}
+
+	usage := t.memoryUsage()
+	if usage <= t.ratio {
+		return requested
+	}
+
+	allowed := 1
+	if usage < maxMemoryRatio {
+		allowed = 1 + int(float64(requested-1)*(maxMemoryRatio-usage)/(maxMemoryRatio-t.ratio))
+	}
+	if allowed < requested {
+		log.Debugf("memory usage at %.0f%% of the limit: throttling %s from %v to %v", usage*100, operation, requested, allowed)
+		diag.DefaultMonitoring.BulkOperationThrottled(operation)
+	}
+	return allowed
+}
+
+// memoryUsage returns the memory used as a ratio of the limit, zero when it is unknown
+func (t *MemoryThrottle) memoryUsage() float64 {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.disabled || time.Since(t.sampled) < sampleInterval {
+		return t.usage
+	}
+	t.sampled = time.Now()
+
+	usage, limit, err := t.reader.Memory()
+	if err != nil {
+		log.Warnf("error reading the memory usage, bulk operations won't be throttled: %s", err)
+		t.disabled = true
+		t.usage = 0
+		return 0
+	}
+	t.usage = 0
+	if limit > 0 {
+		t.usage = float64(usage) / float64(limit)
+	}
+	return t.usage
+}
@@ -0,0 +1,63 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package throttle
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeMemoryReader struct {
+	usage, limit uint64
+	err          error
+	reads        int
+}
+
+func (r *fakeMemoryReader) Memory() (uint64, uint64, error) {
+	r.reads++
+	return r.usage, r.limit, r.err
+}
+
+func TestMemoryThrottle(t *testing.T) {
+	t.Run("nil throttle", func(t *testing.T) {
+		var throttle *MemoryThrottle
+		assert.Equal(t, 10, throttle.Parallelism("op", 10))
+	})
+
+	t.Run("below the ratio", func(t *testing.T) {
+		throttle := NewMemoryThrottle(&fakeMemoryReader{usage: 50, limit: 100}, 0.8)
+		assert.Equal(t, 10, throttle.Parallelism("op", 10))
+	})
+
+	t.Run("no memory limit", func(t *testing.T) {
+		throttle := NewMemoryThrottle(&fakeMemoryReader{usage: 50}, 0.8)
+		assert.Equal(t, 10, throttle.Parallelism("op", 10))
+	})
+
+	t.Run("reduces parallelism linearly", func(t *testing.T) {
+		reader := &fakeMemoryReader{usage: 875, limit: 1000}
+		throttle := NewMemoryThrottle(reader, 0.8)
+		assert.Equal(t, 6, throttle.Parallelism("op", 11))
+		assert.Equal(t, 1, throttle.Parallelism("op", 1))
+		assert.Equal(t, 1, reader.reads)
+	})
+
+	t.Run("one at a time near the limit", func(t *testing.T) {
+		throttle := NewMemoryThrottle(&fakeMemoryReader{usage: 99, limit: 100}, 0.8)
+		assert.Equal(t, 1, throttle.Parallelism("op", 10))
+	})
+
+	t.Run("unreadable memory disables throttling", func(t *testing.T) {
+		reader := &fakeMemoryReader{err: errors.New("no cgroup")}
+		throttle := NewMemoryThrottle(reader, 0.8)
+		assert.Equal(t, 10, throttle.Parallelism("op", 10))
+		throttle.sampled = throttle.sampled.Add(-sampleInterval)
+		assert.Equal(t, 10, throttle.Parallelism("op", 10))
+		assert.Equal(t, 1, reader.reads)
+	})
+}